@@ -59,8 +59,12 @@ func (s Service) ListRuntimeEntries(component string) ([]string, error) {
 	return s.base.ListRuntimeEntries(component)
 }
 
-func (s Service) NewRunNumber() (runNumber uint32, err error) {
-	return s.base.NewRunNumber()
+func (s Service) NewRunNumber(envId string, requester string) (runNumber uint32, err error) {
+	return s.base.NewRunNumber(envId, requester)
+}
+
+func (s Service) GetRunNumberAllocations(query *configuration.RunNumberAllocationsQuery) (allocations []*configuration.RunNumberAllocation, err error) {
+	return s.base.GetRunNumberAllocations(query)
 }
 
 func (s Service) GetDefaults() map[string]string {
@@ -107,8 +111,20 @@ func (s Service) ResolveComponentQuery(query *componentcfg.Query) (resolved *com
 	return s.base.ResolveComponentQuery(query)
 }
 
-func (s Service) ImportComponentConfiguration(query *componentcfg.Query, payload string, newComponent bool) (existingComponentUpdated bool, existingEntryUpdated bool, err error) {
-	return s.base.ImportComponentConfiguration(query, payload, newComponent)
+func (s Service) ImportComponentConfiguration(query *componentcfg.Query, payload string, newComponent bool, format string) (existingComponentUpdated bool, existingEntryUpdated bool, err error) {
+	return s.base.ImportComponentConfiguration(query, payload, newComponent, format)
+}
+
+func (s Service) ValidateComponentConfiguration(query *componentcfg.Query, payload string, format string, varStack map[string]string) (result *componentcfg.ValidationResult, err error) {
+	return s.base.ValidateComponentConfiguration(query, payload, format, varStack)
+}
+
+func (s Service) ListComponentSchemas(component string) (schemas map[string]string, err error) {
+	return s.base.ListComponentSchemas(component)
+}
+
+func (s Service) ImportComponentSchema(component string, name string, payload string) error {
+	return s.base.ImportComponentSchema(component, name, payload)
 }
 
 func (s Service) GetDetectorForHost(hostname string) (string, error) {
@@ -155,6 +171,28 @@ func (s Service) RawGetRecursive(path string) (string, error) {
 	return s.base.RawGetRecursive(path)
 }
 
+func (s Service) RawPutRecursive(path string, payload string) error {
+	return s.base.RawPutRecursive(path, payload)
+}
+
+// Secrets are never cached, so that a change or a revocation takes effect
+// on the next task launch.
+func (s Service) GetSecret(name string) (string, error) {
+	return s.base.GetSecret(name)
+}
+
+func (s Service) SetSecret(name string, value string) error {
+	return s.base.SetSecret(name, value)
+}
+
+func (s Service) DeleteSecret(name string) error {
+	return s.base.DeleteSecret(name)
+}
+
+func (s Service) ListSecrets() ([]string, error) {
+	return s.base.ListSecrets()
+}
+
 func (s Service) InvalidateComponentTemplateCache() {
 	s.base.InvalidateComponentTemplateCache()
 }
@@ -44,16 +44,20 @@ func setDefaults() error {
 	viper.SetDefault("workingDir", "/var/lib/o2/apricot")
 	viper.SetDefault("verbose", false)
 	viper.SetDefault("trimSpaceInVarsFromConsulKV", true)
+	viper.SetDefault("secretsKeyFile", "")
+	viper.SetDefault("secretsTokenFile", "")
 	return nil
 }
 
 func setFlags() error {
 	pflag.Int("listenPort", viper.GetInt("listenPort"), "Port of apricot server")
 	pflag.Int("httpListenPort", viper.GetInt("listenPort"), "Port of apricot http server")
-	pflag.String("backendUri", viper.GetString("backendUri"), "URI of the Consul server or YAML configuration file")
+	pflag.String("backendUri", viper.GetString("backendUri"), "URI of the Consul server, etcd server or YAML configuration file")
 	pflag.Bool("verbose", viper.GetBool("verbose"), "Verbose logging")
 	pflag.Bool("trimSpaceInVarsFromConsulKV", viper.GetBool("trimSpaceInVarsFromConsulKV"), "When true, the variables imported from the Consul KV are trimmed if the contain whitespaces")
 	pflag.String("workingDir", viper.GetString("workingDir"), "Working directory for apricot")
+	pflag.String("secretsKeyFile", viper.GetString("secretsKeyFile"), "File with a base64-encoded 256-bit key used to encrypt secrets at rest (optional)")
+	pflag.String("secretsTokenFile", viper.GetString("secretsTokenFile"), "File with the token clients must present to access secrets (secrets RPCs are disabled if not set)")
 
 	pflag.Parse()
 	return viper.BindPFlags(pflag.CommandLine)
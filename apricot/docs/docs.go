@@ -420,6 +420,174 @@ const docTemplate = `{
                 }
             }
         },
+        "/components/{component}/{runtype}/{rolename}/{entry}/validate": {
+            "get": {
+                "description": "Checks the syntax of a configuration payload according to its format, and validates it against the schema associated with the given entry, if any. Template processing is performed before validation, with defaults, vars and the schema's sample var stack; any number of additional string key-value pairs may be passed as query parameters to extend the var stack. With GET, the currently stored entry is validated; with POST, the request body is validated as the payload for the given entry, without storing it.",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "component configuration"
+                ],
+                "summary": "Validates a configuration payload for a given component, run type, role name and entry key",
+                "parameters": [
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "ini",
+                            "toml"
+                        ],
+                        "type": "string",
+                        "description": "Payload format, overridden by the format declared in the schema",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration component",
+                        "name": "component",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O² Run type, must be capitalized",
+                        "name": "runtype",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role name",
+                        "name": "rolename",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entry key",
+                        "name": "entry",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Configuration payload to validate (POST only)",
+                        "name": "payload",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Validation result",
+                        "schema": {
+                            "$ref": "#/definitions/local.ValidationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request, if a parameter is invalid",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Checks the syntax of a configuration payload according to its format, and validates it against the schema associated with the given entry, if any. Template processing is performed before validation, with defaults, vars and the schema's sample var stack; any number of additional string key-value pairs may be passed as query parameters to extend the var stack. With GET, the currently stored entry is validated; with POST, the request body is validated as the payload for the given entry, without storing it.",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "component configuration"
+                ],
+                "summary": "Validates a configuration payload for a given component, run type, role name and entry key",
+                "parameters": [
+                    {
+                        "enum": [
+                            "json",
+                            "yaml",
+                            "ini",
+                            "toml"
+                        ],
+                        "type": "string",
+                        "description": "Payload format, overridden by the format declared in the schema",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Configuration component",
+                        "name": "component",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "O² Run type, must be capitalized",
+                        "name": "runtype",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role name",
+                        "name": "rolename",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entry key",
+                        "name": "entry",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Configuration payload to validate (POST only)",
+                        "name": "payload",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Validation result",
+                        "schema": {
+                            "$ref": "#/definitions/local.ValidationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request, if a parameter is invalid",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/inventory/detectors/{detector}/flps/{format}": {
             "get": {
                 "description": "Returns the list of all Apricot-managed hosts in the cluster that are known to be FLPs and serving the given detector, newline-separated or JSON depending on the format parameter",
@@ -518,6 +686,181 @@ const docTemplate = `{
                     }
                 }
             }
+        },
+        "/runs": {
+            "get": {
+                "description": "Every run number handed out by Apricot is recorded together with the environment it was allocated to, the user who requested it and a timestamp. The records are kept after the environment is gone. The list can be restricted to a single run number and/or a single environment.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "run numbers"
+                ],
+                "summary": "Returns which environments were allocated which run numbers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Environment ID",
+                        "name": "environmentId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Run number allocations, ordered by run number",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/local.RunNumberAllocationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request, if the run number is invalid",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/runs/{runNumber}": {
+            "get": {
+                "description": "Every run number handed out by Apricot is recorded together with the environment it was allocated to, the user who requested it and a timestamp. The records are kept after the environment is gone. The list can be restricted to a single run number and/or a single environment.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "run numbers"
+                ],
+                "summary": "Returns which environments were allocated which run numbers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Run number",
+                        "name": "runNumber",
+                        "in": "path"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Environment ID",
+                        "name": "environmentId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Run number allocations, ordered by run number",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/local.RunNumberAllocationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request, if the run number is invalid",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/schemas/{component}": {
+            "get": {
+                "description": "Returns a map of schema names to schema documents for the given component. Each schema optionally restricts the entries it applies to with an entry pattern over RUNTYPE/rolename/entry, declares the payload format, and contains a JSON Schema and/or an INI key schema.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "component configuration"
+                ],
+                "summary": "Lists the configuration schemas associated with an Apricot-managed configuration component",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Configuration component",
+                        "name": "component",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Map of schema names to schema documents",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request, if the component name is invalid",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "local.RunNumberAllocationResponse": {
+            "type": "object",
+            "properties": {
+                "environmentId": {
+                    "type": "string"
+                },
+                "requester": {
+                    "type": "string"
+                },
+                "runNumber": {
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "local.ValidationResponse": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "format": {
+                    "type": "string"
+                },
+                "schema": {
+                    "type": "string"
+                },
+                "valid": {
+                    "type": "boolean"
+                }
+            }
         }
     },
     "externalDocs": {
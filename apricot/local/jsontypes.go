@@ -24,6 +24,8 @@
 
 package local
 
+import "time"
+
 type Card struct {
 	Type             string `json:"type"`
 	PciAddress       string `json:"pciAddress"`
@@ -33,3 +35,17 @@ type Card struct {
 	Firware          string `json:"firmware"`
 	UserLogicVersion string `json:"userLogicVersion"`
 }
+
+type ValidationResponse struct {
+	Valid  bool     `json:"valid"`
+	Format string   `json:"format,omitempty"`
+	Schema string   `json:"schema,omitempty"`
+	Errors []string `json:"errors"`
+}
+
+type RunNumberAllocationResponse struct {
+	RunNumber     uint32    `json:"runNumber"`
+	EnvironmentId string    `json:"environmentId"`
+	Requester     string    `json:"requester"`
+	Timestamp     time.Time `json:"timestamp"`
+}
@@ -0,0 +1,287 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package local
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/AliceO2Group/Control/configuration"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	runCounterFile     = "runcounter.txt"
+	runCounterLockFile = "runcounter.lock"
+	runAllocationsFile = "runallocations.jsonl"
+)
+
+// fileRunNumberAllocator hands out run numbers from a counter file, for
+// backends which do not provide an atomic counter.
+// Allocations are serialized across processes with an exclusive flock on a
+// separate lock file, and the counter file is only ever replaced by an fsynced
+// atomic rename, so a crash can leave a gap in the sequence but never a
+// duplicate or a reset run number.
+// Every allocation is appended to an audit log in the same directory.
+type fileRunNumberAllocator struct {
+	dir string
+}
+
+func newFileRunNumberAllocator(dir string) *fileRunNumberAllocator {
+	return &fileRunNumberAllocator{dir: dir}
+}
+
+func (a *fileRunNumberAllocator) next(envId string, requester string) (runNumber uint32, err error) {
+	var unlock func()
+	unlock, err = a.lock()
+	if err != nil {
+		return
+	}
+	defer unlock()
+
+	var current uint32
+	current, err = a.readCounter()
+	if errors.Is(err, os.ErrNotExist) {
+		// the counter is gone, but we must not hand out numbers which were
+		// already allocated, so we resume from the audit log
+		current, err = a.lastAllocated()
+	}
+	if err != nil {
+		return
+	}
+	if current == math.MaxUint32 {
+		err = fmt.Errorf("run number counter in %s overflowed", a.dir)
+		return
+	}
+	runNumber = current + 1
+
+	err = writeFileAtomic(filepath.Join(a.dir, runCounterFile), []byte(strconv.FormatUint(uint64(runNumber), 10)))
+	if err != nil {
+		return 0, fmt.Errorf("cannot store run number %d: %w", runNumber, err)
+	}
+
+	err = a.appendAllocation(&configuration.RunNumberAllocation{
+		RunNumber:     runNumber,
+		EnvironmentId: envId,
+		Requester:     requester,
+		Timestamp:     time.Now().UTC(),
+	})
+	if err != nil {
+		// the number is burned, but it was never recorded, so we don't hand it out
+		return 0, fmt.Errorf("cannot record allocation of run number %d: %w", runNumber, err)
+	}
+	return
+}
+
+func (a *fileRunNumberAllocator) allocations(query *configuration.RunNumberAllocationsQuery) (allocations []*configuration.RunNumberAllocation, err error) {
+	var all []*configuration.RunNumberAllocation
+	all, err = a.readAllocations()
+	if err != nil {
+		return
+	}
+	allocations = make([]*configuration.RunNumberAllocation, 0)
+	for _, allocation := range all {
+		if query.Matches(allocation) {
+			allocations = append(allocations, allocation)
+		}
+	}
+	return
+}
+
+func (a *fileRunNumberAllocator) lock() (unlock func(), err error) {
+	if a.dir != "" {
+		err = os.MkdirAll(a.dir, 0755)
+		if err != nil {
+			return
+		}
+	}
+	var lockFile *os.File
+	lockFile, err = os.OpenFile(filepath.Join(a.dir, runCounterLockFile), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return
+	}
+	for {
+		err = unix.Flock(int(lockFile.Fd()), unix.LOCK_EX)
+		if !errors.Is(err, unix.EINTR) {
+			break
+		}
+	}
+	if err != nil {
+		_ = lockFile.Close()
+		return nil, fmt.Errorf("cannot lock run number counter in %s: %w", a.dir, err)
+	}
+	unlock = func() {
+		_ = unix.Flock(int(lockFile.Fd()), unix.LOCK_UN)
+		_ = lockFile.Close()
+	}
+	return
+}
+
+func (a *fileRunNumberAllocator) readCounter() (uint32, error) {
+	raw, err := os.ReadFile(filepath.Join(a.dir, runCounterFile))
+	if err != nil {
+		return 0, err
+	}
+	rn64, err := strconv.ParseUint(string(bytes.TrimSpace(raw)), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid run number counter in %s: %w", a.dir, err)
+	}
+	return uint32(rn64), nil
+}
+
+func (a *fileRunNumberAllocator) lastAllocated() (last uint32, err error) {
+	var all []*configuration.RunNumberAllocation
+	all, err = a.readAllocations()
+	if err != nil {
+		return
+	}
+	for _, allocation := range all {
+		if allocation.RunNumber > last {
+			last = allocation.RunNumber
+		}
+	}
+	return
+}
+
+func (a *fileRunNumberAllocator) readAllocations() (allocations []*configuration.RunNumberAllocation, err error) {
+	var f *os.File
+	f, err = os.Open(filepath.Join(a.dir, runAllocationsFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return []*configuration.RunNumberAllocation{}, nil
+	}
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	allocations = make([]*configuration.RunNumberAllocation, 0)
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		allocation := &configuration.RunNumberAllocation{}
+		if jsonErr := json.Unmarshal(line, allocation); jsonErr != nil {
+			// most likely a torn write from a crash, the allocation it
+			// belonged to was never handed out
+			log.WithError(jsonErr).
+				WithField("file", f.Name()).
+				WithField("line", lineNo).
+				Warn("skipping malformed run number allocation record")
+			continue
+		}
+		allocations = append(allocations, allocation)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(allocations, func(i, j int) bool {
+		return allocations[i].RunNumber < allocations[j].RunNumber
+	})
+	return
+}
+
+func (a *fileRunNumberAllocator) appendAllocation(allocation *configuration.RunNumberAllocation) error {
+	record, err := json.Marshal(allocation)
+	if err != nil {
+		return err
+	}
+	record = append(record, '\n')
+
+	f, err := os.OpenFile(filepath.Join(a.dir, runAllocationsFile), os.O_RDWR|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	// if a crash left behind a torn record, we terminate it so that it
+	// doesn't swallow this one
+	if fi, statErr := f.Stat(); statErr == nil && fi.Size() > 0 {
+		lastByte := make([]byte, 1)
+		if _, readErr := f.ReadAt(lastByte, fi.Size()-1); readErr == nil && lastByte[0] != '\n' {
+			record = append([]byte{'\n'}, record...)
+		}
+	}
+	if _, err = f.Write(record); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// writeFileAtomic replaces the file at path with data, such that after a crash
+// the file holds either the old or the new contents.
+func writeFileAtomic(path string, data []byte) (err error) {
+	dir := filepath.Dir(path)
+	var tmp *os.File
+	tmp, err = os.CreateTemp(dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if err = tmp.Close(); err != nil {
+		return
+	}
+	if err = os.Chmod(tmp.Name(), 0644); err != nil {
+		return
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return
+	}
+
+	// make the rename itself durable
+	var d *os.File
+	d, err = os.Open(dir)
+	if err != nil {
+		return
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -0,0 +1,186 @@
+package local
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/AliceO2Group/Control/configuration"
+	"github.com/AliceO2Group/Control/configuration/cfgbackend"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("file run number allocator", func() {
+	var (
+		dir       string
+		allocator *fileRunNumberAllocator
+		err       error
+	)
+
+	BeforeEach(func() {
+		dir, err = os.MkdirTemp("", "o2control-runnumber")
+		Expect(err).NotTo(HaveOccurred())
+		allocator = newFileRunNumberAllocator(dir)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("should continue from an existing counter", func() {
+		Expect(os.WriteFile(filepath.Join(dir, runCounterFile), []byte("556122\n"), 0644)).To(Succeed())
+		Expect(allocator.next("2oDvieFrVTi", "jdoe")).To(Equal(uint32(556123)))
+		Expect(os.ReadFile(filepath.Join(dir, runCounterFile))).To(Equal([]byte("556123")))
+	})
+
+	It("should refuse to reset a corrupted counter", func() {
+		Expect(os.WriteFile(filepath.Join(dir, runCounterFile), []byte("55612x"), 0644)).To(Succeed())
+		_, err = allocator.next("2oDvieFrVTi", "jdoe")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should never hand out the same run number twice", func() {
+		const workers, perWorker = 8, 10
+		var (
+			mu     sync.Mutex
+			wg     sync.WaitGroup
+			values = make(map[uint32]struct{})
+		)
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				// each worker gets its own allocator, like separate apricot instances would
+				workerAllocator := newFileRunNumberAllocator(dir)
+				for i := 0; i < perWorker; i++ {
+					rn, nextErr := workerAllocator.next("2oDvieFrVTi", "jdoe")
+					Expect(nextErr).NotTo(HaveOccurred())
+					mu.Lock()
+					values[rn] = struct{}{}
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		Expect(values).To(HaveLen(workers * perWorker))
+		Expect(allocator.allocations(nil)).To(HaveLen(workers * perWorker))
+	})
+
+	It("should resume from the audit log if the counter is lost", func() {
+		Expect(allocator.next("2oDvieFrVTi", "jdoe")).To(Equal(uint32(1)))
+		Expect(allocator.next("2oDvieFrVTi", "jdoe")).To(Equal(uint32(2)))
+		Expect(os.Remove(filepath.Join(dir, runCounterFile))).To(Succeed())
+		Expect(allocator.next("2oDvmzJRWJ1", "jdoe")).To(Equal(uint32(3)))
+	})
+
+	It("should record and filter allocations", func() {
+		Expect(allocator.next("2oDvieFrVTi", "jdoe")).To(Equal(uint32(1)))
+		Expect(allocator.next("2oDvmzJRWJ1", "asmith")).To(Equal(uint32(2)))
+
+		allocations, err := allocator.allocations(&configuration.RunNumberAllocationsQuery{RunNumber: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allocations).To(HaveLen(1))
+		Expect(allocations[0].EnvironmentId).To(Equal("2oDvmzJRWJ1"))
+		Expect(allocations[0].Requester).To(Equal("asmith"))
+
+		allocations, err = allocator.allocations(&configuration.RunNumberAllocationsQuery{EnvironmentId: "2oDvieFrVTi"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allocations).To(HaveLen(1))
+		Expect(allocations[0].RunNumber).To(Equal(uint32(1)))
+	})
+
+	It("should survive a torn audit record", func() {
+		Expect(allocator.next("2oDvieFrVTi", "jdoe")).To(Equal(uint32(1)))
+		f, err := os.OpenFile(filepath.Join(dir, runAllocationsFile), os.O_WRONLY|os.O_APPEND, 0644)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = f.WriteString(`{"runNumber":2,"environ`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+
+		Expect(allocator.next("2oDvmzJRWJ1", "jdoe")).To(Equal(uint32(2)))
+		allocations, err := allocator.allocations(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allocations).To(HaveLen(2))
+		Expect(allocations[1].EnvironmentId).To(Equal("2oDvmzJRWJ1"))
+	})
+})
+
+// countingSource adds an atomic counter to a configuration backend, like
+// Consul has, and counts the reads which go through it.
+type countingSource struct {
+	cfgbackend.Source
+	counter uint32
+	reads   int
+}
+
+func (c *countingSource) GetNextUInt32(string) (uint32, error) {
+	c.counter++
+	return c.counter, nil
+}
+
+func (c *countingSource) Get(key string) (string, error) {
+	c.reads++
+	return c.Source.Get(key)
+}
+
+func (c *countingSource) Exists(key string) (bool, error) {
+	c.reads++
+	return c.Source.Exists(key)
+}
+
+func (c *countingSource) GetRecursive(key string) (cfgbackend.Item, error) {
+	c.reads++
+	return c.Source.GetRecursive(key)
+}
+
+var _ = Describe("recorded run number allocations", func() {
+	var (
+		dir string
+		src *countingSource
+		svc *Service
+		err error
+	)
+
+	BeforeEach(func() {
+		dir, err = os.MkdirTemp("", "o2control-runnumber")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("o2:\n  runtime:\n    aliecs: {}\n"), 0644)).To(Succeed())
+		var yamlSrc cfgbackend.Source
+		yamlSrc, err = cfgbackend.NewSource("file://" + filepath.Join(dir, "config.yaml"))
+		Expect(err).NotTo(HaveOccurred())
+		src = &countingSource{Source: yamlSrc}
+		svc = &Service{src: src}
+
+		Expect(svc.NewRunNumber("2oDvieFrVTi", "jdoe")).To(Equal(uint32(1)))
+		Expect(svc.NewRunNumber("2oDvmzJRWJ1", "asmith")).To(Equal(uint32(2)))
+		Expect(svc.NewRunNumber("2oDvieFrVTi", "jdoe")).To(Equal(uint32(3)))
+		Expect(src.Put(filepath.Join(getRunNumberAllocationsPrefix(), "4"), "not json")).To(Succeed())
+		src.reads = 0
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("should read all records at once and filter them", func() {
+		allocations, err := svc.GetRunNumberAllocations(&configuration.RunNumberAllocationsQuery{EnvironmentId: "2oDvieFrVTi"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allocations).To(HaveLen(2))
+		Expect(allocations[0].RunNumber).To(Equal(uint32(1)))
+		Expect(allocations[1].RunNumber).To(Equal(uint32(3)))
+		Expect(src.reads).To(Equal(1))
+	})
+
+	It("should find a single run number and skip malformed records", func() {
+		allocations, err := svc.GetRunNumberAllocations(&configuration.RunNumberAllocationsQuery{RunNumber: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allocations).To(HaveLen(1))
+		Expect(allocations[0].Requester).To(Equal("asmith"))
+
+		allocations, err = svc.GetRunNumberAllocations(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allocations).To(HaveLen(3))
+	})
+})
@@ -0,0 +1,263 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package local
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/secrets"
+	"github.com/AliceO2Group/Control/configuration/cfgbackend"
+	"github.com/spf13/viper"
+)
+
+// Secrets are kept as flat string values under their own prefix, which is
+// hidden from RawGetRecursive and protected from RawPutRecursive.
+// If secretsKeyFile is set, values are stored encrypted with AES-256-GCM,
+// otherwise they are stored as plain text and only protected by the access
+// control of the backend and of the apricot secrets RPCs.
+const (
+	secretsKeyPrefix     = "o2/secrets"
+	encryptedValuePrefix = "enc:v1:"
+)
+
+func (s *Service) GetSecret(name string) (string, error) {
+	s.logMethod()
+
+	if err := secrets.ValidateName(name); err != nil {
+		return "", err
+	}
+	key := secretsKeyPrefix + "/" + name
+	exists, err := s.src.Exists(key)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", fmt.Errorf("%w: %s", secrets.ErrNotFound, name)
+	}
+	stored, err := s.src.Get(key)
+	if err != nil {
+		return "", err
+	}
+	return decryptSecret(stored)
+}
+
+func (s *Service) SetSecret(name string, value string) error {
+	s.logMethod()
+
+	if err := secrets.ValidateName(name); err != nil {
+		return err
+	}
+	stored, err := encryptSecret(value)
+	if err != nil {
+		return err
+	}
+	err = s.src.Put(secretsKeyPrefix+"/"+name, stored)
+	if err != nil {
+		return err
+	}
+	log.WithField("secret", name).Info("secret updated")
+	return nil
+}
+
+func (s *Service) DeleteSecret(name string) error {
+	s.logMethod()
+
+	if err := secrets.ValidateName(name); err != nil {
+		return err
+	}
+	stored, err := s.getSecretsMap()
+	if err != nil {
+		return err
+	}
+	if _, ok := stored[name]; !ok {
+		return fmt.Errorf("%w: %s", secrets.ErrNotFound, name)
+	}
+	// the backends have no single key deletion, so we replace the whole
+	// secrets subtree with what is left
+	delete(stored, name)
+	err = s.src.PutRecursive(secretsKeyPrefix, stored)
+	if err != nil {
+		return err
+	}
+	log.WithField("secret", name).Info("secret deleted")
+	return nil
+}
+
+func (s *Service) ListSecrets() ([]string, error) {
+	s.logMethod()
+
+	stored, err := s.getSecretsMap()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(stored))
+	for name, item := range stored {
+		if item != nil && item.Type() == cfgbackend.IT_Value {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// getSecretsMap returns a copy of the stored (and possibly encrypted)
+// secrets, so the caller is free to modify it.
+func (s *Service) getSecretsMap() (cfgbackend.Map, error) {
+	item, err := s.src.GetRecursive(secretsKeyPrefix)
+	if err != nil {
+		if exists, _ := s.src.Exists(secretsKeyPrefix); !exists {
+			return cfgbackend.Map{}, nil
+		}
+		return nil, err
+	}
+	if item == nil {
+		return cfgbackend.Map{}, nil
+	}
+	if item.Type() != cfgbackend.IT_Map {
+		return nil, fmt.Errorf("unexpected value at %s", secretsKeyPrefix)
+	}
+	return item.DeepCopy().Map(), nil
+}
+
+// secretsPathRelation tells whether a configuration path points inside the
+// secrets namespace, or to one of its ancestors.
+func secretsPathRelation(p string) (inside bool, ancestor bool) {
+	p = strings.Trim(path.Clean("/"+p), "/")
+	if p == secretsKeyPrefix || strings.HasPrefix(p, secretsKeyPrefix+"/") {
+		return true, false
+	}
+	return false, p == "" || strings.HasPrefix(secretsKeyPrefix, p+"/")
+}
+
+// withoutSecrets returns a copy of a configuration subtree rooted at p,
+// stripped of the secrets namespace.
+func withoutSecrets(p string, item cfgbackend.Item) cfgbackend.Item {
+	if item == nil || item.Type() != cfgbackend.IT_Map {
+		return item
+	}
+	p = strings.Trim(path.Clean("/"+p), "/")
+	relative := strings.Trim(strings.TrimPrefix(secretsKeyPrefix, p), "/")
+	components := strings.Split(relative, "/")
+
+	stripped := item.DeepCopy()
+	current := stripped.Map()
+	for i, k := range components {
+		if i == len(components)-1 {
+			delete(current, k)
+			break
+		}
+		next, ok := current[k]
+		if !ok || next == nil || next.Type() != cfgbackend.IT_Map {
+			break
+		}
+		current = next.Map()
+	}
+	return stripped
+}
+
+func loadSecretsKey() ([]byte, error) {
+	keyFile := viper.GetString("secretsKeyFile")
+	if keyFile == "" {
+		return nil, nil
+	}
+	info, err := os.Stat(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot access secrets key file: %w", err)
+	}
+	if info.Mode().Perm()&0o077 != 0 {
+		return nil, fmt.Errorf("secrets key file %s must not be accessible by group or others", keyFile)
+	}
+	encoded, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read secrets key file: %w", err)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil || len(key) != 32 {
+		return nil, errors.New("secrets key file must contain a base64-encoded 256-bit key")
+	}
+	return key, nil
+}
+
+func encryptSecret(value string) (string, error) {
+	key, err := loadSecretsKey()
+	if err != nil || key == nil {
+		return value, err
+	}
+	gcm, err := newSecretsCipher(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(value), nil)
+	return encryptedValuePrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func decryptSecret(stored string) (string, error) {
+	if !strings.HasPrefix(stored, encryptedValuePrefix) {
+		// stored before encryption was enabled
+		return stored, nil
+	}
+	key, err := loadSecretsKey()
+	if err != nil {
+		return "", err
+	}
+	if key == nil {
+		return "", errors.New("secret is encrypted but no secrets key file is configured")
+	}
+	gcm, err := newSecretsCipher(key)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(stored, encryptedValuePrefix))
+	if err != nil || len(sealed) < gcm.NonceSize() {
+		return "", errors.New("malformed encrypted secret")
+	}
+	plain, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errors.New("cannot decrypt secret, wrong secrets key?")
+	}
+	return string(plain), nil
+}
+
+func newSecretsCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,136 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package local
+
+import (
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/secrets"
+	"github.com/spf13/viper"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("secrets", func() {
+	var (
+		dir string
+		svc *Service
+		err error
+	)
+
+	BeforeEach(func() {
+		dir, err = os.MkdirTemp("", "o2control-secrets")
+		Expect(err).NotTo(HaveOccurred())
+		configFile := filepath.Join(dir, "secrets_test.yaml")
+		Expect(os.WriteFile(configFile, []byte("o2:\n  components:\n    qc:\n      ANY:\n        any:\n          entry: \"config\"\n"), 0600)).To(Succeed())
+		svc, err = NewService("file://" + configFile)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		viper.Set("secretsKeyFile", "")
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	writeKeyFile := func(mode os.FileMode) string {
+		keyFile := filepath.Join(dir, "secrets.key")
+		key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+		Expect(os.WriteFile(keyFile, []byte(key+"\n"), mode)).To(Succeed())
+		Expect(os.Chmod(keyFile, mode)).To(Succeed())
+		return keyFile
+	}
+
+	It("should store, list and delete secrets", func() {
+		Expect(svc.SetSecret("bkp-token", "s3cr3t")).To(Succeed())
+		Expect(svc.SetSecret("dcs.pass", "hunter2")).To(Succeed())
+		Expect(svc.GetSecret("bkp-token")).To(Equal("s3cr3t"))
+		Expect(svc.ListSecrets()).To(Equal([]string{"bkp-token", "dcs.pass"}))
+
+		Expect(svc.DeleteSecret("bkp-token")).To(Succeed())
+		Expect(svc.ListSecrets()).To(Equal([]string{"dcs.pass"}))
+		_, err = svc.GetSecret("bkp-token")
+		Expect(errors.Is(err, secrets.ErrNotFound)).To(BeTrue())
+		Expect(errors.Is(svc.DeleteSecret("bkp-token"), secrets.ErrNotFound)).To(BeTrue())
+	})
+
+	It("should list no secrets if none were ever stored", func() {
+		Expect(svc.ListSecrets()).To(BeEmpty())
+	})
+
+	It("should reject invalid secret names", func() {
+		Expect(svc.SetSecret("../components", "x")).NotTo(Succeed())
+		_, err = svc.GetSecret("a/b")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should encrypt secrets at rest if a key file is configured", func() {
+		viper.Set("secretsKeyFile", writeKeyFile(0600))
+		Expect(svc.SetSecret("bkp-token", "s3cr3t")).To(Succeed())
+
+		stored, err := svc.src.Get(secretsKeyPrefix + "/bkp-token")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored).To(HavePrefix(encryptedValuePrefix))
+		Expect(stored).NotTo(ContainSubstring("s3cr3t"))
+		Expect(svc.GetSecret("bkp-token")).To(Equal("s3cr3t"))
+
+		viper.Set("secretsKeyFile", "")
+		_, err = svc.GetSecret("bkp-token")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should refuse a key file which is readable by others", func() {
+		viper.Set("secretsKeyFile", writeKeyFile(0644))
+		Expect(svc.SetSecret("bkp-token", "s3cr3t")).NotTo(Succeed())
+	})
+
+	It("should keep secrets out of raw configuration access", func() {
+		Expect(svc.SetSecret("bkp-token", "s3cr3t")).To(Succeed())
+
+		_, err = svc.RawGetRecursive("o2/secrets")
+		Expect(err).To(HaveOccurred())
+		_, err = svc.RawGetRecursive("/o2/secrets/bkp-token")
+		Expect(err).To(HaveOccurred())
+		Expect(svc.RawPutRecursive("o2/secrets", "{\"bkp-token\": \"stolen\"}")).NotTo(Succeed())
+
+		payload, err := svc.RawGetRecursive("o2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(payload).To(ContainSubstring("components"))
+		Expect(payload).NotTo(ContainSubstring("s3cr3t"))
+		Expect(payload).NotTo(ContainSubstring("secrets"))
+	})
+
+	It("should preserve secrets when replacing one of their ancestors", func() {
+		Expect(svc.SetSecret("bkp-token", "s3cr3t")).To(Succeed())
+
+		Expect(svc.RawPutRecursive("o2", "{\"components\": {}, \"secrets\": {\"bkp-token\": \"stolen\"}}")).To(Succeed())
+		Expect(svc.GetSecret("bkp-token")).To(Equal("s3cr3t"))
+		Expect(svc.ListSecrets()).To(Equal([]string{"bkp-token"}))
+	})
+})
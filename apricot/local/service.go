@@ -32,6 +32,7 @@ import (
 	"fmt"
 	"github.com/AliceO2Group/Control/common/logger"
 	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/configuration"
 	"github.com/AliceO2Group/Control/configuration/cfgbackend"
 	"github.com/AliceO2Group/Control/configuration/componentcfg"
 	"github.com/AliceO2Group/Control/configuration/template"
@@ -39,16 +40,15 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
-	"io/ioutil"
 	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
 	"runtime"
 	"sort"
-	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 var log = logger.New(logrus.StandardLogger(), "confsys")
@@ -66,40 +66,55 @@ type Service struct {
 func NewService(uri string) (svc *Service, err error) {
 	var src cfgbackend.Source
 	src, err = cfgbackend.NewSource(uri)
-	return &Service{
+	svc = &Service{
 		src: src,
-	}, err
+	}
+	if err == nil {
+		if wSrc, ok := src.(cfgbackend.WatchableSource); ok {
+			svc.invalidateTemplateCacheOnChange(wSrc)
+		}
+	}
+	return
 }
 
-func (s *Service) NewRunNumber() (runNumber uint32, err error) {
-	if cSrc, ok := s.src.(*cfgbackend.ConsulSource); ok {
-		return cSrc.GetNextUInt32(filepath.Join(getConsulRuntimePrefix(), "run_number"))
-	} else {
-		// Unsafe check-and-set, only for file backend
-		var rnf string
-		rnf = filepath.Join(viper.GetString("coreWorkingDir"), "runcounter.txt")
-		if _, err = os.Stat(rnf); os.IsNotExist(err) {
-			err = ioutil.WriteFile(rnf, []byte("0"), 0644)
-			if err != nil {
-				return
-			}
-		}
-		var raw []byte
-		raw, err = ioutil.ReadFile(rnf)
+func (s *Service) NewRunNumber(envId string, requester string) (runNumber uint32, err error) {
+	if cSrc, ok := s.src.(cfgbackend.CounterSource); ok {
+		runNumber, err = cSrc.GetNextUInt32(filepath.Join(getConsulRuntimePrefix(), "run_number"))
 		if err != nil {
 			return
 		}
-		var rn64 uint64
-		rn64, err = strconv.ParseUint(string(raw[:]), 10, 32)
+		err = s.recordRunNumberAllocation(&configuration.RunNumberAllocation{
+			RunNumber:     runNumber,
+			EnvironmentId: envId,
+			Requester:     requester,
+			Timestamp:     time.Now().UTC(),
+		})
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		// the file backend has no atomic counter, so we keep a locked counter file
+		runNumber, err = s.fileRunNumberAllocator().next(envId, requester)
 		if err != nil {
 			return
 		}
-		runNumber = uint32(rn64)
-		runNumber++
-		raw = []byte(strconv.FormatUint(uint64(runNumber), 10))
-		err = ioutil.WriteFile(rnf, raw, 0)
-		return
 	}
+
+	log.WithField(infologger.Run, runNumber).
+		WithField(infologger.Partition, envId).
+		WithField("requester", requester).
+		Debug("run number allocated")
+	return
+}
+
+func (s *Service) GetRunNumberAllocations(query *configuration.RunNumberAllocationsQuery) (allocations []*configuration.RunNumberAllocation, err error) {
+	if s.src == nil {
+		return nil, errors.New("configuration backend unavailable")
+	}
+	if _, ok := s.src.(cfgbackend.CounterSource); ok {
+		return s.getRecordedRunNumberAllocations(query)
+	}
+	return s.fileRunNumberAllocator().allocations(query)
 }
 
 // maybe this one shouldn't exist at all, because vars should get inserted
@@ -372,7 +387,7 @@ func (s *Service) GetComponentConfiguration(query *componentcfg.Query) (payload
 func (s *Service) GetComponentConfigurationWithLastIndex(query *componentcfg.Query) (payload string, lastIndex uint64, err error) {
 	s.logMethod()
 
-	if cSrc, ok := s.src.(*cfgbackend.ConsulSource); ok {
+	if cSrc, ok := s.src.(cfgbackend.IndexedSource); ok {
 		var absolutePath string
 		absolutePath, err = s.queryToAbsPath(query)
 		if err != nil {
@@ -388,19 +403,7 @@ func (s *Service) GetComponentConfigurationWithLastIndex(query *componentcfg.Que
 func (s *Service) GetAndProcessComponentConfiguration(query *componentcfg.Query, varStack map[string]string) (payload string, err error) {
 	s.logMethod()
 
-	path := query.Path()
-
-	// We need to decompose the requested GetConfig path into prefix and suffix,
-	// with the last / as separator.
-	// This is done to allow internal references between template snippets
-	// within the same Consul directory.
-	indexOfLastSeparator := strings.LastIndex(path, "/")
-	var basePath string
-	shortPath := path
-	if indexOfLastSeparator != -1 {
-		basePath = path[:indexOfLastSeparator]
-		shortPath = path[indexOfLastSeparator+1:]
-	}
+	basePath, shortPath := splitTemplatePath(query.Path())
 
 	// We get a TemplateSet, with a custom TemplateLoader. Depending on past events, a template set for this base path
 	// might already exist in the service's template set cache map. We will then use the cache of this template set to
@@ -417,18 +420,7 @@ func (s *Service) GetAndProcessComponentConfiguration(query *componentcfg.Query,
 		return fmt.Sprintf("{\"error\":\"%s\"}", err.Error()), err
 	}
 
-	bindings := make(map[string]interface{})
-	for k, v := range varStack {
-		bindings[strings.TrimSpace(k)] = v
-	}
-
-	// Add custom functions to bindings:
-	funcMap := template.MakeUtilFuncMap(varStack)
-	for k, v := range funcMap {
-		bindings[k] = v
-	}
-
-	payload, err = tpl.Execute(bindings)
+	payload, err = tpl.Execute(makeTemplateBindings(varStack))
 	return
 }
 
@@ -447,11 +439,18 @@ func (s *Service) ResolveComponentQuery(query *componentcfg.Query) (resolved *co
 func (s *Service) RawGetRecursive(path string) (string, error) {
 	s.logMethod()
 
+	inSecrets, aboveSecrets := secretsPathRelation(path)
+	if inSecrets {
+		return "", errors.New("the secrets namespace cannot be accessed as raw configuration")
+	}
 	cfgDump, err := s.src.GetRecursive(path)
 	if err != nil {
 		log.WithError(err).Error("cannot retrieve configuration")
 		return "", err
 	}
+	if aboveSecrets {
+		cfgDump = withoutSecrets(path, cfgDump)
+	}
 	cfgBytes, err := json.MarshalIndent(cfgDump, "", "\t")
 	if err != nil {
 		log.WithError(err).Error("cannot marshal configuration dump")
@@ -460,6 +459,48 @@ func (s *Service) RawGetRecursive(path string) (string, error) {
 	return string(cfgBytes[:]), nil
 }
 
+// RawPutRecursive replaces the subtree at path with the given JSON payload,
+// in the same format as produced by RawGetRecursive.
+// The secrets namespace is left untouched, even if path is one of its
+// ancestors.
+func (s *Service) RawPutRecursive(path string, payload string) error {
+	s.logMethod()
+
+	if len(strings.TrimSpace(payload)) == 0 {
+		return errors.New("cannot put empty payload")
+	}
+	inSecrets, aboveSecrets := secretsPathRelation(path)
+	if inSecrets {
+		return errors.New("the secrets namespace cannot be accessed as raw configuration")
+	}
+	var storedSecrets cfgbackend.Map
+	if aboveSecrets {
+		var err error
+		storedSecrets, err = s.getSecretsMap()
+		if err != nil {
+			log.WithError(err).Error("cannot preserve secrets")
+			return err
+		}
+	}
+	// JSON is valid YAML, so we let the backend parse the payload
+	err := s.src.PutRecursiveYaml(path, []byte(payload))
+	if err != nil {
+		log.WithError(err).Error("cannot put configuration subtree")
+		return err
+	}
+	if aboveSecrets {
+		// whatever the payload had in place of the secrets namespace is
+		// discarded, and the previous secrets are put back
+		err = s.src.PutRecursive(secretsKeyPrefix, storedSecrets)
+		if err != nil {
+			log.WithError(err).Error("cannot restore secrets after putting configuration subtree")
+			return err
+		}
+	}
+	s.InvalidateComponentTemplateCache()
+	return nil
+}
+
 func (s *Service) GetDetectorForHost(hostname string) (string, error) {
 	s.logMethod()
 
@@ -555,8 +596,8 @@ func (s *Service) GetEndpointsForCRUCard(hostname, cardSerial string) ([]string,
 func (s *Service) GetRuntimeEntry(component string, key string) (string, error) {
 	s.logMethod()
 
-	if cSrc, ok := s.src.(*cfgbackend.ConsulSource); ok {
-		return cSrc.Get(filepath.Join(getConsulRuntimePrefix(), component, key))
+	if s.isKVStore() {
+		return s.src.Get(filepath.Join(getConsulRuntimePrefix(), component, key))
 	} else {
 		return "", errors.New("runtime KV not supported with file backend")
 	}
@@ -565,8 +606,8 @@ func (s *Service) GetRuntimeEntry(component string, key string) (string, error)
 func (s *Service) SetRuntimeEntry(component string, key string, value string) error {
 	s.logMethod()
 
-	if cSrc, ok := s.src.(*cfgbackend.ConsulSource); ok {
-		return cSrc.Put(filepath.Join(getConsulRuntimePrefix(), component, key), value)
+	if s.isKVStore() {
+		return s.src.Put(filepath.Join(getConsulRuntimePrefix(), component, key), value)
 	} else {
 		return errors.New("runtime KV not supported with file backend")
 	}
@@ -595,9 +636,9 @@ func (s *Service) GetRuntimeEntries(component string) (map[string]string, error)
 func (s *Service) ListRuntimeEntries(component string) ([]string, error) {
 	s.logMethod()
 
-	if cSrc, ok := s.src.(*cfgbackend.ConsulSource); ok {
+	if s.isKVStore() {
 		path := filepath.Join(getConsulRuntimePrefix(), component)
-		keys, err := cSrc.GetKeysByPrefix(path)
+		keys, err := s.src.GetKeysByPrefix(path)
 		if err != nil {
 			return nil, err
 		}
@@ -709,7 +750,7 @@ func (s *Service) ListComponentEntries(query *componentcfg.EntriesQuery) (entrie
 	return
 }
 
-func (s *Service) ImportComponentConfiguration(query *componentcfg.Query, payload string, newComponent bool) (existingComponentUpdated bool, existingEntryUpdated bool, err error) {
+func (s *Service) ImportComponentConfiguration(query *componentcfg.Query, payload string, newComponent bool, format string) (existingComponentUpdated bool, existingEntryUpdated bool, err error) {
 	s.logMethod()
 
 	if query == nil {
@@ -747,6 +788,24 @@ func (s *Service) ImportComponentConfiguration(query *componentcfg.Query, payloa
 		entryExists = entriesMap[query.EntryKey]
 	}
 
+	// If a schema applies to this entry or a format is declared, we refuse to store a payload that doesn't
+	// pass validation. Without a schema, this amounts to a syntax check in the declared format.
+	schemaName, schema, err := s.getSchemaForQuery(query)
+	if err != nil {
+		return
+	}
+	if schema != nil || componentcfg.NormalizeFormat(format) != "" {
+		var result *componentcfg.ValidationResult
+		result, err = s.validateComponentConfiguration(query, payload, format, nil, schemaName, schema)
+		if err != nil {
+			return
+		}
+		if !result.IsValid() {
+			err = result
+			return
+		}
+	}
+
 	fullKey := query.AbsoluteRaw()
 
 	err = s.src.Put(fullKey, payload)
@@ -759,6 +818,129 @@ func (s *Service) ImportComponentConfiguration(query *componentcfg.Query, payloa
 	return
 }
 
+// ValidateComponentConfiguration checks a component configuration payload for syntax errors according to its
+// format, and against the schema associated with the queried entry, if any.
+// If the payload is empty, the entry currently stored at the query path is validated instead.
+// The format is taken from the schema if set, otherwise from the format argument.
+// Template processing is performed before validation, with a var stack made of defaults, vars, the schema's
+// sample var stack and the varStack argument, in increasing order of precedence.
+func (s *Service) ValidateComponentConfiguration(query *componentcfg.Query, payload string, format string, varStack map[string]string) (result *componentcfg.ValidationResult, err error) {
+	s.logMethod()
+
+	if query == nil {
+		err = errors.New("bad query for ValidateComponentConfiguration")
+		return
+	}
+
+	if len(payload) == 0 {
+		payload, err = s.GetComponentConfiguration(query)
+		if err != nil {
+			return
+		}
+	}
+
+	schemaName, schema, err := s.getSchemaForQuery(query)
+	if err != nil {
+		return
+	}
+	return s.validateComponentConfiguration(query, payload, format, varStack, schemaName, schema)
+}
+
+func (s *Service) validateComponentConfiguration(query *componentcfg.Query, payload string, format string, varStack map[string]string, schemaName string, schema *componentcfg.Schema) (result *componentcfg.ValidationResult, err error) {
+	result = &componentcfg.ValidationResult{
+		Format: componentcfg.NormalizeFormat(format),
+		Schema: schemaName,
+		Errors: make([]string, 0),
+	}
+
+	sampleVarStack := s.GetDefaults()
+	for k, v := range s.GetVars() {
+		sampleVarStack[k] = v
+	}
+	if schema != nil {
+		if schema.Format != "" {
+			result.Format = schema.Format
+		}
+		for k, v := range schema.SampleVarStack {
+			sampleVarStack[k] = v
+		}
+	}
+	for k, v := range varStack {
+		sampleVarStack[k] = v
+	}
+
+	// We process the payload as a template in the context of its own base path, so that includes of other
+	// entries in the same directory resolve like they would at CONFIGURE time
+	basePath, _ := splitTemplatePath(query.Path())
+	tpl, tplErr := s.templateSetForBasePath(basePath).FromString(payload)
+	if tplErr != nil {
+		result.Errors = append(result.Errors, "template error: "+tplErr.Error())
+		return
+	}
+	processed, tplErr := tpl.Execute(makeTemplateBindings(sampleVarStack))
+	if tplErr != nil {
+		result.Errors = append(result.Errors, "template processing error: "+tplErr.Error())
+		return
+	}
+
+	result.Errors = componentcfg.ValidatePayload(processed, result.Format, schema)
+	return
+}
+
+func (s *Service) ListComponentSchemas(component string) (schemas map[string]string, err error) {
+	s.logMethod()
+
+	if !componentcfg.IsInputValidComponentName(component) || len(component) == 0 {
+		err = fmt.Errorf("invalid component name %s", component)
+		return
+	}
+
+	keyPrefix := componentcfg.ConfigSchemasPath + component + componentcfg.SEPARATOR
+	schemas = make(map[string]string)
+	if !s.isKVStore() && !s.src.IsDir(keyPrefix) {
+		// file backends fail on non-existent prefixes, for us this just means no schemas
+		return
+	}
+
+	var keys []string
+	keys, err = s.src.GetKeysByPrefix(keyPrefix)
+	if err != nil {
+		return
+	}
+
+	for _, key := range keys {
+		name := strings.TrimPrefix(key, keyPrefix)
+		if len(name) == 0 || strings.HasSuffix(name, componentcfg.SEPARATOR) {
+			continue
+		}
+		var payload string
+		payload, err = s.src.Get(key)
+		if err != nil {
+			return
+		}
+		schemas[name] = payload
+	}
+	return
+}
+
+func (s *Service) ImportComponentSchema(component string, name string, payload string) (err error) {
+	s.logMethod()
+
+	if !componentcfg.IsInputValidComponentName(component) || len(component) == 0 {
+		return fmt.Errorf("invalid component name %s", component)
+	}
+	if !componentcfg.IsInputValidComponentName(name) || len(name) == 0 {
+		return fmt.Errorf("invalid schema name %s", name)
+	}
+
+	_, err = componentcfg.ParseSchema(payload)
+	if err != nil {
+		return
+	}
+
+	return s.src.Put(componentcfg.ConfigSchemasPath+component+componentcfg.SEPARATOR+name, payload)
+}
+
 func getConsulRuntimePrefix() string {
 	// FIXME: this should not be hardcoded
 	return "o2/runtime"
@@ -1,7 +1,10 @@
 package local
 
 import (
+	"errors"
+
 	apricotpb "github.com/AliceO2Group/Control/apricot/protos"
+	"github.com/AliceO2Group/Control/configuration"
 	"github.com/AliceO2Group/Control/configuration/cfgbackend"
 	"github.com/AliceO2Group/Control/configuration/componentcfg"
 	. "github.com/onsi/ginkgo/v2"
@@ -34,10 +37,29 @@ var _ = Describe("local service", func() {
 		Describe("creating a new run number", func() {
 			When("the run number does not exist yet", func() {
 				It("should return number 1", func() {
-					Expect(svc.NewRunNumber()).To(Equal(uint32(1)))
+					Expect(svc.NewRunNumber("2oDvieFrVTi", "anonymous")).To(Equal(uint32(1)))
 				})
 				It("should return number 2 after number 1", func() {
-					Expect(svc.NewRunNumber()).To(Equal(uint32(2)))
+					Expect(svc.NewRunNumber("2oDvieFrVTi", "anonymous")).To(Equal(uint32(2)))
+				})
+			})
+			When("run numbers were allocated", func() {
+				It("should tell which environment got a given run number", func() {
+					rn, err := svc.NewRunNumber("2oDvmzJRWJ1", "jdoe")
+					Expect(err).NotTo(HaveOccurred())
+					allocations, err := svc.GetRunNumberAllocations(&configuration.RunNumberAllocationsQuery{RunNumber: rn})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(allocations).To(HaveLen(1))
+					Expect(allocations[0].EnvironmentId).To(Equal("2oDvmzJRWJ1"))
+					Expect(allocations[0].Requester).To(Equal("jdoe"))
+					Expect(allocations[0].Timestamp).NotTo(BeZero())
+				})
+				It("should list the run numbers of a given environment", func() {
+					allocations, err := svc.GetRunNumberAllocations(&configuration.RunNumberAllocationsQuery{EnvironmentId: "2oDvieFrVTi"})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(allocations).To(HaveLen(2))
+					Expect(allocations[0].RunNumber).To(Equal(uint32(1)))
+					Expect(allocations[1].RunNumber).To(Equal(uint32(2)))
 				})
 			})
 		})
@@ -311,6 +333,28 @@ var _ = Describe("local service", func() {
 			})
 		})
 
+		Describe("putting raw json payload under a node", func() {
+			var (
+				payload string
+				err     error
+			)
+			When("putting a raw json payload", func() {
+				It("should replace the subtree, so that it can be retrieved as is", func() {
+					err = svc.RawPutRecursive("o2/components/raw", "{\"any\": {\"entry\": \"restored\"}}")
+					Expect(err).NotTo(HaveOccurred())
+					payload, err = svc.RawGetRecursive("o2/components/raw")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(payload).To(Equal("{\n\t\"any\": {\n\t\t\"entry\": \"restored\"\n\t}\n}"))
+				})
+			})
+			When("putting an empty payload", func() {
+				It("should return an error", func() {
+					err = svc.RawPutRecursive("o2/components/raw", " ")
+					Expect(err).To(HaveOccurred())
+				})
+			})
+		})
+
 		Describe("importing component configuration", func() {
 			var (
 				importedPayload          string
@@ -325,7 +369,7 @@ var _ = Describe("local service", func() {
 					query, err = componentcfg.NewQuery("reco/ANY/any/entry2")
 					Expect(err).NotTo(HaveOccurred())
 					importedPayload = "hello"
-					existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(query, importedPayload, true)
+					existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(query, importedPayload, true, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(existingComponentUpdated).To(BeFalse())
 					Expect(existingEntryUpdated).To(BeFalse())
@@ -339,7 +383,7 @@ var _ = Describe("local service", func() {
 					query, err = componentcfg.NewQuery("gpu/ANY/any/entry2")
 					Expect(err).NotTo(HaveOccurred())
 					importedPayload = "hello"
-					existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(query, importedPayload, false)
+					existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(query, importedPayload, false, "")
 					Expect(err).To(HaveOccurred())
 				})
 			})
@@ -348,7 +392,7 @@ var _ = Describe("local service", func() {
 					query, err = componentcfg.NewQuery("qc/ANY/any/entry3")
 					Expect(err).NotTo(HaveOccurred())
 					importedPayload = "hello"
-					existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(query, importedPayload, false)
+					existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(query, importedPayload, false, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(existingComponentUpdated).To(BeTrue())
 					Expect(existingEntryUpdated).To(BeFalse())
@@ -362,11 +406,11 @@ var _ = Describe("local service", func() {
 					query, err = componentcfg.NewQuery("qc/ANY/any/entry4")
 					Expect(err).NotTo(HaveOccurred())
 					importedPayload = "hello"
-					existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(query, importedPayload, false)
+					existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(query, importedPayload, false, "")
 					Expect(err).NotTo(HaveOccurred())
 
 					importedPayload = "hello2"
-					existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(query, importedPayload, false)
+					existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(query, importedPayload, false, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(existingComponentUpdated).To(BeTrue())
 					Expect(existingEntryUpdated).To(BeTrue())
@@ -380,7 +424,7 @@ var _ = Describe("local service", func() {
 					query, err = componentcfg.NewQuery("qc/PHYSICS/role1/sub/entry2")
 					Expect(err).NotTo(HaveOccurred())
 					importedPayload = "sub hello"
-					existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(query, importedPayload, false)
+					existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(query, importedPayload, false, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(svc.src.Exists("o2/components/qc/PHYSICS/role1/sub/entry2")).To(BeTrue())
 
@@ -390,6 +434,143 @@ var _ = Describe("local service", func() {
 				})
 			})
 
+			When("we import a payload which does not conform to the schema matching the entry", func() {
+				It("should produce an error and not store the payload", func() {
+					query, err = componentcfg.NewQuery("qc/ANY/any/json1")
+					Expect(err).NotTo(HaveOccurred())
+					_, _, err = svc.ImportComponentConfiguration(query, `{"frequency": 1}`, false, "")
+					Expect(err).To(HaveOccurred())
+					Expect(svc.src.Exists("o2/components/qc/ANY/any/json1")).To(BeFalse())
+				})
+			})
+			When("we import a payload which conforms to the schema matching the entry", func() {
+				It("should be correctly stored", func() {
+					query, err = componentcfg.NewQuery("qc/ANY/any/json2")
+					Expect(err).NotTo(HaveOccurred())
+					importedPayload = `{"rate": {{ rate }}}`
+					_, _, err = svc.ImportComponentConfiguration(query, importedPayload, false, "")
+					Expect(err).NotTo(HaveOccurred())
+					retrievedPayload, err = svc.GetComponentConfiguration(query)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(retrievedPayload).To(Equal(importedPayload))
+				})
+			})
+			When("we import a payload with a syntax error in its declared format and no schema applies", func() {
+				It("should produce an error and not store the payload", func() {
+					query, err = componentcfg.NewQuery("qc/ANY/any/entry5")
+					Expect(err).NotTo(HaveOccurred())
+					_, _, err = svc.ImportComponentConfiguration(query, `{"anything": 1,}`, false, "json")
+					var result *componentcfg.ValidationResult
+					Expect(errors.As(err, &result)).To(BeTrue())
+					Expect(result.Schema).To(BeEmpty())
+					Expect(svc.src.Exists("o2/components/qc/ANY/any/entry5")).To(BeFalse())
+				})
+			})
+			When("we import a payload with a valid syntax in its declared format and no schema applies", func() {
+				It("should be correctly stored", func() {
+					query, err = componentcfg.NewQuery("qc/ANY/any/entry6")
+					Expect(err).NotTo(HaveOccurred())
+					importedPayload = "key: {{ key1 }}"
+					_, _, err = svc.ImportComponentConfiguration(query, importedPayload, false, "yaml")
+					Expect(err).NotTo(HaveOccurred())
+					retrievedPayload, err = svc.GetComponentConfiguration(query)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(retrievedPayload).To(Equal(importedPayload))
+				})
+			})
+		})
+
+		Describe("validating component configuration", func() {
+			var (
+				result *componentcfg.ValidationResult
+				query  *componentcfg.Query
+				err    error
+			)
+			When("we validate a payload for an entry with a schema", func() {
+				BeforeEach(func() {
+					query, err = componentcfg.NewQuery("qc/PHYSICS/role1/json3")
+					Expect(err).NotTo(HaveOccurred())
+				})
+				It("should use the schema and its format", func() {
+					result, err = svc.ValidateComponentConfiguration(query, `{"rate": 1}`, "yaml", nil)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.IsValid()).To(BeTrue())
+					Expect(result.Schema).To(Equal("json-entries"))
+					Expect(result.Format).To(Equal("json"))
+				})
+				It("should process templates with the provided var stack before validating", func() {
+					result, err = svc.ValidateComponentConfiguration(query, `{"rate": "{{ rate }}"}`, "", map[string]string{"rate": "1"})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.IsValid()).To(BeFalse())
+					result, err = svc.ValidateComponentConfiguration(query, `{"rate": {{ rate }}}`, "", map[string]string{"rate": "1"})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.IsValid()).To(BeTrue())
+				})
+				It("should report schema violations", func() {
+					result, err = svc.ValidateComponentConfiguration(query, `{"rate": true}`, "", nil)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.IsValid()).To(BeFalse())
+					Expect(result.Errors).To(HaveLen(1))
+				})
+				It("should report template errors", func() {
+					result, err = svc.ValidateComponentConfiguration(query, `{"rate": {{ rate }`, "", nil)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.IsValid()).To(BeFalse())
+				})
+			})
+			When("we validate a payload for an entry without a schema", func() {
+				BeforeEach(func() {
+					query, err = componentcfg.NewQuery("qc/ANY/any/entry1")
+					Expect(err).NotTo(HaveOccurred())
+				})
+				It("should only check the syntax for the provided format", func() {
+					result, err = svc.ValidateComponentConfiguration(query, `{"anything": 1,}`, "json", nil)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.IsValid()).To(BeFalse())
+					Expect(result.Schema).To(BeEmpty())
+				})
+				It("should validate the stored payload if none is provided", func() {
+					result, err = svc.ValidateComponentConfiguration(query, "", "yaml", nil)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.IsValid()).To(BeTrue())
+				})
+			})
+		})
+
+		Describe("managing component configuration schemas", func() {
+			var (
+				schemas map[string]string
+				err     error
+			)
+			When("listing the schemas of a component which has some", func() {
+				It("should return them", func() {
+					schemas, err = svc.ListComponentSchemas("qc")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(schemas).To(HaveKey("json-entries"))
+				})
+			})
+			When("listing the schemas of a component which has none", func() {
+				It("should return an empty map", func() {
+					schemas, err = svc.ListComponentSchemas("readoutcard")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(schemas).To(BeEmpty())
+				})
+			})
+			When("importing a valid schema", func() {
+				It("should be stored and listed", func() {
+					err = svc.ImportComponentSchema("readoutcard", "cru", `{"format": "json"}`)
+					Expect(err).NotTo(HaveOccurred())
+					schemas, err = svc.ListComponentSchemas("readoutcard")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(schemas).To(HaveKeyWithValue("cru", `{"format": "json"}`))
+				})
+			})
+			When("importing an invalid schema", func() {
+				It("should produce an error", func() {
+					err = svc.ImportComponentSchema("readoutcard", "broken", `{"format": "xml"}`)
+					Expect(err).To(HaveOccurred())
+				})
+			})
 		})
 
 		Describe("getting detector for host", func() {
@@ -28,6 +28,7 @@ package local
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"sort"
 	"strconv"
@@ -89,11 +90,30 @@ func newHandlerForHttpService(httpsvc *HttpService) *mux.Router {
 	// GET /components/{component}/{runtype}/{rolename}/{remainder:.*} with '/resolve' within the remainder,
 	// assumes this is not a raw path, returns a raw path like {component}/{runtype}/{rolename}/{entry}
 	apiComponentQuery.HandleFunc("/resolve", httpsvc.ApiResolveComponentQuery).Methods(http.MethodGet)
+	// GET /components/{component}/{runtype}/{rolename}/{remainder:.*} with '/validate' within the remainder validates
+	// the stored entry, POST with the same path validates the payload in the request body
+	apiComponentQuery.HandleFunc("/validate", httpsvc.ApiValidateComponentConfiguration).Methods(http.MethodGet, http.MethodPost)
 	// GET /components/{component}/{runtype}/{rolename}/{remainder:.*}, accepts raw or non-raw path, returns payload
 	// that may be processed or not depending on process=true or false
 	apiComponentQuery.HandleFunc("", httpsvc.ApiGetComponentConfiguration).Methods(http.MethodGet)
 	apiComponentQuery.HandleFunc("/", httpsvc.ApiGetComponentConfiguration).Methods(http.MethodGet)
 
+	// component configuration schema API
+
+	// GET /schemas/{component}
+	apiSchemas := router.PathPrefix("/schemas/{component}").Subrouter()
+	apiSchemas.HandleFunc("", httpsvc.ApiListComponentSchemas).Methods(http.MethodGet)
+	apiSchemas.HandleFunc("/", httpsvc.ApiListComponentSchemas).Methods(http.MethodGet)
+
+	// run number API
+
+	// GET /runs
+	apiRuns := router.PathPrefix("/runs").Subrouter()
+	apiRuns.HandleFunc("", httpsvc.ApiGetRunNumberAllocations).Methods(http.MethodGet)
+	apiRuns.HandleFunc("/", httpsvc.ApiGetRunNumberAllocations).Methods(http.MethodGet)
+	// GET /runs/{runNumber}
+	apiRuns.HandleFunc("/{runNumber}", httpsvc.ApiGetRunNumberAllocations).Methods(http.MethodGet)
+
 	// inventory API
 
 	apiInventoryFlps := router.PathPrefix("/inventory/flps").Subrouter()
@@ -430,6 +450,197 @@ func (httpsvc *HttpService) ApiResolveComponentQuery(w http.ResponseWriter, r *h
 	_, _ = fmt.Fprintln(w, resolvedStr)
 }
 
+// ApiValidateComponentConfiguration validates a configuration payload for a given component, run type, role name and entry key
+//
+//	@Summary		Validates a configuration payload for a given component, run type, role name and entry key
+//	@Description	Checks the syntax of a configuration payload according to its format, and validates it against the schema associated with the given entry, if any. Template processing is performed before validation, with defaults, vars and the schema's sample var stack; any number of additional string key-value pairs may be passed as query parameters to extend the var stack. With GET, the currently stored entry is validated; with POST, the request body is validated as the payload for the given entry, without storing it.
+//	@Tags			component configuration
+//	@Accept			plain
+//	@Produce		json
+//	@Param			format		query		string				false	"Payload format, overridden by the format declared in the schema"	Enums(json, yaml, ini, toml)
+//	@Param			component	path		string				true	"Configuration component"
+//	@Param			runtype		path		string				true	"O² Run type, must be capitalized"
+//	@Param			rolename	path		string				true	"Role name"
+//	@Param			entry		path		string				true	"Entry key"
+//	@Param			payload		body		string				false	"Configuration payload to validate (POST only)"
+//	@Success		200			{object}	ValidationResponse	"Validation result"
+//	@Failure		400			{string}	string				"Bad request, if a parameter is invalid"
+//	@Failure		500			{string}	string				"Internal server error"
+//	@Router			/components/{component}/{runtype}/{rolename}/{entry}/validate [get]
+//	@Router			/components/{component}/{runtype}/{rolename}/{entry}/validate [post]
+func (httpsvc *HttpService) ApiValidateComponentConfiguration(w http.ResponseWriter, r *http.Request) {
+	queryParams := mux.Vars(r)
+	component, hasComponent := queryParams["component"]
+	if !hasComponent {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintln(w, "component name not provided")
+		return
+	}
+
+	runtypeS := strings.ToUpper(queryParams["runtype"])
+	runTypeInt, isRunTypeValid := apricotpb.RunType_value[runtypeS]
+	if !isRunTypeValid {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintln(w, "runtype not valid")
+		return
+	}
+
+	rolename, hasRolename := queryParams["rolename"]
+	if !hasRolename {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintln(w, "rolename not provided")
+		return
+	}
+
+	entry := strings.TrimSuffix(queryParams["remainder"], "/validate")
+	if len(entry) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintln(w, "entry not provided")
+		return
+	}
+
+	var payload string
+	if r.Method == http.MethodPost {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintln(w, err)
+			return
+		}
+		payload = string(body)
+		if len(payload) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintln(w, "payload not provided")
+			return
+		}
+	}
+
+	queryArgs := r.URL.Query()
+	format := queryArgs.Get("format")
+	queryArgs.Del("format")
+
+	varStack := make(map[string]string)
+	for k, v := range queryArgs {
+		if len(v) > 0 {
+			varStack[k] = v[0]
+		}
+	}
+
+	result, err := httpsvc.svc.ValidateComponentConfiguration(&componentcfg.Query{
+		Component: component,
+		RunType:   apricotpb.RunType(runTypeInt),
+		RoleName:  rolename,
+		EntryKey:  entry,
+	}, payload, format, varStack)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintln(w, err)
+		return
+	}
+
+	response, err := json.MarshalIndent(ValidationResponse{
+		Valid:  result.IsValid(),
+		Format: result.Format,
+		Schema: result.Schema,
+		Errors: result.Errors,
+	}, "", "\t")
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintln(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = fmt.Fprintln(w, string(response))
+}
+
+// ApiListComponentSchemas lists the configuration schemas for a given component
+//
+//	@Summary		Lists the configuration schemas associated with an Apricot-managed configuration component
+//	@Description	Returns a map of schema names to schema documents for the given component. Each schema optionally restricts the entries it applies to with an entry pattern over RUNTYPE/rolename/entry, declares the payload format, and contains a JSON Schema and/or an INI key schema.
+//	@Tags			component configuration
+//	@Produce		json
+//	@Param			component	path		string				true	"Configuration component"
+//	@Success		200			{object}	map[string]string	"Map of schema names to schema documents"
+//	@Failure		400			{string}	string				"Bad request, if the component name is invalid"
+//	@Failure		500			{string}	string				"Internal server error"
+//	@Router			/schemas/{component} [get]
+func (httpsvc *HttpService) ApiListComponentSchemas(w http.ResponseWriter, r *http.Request) {
+	queryParams := mux.Vars(r)
+	component := queryParams["component"]
+	if !componentcfg.IsInputValidComponentName(component) || len(component) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintln(w, "component name not valid")
+		return
+	}
+
+	schemas, err := httpsvc.svc.ListComponentSchemas(component)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintln(w, err)
+		return
+	}
+
+	response, err := json.MarshalIndent(schemas, "", "\t")
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintln(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = fmt.Fprintln(w, string(response))
+}
+
+// ApiGetRunNumberAllocations returns the audit trail of run number allocations
+//
+//	@Summary		Returns which environments were allocated which run numbers
+//	@Description	Every run number handed out by Apricot is recorded together with the environment it was allocated to, the user who requested it and a timestamp. The records are kept after the environment is gone. The list can be restricted to a single run number and/or a single environment.
+//	@Tags			run numbers
+//	@Produce		json
+//	@Param			runNumber		path		integer						false	"Run number"
+//	@Param			environmentId	query		string						false	"Environment ID"
+//	@Success		200				{array}		RunNumberAllocationResponse	"Run number allocations, ordered by run number"
+//	@Failure		400				{string}	string						"Bad request, if the run number is invalid"
+//	@Failure		500				{string}	string						"Internal server error"
+//	@Router			/runs [get]
+//	@Router			/runs/{runNumber} [get]
+func (httpsvc *HttpService) ApiGetRunNumberAllocations(w http.ResponseWriter, r *http.Request) {
+	query := &configuration.RunNumberAllocationsQuery{
+		EnvironmentId: r.URL.Query().Get("environmentId"),
+	}
+	if runNumberS, ok := mux.Vars(r)["runNumber"]; ok {
+		runNumber, err := strconv.ParseUint(runNumberS, 10, 32)
+		if err != nil || runNumber == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintln(w, "run number not valid")
+			return
+		}
+		query.RunNumber = uint32(runNumber)
+	}
+
+	allocations, err := httpsvc.svc.GetRunNumberAllocations(query)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintln(w, err)
+		return
+	}
+
+	responseAllocations := make([]RunNumberAllocationResponse, len(allocations))
+	for i, allocation := range allocations {
+		responseAllocations[i] = RunNumberAllocationResponse(*allocation)
+	}
+	response, err := json.MarshalIndent(responseAllocations, "", "\t")
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintln(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = fmt.Fprintln(w, string(response))
+}
+
 // ApiGetComponentConfiguration returns the processed configuration payload for a given component, run type, role name and entry key
 //
 //	@Summary		Returns a configuration payload for a given component, run type, role name and entry key
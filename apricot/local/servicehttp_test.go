@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 )
 
 var _ = Describe("HTTP apricot service", func() {
@@ -398,5 +399,75 @@ var _ = Describe("HTTP apricot service", func() {
 				})
 			})
 		})
+
+		Describe("validating component configuration", func() {
+			var response ValidationResponse
+			When("validating a stored entry", func() {
+				It("should process templates before checking the syntax", func() {
+					req, err := http.NewRequest("GET", "/components/qc/ANY/any/entry10/validate?format=yaml&var1=hello", nil)
+					Expect(err).NotTo(HaveOccurred())
+					handler.ServeHTTP(recorder, req)
+					Expect(recorder.Code).To(Equal(http.StatusOK))
+
+					err = json.NewDecoder(recorder.Body).Decode(&response)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Valid).To(BeTrue())
+					Expect(response.Format).To(Equal("yaml"))
+				})
+			})
+			When("validating a payload in the request body", func() {
+				It("should report syntax errors", func() {
+					req, err := http.NewRequest("POST", "/components/qc/ANY/any/newentry/validate?format=json", strings.NewReader(`{"a": }`))
+					Expect(err).NotTo(HaveOccurred())
+					handler.ServeHTTP(recorder, req)
+					Expect(recorder.Code).To(Equal(http.StatusOK))
+
+					err = json.NewDecoder(recorder.Body).Decode(&response)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Valid).To(BeFalse())
+					Expect(response.Errors).To(HaveLen(1))
+				})
+			})
+		})
+
+		Describe("listing component configuration schemas", func() {
+			When("the component has no schemas", func() {
+				It("should return an empty JSON object", func() {
+					req, err := http.NewRequest("GET", "/schemas/qc", nil)
+					Expect(err).NotTo(HaveOccurred())
+					handler.ServeHTTP(recorder, req)
+					Expect(recorder.Code).To(Equal(http.StatusOK))
+
+					var schemas map[string]string
+					err = json.NewDecoder(recorder.Body).Decode(&schemas)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(schemas).To(BeEmpty())
+				})
+			})
+		})
+
+		Describe("getting run number allocations", func() {
+			When("no run number was allocated to the environment", func() {
+				It("should return an empty JSON array", func() {
+					req, err := http.NewRequest("GET", "/runs?environmentId=2oDvieFrVTx", nil)
+					Expect(err).NotTo(HaveOccurred())
+					handler.ServeHTTP(recorder, req)
+					Expect(recorder.Code).To(Equal(http.StatusOK))
+
+					var allocations []RunNumberAllocationResponse
+					err = json.NewDecoder(recorder.Body).Decode(&allocations)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(allocations).To(BeEmpty())
+				})
+			})
+			When("the run number is not valid", func() {
+				It("should return bad request", func() {
+					req, err := http.NewRequest("GET", "/runs/abc", nil)
+					Expect(err).NotTo(HaveOccurred())
+					handler.ServeHTTP(recorder, req)
+					Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+				})
+			})
+		})
 	})
 })
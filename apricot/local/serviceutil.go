@@ -25,14 +25,21 @@
 package local
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/viper"
 
+	"github.com/AliceO2Group/Control/configuration"
 	"github.com/AliceO2Group/Control/configuration/cfgbackend"
 	"github.com/AliceO2Group/Control/configuration/componentcfg"
+	"github.com/AliceO2Group/Control/configuration/template"
 )
 
 func (s *Service) queryToAbsPath(query *componentcfg.Query) (absolutePath string, err error) {
@@ -119,3 +126,152 @@ func (s *Service) resolveComponentQuery(query *componentcfg.Query) (resolved *co
 
 	return nil, fmt.Errorf("could not resolve configuration path %s", query.AbsoluteRaw())
 }
+
+// splitTemplatePath decomposes a component configuration path into prefix and
+// suffix, with the last / as separator.
+// This is done to allow internal references between template snippets
+// within the same Consul directory.
+func splitTemplatePath(path string) (basePath string, shortPath string) {
+	indexOfLastSeparator := strings.LastIndex(path, "/")
+	shortPath = path
+	if indexOfLastSeparator != -1 {
+		basePath = path[:indexOfLastSeparator]
+		shortPath = path[indexOfLastSeparator+1:]
+	}
+	return
+}
+
+func makeTemplateBindings(varStack map[string]string) map[string]interface{} {
+	bindings := make(map[string]interface{})
+	for k, v := range varStack {
+		bindings[strings.TrimSpace(k)] = v
+	}
+
+	// Add custom functions to bindings:
+	funcMap := template.MakeUtilFuncMap(varStack)
+	for k, v := range funcMap {
+		bindings[k] = v
+	}
+	return bindings
+}
+
+// getSchemaForQuery returns the schema that applies to the queried component
+// configuration entry, if any. Schemas with an explicit entry pattern take
+// precedence over catch-all component schemas.
+func (s *Service) getSchemaForQuery(query *componentcfg.Query) (name string, schema *componentcfg.Schema, err error) {
+	var schemas map[string]string
+	schemas, err = s.ListComponentSchemas(query.Component)
+	if err != nil {
+		return
+	}
+
+	names := make([]string, 0, len(schemas))
+	for schemaName := range schemas {
+		names = append(names, schemaName)
+	}
+	sort.Strings(names)
+
+	var fallbackName string
+	var fallback *componentcfg.Schema
+	for _, schemaName := range names {
+		var candidate *componentcfg.Schema
+		candidate, err = componentcfg.ParseSchema(schemas[schemaName])
+		if err != nil {
+			err = fmt.Errorf("schema %s for component %s: %w", schemaName, query.Component, err)
+			return
+		}
+		if !candidate.Matches(query) {
+			continue
+		}
+		if candidate.EntryPattern != "" {
+			return schemaName, candidate, nil
+		}
+		if fallback == nil {
+			fallbackName, fallback = schemaName, candidate
+		}
+	}
+	return fallbackName, fallback, nil
+}
+
+// isKVStore returns true if the backend is a key-value store, as opposed to a
+// configuration file, and can therefore hold runtime entries.
+func (s *Service) isKVStore() bool {
+	switch s.src.(type) {
+	case *cfgbackend.ConsulSource, *cfgbackend.EtcdSource:
+		return true
+	}
+	return false
+}
+
+// invalidateTemplateCacheOnChange keeps the template cache in sync with the
+// component configuration tree, for backends which support watches.
+func (s *Service) invalidateTemplateCacheOnChange(src cfgbackend.WatchableSource) {
+	changes, err := src.WatchRecursive(context.Background(), componentcfg.ConfigComponentsPath)
+	if err != nil {
+		log.WithError(err).Warn("cannot watch component configuration, template cache will not be invalidated on change")
+		return
+	}
+	go func() {
+		for changedKeys := range changes {
+			log.WithField("keys", strings.Join(changedKeys, ", ")).
+				Debug("component configuration changed, invalidating template cache")
+			s.InvalidateComponentTemplateCache()
+		}
+	}()
+}
+
+func (s *Service) fileRunNumberAllocator() *fileRunNumberAllocator {
+	return newFileRunNumberAllocator(viper.GetString("coreWorkingDir"))
+}
+
+func getRunNumberAllocationsPrefix() string {
+	return filepath.Join(getConsulRuntimePrefix(), "run_number_allocations")
+}
+
+func (s *Service) recordRunNumberAllocation(allocation *configuration.RunNumberAllocation) error {
+	record, err := json.Marshal(allocation)
+	if err != nil {
+		return err
+	}
+	key := filepath.Join(getRunNumberAllocationsPrefix(), strconv.FormatUint(uint64(allocation.RunNumber), 10))
+	err = s.src.Put(key, string(record))
+	if err != nil {
+		return fmt.Errorf("cannot record allocation of run number %d: %w", allocation.RunNumber, err)
+	}
+	return nil
+}
+
+// getRecordedRunNumberAllocations reads all allocation records with a single
+// recursive read of their prefix, and filters them according to query.
+func (s *Service) getRecordedRunNumberAllocations(query *configuration.RunNumberAllocationsQuery) (allocations []*configuration.RunNumberAllocation, err error) {
+	allocations = make([]*configuration.RunNumberAllocation, 0)
+
+	var records cfgbackend.Item
+	records, err = s.src.GetRecursive(getRunNumberAllocationsPrefix())
+	if err != nil {
+		return nil, err
+	}
+	if records == nil || records.Type() != cfgbackend.IT_Map {
+		return
+	}
+
+	for key, record := range records.Map() {
+		if record == nil || record.Type() != cfgbackend.IT_Value {
+			continue
+		}
+		allocation := &configuration.RunNumberAllocation{}
+		if jsonErr := json.Unmarshal([]byte(record.Value()), allocation); jsonErr != nil {
+			log.WithError(jsonErr).
+				WithField("key", filepath.Join(getRunNumberAllocationsPrefix(), key)).
+				Warn("skipping malformed run number allocation record")
+			continue
+		}
+		if query.Matches(allocation) {
+			allocations = append(allocations, allocation)
+		}
+	}
+	sort.Slice(allocations, func(i, j int) bool {
+		return allocations[i].RunNumber < allocations[j].RunNumber
+	})
+	return
+}
@@ -686,6 +686,61 @@ func (x *DetectorEntriesResponse) GetDetectorEntries() map[string]*DetectorInven
 	return nil
 }
 
+type RunNumberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentId string `protobuf:"bytes,1,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	Requester     string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+}
+
+func (x *RunNumberRequest) Reset() {
+	*x = RunNumberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunNumberRequest) ProtoMessage() {}
+
+func (x *RunNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunNumberRequest.ProtoReflect.Descriptor instead.
+func (*RunNumberRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RunNumberRequest) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+func (x *RunNumberRequest) GetRequester() string {
+	if x != nil {
+		return x.Requester
+	}
+	return ""
+}
+
 type RunNumberResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -697,7 +752,7 @@ type RunNumberResponse struct {
 func (x *RunNumberResponse) Reset() {
 	*x = RunNumberResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[10]
+		mi := &file_protos_apricot_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -710,7 +765,7 @@ func (x *RunNumberResponse) String() string {
 func (*RunNumberResponse) ProtoMessage() {}
 
 func (x *RunNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[10]
+	mi := &file_protos_apricot_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,7 +778,7 @@ func (x *RunNumberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunNumberResponse.ProtoReflect.Descriptor instead.
 func (*RunNumberResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{10}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RunNumberResponse) GetRunNumber() uint32 {
@@ -733,6 +788,182 @@ func (x *RunNumberResponse) GetRunNumber() uint32 {
 	return 0
 }
 
+type RunNumberAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunNumber     uint32 `protobuf:"varint,1,opt,name=runNumber,proto3" json:"runNumber,omitempty"`
+	EnvironmentId string `protobuf:"bytes,2,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	Requester     string `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	// milliseconds since epoch
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *RunNumberAllocation) Reset() {
+	*x = RunNumberAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunNumberAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunNumberAllocation) ProtoMessage() {}
+
+func (x *RunNumberAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunNumberAllocation.ProtoReflect.Descriptor instead.
+func (*RunNumberAllocation) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RunNumberAllocation) GetRunNumber() uint32 {
+	if x != nil {
+		return x.RunNumber
+	}
+	return 0
+}
+
+func (x *RunNumberAllocation) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+func (x *RunNumberAllocation) GetRequester() string {
+	if x != nil {
+		return x.Requester
+	}
+	return ""
+}
+
+func (x *RunNumberAllocation) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type RunNumberAllocationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 matches any run number
+	RunNumber uint32 `protobuf:"varint,1,opt,name=runNumber,proto3" json:"runNumber,omitempty"`
+	// empty matches any environment
+	EnvironmentId string `protobuf:"bytes,2,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+}
+
+func (x *RunNumberAllocationsRequest) Reset() {
+	*x = RunNumberAllocationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunNumberAllocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunNumberAllocationsRequest) ProtoMessage() {}
+
+func (x *RunNumberAllocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunNumberAllocationsRequest.ProtoReflect.Descriptor instead.
+func (*RunNumberAllocationsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RunNumberAllocationsRequest) GetRunNumber() uint32 {
+	if x != nil {
+		return x.RunNumber
+	}
+	return 0
+}
+
+func (x *RunNumberAllocationsRequest) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+type RunNumberAllocationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allocations []*RunNumberAllocation `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+}
+
+func (x *RunNumberAllocationsResponse) Reset() {
+	*x = RunNumberAllocationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunNumberAllocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunNumberAllocationsResponse) ProtoMessage() {}
+
+func (x *RunNumberAllocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunNumberAllocationsResponse.ProtoReflect.Descriptor instead.
+func (*RunNumberAllocationsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RunNumberAllocationsResponse) GetAllocations() []*RunNumberAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
 type StringMap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -744,7 +975,7 @@ type StringMap struct {
 func (x *StringMap) Reset() {
 	*x = StringMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[11]
+		mi := &file_protos_apricot_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -757,7 +988,7 @@ func (x *StringMap) String() string {
 func (*StringMap) ProtoMessage() {}
 
 func (x *StringMap) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[11]
+	mi := &file_protos_apricot_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,7 +1001,7 @@ func (x *StringMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringMap.ProtoReflect.Descriptor instead.
 func (*StringMap) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{11}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *StringMap) GetStringMap() map[string]string {
@@ -791,7 +1022,7 @@ type RawGetRecursiveRequest struct {
 func (x *RawGetRecursiveRequest) Reset() {
 	*x = RawGetRecursiveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[12]
+		mi := &file_protos_apricot_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -804,7 +1035,7 @@ func (x *RawGetRecursiveRequest) String() string {
 func (*RawGetRecursiveRequest) ProtoMessage() {}
 
 func (x *RawGetRecursiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[12]
+	mi := &file_protos_apricot_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +1048,7 @@ func (x *RawGetRecursiveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RawGetRecursiveRequest.ProtoReflect.Descriptor instead.
 func (*RawGetRecursiveRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{12}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RawGetRecursiveRequest) GetRawPath() string {
@@ -827,6 +1058,211 @@ func (x *RawGetRecursiveRequest) GetRawPath() string {
 	return ""
 }
 
+type RawPutRecursiveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RawPath string `protobuf:"bytes,1,opt,name=rawPath,proto3" json:"rawPath,omitempty"`
+	// JSON subtree, as returned by RawGetRecursive
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *RawPutRecursiveRequest) Reset() {
+	*x = RawPutRecursiveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawPutRecursiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawPutRecursiveRequest) ProtoMessage() {}
+
+func (x *RawPutRecursiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawPutRecursiveRequest.ProtoReflect.Descriptor instead.
+func (*RawPutRecursiveRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RawPutRecursiveRequest) GetRawPath() string {
+	if x != nil {
+		return x.RawPath
+	}
+	return ""
+}
+
+func (x *RawPutRecursiveRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type SecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SecretRequest) Reset() {
+	*x = SecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretRequest) ProtoMessage() {}
+
+func (x *SecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretRequest.ProtoReflect.Descriptor instead.
+func (*SecretRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SecretResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SecretResponse) Reset() {
+	*x = SecretResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretResponse) ProtoMessage() {}
+
+func (x *SecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretResponse.ProtoReflect.Descriptor instead.
+func (*SecretResponse) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SecretResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SetSecretRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SetSecretRequest) Reset() {
+	*x = SetSecretRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSecretRequest) ProtoMessage() {}
+
+func (x *SetSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSecretRequest.ProtoReflect.Descriptor instead.
+func (*SetSecretRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetSecretRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type GetRuntimeEntryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -839,7 +1275,7 @@ type GetRuntimeEntryRequest struct {
 func (x *GetRuntimeEntryRequest) Reset() {
 	*x = GetRuntimeEntryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[13]
+		mi := &file_protos_apricot_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -852,7 +1288,7 @@ func (x *GetRuntimeEntryRequest) String() string {
 func (*GetRuntimeEntryRequest) ProtoMessage() {}
 
 func (x *GetRuntimeEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[13]
+	mi := &file_protos_apricot_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -865,7 +1301,7 @@ func (x *GetRuntimeEntryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRuntimeEntryRequest.ProtoReflect.Descriptor instead.
 func (*GetRuntimeEntryRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{13}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetRuntimeEntryRequest) GetComponent() string {
@@ -895,7 +1331,7 @@ type SetRuntimeEntryRequest struct {
 func (x *SetRuntimeEntryRequest) Reset() {
 	*x = SetRuntimeEntryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[14]
+		mi := &file_protos_apricot_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -908,7 +1344,7 @@ func (x *SetRuntimeEntryRequest) String() string {
 func (*SetRuntimeEntryRequest) ProtoMessage() {}
 
 func (x *SetRuntimeEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[14]
+	mi := &file_protos_apricot_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,7 +1357,7 @@ func (x *SetRuntimeEntryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetRuntimeEntryRequest.ProtoReflect.Descriptor instead.
 func (*SetRuntimeEntryRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{14}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SetRuntimeEntryRequest) GetComponent() string {
@@ -956,7 +1392,7 @@ type GetEntryRequest struct {
 func (x *GetEntryRequest) Reset() {
 	*x = GetEntryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[15]
+		mi := &file_protos_apricot_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -969,7 +1405,7 @@ func (x *GetEntryRequest) String() string {
 func (*GetEntryRequest) ProtoMessage() {}
 
 func (x *GetEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[15]
+	mi := &file_protos_apricot_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,7 +1418,7 @@ func (x *GetEntryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEntryRequest.ProtoReflect.Descriptor instead.
 func (*GetEntryRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{15}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetEntryRequest) GetKey() string {
@@ -1003,7 +1439,7 @@ type GetRuntimeEntriesRequest struct {
 func (x *GetRuntimeEntriesRequest) Reset() {
 	*x = GetRuntimeEntriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[16]
+		mi := &file_protos_apricot_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1016,7 +1452,7 @@ func (x *GetRuntimeEntriesRequest) String() string {
 func (*GetRuntimeEntriesRequest) ProtoMessage() {}
 
 func (x *GetRuntimeEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[16]
+	mi := &file_protos_apricot_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,7 +1465,7 @@ func (x *GetRuntimeEntriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRuntimeEntriesRequest.ProtoReflect.Descriptor instead.
 func (*GetRuntimeEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{16}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetRuntimeEntriesRequest) GetComponent() string {
@@ -1050,7 +1486,7 @@ type ListRuntimeEntriesRequest struct {
 func (x *ListRuntimeEntriesRequest) Reset() {
 	*x = ListRuntimeEntriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[17]
+		mi := &file_protos_apricot_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1063,7 +1499,7 @@ func (x *ListRuntimeEntriesRequest) String() string {
 func (*ListRuntimeEntriesRequest) ProtoMessage() {}
 
 func (x *ListRuntimeEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[17]
+	mi := &file_protos_apricot_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,7 +1512,7 @@ func (x *ListRuntimeEntriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRuntimeEntriesRequest.ProtoReflect.Descriptor instead.
 func (*ListRuntimeEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{17}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListRuntimeEntriesRequest) GetComponent() string {
@@ -1099,7 +1535,7 @@ type ComponentEntriesQuery struct {
 func (x *ComponentEntriesQuery) Reset() {
 	*x = ComponentEntriesQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[18]
+		mi := &file_protos_apricot_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1112,7 +1548,7 @@ func (x *ComponentEntriesQuery) String() string {
 func (*ComponentEntriesQuery) ProtoMessage() {}
 
 func (x *ComponentEntriesQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[18]
+	mi := &file_protos_apricot_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1125,7 +1561,7 @@ func (x *ComponentEntriesQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComponentEntriesQuery.ProtoReflect.Descriptor instead.
 func (*ComponentEntriesQuery) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{18}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ComponentEntriesQuery) GetComponent() string {
@@ -1164,7 +1600,7 @@ type ListComponentEntriesRequest struct {
 func (x *ListComponentEntriesRequest) Reset() {
 	*x = ListComponentEntriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[19]
+		mi := &file_protos_apricot_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1177,7 +1613,7 @@ func (x *ListComponentEntriesRequest) String() string {
 func (*ListComponentEntriesRequest) ProtoMessage() {}
 
 func (x *ListComponentEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[19]
+	mi := &file_protos_apricot_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,7 +1626,7 @@ func (x *ListComponentEntriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListComponentEntriesRequest.ProtoReflect.Descriptor instead.
 func (*ListComponentEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{19}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{27}
 }
 
 func (m *ListComponentEntriesRequest) GetQueryPath() isListComponentEntriesRequest_QueryPath {
@@ -1241,7 +1677,7 @@ type ComponentEntriesResponse struct {
 func (x *ComponentEntriesResponse) Reset() {
 	*x = ComponentEntriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[20]
+		mi := &file_protos_apricot_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1254,7 +1690,7 @@ func (x *ComponentEntriesResponse) String() string {
 func (*ComponentEntriesResponse) ProtoMessage() {}
 
 func (x *ComponentEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[20]
+	mi := &file_protos_apricot_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1267,7 +1703,7 @@ func (x *ComponentEntriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComponentEntriesResponse.ProtoReflect.Descriptor instead.
 func (*ComponentEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{20}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ComponentEntriesResponse) GetPayload() []string {
@@ -1288,7 +1724,7 @@ type DetectorsRequest struct {
 func (x *DetectorsRequest) Reset() {
 	*x = DetectorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[21]
+		mi := &file_protos_apricot_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1301,7 +1737,7 @@ func (x *DetectorsRequest) String() string {
 func (*DetectorsRequest) ProtoMessage() {}
 
 func (x *DetectorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[21]
+	mi := &file_protos_apricot_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1314,7 +1750,7 @@ func (x *DetectorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetectorsRequest.ProtoReflect.Descriptor instead.
 func (*DetectorsRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{21}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DetectorsRequest) GetGetAll() bool {
@@ -1335,7 +1771,7 @@ type DetectorsResponse struct {
 func (x *DetectorsResponse) Reset() {
 	*x = DetectorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[22]
+		mi := &file_protos_apricot_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1348,7 +1784,7 @@ func (x *DetectorsResponse) String() string {
 func (*DetectorsResponse) ProtoMessage() {}
 
 func (x *DetectorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[22]
+	mi := &file_protos_apricot_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1361,7 +1797,7 @@ func (x *DetectorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetectorsResponse.ProtoReflect.Descriptor instead.
 func (*DetectorsResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{22}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DetectorsResponse) GetDetectors() []string {
@@ -1382,7 +1818,7 @@ type HostGetRequest struct {
 func (x *HostGetRequest) Reset() {
 	*x = HostGetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[23]
+		mi := &file_protos_apricot_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1395,7 +1831,7 @@ func (x *HostGetRequest) String() string {
 func (*HostGetRequest) ProtoMessage() {}
 
 func (x *HostGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[23]
+	mi := &file_protos_apricot_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1408,7 +1844,7 @@ func (x *HostGetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostGetRequest.ProtoReflect.Descriptor instead.
 func (*HostGetRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{23}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *HostGetRequest) GetDetector() string {
@@ -1429,7 +1865,7 @@ type HostEntriesResponse struct {
 func (x *HostEntriesResponse) Reset() {
 	*x = HostEntriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[24]
+		mi := &file_protos_apricot_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1442,7 +1878,7 @@ func (x *HostEntriesResponse) String() string {
 func (*HostEntriesResponse) ProtoMessage() {}
 
 func (x *HostEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[24]
+	mi := &file_protos_apricot_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1455,7 +1891,7 @@ func (x *HostEntriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostEntriesResponse.ProtoReflect.Descriptor instead.
 func (*HostEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{24}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *HostEntriesResponse) GetHosts() []string {
@@ -1473,12 +1909,14 @@ type ImportComponentConfigurationRequest struct {
 	Query        *ComponentQuery `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	Payload      string          `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 	NewComponent bool            `protobuf:"varint,3,opt,name=newComponent,proto3" json:"newComponent,omitempty"`
+	// json, yaml, ini or toml, used for the syntax check if no schema applies
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
 }
 
 func (x *ImportComponentConfigurationRequest) Reset() {
 	*x = ImportComponentConfigurationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[25]
+		mi := &file_protos_apricot_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1491,7 +1929,7 @@ func (x *ImportComponentConfigurationRequest) String() string {
 func (*ImportComponentConfigurationRequest) ProtoMessage() {}
 
 func (x *ImportComponentConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[25]
+	mi := &file_protos_apricot_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1504,56 +1942,310 @@ func (x *ImportComponentConfigurationRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ImportComponentConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*ImportComponentConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{25}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ImportComponentConfigurationRequest) GetQuery() *ComponentQuery {
 	if x != nil {
 		return x.Query
 	}
-	return nil
+	return nil
+}
+
+func (x *ImportComponentConfigurationRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *ImportComponentConfigurationRequest) GetNewComponent() bool {
+	if x != nil {
+		return x.NewComponent
+	}
+	return false
+}
+
+func (x *ImportComponentConfigurationRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ImportComponentConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExistingComponentUpdated bool `protobuf:"varint,1,opt,name=existingComponentUpdated,proto3" json:"existingComponentUpdated,omitempty"`
+	ExistingEntryUpdated     bool `protobuf:"varint,2,opt,name=existingEntryUpdated,proto3" json:"existingEntryUpdated,omitempty"`
+}
+
+func (x *ImportComponentConfigurationResponse) Reset() {
+	*x = ImportComponentConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportComponentConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportComponentConfigurationResponse) ProtoMessage() {}
+
+func (x *ImportComponentConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportComponentConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ImportComponentConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportComponentConfigurationResponse) GetExistingComponentUpdated() bool {
+	if x != nil {
+		return x.ExistingComponentUpdated
+	}
+	return false
+}
+
+func (x *ImportComponentConfigurationResponse) GetExistingEntryUpdated() bool {
+	if x != nil {
+		return x.ExistingEntryUpdated
+	}
+	return false
+}
+
+type ValidateComponentConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query *ComponentQuery `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// if empty, the payload currently stored for the query is validated
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// json, yaml, ini or toml, overridden by the format declared in the schema
+	Format   string            `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	VarStack map[string]string `protobuf:"bytes,4,rep,name=varStack,proto3" json:"varStack,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ValidateComponentConfigurationRequest) Reset() {
+	*x = ValidateComponentConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateComponentConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateComponentConfigurationRequest) ProtoMessage() {}
+
+func (x *ValidateComponentConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateComponentConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ValidateComponentConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ValidateComponentConfigurationRequest) GetQuery() *ComponentQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ValidateComponentConfigurationRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *ValidateComponentConfigurationRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ValidateComponentConfigurationRequest) GetVarStack() map[string]string {
+	if x != nil {
+		return x.VarStack
+	}
+	return nil
+}
+
+type ValidateComponentConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid  bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Format string   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Schema string   `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	Errors []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ValidateComponentConfigurationResponse) Reset() {
+	*x = ValidateComponentConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateComponentConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateComponentConfigurationResponse) ProtoMessage() {}
+
+func (x *ValidateComponentConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateComponentConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ValidateComponentConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ValidateComponentConfigurationResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateComponentConfigurationResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ValidateComponentConfigurationResponse) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *ValidateComponentConfigurationResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ListComponentSchemasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+}
+
+func (x *ListComponentSchemasRequest) Reset() {
+	*x = ListComponentSchemasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListComponentSchemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentSchemasRequest) ProtoMessage() {}
+
+func (x *ListComponentSchemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *ImportComponentConfigurationRequest) GetPayload() string {
-	if x != nil {
-		return x.Payload
-	}
-	return ""
+// Deprecated: Use ListComponentSchemasRequest.ProtoReflect.Descriptor instead.
+func (*ListComponentSchemasRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ImportComponentConfigurationRequest) GetNewComponent() bool {
+func (x *ListComponentSchemasRequest) GetComponent() string {
 	if x != nil {
-		return x.NewComponent
+		return x.Component
 	}
-	return false
+	return ""
 }
 
-type ImportComponentConfigurationResponse struct {
+type ImportComponentSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExistingComponentUpdated bool `protobuf:"varint,1,opt,name=existingComponentUpdated,proto3" json:"existingComponentUpdated,omitempty"`
-	ExistingEntryUpdated     bool `protobuf:"varint,2,opt,name=existingEntryUpdated,proto3" json:"existingEntryUpdated,omitempty"`
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Payload   string `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (x *ImportComponentConfigurationResponse) Reset() {
-	*x = ImportComponentConfigurationResponse{}
+func (x *ImportComponentSchemaRequest) Reset() {
+	*x = ImportComponentSchemaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[26]
+		mi := &file_protos_apricot_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportComponentConfigurationResponse) String() string {
+func (x *ImportComponentSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportComponentConfigurationResponse) ProtoMessage() {}
+func (*ImportComponentSchemaRequest) ProtoMessage() {}
 
-func (x *ImportComponentConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[26]
+func (x *ImportComponentSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1564,23 +2256,30 @@ func (x *ImportComponentConfigurationResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportComponentConfigurationResponse.ProtoReflect.Descriptor instead.
-func (*ImportComponentConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ImportComponentSchemaRequest.ProtoReflect.Descriptor instead.
+func (*ImportComponentSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ImportComponentConfigurationResponse) GetExistingComponentUpdated() bool {
+func (x *ImportComponentSchemaRequest) GetComponent() string {
 	if x != nil {
-		return x.ExistingComponentUpdated
+		return x.Component
 	}
-	return false
+	return ""
 }
 
-func (x *ImportComponentConfigurationResponse) GetExistingEntryUpdated() bool {
+func (x *ImportComponentSchemaRequest) GetName() string {
 	if x != nil {
-		return x.ExistingEntryUpdated
+		return x.Name
 	}
-	return false
+	return ""
+}
+
+func (x *ImportComponentSchemaRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
 }
 
 type CRUCardsResponse struct {
@@ -1594,7 +2293,7 @@ type CRUCardsResponse struct {
 func (x *CRUCardsResponse) Reset() {
 	*x = CRUCardsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[27]
+		mi := &file_protos_apricot_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1607,7 +2306,7 @@ func (x *CRUCardsResponse) String() string {
 func (*CRUCardsResponse) ProtoMessage() {}
 
 func (x *CRUCardsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[27]
+	mi := &file_protos_apricot_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,7 +2319,7 @@ func (x *CRUCardsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CRUCardsResponse.ProtoReflect.Descriptor instead.
 func (*CRUCardsResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{27}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *CRUCardsResponse) GetCards() string {
@@ -1642,7 +2341,7 @@ type CardRequest struct {
 func (x *CardRequest) Reset() {
 	*x = CardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[28]
+		mi := &file_protos_apricot_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1655,7 +2354,7 @@ func (x *CardRequest) String() string {
 func (*CardRequest) ProtoMessage() {}
 
 func (x *CardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[28]
+	mi := &file_protos_apricot_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1668,7 +2367,7 @@ func (x *CardRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardRequest.ProtoReflect.Descriptor instead.
 func (*CardRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{28}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *CardRequest) GetHostname() string {
@@ -1696,7 +2395,7 @@ type CRUCardEndpointResponse struct {
 func (x *CRUCardEndpointResponse) Reset() {
 	*x = CRUCardEndpointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[29]
+		mi := &file_protos_apricot_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1709,7 +2408,7 @@ func (x *CRUCardEndpointResponse) String() string {
 func (*CRUCardEndpointResponse) ProtoMessage() {}
 
 func (x *CRUCardEndpointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[29]
+	mi := &file_protos_apricot_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1722,7 +2421,7 @@ func (x *CRUCardEndpointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CRUCardEndpointResponse.ProtoReflect.Descriptor instead.
 func (*CRUCardEndpointResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{29}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CRUCardEndpointResponse) GetEndpoints() string {
@@ -1746,7 +2445,7 @@ type LinkIDsRequest struct {
 func (x *LinkIDsRequest) Reset() {
 	*x = LinkIDsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[30]
+		mi := &file_protos_apricot_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1759,7 +2458,7 @@ func (x *LinkIDsRequest) String() string {
 func (*LinkIDsRequest) ProtoMessage() {}
 
 func (x *LinkIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[30]
+	mi := &file_protos_apricot_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1772,7 +2471,7 @@ func (x *LinkIDsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkIDsRequest.ProtoReflect.Descriptor instead.
 func (*LinkIDsRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{30}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *LinkIDsRequest) GetHostname() string {
@@ -1814,7 +2513,7 @@ type LinkIDsResponse struct {
 func (x *LinkIDsResponse) Reset() {
 	*x = LinkIDsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[31]
+		mi := &file_protos_apricot_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1827,7 +2526,7 @@ func (x *LinkIDsResponse) String() string {
 func (*LinkIDsResponse) ProtoMessage() {}
 
 func (x *LinkIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[31]
+	mi := &file_protos_apricot_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,7 +2539,7 @@ func (x *LinkIDsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkIDsResponse.ProtoReflect.Descriptor instead.
 func (*LinkIDsResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{31}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *LinkIDsResponse) GetLinkIDs() []string {
@@ -1862,7 +2561,7 @@ type AliasedLinkIDsRequest struct {
 func (x *AliasedLinkIDsRequest) Reset() {
 	*x = AliasedLinkIDsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[32]
+		mi := &file_protos_apricot_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1875,7 +2574,7 @@ func (x *AliasedLinkIDsRequest) String() string {
 func (*AliasedLinkIDsRequest) ProtoMessage() {}
 
 func (x *AliasedLinkIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[32]
+	mi := &file_protos_apricot_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1888,7 +2587,7 @@ func (x *AliasedLinkIDsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AliasedLinkIDsRequest.ProtoReflect.Descriptor instead.
 func (*AliasedLinkIDsRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{32}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *AliasedLinkIDsRequest) GetDetector() string {
@@ -1916,7 +2615,7 @@ type AliasedLinkIDsResponse struct {
 func (x *AliasedLinkIDsResponse) Reset() {
 	*x = AliasedLinkIDsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[33]
+		mi := &file_protos_apricot_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1929,7 +2628,7 @@ func (x *AliasedLinkIDsResponse) String() string {
 func (*AliasedLinkIDsResponse) ProtoMessage() {}
 
 func (x *AliasedLinkIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[33]
+	mi := &file_protos_apricot_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1942,7 +2641,7 @@ func (x *AliasedLinkIDsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AliasedLinkIDsResponse.ProtoReflect.Descriptor instead.
 func (*AliasedLinkIDsResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{33}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *AliasedLinkIDsResponse) GetAliasedLinkIDs() []string {
@@ -2018,277 +2717,403 @@ var file_protos_apricot_proto_rawDesc = []byte{
 	0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x44, 0x65, 0x74, 0x65,
 	0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x31, 0x0a, 0x11, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x22, 0x8a, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61,
-	0x70, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d,
-	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d,
-	0x61, 0x70, 0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x32, 0x0a, 0x16, 0x52, 0x61, 0x77, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73,
-	0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61,
-	0x77, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x77,
-	0x50, 0x61, 0x74, 0x68, 0x22, 0x48, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x5e,
-	0x0a, 0x16, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x23,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x22, 0x38, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x39, 0x0a,
-	0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x22, 0x56, 0x0a, 0x10, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22, 0x31, 0x0a, 0x11, 0x52, 0x75, 0x6e, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x95, 0x01, 0x0a, 0x13,
+	0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x61, 0x0a, 0x1b, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x1c, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x4d, 0x61, 0x70, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61,
+	0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4d, 0x61, 0x70, 0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x32, 0x0a, 0x16, 0x52, 0x61, 0x77, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x61, 0x77, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x61, 0x77, 0x50, 0x61, 0x74, 0x68, 0x22, 0x4c, 0x0a, 0x16, 0x52, 0x61, 0x77, 0x50, 0x75,
+	0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x77, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x77, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x23, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x26, 0x0a, 0x0e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x3c, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x48, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
 	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x5e, 0x0a, 0x16, 0x53, 0x65,
+	0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x23, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
+	0x38, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x39, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x07,
+	0x72, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x07, 0x72, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x78, 0x0a, 0x1b, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x36, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x74, 0x68, 0x22, 0x34, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x2a, 0x0a, 0x10, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x67, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x22, 0x31, 0x0a, 0x11, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x2c, 0x0a, 0x0e, 0x48, 0x6f, 0x73,
+	0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x2b, 0x0a, 0x13, 0x48, 0x6f, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x68,
+	0x6f, 0x73, 0x74, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x23, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x65, 0x77,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x22, 0x96, 0x01, 0x0a, 0x24, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x9f, 0x02, 0x0a, 0x25, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x58, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x53, 0x74, 0x61, 0x63,
+	0x6b, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x72, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x76, 0x61, 0x72, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x1a,
+	0x3b, 0x0a, 0x0d, 0x56, 0x61, 0x72, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x86, 0x01, 0x0a,
+	0x26, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x22, 0x6a, 0x0a, 0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x12, 0x2a, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x78,
-	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x48, 0x00, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x22, 0x34, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x2a,
-	0x0a, 0x10, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x22, 0x31, 0x0a, 0x11, 0x44, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x2c, 0x0a,
-	0x0e, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x2b, 0x0a, 0x13, 0x48,
-	0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x23, 0x49, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2d, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x77,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x96, 0x01,
-	0x0a, 0x24, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x14, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x28, 0x0a, 0x10, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72,
-	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61,
-	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73,
-	0x22, 0x49, 0x0a, 0x0b, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0x37, 0x0a, 0x17, 0x43,
-	0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x28,
+	0x0a, 0x10, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x0b, 0x43, 0x61, 0x72, 0x64,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
 	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61,
 	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x22, 0x2b, 0x0a, 0x0f, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x22, 0x55,
-	0x0a, 0x15, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x40, 0x0a, 0x16, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x64,
-	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x64,
-	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x2a, 0x96, 0x03, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x50, 0x48, 0x59, 0x53, 0x49, 0x43, 0x53, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45,
-	0x43, 0x48, 0x4e, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x45, 0x44,
-	0x45, 0x53, 0x54, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x4c, 0x53, 0x45,
-	0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x41, 0x53, 0x45, 0x52, 0x10, 0x05, 0x12, 0x1b,
-	0x0a, 0x17, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x54,
-	0x48, 0x52, 0x5f, 0x54, 0x55, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18, 0x43,
-	0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x43, 0x41, 0x53, 0x4e,
-	0x5f, 0x54, 0x55, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x41, 0x4c,
-	0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x48, 0x52, 0x5f, 0x53, 0x43, 0x41,
-	0x4e, 0x10, 0x08, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x47, 0x49, 0x54, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10,
-	0x09, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x41, 0x4e, 0x41, 0x4c, 0x4f, 0x47, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x0a, 0x12, 0x13,
-	0x0a, 0x0f, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x48,
-	0x52, 0x10, 0x0b, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x50, 0x49, 0x44, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x0c,
-	0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x53, 0x4d, 0x49, 0x43, 0x53, 0x10, 0x0e, 0x12, 0x0d,
-	0x0a, 0x09, 0x53, 0x59, 0x4e, 0x54, 0x48, 0x45, 0x54, 0x49, 0x43, 0x10, 0x0f, 0x12, 0x09, 0x0a,
-	0x05, 0x4e, 0x4f, 0x49, 0x53, 0x45, 0x10, 0x10, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x4c, 0x49,
-	0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x55, 0x4c, 0x53, 0x45, 0x5f, 0x4c, 0x45,
-	0x4e, 0x47, 0x54, 0x48, 0x10, 0x11, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x52, 0x45, 0x53, 0x45, 0x54, 0x44, 0x10, 0x12, 0x12,
-	0x08, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0xac, 0x02, 0x22, 0x05, 0x08, 0x13, 0x10, 0xab, 0x02,
-	0x32, 0x91, 0x0f, 0x0a, 0x07, 0x41, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x12, 0x3c, 0x0a, 0x0c,
-	0x4e, 0x65, 0x77, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x0e, 0x2e, 0x61,
-	0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x61,
-	0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69,
-	0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x72, 0x69,
-	0x63, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x22, 0x00, 0x12,
-	0x2f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72,
-	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x72,
-	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x22, 0x00,
-	0x12, 0x50, 0x0a, 0x0f, 0x52, 0x61, 0x77, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73,
-	0x69, 0x76, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x61,
-	0x77, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x44, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x72, 0x69,
-	0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
-	0x72, 0x79, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x44, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x46, 0x6f, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61,
-	0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x44, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x46,
-	0x6f, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
-	0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x48, 0x6f,
-	0x73, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63,
-	0x6f, 0x74, 0x2e, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x12,
-	0x14, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e,
-	0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x18, 0x47, 0x65, 0x74,
-	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x52, 0x55, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e,
-	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x1c, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x46,
-	0x6f, 0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x72,
-	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b,
-	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x72,
-	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b,
-	0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x69, 0x61, 0x6c, 0x22, 0x37, 0x0a, 0x17, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x8a, 0x01, 0x0a,
+	0x0e, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6e,
+	0x6c, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x2b, 0x0a, 0x0f, 0x4c, 0x69, 0x6e,
+	0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x22, 0x55, 0x0a, 0x15, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65,
+	0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6f,
+	0x6e, 0x6c, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x40, 0x0a,
+	0x16, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x2a,
+	0x96, 0x03, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x48, 0x59, 0x53, 0x49, 0x43, 0x53,
+	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x43, 0x48, 0x4e, 0x49, 0x43, 0x41, 0x4c, 0x10,
+	0x02, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x45, 0x44, 0x45, 0x53, 0x54, 0x41, 0x4c, 0x10, 0x03, 0x12,
+	0x0a, 0x0a, 0x06, 0x50, 0x55, 0x4c, 0x53, 0x45, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x4c,
+	0x41, 0x53, 0x45, 0x52, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x54, 0x48, 0x52, 0x5f, 0x54, 0x55, 0x4e, 0x49, 0x4e,
+	0x47, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x56, 0x43, 0x41, 0x53, 0x4e, 0x5f, 0x54, 0x55, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x07, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x48, 0x52, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x08, 0x12, 0x1c, 0x0a, 0x18, 0x43,
+	0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x47, 0x49, 0x54,
+	0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x09, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x41, 0x4c,
+	0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4e, 0x41, 0x4c, 0x4f, 0x47, 0x5f,
+	0x53, 0x43, 0x41, 0x4e, 0x10, 0x0a, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x48, 0x52, 0x10, 0x0b, 0x12, 0x1b, 0x0a, 0x17, 0x43,
+	0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x50, 0x49, 0x44,
+	0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x4c, 0x49,
+	0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x53,
+	0x4d, 0x49, 0x43, 0x53, 0x10, 0x0e, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x59, 0x4e, 0x54, 0x48, 0x45,
+	0x54, 0x49, 0x43, 0x10, 0x0f, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x4f, 0x49, 0x53, 0x45, 0x10, 0x10,
+	0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x55, 0x4c, 0x53, 0x45, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x11, 0x12, 0x17,
+	0x0a, 0x13, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x52,
+	0x45, 0x53, 0x45, 0x54, 0x44, 0x10, 0x12, 0x12, 0x08, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0xac,
+	0x02, 0x22, 0x05, 0x08, 0x13, 0x10, 0xab, 0x02, 0x32, 0xf0, 0x14, 0x0a, 0x07, 0x41, 0x70, 0x72,
+	0x69, 0x63, 0x6f, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x4e, 0x65, 0x77, 0x52, 0x75, 0x6e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52,
+	0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63,
+	0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x22, 0x00, 0x12, 0x50, 0x0a,
+	0x0f, 0x52, 0x61, 0x77, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65,
+	0x12, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x61, 0x77, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
 	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x44, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x65, 0x74,
-	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x44, 0x0a, 0x0f, 0x52, 0x61, 0x77, 0x50, 0x75, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69,
+	0x76, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x61, 0x77,
+	0x50, 0x75, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x72,
-	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
-	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61,
-	0x70, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x72, 0x69,
-	0x63, 0x6f, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
-	0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x19,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x72, 0x69,
-	0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74,
+	0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61,
+	0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x49, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x46, 0x6f, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12,
+	0x14, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x46, 0x6f, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x72,
+	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x73, 0x46, 0x6f,
+	0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x52, 0x55, 0x43, 0x61,
+	0x72, 0x64, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63,
+	0x6f, 0x74, 0x2e, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x52, 0x55,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63,
+	0x6f, 0x74, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x49,
+	0x44, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x2e,
+	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x64, 0x4c,
+	0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x64, 0x4c,
+	0x69, 0x6e, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x50, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43,
 	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x6e, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57,
-	0x69, 0x74, 0x68, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x2e, 0x61,
+	0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e,
+	0x53, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e,
+	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x4d, 0x61, 0x70, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x61,
+	0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x72,
+	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x54, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x61,
 	0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
 	0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x61, 0x70,
-	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x00, 0x12,
-	0x7d, 0x0a, 0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2c, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
-	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x19, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x72,
+	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17,
+	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x22, 0x00, 0x12, 0x7d, 0x0a, 0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x83, 0x01, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
 	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44,
-	0x0a, 0x20, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x42, 0x5e, 0x0a, 0x22, 0x63, 0x68, 0x2e, 0x63, 0x65, 0x72, 0x6e, 0x2e,
-	0x61, 0x6c, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x32, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74,
-	0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6c, 0x69, 0x63, 0x65, 0x4f, 0x32, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x2f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x72, 0x69,
-	0x63, 0x6f, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x3b, 0x61, 0x70, 0x72, 0x69, 0x63,
-	0x6f, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x20, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x0e, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x22,
+	0x00, 0x12, 0x50, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x72,
+	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x16, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63,
+	0x6f, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x19, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a,
+	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x2e,
+	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x5e, 0x0a, 0x22, 0x63,
+	0x68, 0x2e, 0x63, 0x65, 0x72, 0x6e, 0x2e, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x32, 0x2e,
+	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6c,
+	0x69, 0x63, 0x65, 0x4f, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2f, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x3b, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2304,110 +3129,144 @@ func file_protos_apricot_proto_rawDescGZIP() []byte {
 }
 
 var file_protos_apricot_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_protos_apricot_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_protos_apricot_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_protos_apricot_proto_goTypes = []interface{}{
-	(RunType)(0),                                 // 0: apricot.RunType
-	(*Empty)(nil),                                // 1: apricot.Empty
-	(*ComponentQuery)(nil),                       // 2: apricot.ComponentQuery
-	(*ComponentRequest)(nil),                     // 3: apricot.ComponentRequest
-	(*ComponentResponse)(nil),                    // 4: apricot.ComponentResponse
-	(*ComponentResponseWithLastIndex)(nil),       // 5: apricot.ComponentResponseWithLastIndex
-	(*HostRequest)(nil),                          // 6: apricot.HostRequest
-	(*HostsRequest)(nil),                         // 7: apricot.HostsRequest
-	(*DetectorResponse)(nil),                     // 8: apricot.DetectorResponse
-	(*DetectorInventoryResponse)(nil),            // 9: apricot.DetectorInventoryResponse
-	(*DetectorEntriesResponse)(nil),              // 10: apricot.DetectorEntriesResponse
-	(*RunNumberResponse)(nil),                    // 11: apricot.RunNumberResponse
-	(*StringMap)(nil),                            // 12: apricot.StringMap
-	(*RawGetRecursiveRequest)(nil),               // 13: apricot.RawGetRecursiveRequest
-	(*GetRuntimeEntryRequest)(nil),               // 14: apricot.GetRuntimeEntryRequest
-	(*SetRuntimeEntryRequest)(nil),               // 15: apricot.SetRuntimeEntryRequest
-	(*GetEntryRequest)(nil),                      // 16: apricot.GetEntryRequest
-	(*GetRuntimeEntriesRequest)(nil),             // 17: apricot.GetRuntimeEntriesRequest
-	(*ListRuntimeEntriesRequest)(nil),            // 18: apricot.ListRuntimeEntriesRequest
-	(*ComponentEntriesQuery)(nil),                // 19: apricot.ComponentEntriesQuery
-	(*ListComponentEntriesRequest)(nil),          // 20: apricot.ListComponentEntriesRequest
-	(*ComponentEntriesResponse)(nil),             // 21: apricot.ComponentEntriesResponse
-	(*DetectorsRequest)(nil),                     // 22: apricot.DetectorsRequest
-	(*DetectorsResponse)(nil),                    // 23: apricot.DetectorsResponse
-	(*HostGetRequest)(nil),                       // 24: apricot.HostGetRequest
-	(*HostEntriesResponse)(nil),                  // 25: apricot.HostEntriesResponse
-	(*ImportComponentConfigurationRequest)(nil),  // 26: apricot.ImportComponentConfigurationRequest
-	(*ImportComponentConfigurationResponse)(nil), // 27: apricot.ImportComponentConfigurationResponse
-	(*CRUCardsResponse)(nil),                     // 28: apricot.CRUCardsResponse
-	(*CardRequest)(nil),                          // 29: apricot.CardRequest
-	(*CRUCardEndpointResponse)(nil),              // 30: apricot.CRUCardEndpointResponse
-	(*LinkIDsRequest)(nil),                       // 31: apricot.LinkIDsRequest
-	(*LinkIDsResponse)(nil),                      // 32: apricot.LinkIDsResponse
-	(*AliasedLinkIDsRequest)(nil),                // 33: apricot.AliasedLinkIDsRequest
-	(*AliasedLinkIDsResponse)(nil),               // 34: apricot.AliasedLinkIDsResponse
-	nil,                                          // 35: apricot.ComponentRequest.VarStackEntry
-	nil,                                          // 36: apricot.DetectorEntriesResponse.DetectorEntriesEntry
-	nil,                                          // 37: apricot.StringMap.StringMapEntry
+	(RunType)(0),                                   // 0: apricot.RunType
+	(*Empty)(nil),                                  // 1: apricot.Empty
+	(*ComponentQuery)(nil),                         // 2: apricot.ComponentQuery
+	(*ComponentRequest)(nil),                       // 3: apricot.ComponentRequest
+	(*ComponentResponse)(nil),                      // 4: apricot.ComponentResponse
+	(*ComponentResponseWithLastIndex)(nil),         // 5: apricot.ComponentResponseWithLastIndex
+	(*HostRequest)(nil),                            // 6: apricot.HostRequest
+	(*HostsRequest)(nil),                           // 7: apricot.HostsRequest
+	(*DetectorResponse)(nil),                       // 8: apricot.DetectorResponse
+	(*DetectorInventoryResponse)(nil),              // 9: apricot.DetectorInventoryResponse
+	(*DetectorEntriesResponse)(nil),                // 10: apricot.DetectorEntriesResponse
+	(*RunNumberRequest)(nil),                       // 11: apricot.RunNumberRequest
+	(*RunNumberResponse)(nil),                      // 12: apricot.RunNumberResponse
+	(*RunNumberAllocation)(nil),                    // 13: apricot.RunNumberAllocation
+	(*RunNumberAllocationsRequest)(nil),            // 14: apricot.RunNumberAllocationsRequest
+	(*RunNumberAllocationsResponse)(nil),           // 15: apricot.RunNumberAllocationsResponse
+	(*StringMap)(nil),                              // 16: apricot.StringMap
+	(*RawGetRecursiveRequest)(nil),                 // 17: apricot.RawGetRecursiveRequest
+	(*RawPutRecursiveRequest)(nil),                 // 18: apricot.RawPutRecursiveRequest
+	(*SecretRequest)(nil),                          // 19: apricot.SecretRequest
+	(*SecretResponse)(nil),                         // 20: apricot.SecretResponse
+	(*SetSecretRequest)(nil),                       // 21: apricot.SetSecretRequest
+	(*GetRuntimeEntryRequest)(nil),                 // 22: apricot.GetRuntimeEntryRequest
+	(*SetRuntimeEntryRequest)(nil),                 // 23: apricot.SetRuntimeEntryRequest
+	(*GetEntryRequest)(nil),                        // 24: apricot.GetEntryRequest
+	(*GetRuntimeEntriesRequest)(nil),               // 25: apricot.GetRuntimeEntriesRequest
+	(*ListRuntimeEntriesRequest)(nil),              // 26: apricot.ListRuntimeEntriesRequest
+	(*ComponentEntriesQuery)(nil),                  // 27: apricot.ComponentEntriesQuery
+	(*ListComponentEntriesRequest)(nil),            // 28: apricot.ListComponentEntriesRequest
+	(*ComponentEntriesResponse)(nil),               // 29: apricot.ComponentEntriesResponse
+	(*DetectorsRequest)(nil),                       // 30: apricot.DetectorsRequest
+	(*DetectorsResponse)(nil),                      // 31: apricot.DetectorsResponse
+	(*HostGetRequest)(nil),                         // 32: apricot.HostGetRequest
+	(*HostEntriesResponse)(nil),                    // 33: apricot.HostEntriesResponse
+	(*ImportComponentConfigurationRequest)(nil),    // 34: apricot.ImportComponentConfigurationRequest
+	(*ImportComponentConfigurationResponse)(nil),   // 35: apricot.ImportComponentConfigurationResponse
+	(*ValidateComponentConfigurationRequest)(nil),  // 36: apricot.ValidateComponentConfigurationRequest
+	(*ValidateComponentConfigurationResponse)(nil), // 37: apricot.ValidateComponentConfigurationResponse
+	(*ListComponentSchemasRequest)(nil),            // 38: apricot.ListComponentSchemasRequest
+	(*ImportComponentSchemaRequest)(nil),           // 39: apricot.ImportComponentSchemaRequest
+	(*CRUCardsResponse)(nil),                       // 40: apricot.CRUCardsResponse
+	(*CardRequest)(nil),                            // 41: apricot.CardRequest
+	(*CRUCardEndpointResponse)(nil),                // 42: apricot.CRUCardEndpointResponse
+	(*LinkIDsRequest)(nil),                         // 43: apricot.LinkIDsRequest
+	(*LinkIDsResponse)(nil),                        // 44: apricot.LinkIDsResponse
+	(*AliasedLinkIDsRequest)(nil),                  // 45: apricot.AliasedLinkIDsRequest
+	(*AliasedLinkIDsResponse)(nil),                 // 46: apricot.AliasedLinkIDsResponse
+	nil,                                            // 47: apricot.ComponentRequest.VarStackEntry
+	nil,                                            // 48: apricot.DetectorEntriesResponse.DetectorEntriesEntry
+	nil,                                            // 49: apricot.StringMap.StringMapEntry
+	nil,                                            // 50: apricot.ValidateComponentConfigurationRequest.VarStackEntry
 }
 var file_protos_apricot_proto_depIdxs = []int32{
 	0,  // 0: apricot.ComponentQuery.runType:type_name -> apricot.RunType
 	2,  // 1: apricot.ComponentRequest.query:type_name -> apricot.ComponentQuery
-	35, // 2: apricot.ComponentRequest.varStack:type_name -> apricot.ComponentRequest.VarStackEntry
-	36, // 3: apricot.DetectorEntriesResponse.detectorEntries:type_name -> apricot.DetectorEntriesResponse.DetectorEntriesEntry
-	37, // 4: apricot.StringMap.stringMap:type_name -> apricot.StringMap.StringMapEntry
-	0,  // 5: apricot.ComponentEntriesQuery.runType:type_name -> apricot.RunType
-	19, // 6: apricot.ListComponentEntriesRequest.query:type_name -> apricot.ComponentEntriesQuery
-	2,  // 7: apricot.ImportComponentConfigurationRequest.query:type_name -> apricot.ComponentQuery
-	9,  // 8: apricot.DetectorEntriesResponse.DetectorEntriesEntry.value:type_name -> apricot.DetectorInventoryResponse
-	1,  // 9: apricot.Apricot.NewRunNumber:input_type -> apricot.Empty
-	1,  // 10: apricot.Apricot.GetDefaults:input_type -> apricot.Empty
-	1,  // 11: apricot.Apricot.GetVars:input_type -> apricot.Empty
-	13, // 12: apricot.Apricot.RawGetRecursive:input_type -> apricot.RawGetRecursiveRequest
-	22, // 13: apricot.Apricot.ListDetectors:input_type -> apricot.DetectorsRequest
-	24, // 14: apricot.Apricot.GetHostInventory:input_type -> apricot.HostGetRequest
-	1,  // 15: apricot.Apricot.GetDetectorsInventory:input_type -> apricot.Empty
-	6,  // 16: apricot.Apricot.GetDetectorForHost:input_type -> apricot.HostRequest
-	7,  // 17: apricot.Apricot.GetDetectorsForHosts:input_type -> apricot.HostsRequest
-	6,  // 18: apricot.Apricot.GetCRUCardsForHost:input_type -> apricot.HostRequest
-	29, // 19: apricot.Apricot.GetEndpointsForCRUCard:input_type -> apricot.CardRequest
-	31, // 20: apricot.Apricot.GetLinkIDsForCRUEndpoint:input_type -> apricot.LinkIDsRequest
-	33, // 21: apricot.Apricot.GetAliasedLinkIDsForDetector:input_type -> apricot.AliasedLinkIDsRequest
-	14, // 22: apricot.Apricot.GetRuntimeEntry:input_type -> apricot.GetRuntimeEntryRequest
-	15, // 23: apricot.Apricot.SetRuntimeEntry:input_type -> apricot.SetRuntimeEntryRequest
-	17, // 24: apricot.Apricot.GetRuntimeEntries:input_type -> apricot.GetRuntimeEntriesRequest
-	18, // 25: apricot.Apricot.ListRuntimeEntries:input_type -> apricot.ListRuntimeEntriesRequest
-	1,  // 26: apricot.Apricot.ListComponents:input_type -> apricot.Empty
-	20, // 27: apricot.Apricot.ListComponentEntries:input_type -> apricot.ListComponentEntriesRequest
-	3,  // 28: apricot.Apricot.GetComponentConfiguration:input_type -> apricot.ComponentRequest
-	3,  // 29: apricot.Apricot.GetComponentConfigurationWithLastIndex:input_type -> apricot.ComponentRequest
-	2,  // 30: apricot.Apricot.ResolveComponentQuery:input_type -> apricot.ComponentQuery
-	26, // 31: apricot.Apricot.ImportComponentConfiguration:input_type -> apricot.ImportComponentConfigurationRequest
-	1,  // 32: apricot.Apricot.InvalidateComponentTemplateCache:input_type -> apricot.Empty
-	11, // 33: apricot.Apricot.NewRunNumber:output_type -> apricot.RunNumberResponse
-	12, // 34: apricot.Apricot.GetDefaults:output_type -> apricot.StringMap
-	12, // 35: apricot.Apricot.GetVars:output_type -> apricot.StringMap
-	4,  // 36: apricot.Apricot.RawGetRecursive:output_type -> apricot.ComponentResponse
-	23, // 37: apricot.Apricot.ListDetectors:output_type -> apricot.DetectorsResponse
-	25, // 38: apricot.Apricot.GetHostInventory:output_type -> apricot.HostEntriesResponse
-	10, // 39: apricot.Apricot.GetDetectorsInventory:output_type -> apricot.DetectorEntriesResponse
-	8,  // 40: apricot.Apricot.GetDetectorForHost:output_type -> apricot.DetectorResponse
-	23, // 41: apricot.Apricot.GetDetectorsForHosts:output_type -> apricot.DetectorsResponse
-	28, // 42: apricot.Apricot.GetCRUCardsForHost:output_type -> apricot.CRUCardsResponse
-	30, // 43: apricot.Apricot.GetEndpointsForCRUCard:output_type -> apricot.CRUCardEndpointResponse
-	32, // 44: apricot.Apricot.GetLinkIDsForCRUEndpoint:output_type -> apricot.LinkIDsResponse
-	34, // 45: apricot.Apricot.GetAliasedLinkIDsForDetector:output_type -> apricot.AliasedLinkIDsResponse
-	4,  // 46: apricot.Apricot.GetRuntimeEntry:output_type -> apricot.ComponentResponse
-	1,  // 47: apricot.Apricot.SetRuntimeEntry:output_type -> apricot.Empty
-	12, // 48: apricot.Apricot.GetRuntimeEntries:output_type -> apricot.StringMap
-	21, // 49: apricot.Apricot.ListRuntimeEntries:output_type -> apricot.ComponentEntriesResponse
-	21, // 50: apricot.Apricot.ListComponents:output_type -> apricot.ComponentEntriesResponse
-	21, // 51: apricot.Apricot.ListComponentEntries:output_type -> apricot.ComponentEntriesResponse
-	4,  // 52: apricot.Apricot.GetComponentConfiguration:output_type -> apricot.ComponentResponse
-	5,  // 53: apricot.Apricot.GetComponentConfigurationWithLastIndex:output_type -> apricot.ComponentResponseWithLastIndex
-	2,  // 54: apricot.Apricot.ResolveComponentQuery:output_type -> apricot.ComponentQuery
-	27, // 55: apricot.Apricot.ImportComponentConfiguration:output_type -> apricot.ImportComponentConfigurationResponse
-	1,  // 56: apricot.Apricot.InvalidateComponentTemplateCache:output_type -> apricot.Empty
-	33, // [33:57] is the sub-list for method output_type
-	9,  // [9:33] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	47, // 2: apricot.ComponentRequest.varStack:type_name -> apricot.ComponentRequest.VarStackEntry
+	48, // 3: apricot.DetectorEntriesResponse.detectorEntries:type_name -> apricot.DetectorEntriesResponse.DetectorEntriesEntry
+	13, // 4: apricot.RunNumberAllocationsResponse.allocations:type_name -> apricot.RunNumberAllocation
+	49, // 5: apricot.StringMap.stringMap:type_name -> apricot.StringMap.StringMapEntry
+	0,  // 6: apricot.ComponentEntriesQuery.runType:type_name -> apricot.RunType
+	27, // 7: apricot.ListComponentEntriesRequest.query:type_name -> apricot.ComponentEntriesQuery
+	2,  // 8: apricot.ImportComponentConfigurationRequest.query:type_name -> apricot.ComponentQuery
+	2,  // 9: apricot.ValidateComponentConfigurationRequest.query:type_name -> apricot.ComponentQuery
+	50, // 10: apricot.ValidateComponentConfigurationRequest.varStack:type_name -> apricot.ValidateComponentConfigurationRequest.VarStackEntry
+	9,  // 11: apricot.DetectorEntriesResponse.DetectorEntriesEntry.value:type_name -> apricot.DetectorInventoryResponse
+	11, // 12: apricot.Apricot.NewRunNumber:input_type -> apricot.RunNumberRequest
+	14, // 13: apricot.Apricot.GetRunNumberAllocations:input_type -> apricot.RunNumberAllocationsRequest
+	1,  // 14: apricot.Apricot.GetDefaults:input_type -> apricot.Empty
+	1,  // 15: apricot.Apricot.GetVars:input_type -> apricot.Empty
+	17, // 16: apricot.Apricot.RawGetRecursive:input_type -> apricot.RawGetRecursiveRequest
+	18, // 17: apricot.Apricot.RawPutRecursive:input_type -> apricot.RawPutRecursiveRequest
+	30, // 18: apricot.Apricot.ListDetectors:input_type -> apricot.DetectorsRequest
+	32, // 19: apricot.Apricot.GetHostInventory:input_type -> apricot.HostGetRequest
+	1,  // 20: apricot.Apricot.GetDetectorsInventory:input_type -> apricot.Empty
+	6,  // 21: apricot.Apricot.GetDetectorForHost:input_type -> apricot.HostRequest
+	7,  // 22: apricot.Apricot.GetDetectorsForHosts:input_type -> apricot.HostsRequest
+	6,  // 23: apricot.Apricot.GetCRUCardsForHost:input_type -> apricot.HostRequest
+	41, // 24: apricot.Apricot.GetEndpointsForCRUCard:input_type -> apricot.CardRequest
+	43, // 25: apricot.Apricot.GetLinkIDsForCRUEndpoint:input_type -> apricot.LinkIDsRequest
+	45, // 26: apricot.Apricot.GetAliasedLinkIDsForDetector:input_type -> apricot.AliasedLinkIDsRequest
+	22, // 27: apricot.Apricot.GetRuntimeEntry:input_type -> apricot.GetRuntimeEntryRequest
+	23, // 28: apricot.Apricot.SetRuntimeEntry:input_type -> apricot.SetRuntimeEntryRequest
+	25, // 29: apricot.Apricot.GetRuntimeEntries:input_type -> apricot.GetRuntimeEntriesRequest
+	26, // 30: apricot.Apricot.ListRuntimeEntries:input_type -> apricot.ListRuntimeEntriesRequest
+	1,  // 31: apricot.Apricot.ListComponents:input_type -> apricot.Empty
+	28, // 32: apricot.Apricot.ListComponentEntries:input_type -> apricot.ListComponentEntriesRequest
+	3,  // 33: apricot.Apricot.GetComponentConfiguration:input_type -> apricot.ComponentRequest
+	3,  // 34: apricot.Apricot.GetComponentConfigurationWithLastIndex:input_type -> apricot.ComponentRequest
+	2,  // 35: apricot.Apricot.ResolveComponentQuery:input_type -> apricot.ComponentQuery
+	34, // 36: apricot.Apricot.ImportComponentConfiguration:input_type -> apricot.ImportComponentConfigurationRequest
+	36, // 37: apricot.Apricot.ValidateComponentConfiguration:input_type -> apricot.ValidateComponentConfigurationRequest
+	1,  // 38: apricot.Apricot.InvalidateComponentTemplateCache:input_type -> apricot.Empty
+	38, // 39: apricot.Apricot.ListComponentSchemas:input_type -> apricot.ListComponentSchemasRequest
+	39, // 40: apricot.Apricot.ImportComponentSchema:input_type -> apricot.ImportComponentSchemaRequest
+	19, // 41: apricot.Apricot.GetSecret:input_type -> apricot.SecretRequest
+	21, // 42: apricot.Apricot.SetSecret:input_type -> apricot.SetSecretRequest
+	19, // 43: apricot.Apricot.DeleteSecret:input_type -> apricot.SecretRequest
+	1,  // 44: apricot.Apricot.ListSecrets:input_type -> apricot.Empty
+	12, // 45: apricot.Apricot.NewRunNumber:output_type -> apricot.RunNumberResponse
+	15, // 46: apricot.Apricot.GetRunNumberAllocations:output_type -> apricot.RunNumberAllocationsResponse
+	16, // 47: apricot.Apricot.GetDefaults:output_type -> apricot.StringMap
+	16, // 48: apricot.Apricot.GetVars:output_type -> apricot.StringMap
+	4,  // 49: apricot.Apricot.RawGetRecursive:output_type -> apricot.ComponentResponse
+	1,  // 50: apricot.Apricot.RawPutRecursive:output_type -> apricot.Empty
+	31, // 51: apricot.Apricot.ListDetectors:output_type -> apricot.DetectorsResponse
+	33, // 52: apricot.Apricot.GetHostInventory:output_type -> apricot.HostEntriesResponse
+	10, // 53: apricot.Apricot.GetDetectorsInventory:output_type -> apricot.DetectorEntriesResponse
+	8,  // 54: apricot.Apricot.GetDetectorForHost:output_type -> apricot.DetectorResponse
+	31, // 55: apricot.Apricot.GetDetectorsForHosts:output_type -> apricot.DetectorsResponse
+	40, // 56: apricot.Apricot.GetCRUCardsForHost:output_type -> apricot.CRUCardsResponse
+	42, // 57: apricot.Apricot.GetEndpointsForCRUCard:output_type -> apricot.CRUCardEndpointResponse
+	44, // 58: apricot.Apricot.GetLinkIDsForCRUEndpoint:output_type -> apricot.LinkIDsResponse
+	46, // 59: apricot.Apricot.GetAliasedLinkIDsForDetector:output_type -> apricot.AliasedLinkIDsResponse
+	4,  // 60: apricot.Apricot.GetRuntimeEntry:output_type -> apricot.ComponentResponse
+	1,  // 61: apricot.Apricot.SetRuntimeEntry:output_type -> apricot.Empty
+	16, // 62: apricot.Apricot.GetRuntimeEntries:output_type -> apricot.StringMap
+	29, // 63: apricot.Apricot.ListRuntimeEntries:output_type -> apricot.ComponentEntriesResponse
+	29, // 64: apricot.Apricot.ListComponents:output_type -> apricot.ComponentEntriesResponse
+	29, // 65: apricot.Apricot.ListComponentEntries:output_type -> apricot.ComponentEntriesResponse
+	4,  // 66: apricot.Apricot.GetComponentConfiguration:output_type -> apricot.ComponentResponse
+	5,  // 67: apricot.Apricot.GetComponentConfigurationWithLastIndex:output_type -> apricot.ComponentResponseWithLastIndex
+	2,  // 68: apricot.Apricot.ResolveComponentQuery:output_type -> apricot.ComponentQuery
+	35, // 69: apricot.Apricot.ImportComponentConfiguration:output_type -> apricot.ImportComponentConfigurationResponse
+	37, // 70: apricot.Apricot.ValidateComponentConfiguration:output_type -> apricot.ValidateComponentConfigurationResponse
+	1,  // 71: apricot.Apricot.InvalidateComponentTemplateCache:output_type -> apricot.Empty
+	16, // 72: apricot.Apricot.ListComponentSchemas:output_type -> apricot.StringMap
+	1,  // 73: apricot.Apricot.ImportComponentSchema:output_type -> apricot.Empty
+	20, // 74: apricot.Apricot.GetSecret:output_type -> apricot.SecretResponse
+	1,  // 75: apricot.Apricot.SetSecret:output_type -> apricot.Empty
+	1,  // 76: apricot.Apricot.DeleteSecret:output_type -> apricot.Empty
+	29, // 77: apricot.Apricot.ListSecrets:output_type -> apricot.ComponentEntriesResponse
+	45, // [45:78] is the sub-list for method output_type
+	12, // [12:45] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_protos_apricot_proto_init() }
@@ -2537,7 +3396,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunNumberResponse); i {
+			switch v := v.(*RunNumberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2549,7 +3408,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringMap); i {
+			switch v := v.(*RunNumberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2561,7 +3420,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawGetRecursiveRequest); i {
+			switch v := v.(*RunNumberAllocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2573,7 +3432,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRuntimeEntryRequest); i {
+			switch v := v.(*RunNumberAllocationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2585,7 +3444,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetRuntimeEntryRequest); i {
+			switch v := v.(*RunNumberAllocationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2597,7 +3456,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEntryRequest); i {
+			switch v := v.(*StringMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2609,7 +3468,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRuntimeEntriesRequest); i {
+			switch v := v.(*RawGetRecursiveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2621,7 +3480,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRuntimeEntriesRequest); i {
+			switch v := v.(*RawPutRecursiveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2633,7 +3492,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComponentEntriesQuery); i {
+			switch v := v.(*SecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2645,7 +3504,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListComponentEntriesRequest); i {
+			switch v := v.(*SecretResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2657,7 +3516,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComponentEntriesResponse); i {
+			switch v := v.(*SetSecretRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2669,7 +3528,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectorsRequest); i {
+			switch v := v.(*GetRuntimeEntryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2681,7 +3540,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectorsResponse); i {
+			switch v := v.(*SetRuntimeEntryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2693,7 +3552,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostGetRequest); i {
+			switch v := v.(*GetEntryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2705,7 +3564,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostEntriesResponse); i {
+			switch v := v.(*GetRuntimeEntriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2717,7 +3576,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportComponentConfigurationRequest); i {
+			switch v := v.(*ListRuntimeEntriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2729,7 +3588,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportComponentConfigurationResponse); i {
+			switch v := v.(*ComponentEntriesQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2741,7 +3600,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CRUCardsResponse); i {
+			switch v := v.(*ListComponentEntriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2753,7 +3612,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardRequest); i {
+			switch v := v.(*ComponentEntriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2765,7 +3624,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CRUCardEndpointResponse); i {
+			switch v := v.(*DetectorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2777,7 +3636,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LinkIDsRequest); i {
+			switch v := v.(*DetectorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2789,7 +3648,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LinkIDsResponse); i {
+			switch v := v.(*HostGetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2801,7 +3660,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AliasedLinkIDsRequest); i {
+			switch v := v.(*HostEntriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2813,6 +3672,150 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportComponentConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportComponentConfigurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateComponentConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateComponentConfigurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListComponentSchemasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportComponentSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CRUCardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CRUCardEndpointResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkIDsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkIDsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AliasedLinkIDsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AliasedLinkIDsResponse); i {
 			case 0:
 				return &v.state
@@ -2829,7 +3832,7 @@ func file_protos_apricot_proto_init() {
 		(*ComponentRequest_Path)(nil),
 		(*ComponentRequest_Query)(nil),
 	}
-	file_protos_apricot_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_protos_apricot_proto_msgTypes[27].OneofWrappers = []interface{}{
 		(*ListComponentEntriesRequest_Path)(nil),
 		(*ListComponentEntriesRequest_Query)(nil),
 	}
@@ -2839,7 +3842,7 @@ func file_protos_apricot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_apricot_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   37,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
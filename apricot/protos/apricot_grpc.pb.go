@@ -43,9 +43,11 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Apricot_NewRunNumber_FullMethodName                           = "/apricot.Apricot/NewRunNumber"
+	Apricot_GetRunNumberAllocations_FullMethodName                = "/apricot.Apricot/GetRunNumberAllocations"
 	Apricot_GetDefaults_FullMethodName                            = "/apricot.Apricot/GetDefaults"
 	Apricot_GetVars_FullMethodName                                = "/apricot.Apricot/GetVars"
 	Apricot_RawGetRecursive_FullMethodName                        = "/apricot.Apricot/RawGetRecursive"
+	Apricot_RawPutRecursive_FullMethodName                        = "/apricot.Apricot/RawPutRecursive"
 	Apricot_ListDetectors_FullMethodName                          = "/apricot.Apricot/ListDetectors"
 	Apricot_GetHostInventory_FullMethodName                       = "/apricot.Apricot/GetHostInventory"
 	Apricot_GetDetectorsInventory_FullMethodName                  = "/apricot.Apricot/GetDetectorsInventory"
@@ -65,17 +67,26 @@ const (
 	Apricot_GetComponentConfigurationWithLastIndex_FullMethodName = "/apricot.Apricot/GetComponentConfigurationWithLastIndex"
 	Apricot_ResolveComponentQuery_FullMethodName                  = "/apricot.Apricot/ResolveComponentQuery"
 	Apricot_ImportComponentConfiguration_FullMethodName           = "/apricot.Apricot/ImportComponentConfiguration"
+	Apricot_ValidateComponentConfiguration_FullMethodName         = "/apricot.Apricot/ValidateComponentConfiguration"
 	Apricot_InvalidateComponentTemplateCache_FullMethodName       = "/apricot.Apricot/InvalidateComponentTemplateCache"
+	Apricot_ListComponentSchemas_FullMethodName                   = "/apricot.Apricot/ListComponentSchemas"
+	Apricot_ImportComponentSchema_FullMethodName                  = "/apricot.Apricot/ImportComponentSchema"
+	Apricot_GetSecret_FullMethodName                              = "/apricot.Apricot/GetSecret"
+	Apricot_SetSecret_FullMethodName                              = "/apricot.Apricot/SetSecret"
+	Apricot_DeleteSecret_FullMethodName                           = "/apricot.Apricot/DeleteSecret"
+	Apricot_ListSecrets_FullMethodName                            = "/apricot.Apricot/ListSecrets"
 )
 
 // ApricotClient is the client API for Apricot service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ApricotClient interface {
-	NewRunNumber(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RunNumberResponse, error)
+	NewRunNumber(ctx context.Context, in *RunNumberRequest, opts ...grpc.CallOption) (*RunNumberResponse, error)
+	GetRunNumberAllocations(ctx context.Context, in *RunNumberAllocationsRequest, opts ...grpc.CallOption) (*RunNumberAllocationsResponse, error)
 	GetDefaults(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StringMap, error)
 	GetVars(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StringMap, error)
 	RawGetRecursive(ctx context.Context, in *RawGetRecursiveRequest, opts ...grpc.CallOption) (*ComponentResponse, error)
+	RawPutRecursive(ctx context.Context, in *RawPutRecursiveRequest, opts ...grpc.CallOption) (*Empty, error)
 	// Detectors and host inventories
 	ListDetectors(ctx context.Context, in *DetectorsRequest, opts ...grpc.CallOption) (*DetectorsResponse, error)
 	GetHostInventory(ctx context.Context, in *HostGetRequest, opts ...grpc.CallOption) (*HostEntriesResponse, error)
@@ -98,7 +109,16 @@ type ApricotClient interface {
 	GetComponentConfigurationWithLastIndex(ctx context.Context, in *ComponentRequest, opts ...grpc.CallOption) (*ComponentResponseWithLastIndex, error)
 	ResolveComponentQuery(ctx context.Context, in *ComponentQuery, opts ...grpc.CallOption) (*ComponentQuery, error)
 	ImportComponentConfiguration(ctx context.Context, in *ImportComponentConfigurationRequest, opts ...grpc.CallOption) (*ImportComponentConfigurationResponse, error)
+	ValidateComponentConfiguration(ctx context.Context, in *ValidateComponentConfigurationRequest, opts ...grpc.CallOption) (*ValidateComponentConfigurationResponse, error)
 	InvalidateComponentTemplateCache(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	// Component configuration schema calls
+	ListComponentSchemas(ctx context.Context, in *ListComponentSchemasRequest, opts ...grpc.CallOption) (*StringMap, error)
+	ImportComponentSchema(ctx context.Context, in *ImportComponentSchemaRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Secrets calls, only served to clients which present the secrets token
+	GetSecret(ctx context.Context, in *SecretRequest, opts ...grpc.CallOption) (*SecretResponse, error)
+	SetSecret(ctx context.Context, in *SetSecretRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteSecret(ctx context.Context, in *SecretRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListSecrets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ComponentEntriesResponse, error)
 }
 
 type apricotClient struct {
@@ -109,7 +129,7 @@ func NewApricotClient(cc grpc.ClientConnInterface) ApricotClient {
 	return &apricotClient{cc}
 }
 
-func (c *apricotClient) NewRunNumber(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RunNumberResponse, error) {
+func (c *apricotClient) NewRunNumber(ctx context.Context, in *RunNumberRequest, opts ...grpc.CallOption) (*RunNumberResponse, error) {
 	out := new(RunNumberResponse)
 	err := c.cc.Invoke(ctx, Apricot_NewRunNumber_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -118,6 +138,15 @@ func (c *apricotClient) NewRunNumber(ctx context.Context, in *Empty, opts ...grp
 	return out, nil
 }
 
+func (c *apricotClient) GetRunNumberAllocations(ctx context.Context, in *RunNumberAllocationsRequest, opts ...grpc.CallOption) (*RunNumberAllocationsResponse, error) {
+	out := new(RunNumberAllocationsResponse)
+	err := c.cc.Invoke(ctx, Apricot_GetRunNumberAllocations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apricotClient) GetDefaults(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StringMap, error) {
 	out := new(StringMap)
 	err := c.cc.Invoke(ctx, Apricot_GetDefaults_FullMethodName, in, out, opts...)
@@ -145,6 +174,15 @@ func (c *apricotClient) RawGetRecursive(ctx context.Context, in *RawGetRecursive
 	return out, nil
 }
 
+func (c *apricotClient) RawPutRecursive(ctx context.Context, in *RawPutRecursiveRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Apricot_RawPutRecursive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apricotClient) ListDetectors(ctx context.Context, in *DetectorsRequest, opts ...grpc.CallOption) (*DetectorsResponse, error) {
 	out := new(DetectorsResponse)
 	err := c.cc.Invoke(ctx, Apricot_ListDetectors_FullMethodName, in, out, opts...)
@@ -316,6 +354,15 @@ func (c *apricotClient) ImportComponentConfiguration(ctx context.Context, in *Im
 	return out, nil
 }
 
+func (c *apricotClient) ValidateComponentConfiguration(ctx context.Context, in *ValidateComponentConfigurationRequest, opts ...grpc.CallOption) (*ValidateComponentConfigurationResponse, error) {
+	out := new(ValidateComponentConfigurationResponse)
+	err := c.cc.Invoke(ctx, Apricot_ValidateComponentConfiguration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apricotClient) InvalidateComponentTemplateCache(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, Apricot_InvalidateComponentTemplateCache_FullMethodName, in, out, opts...)
@@ -325,14 +372,70 @@ func (c *apricotClient) InvalidateComponentTemplateCache(ctx context.Context, in
 	return out, nil
 }
 
+func (c *apricotClient) ListComponentSchemas(ctx context.Context, in *ListComponentSchemasRequest, opts ...grpc.CallOption) (*StringMap, error) {
+	out := new(StringMap)
+	err := c.cc.Invoke(ctx, Apricot_ListComponentSchemas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) ImportComponentSchema(ctx context.Context, in *ImportComponentSchemaRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Apricot_ImportComponentSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) GetSecret(ctx context.Context, in *SecretRequest, opts ...grpc.CallOption) (*SecretResponse, error) {
+	out := new(SecretResponse)
+	err := c.cc.Invoke(ctx, Apricot_GetSecret_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) SetSecret(ctx context.Context, in *SetSecretRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Apricot_SetSecret_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) DeleteSecret(ctx context.Context, in *SecretRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Apricot_DeleteSecret_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) ListSecrets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ComponentEntriesResponse, error) {
+	out := new(ComponentEntriesResponse)
+	err := c.cc.Invoke(ctx, Apricot_ListSecrets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApricotServer is the server API for Apricot service.
 // All implementations should embed UnimplementedApricotServer
 // for forward compatibility
 type ApricotServer interface {
-	NewRunNumber(context.Context, *Empty) (*RunNumberResponse, error)
+	NewRunNumber(context.Context, *RunNumberRequest) (*RunNumberResponse, error)
+	GetRunNumberAllocations(context.Context, *RunNumberAllocationsRequest) (*RunNumberAllocationsResponse, error)
 	GetDefaults(context.Context, *Empty) (*StringMap, error)
 	GetVars(context.Context, *Empty) (*StringMap, error)
 	RawGetRecursive(context.Context, *RawGetRecursiveRequest) (*ComponentResponse, error)
+	RawPutRecursive(context.Context, *RawPutRecursiveRequest) (*Empty, error)
 	// Detectors and host inventories
 	ListDetectors(context.Context, *DetectorsRequest) (*DetectorsResponse, error)
 	GetHostInventory(context.Context, *HostGetRequest) (*HostEntriesResponse, error)
@@ -355,16 +458,28 @@ type ApricotServer interface {
 	GetComponentConfigurationWithLastIndex(context.Context, *ComponentRequest) (*ComponentResponseWithLastIndex, error)
 	ResolveComponentQuery(context.Context, *ComponentQuery) (*ComponentQuery, error)
 	ImportComponentConfiguration(context.Context, *ImportComponentConfigurationRequest) (*ImportComponentConfigurationResponse, error)
+	ValidateComponentConfiguration(context.Context, *ValidateComponentConfigurationRequest) (*ValidateComponentConfigurationResponse, error)
 	InvalidateComponentTemplateCache(context.Context, *Empty) (*Empty, error)
+	// Component configuration schema calls
+	ListComponentSchemas(context.Context, *ListComponentSchemasRequest) (*StringMap, error)
+	ImportComponentSchema(context.Context, *ImportComponentSchemaRequest) (*Empty, error)
+	// Secrets calls, only served to clients which present the secrets token
+	GetSecret(context.Context, *SecretRequest) (*SecretResponse, error)
+	SetSecret(context.Context, *SetSecretRequest) (*Empty, error)
+	DeleteSecret(context.Context, *SecretRequest) (*Empty, error)
+	ListSecrets(context.Context, *Empty) (*ComponentEntriesResponse, error)
 }
 
 // UnimplementedApricotServer should be embedded to have forward compatible implementations.
 type UnimplementedApricotServer struct {
 }
 
-func (UnimplementedApricotServer) NewRunNumber(context.Context, *Empty) (*RunNumberResponse, error) {
+func (UnimplementedApricotServer) NewRunNumber(context.Context, *RunNumberRequest) (*RunNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewRunNumber not implemented")
 }
+func (UnimplementedApricotServer) GetRunNumberAllocations(context.Context, *RunNumberAllocationsRequest) (*RunNumberAllocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunNumberAllocations not implemented")
+}
 func (UnimplementedApricotServer) GetDefaults(context.Context, *Empty) (*StringMap, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDefaults not implemented")
 }
@@ -374,6 +489,9 @@ func (UnimplementedApricotServer) GetVars(context.Context, *Empty) (*StringMap,
 func (UnimplementedApricotServer) RawGetRecursive(context.Context, *RawGetRecursiveRequest) (*ComponentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RawGetRecursive not implemented")
 }
+func (UnimplementedApricotServer) RawPutRecursive(context.Context, *RawPutRecursiveRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RawPutRecursive not implemented")
+}
 func (UnimplementedApricotServer) ListDetectors(context.Context, *DetectorsRequest) (*DetectorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDetectors not implemented")
 }
@@ -431,9 +549,30 @@ func (UnimplementedApricotServer) ResolveComponentQuery(context.Context, *Compon
 func (UnimplementedApricotServer) ImportComponentConfiguration(context.Context, *ImportComponentConfigurationRequest) (*ImportComponentConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportComponentConfiguration not implemented")
 }
+func (UnimplementedApricotServer) ValidateComponentConfiguration(context.Context, *ValidateComponentConfigurationRequest) (*ValidateComponentConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateComponentConfiguration not implemented")
+}
 func (UnimplementedApricotServer) InvalidateComponentTemplateCache(context.Context, *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateComponentTemplateCache not implemented")
 }
+func (UnimplementedApricotServer) ListComponentSchemas(context.Context, *ListComponentSchemasRequest) (*StringMap, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComponentSchemas not implemented")
+}
+func (UnimplementedApricotServer) ImportComponentSchema(context.Context, *ImportComponentSchemaRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportComponentSchema not implemented")
+}
+func (UnimplementedApricotServer) GetSecret(context.Context, *SecretRequest) (*SecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecret not implemented")
+}
+func (UnimplementedApricotServer) SetSecret(context.Context, *SetSecretRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSecret not implemented")
+}
+func (UnimplementedApricotServer) DeleteSecret(context.Context, *SecretRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
+}
+func (UnimplementedApricotServer) ListSecrets(context.Context, *Empty) (*ComponentEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
+}
 
 // UnsafeApricotServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApricotServer will
@@ -447,7 +586,7 @@ func RegisterApricotServer(s grpc.ServiceRegistrar, srv ApricotServer) {
 }
 
 func _Apricot_NewRunNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(RunNumberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -459,7 +598,25 @@ func _Apricot_NewRunNumber_Handler(srv interface{}, ctx context.Context, dec fun
 		FullMethod: Apricot_NewRunNumber_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApricotServer).NewRunNumber(ctx, req.(*Empty))
+		return srv.(ApricotServer).NewRunNumber(ctx, req.(*RunNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_GetRunNumberAllocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunNumberAllocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).GetRunNumberAllocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Apricot_GetRunNumberAllocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).GetRunNumberAllocations(ctx, req.(*RunNumberAllocationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -518,6 +675,24 @@ func _Apricot_RawGetRecursive_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Apricot_RawPutRecursive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RawPutRecursiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).RawPutRecursive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Apricot_RawPutRecursive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).RawPutRecursive(ctx, req.(*RawPutRecursiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Apricot_ListDetectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DetectorsRequest)
 	if err := dec(in); err != nil {
@@ -860,6 +1035,24 @@ func _Apricot_ImportComponentConfiguration_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Apricot_ValidateComponentConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateComponentConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).ValidateComponentConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Apricot_ValidateComponentConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).ValidateComponentConfiguration(ctx, req.(*ValidateComponentConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Apricot_InvalidateComponentTemplateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -878,6 +1071,114 @@ func _Apricot_InvalidateComponentTemplateCache_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Apricot_ListComponentSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListComponentSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).ListComponentSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Apricot_ListComponentSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).ListComponentSchemas(ctx, req.(*ListComponentSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_ImportComponentSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportComponentSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).ImportComponentSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Apricot_ImportComponentSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).ImportComponentSchema(ctx, req.(*ImportComponentSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).GetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Apricot_GetSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).GetSecret(ctx, req.(*SecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_SetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).SetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Apricot_SetSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).SetSecret(ctx, req.(*SetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).DeleteSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Apricot_DeleteSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).DeleteSecret(ctx, req.(*SecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).ListSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Apricot_ListSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).ListSecrets(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Apricot_ServiceDesc is the grpc.ServiceDesc for Apricot service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -889,6 +1190,10 @@ var Apricot_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NewRunNumber",
 			Handler:    _Apricot_NewRunNumber_Handler,
 		},
+		{
+			MethodName: "GetRunNumberAllocations",
+			Handler:    _Apricot_GetRunNumberAllocations_Handler,
+		},
 		{
 			MethodName: "GetDefaults",
 			Handler:    _Apricot_GetDefaults_Handler,
@@ -901,6 +1206,10 @@ var Apricot_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RawGetRecursive",
 			Handler:    _Apricot_RawGetRecursive_Handler,
 		},
+		{
+			MethodName: "RawPutRecursive",
+			Handler:    _Apricot_RawPutRecursive_Handler,
+		},
 		{
 			MethodName: "ListDetectors",
 			Handler:    _Apricot_ListDetectors_Handler,
@@ -977,10 +1286,38 @@ var Apricot_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportComponentConfiguration",
 			Handler:    _Apricot_ImportComponentConfiguration_Handler,
 		},
+		{
+			MethodName: "ValidateComponentConfiguration",
+			Handler:    _Apricot_ValidateComponentConfiguration_Handler,
+		},
 		{
 			MethodName: "InvalidateComponentTemplateCache",
 			Handler:    _Apricot_InvalidateComponentTemplateCache_Handler,
 		},
+		{
+			MethodName: "ListComponentSchemas",
+			Handler:    _Apricot_ListComponentSchemas_Handler,
+		},
+		{
+			MethodName: "ImportComponentSchema",
+			Handler:    _Apricot_ImportComponentSchema_Handler,
+		},
+		{
+			MethodName: "GetSecret",
+			Handler:    _Apricot_GetSecret_Handler,
+		},
+		{
+			MethodName: "SetSecret",
+			Handler:    _Apricot_SetSecret_Handler,
+		},
+		{
+			MethodName: "DeleteSecret",
+			Handler:    _Apricot_DeleteSecret_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _Apricot_ListSecrets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/apricot.proto",
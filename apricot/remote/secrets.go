@@ -0,0 +1,234 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package remote
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	apricotpb "github.com/AliceO2Group/Control/apricot/protos"
+	"github.com/AliceO2Group/Control/common/secrets"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// The secrets RPCs are refused unless apricot is started with a
+// secretsTokenFile, and then they are only served to clients which send the
+// same token in the request metadata. Clients read the token from their own
+// secretsTokenFile.
+const SECRETS_TOKEN_METADATA_KEY = "x-apricot-secrets-token"
+
+var (
+	E_SECRETS_DISABLED     = status.Errorf(codes.PermissionDenied, "secrets access is not enabled on this apricot instance")
+	E_SECRETS_UNAUTHORIZED = status.Errorf(codes.Unauthenticated, "missing or invalid secrets token")
+)
+
+func loadSecretsToken() (string, error) {
+	tokenFile := viper.GetString("secretsTokenFile")
+	if tokenFile == "" {
+		return "", nil
+	}
+	token, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("cannot read secrets token file: %w", err)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+func (m *RpcServer) authorizeSecretsAccess(ctx context.Context) error {
+	expected, err := loadSecretsToken()
+	if err != nil {
+		log.WithError(err).Error("secrets access refused")
+		return E_SECRETS_DISABLED
+	}
+	if expected == "" {
+		return E_SECRETS_DISABLED
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	presented := md.Get(SECRETS_TOKEN_METADATA_KEY)
+	if len(presented) != 1 || subtle.ConstantTimeCompare([]byte(presented[0]), []byte(expected)) != 1 {
+		log.Warn("secrets access attempted without a valid token")
+		return E_SECRETS_UNAUTHORIZED
+	}
+	return nil
+}
+
+func secretsErrorToStatus(err error) error {
+	if errors.Is(err, secrets.ErrNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func (m *RpcServer) GetSecret(ctx context.Context, request *apricotpb.SecretRequest) (*apricotpb.SecretResponse, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if err := m.authorizeSecretsAccess(ctx); err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	value, err := m.service.GetSecret(request.Name)
+	if err != nil {
+		return nil, secretsErrorToStatus(err)
+	}
+	return &apricotpb.SecretResponse{Value: value}, nil
+}
+
+func (m *RpcServer) SetSecret(ctx context.Context, request *apricotpb.SetSecretRequest) (*apricotpb.Empty, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if err := m.authorizeSecretsAccess(ctx); err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	err := m.service.SetSecret(request.Name, request.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &apricotpb.Empty{}, nil
+}
+
+func (m *RpcServer) DeleteSecret(ctx context.Context, request *apricotpb.SecretRequest) (*apricotpb.Empty, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if err := m.authorizeSecretsAccess(ctx); err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	err := m.service.DeleteSecret(request.Name)
+	if err != nil {
+		return nil, secretsErrorToStatus(err)
+	}
+	return &apricotpb.Empty{}, nil
+}
+
+func (m *RpcServer) ListSecrets(ctx context.Context, _ *apricotpb.Empty) (*apricotpb.ComponentEntriesResponse, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if err := m.authorizeSecretsAccess(ctx); err != nil {
+		return nil, err
+	}
+
+	names, err := m.service.ListSecrets()
+	if err != nil {
+		return nil, err
+	}
+	return &apricotpb.ComponentEntriesResponse{Payload: names}, nil
+}
+
+// secretsContext attaches this client's secrets token, if any, to the
+// outgoing request metadata.
+func (c *RemoteService) secretsContext() (context.Context, error) {
+	token, err := loadSecretsToken()
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return context.Background(), nil
+	}
+	return metadata.AppendToOutgoingContext(context.Background(), SECRETS_TOKEN_METADATA_KEY, token), nil
+}
+
+func secretsStatusToError(err error, name string) error {
+	if status.Code(err) == codes.NotFound {
+		return fmt.Errorf("%w: %s", secrets.ErrNotFound, name)
+	}
+	return err
+}
+
+func (c *RemoteService) GetSecret(name string) (value string, err error) {
+	var ctx context.Context
+	if ctx, err = c.secretsContext(); err != nil {
+		return "", err
+	}
+	var response *apricotpb.SecretResponse
+	request := &apricotpb.SecretRequest{Name: name}
+	response, err = c.cli.GetSecret(ctx, request, grpc.EmptyCallOption{})
+	if err != nil {
+		return "", secretsStatusToError(err, name)
+	}
+	return response.GetValue(), nil
+}
+
+func (c *RemoteService) SetSecret(name string, value string) (err error) {
+	var ctx context.Context
+	if ctx, err = c.secretsContext(); err != nil {
+		return err
+	}
+	request := &apricotpb.SetSecretRequest{Name: name, Value: value}
+	_, err = c.cli.SetSecret(ctx, request, grpc.EmptyCallOption{})
+	return err
+}
+
+func (c *RemoteService) DeleteSecret(name string) (err error) {
+	var ctx context.Context
+	if ctx, err = c.secretsContext(); err != nil {
+		return err
+	}
+	request := &apricotpb.SecretRequest{Name: name}
+	_, err = c.cli.DeleteSecret(ctx, request, grpc.EmptyCallOption{})
+	return secretsStatusToError(err, name)
+}
+
+func (c *RemoteService) ListSecrets() (names []string, err error) {
+	var ctx context.Context
+	if ctx, err = c.secretsContext(); err != nil {
+		return nil, err
+	}
+	var response *apricotpb.ComponentEntriesResponse
+	response, err = c.cli.ListSecrets(ctx, &apricotpb.Empty{}, grpc.EmptyCallOption{})
+	if err != nil {
+		return nil, err
+	}
+	return response.GetPayload(), nil
+}
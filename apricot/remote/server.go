@@ -28,6 +28,7 @@ package remote
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"runtime"
 	"strings"
 
@@ -68,15 +69,51 @@ func NewServer(service configuration.Service) *grpc.Server {
 	return s
 }
 
-func (m *RpcServer) NewRunNumber(_ context.Context, _ *apricotpb.Empty) (*apricotpb.RunNumberResponse, error) {
+func (m *RpcServer) NewRunNumber(_ context.Context, request *apricotpb.RunNumberRequest) (*apricotpb.RunNumberResponse, error) {
 	if m == nil || m.service == nil {
 		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
 	}
 	m.logMethod()
-	rn, err := m.service.NewRunNumber()
+
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	rn, err := m.service.NewRunNumber(request.GetEnvironmentId(), request.GetRequester())
 	return &apricotpb.RunNumberResponse{RunNumber: rn}, err
 }
 
+func (m *RpcServer) GetRunNumberAllocations(_ context.Context, request *apricotpb.RunNumberAllocationsRequest) (*apricotpb.RunNumberAllocationsResponse, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	allocations, err := m.service.GetRunNumberAllocations(&configuration.RunNumberAllocationsQuery{
+		RunNumber:     request.GetRunNumber(),
+		EnvironmentId: request.GetEnvironmentId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &apricotpb.RunNumberAllocationsResponse{
+		Allocations: make([]*apricotpb.RunNumberAllocation, len(allocations)),
+	}
+	for i, allocation := range allocations {
+		response.Allocations[i] = &apricotpb.RunNumberAllocation{
+			RunNumber:     allocation.RunNumber,
+			EnvironmentId: allocation.EnvironmentId,
+			Requester:     allocation.Requester,
+			Timestamp:     allocation.Timestamp.UnixMilli(),
+		}
+	}
+	return response, nil
+}
+
 func (m *RpcServer) GetDefaults(_ context.Context, _ *apricotpb.Empty) (*apricotpb.StringMap, error) {
 	if m == nil || m.service == nil {
 		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
@@ -402,6 +439,23 @@ func (m *RpcServer) RawGetRecursive(_ context.Context, request *apricotpb.RawGet
 	return &apricotpb.ComponentResponse{Payload: payload}, nil
 }
 
+func (m *RpcServer) RawPutRecursive(_ context.Context, request *apricotpb.RawPutRecursiveRequest) (*apricotpb.Empty, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	err := m.service.RawPutRecursive(request.RawPath, request.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return &apricotpb.Empty{}, nil
+}
+
 func (m *RpcServer) ListDetectors(_ context.Context, request *apricotpb.DetectorsRequest) (*apricotpb.DetectorsResponse, error) {
 	if m == nil || m.service == nil {
 		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
@@ -510,8 +564,11 @@ func (m *RpcServer) ImportComponentConfiguration(_ context.Context, request *apr
 		EntryKey:  request.Query.Entry,
 	}
 
-	existingComponentUpdated, existingEntryUpdated, err := m.service.ImportComponentConfiguration(pushQuery, request.Payload, request.NewComponent)
-	if err != nil {
+	existingComponentUpdated, existingEntryUpdated, err := m.service.ImportComponentConfiguration(pushQuery, request.Payload, request.NewComponent, request.Format)
+	var validationResult *componentcfg.ValidationResult
+	if errors.As(err, &validationResult) {
+		return nil, status.Error(codes.InvalidArgument, validationResult.Error())
+	} else if err != nil {
 		return nil, err
 	}
 	response := &apricotpb.ImportComponentConfigurationResponse{
@@ -521,6 +578,70 @@ func (m *RpcServer) ImportComponentConfiguration(_ context.Context, request *apr
 	return response, nil
 }
 
+func (m *RpcServer) ValidateComponentConfiguration(_ context.Context, request *apricotpb.ValidateComponentConfigurationRequest) (*apricotpb.ValidateComponentConfigurationResponse, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if request == nil || request.Query == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	query := &componentcfg.Query{
+		Component: request.Query.Component,
+		RunType:   request.Query.RunType,
+		RoleName:  request.Query.MachineRole,
+		EntryKey:  request.Query.Entry,
+	}
+
+	result, err := m.service.ValidateComponentConfiguration(query, request.Payload, request.Format, request.VarStack)
+	if err != nil {
+		return nil, err
+	}
+	response := &apricotpb.ValidateComponentConfigurationResponse{
+		Valid:  result.IsValid(),
+		Format: result.Format,
+		Schema: result.Schema,
+		Errors: result.Errors,
+	}
+	return response, nil
+}
+
+func (m *RpcServer) ListComponentSchemas(_ context.Context, request *apricotpb.ListComponentSchemasRequest) (*apricotpb.StringMap, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	schemas, err := m.service.ListComponentSchemas(request.Component)
+	if err != nil {
+		return nil, err
+	}
+	return &apricotpb.StringMap{StringMap: schemas}, nil
+}
+
+func (m *RpcServer) ImportComponentSchema(_ context.Context, request *apricotpb.ImportComponentSchemaRequest) (*apricotpb.Empty, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	err := m.service.ImportComponentSchema(request.Component, request.Name, request.Payload)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &apricotpb.Empty{}, nil
+}
+
 func (m *RpcServer) InvalidateComponentTemplateCache(_ context.Context, _ *apricotpb.Empty) (*apricotpb.Empty, error) {
 	if m == nil || m.service == nil {
 		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
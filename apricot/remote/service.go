@@ -69,15 +69,42 @@ func NewService(configUri string) (svc configuration.Service, err error) {
 	}, nil
 }
 
-func (c *RemoteService) NewRunNumber() (runNumber uint32, err error) {
+func (c *RemoteService) NewRunNumber(envId string, requester string) (runNumber uint32, err error) {
 	var response *apricotpb.RunNumberResponse
-	response, err = c.cli.NewRunNumber(context.Background(), &apricotpb.Empty{}, grpc.EmptyCallOption{})
+	request := &apricotpb.RunNumberRequest{
+		EnvironmentId: envId,
+		Requester:     requester,
+	}
+	response, err = c.cli.NewRunNumber(context.Background(), request, grpc.EmptyCallOption{})
 	if err != nil {
 		return 0, err
 	}
 	return response.GetRunNumber(), nil
 }
 
+func (c *RemoteService) GetRunNumberAllocations(query *configuration.RunNumberAllocationsQuery) (allocations []*configuration.RunNumberAllocation, err error) {
+	var response *apricotpb.RunNumberAllocationsResponse
+	request := &apricotpb.RunNumberAllocationsRequest{}
+	if query != nil {
+		request.RunNumber = query.RunNumber
+		request.EnvironmentId = query.EnvironmentId
+	}
+	response, err = c.cli.GetRunNumberAllocations(context.Background(), request, grpc.EmptyCallOption{})
+	if err != nil {
+		return nil, err
+	}
+	allocations = make([]*configuration.RunNumberAllocation, len(response.GetAllocations()))
+	for i, allocation := range response.GetAllocations() {
+		allocations[i] = &configuration.RunNumberAllocation{
+			RunNumber:     allocation.GetRunNumber(),
+			EnvironmentId: allocation.GetEnvironmentId(),
+			Requester:     allocation.GetRequester(),
+			Timestamp:     time.UnixMilli(allocation.GetTimestamp()).UTC(),
+		}
+	}
+	return
+}
+
 func (c *RemoteService) GetDefaults() map[string]string {
 	response, err := c.cli.GetDefaults(context.Background(), &apricotpb.Empty{}, grpc.EmptyCallOption{})
 	if err != nil {
@@ -177,6 +204,12 @@ func (c *RemoteService) RawGetRecursive(path string) (payload string, err error)
 	return response.GetPayload(), nil
 }
 
+func (c *RemoteService) RawPutRecursive(path string, payload string) (err error) {
+	request := &apricotpb.RawPutRecursiveRequest{RawPath: path, Payload: payload}
+	_, err = c.cli.RawPutRecursive(context.Background(), request, grpc.EmptyCallOption{})
+	return
+}
+
 func (c *RemoteService) GetDetectorForHost(hostname string) (payload string, err error) {
 	var response *apricotpb.DetectorResponse
 	request := &apricotpb.HostRequest{
@@ -369,7 +402,7 @@ func (c *RemoteService) ListComponentEntries(query *componentcfg.EntriesQuery) (
 	return
 }
 
-func (c *RemoteService) ImportComponentConfiguration(query *componentcfg.Query, payload string, newComponent bool) (existingComponentUpdated bool, existingEntryUpdated bool, err error) {
+func (c *RemoteService) ImportComponentConfiguration(query *componentcfg.Query, payload string, newComponent bool, format string) (existingComponentUpdated bool, existingEntryUpdated bool, err error) {
 	var response *apricotpb.ImportComponentConfigurationResponse
 	request := &apricotpb.ImportComponentConfigurationRequest{
 		Query: &apricotpb.ComponentQuery{
@@ -380,6 +413,7 @@ func (c *RemoteService) ImportComponentConfiguration(query *componentcfg.Query,
 		},
 		Payload:      payload,
 		NewComponent: newComponent,
+		Format:       format,
 	}
 
 	response, err = c.cli.ImportComponentConfiguration(context.Background(), request, grpc.EmptyCallOption{})
@@ -392,6 +426,58 @@ func (c *RemoteService) ImportComponentConfiguration(query *componentcfg.Query,
 	return
 }
 
+func (c *RemoteService) ValidateComponentConfiguration(query *componentcfg.Query, payload string, format string, varStack map[string]string) (result *componentcfg.ValidationResult, err error) {
+	var response *apricotpb.ValidateComponentConfigurationResponse
+	request := &apricotpb.ValidateComponentConfigurationRequest{
+		Query: &apricotpb.ComponentQuery{
+			Component:   query.Component,
+			RunType:     query.RunType,
+			MachineRole: query.RoleName,
+			Entry:       query.EntryKey,
+		},
+		Payload:  payload,
+		Format:   format,
+		VarStack: varStack,
+	}
+
+	response, err = c.cli.ValidateComponentConfiguration(context.Background(), request, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	result = &componentcfg.ValidationResult{
+		Format: response.GetFormat(),
+		Schema: response.GetSchema(),
+		Errors: response.GetErrors(),
+	}
+	if result.Errors == nil {
+		result.Errors = make([]string, 0)
+	}
+	return
+}
+
+func (c *RemoteService) ListComponentSchemas(component string) (schemas map[string]string, err error) {
+	var response *apricotpb.StringMap
+	request := &apricotpb.ListComponentSchemasRequest{
+		Component: component,
+	}
+	response, err = c.cli.ListComponentSchemas(context.Background(), request, grpc.EmptyCallOption{})
+	if err != nil {
+		return nil, err
+	}
+	return response.GetStringMap(), nil
+}
+
+func (c *RemoteService) ImportComponentSchema(component string, name string, payload string) (err error) {
+	request := &apricotpb.ImportComponentSchemaRequest{
+		Component: component,
+		Name:      name,
+		Payload:   payload,
+	}
+	_, err = c.cli.ImportComponentSchema(context.Background(), request, grpc.EmptyCallOption{})
+	return
+}
+
 func (c *RemoteService) InvalidateComponentTemplateCache() {
 	_, _ = c.cli.InvalidateComponentTemplateCache(context.Background(), &apricotpb.Empty{}, grpc.EmptyCallOption{})
 }
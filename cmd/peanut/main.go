@@ -25,17 +25,15 @@
 package main
 
 import (
+	"fmt"
 	"os"
-	"strings"
 
 	"github.com/AliceO2Group/Control/occ/peanut"
 )
 
 func main() {
-	cmdArg := os.Args[1:]
-	cmdString := strings.Trim(strings.Join(cmdArg, " "), " ")
-
-	if err := peanut.Run(cmdString); err != nil {
-		panic(err)
+	if err := peanut.Run(os.Args[1:]); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, "peanut: "+err.Error())
+		os.Exit(1)
 	}
 }
@@ -32,12 +32,19 @@ import (
 	"github.com/AliceO2Group/Control/common/logger"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 var log = logger.New(logrus.StandardLogger(), "coconut")
 
 const GrpcMaxCallRecvSize = 100 * 1024 * 1024
 
+// LEADER_METADATA_KEY is the metadata key under which a core instance in
+// active/standby mode advertises the endpoint of the current leader.
+const LEADER_METADATA_KEY = "aliecs-leader"
+
 type internalState struct {
 }
 
@@ -47,6 +54,7 @@ func NewClient(cxt context.Context, cancel context.CancelFunc, endpoint string)
 		endpoint,
 		grpc.WithInsecure(),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(GrpcMaxCallRecvSize)),
+		grpc.WithUnaryInterceptor(followLeader(endpoint)),
 	)
 	if err != nil {
 		log.WithField("error", err.Error()).
@@ -67,6 +75,39 @@ func NewClient(cxt context.Context, cancel context.CancelFunc, endpoint string)
 	return client
 }
 
+// followLeader retries the calls refused by a standby core instance against
+// the leader it points to.
+func followLeader(endpoint string) grpc.UnaryClientInterceptor {
+	return func(cxt context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var header, trailer metadata.MD
+		err := invoker(cxt, method, req, reply, cc, append(opts, grpc.Header(&header), grpc.Trailer(&trailer))...)
+		if status.Code(err) != codes.Unavailable {
+			return err
+		}
+
+		leaders := append(trailer.Get(LEADER_METADATA_KEY), header.Get(LEADER_METADATA_KEY)...)
+		if len(leaders) == 0 || len(leaders[0]) == 0 || leaders[0] == endpoint {
+			return err
+		}
+		leader := leaders[0]
+
+		log.WithField("endpoint", endpoint).
+			WithField("leader", leader).
+			Warn("core instance on standby, redirecting to leader")
+		conn, dialErr := grpc.DialContext(
+			cxt,
+			leader,
+			grpc.WithInsecure(),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(GrpcMaxCallRecvSize)),
+		)
+		if dialErr != nil {
+			return err
+		}
+		defer conn.Close()
+		return conn.Invoke(cxt, method, req, reply, opts...)
+	}
+}
+
 type RpcClient struct {
 	pb.ControlClient
 	state *internalState
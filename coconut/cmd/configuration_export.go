@@ -0,0 +1,52 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/configuration"
+	"github.com/spf13/cobra"
+)
+
+var configurationExportCmd = &cobra.Command{
+	Use:     "export <key> <destination_uri>",
+	Aliases: []string{"x"},
+	Example: `coconut conf export o2/components/readout file:///tmp/readout-snapshot.yaml
+coconut conf export / file:///tmp/o2-snapshot.json
+coconut conf export o2/components consul://consul-test.cern.ch:8500`,
+	Short: "export a configuration subtree to another configuration backend",
+	Long: `The configuration export command requests from O² Configuration the
+subtree at the given key, and writes it at the same key in the configuration
+backend specified by the destination URI, replacing whatever was there.
+Supported destinations are file:// (YAML or JSON file, created if missing),
+consul:// and mock://. Pass / as key to export the whole configuration tree.
+Since Consul has no notion of arrays, subtrees that contain arrays can only
+be exported to a file.`,
+	Run:  configuration.WrapCall(configuration.Export),
+	Args: cobra.ExactArgs(2),
+}
+
+func init() {
+	configurationCmd.AddCommand(configurationExportCmd)
+}
@@ -39,12 +39,19 @@ coconut conf import <component>/<run type>/<machine role>/<entry> <file_path> --
 coconut conf import <component> <entry> <file_path>.json
 coconut conf import <component> <entry> <file_path> 
 coconut conf import <component> <entry> <file_path> --new-component
+coconut conf import <component> <entry> <file_path> --check
 `,
 	Short: "Import a configuration file for the specified component and entry",
 	Long: `The configuration import command generates a timestamp and saves
 the configuration file to Consul under the <component>/<entry> path. 
 Supported configuration file types are JSON, YAML, TOML and INI, 
-and their file extensions are recognized automatically.`,
+and their file extensions are recognized automatically.
+
+Before importing, the payload is checked for syntax errors according to its
+format, and validated against the schema associated with the target entry, if
+any. Template processing is performed before validation. Invalid payloads are
+not imported. With --check, the payload is only validated and the result is
+displayed, without writing anything to the configuration store.`,
 	Run:  configuration.WrapCall(configuration.Import),
 	Args: cobra.RangeArgs(2, 3),
 }
@@ -55,4 +62,5 @@ func init() {
 	configurationImportCmd.Flags().StringP("format", "f", "", "force a specific configuration file type, overriding any file extension")
 	configurationImportCmd.Flags().StringP("runtype", "r", "", "request configuration for this run type (e.g. PHYSICS, TECHNICAL, etc.)")
 	configurationImportCmd.Flags().StringP("role", "l", "", "request configuration for this O² machine role")
+	configurationImportCmd.Flags().BoolP("check", "c", false, "only validate the configuration payload, without importing it")
 }
@@ -0,0 +1,54 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/configuration"
+	"github.com/spf13/cobra"
+)
+
+var configurationRestoreCmd = &cobra.Command{
+	Use: "restore <source_uri> <key>",
+	Example: `coconut conf restore file:///tmp/readout-snapshot.yaml o2/components/readout
+coconut conf restore file:///tmp/o2-snapshot.json /`,
+	Short: "restore a configuration subtree from another configuration backend",
+	Long: `The configuration restore command reads the subtree at the given key
+from the configuration backend specified by the source URI, and writes it
+at the same key in O² Configuration, replacing whatever was there.
+Supported sources are file:// (YAML or JSON file), consul:// and mock://.
+Pass / as key to restore the whole configuration tree.
+If O² Configuration is backed by Consul, the subtree is written with Consul
+transactions. The write is atomic only if it fits in a single transaction
+of 64 operations, i.e. up to 62 keys and folders. Larger subtrees are
+written in several transactions, so other clients may see a partially
+restored subtree in the meantime, and the previous contents are put back
+if the write fails.`,
+	Run:  configuration.WrapCall(configuration.Restore),
+	Args: cobra.ExactArgs(2),
+}
+
+func init() {
+	configurationCmd.AddCommand(configurationRestoreCmd)
+}
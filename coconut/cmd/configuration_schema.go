@@ -0,0 +1,54 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// configurationSchemaCmd represents the configuration schema command
+var configurationSchemaCmd = &cobra.Command{
+	Use:     "schema",
+	Aliases: []string{"schemas", "s"},
+	Short:   "view or modify O² configuration schemas",
+	Long: `The configuration schema command allows you to perform operations on the
+schemas used to validate component configuration payloads.
+
+A schema belongs to a component, and is stored as a JSON document with the
+following optional fields:
+  entryPattern     pattern over <run type>/<machine role>/<entry> to restrict
+                   the entries the schema applies to (e.g. "*/*/readout-*"),
+                   if empty the schema applies to all entries of the component
+  format           json, yaml, ini or toml
+  jsonSchema       a JSON Schema document, applied to JSON, YAML, TOML and INI
+                   payloads after template processing
+  iniSchema        a map of INI section patterns to maps of key names to
+                   {"type": "string|int|float|bool", "required": true|false}
+  sampleVarStack   variables used for template processing during validation`,
+}
+
+func init() {
+	configurationCmd.AddCommand(configurationSchemaCmd)
+}
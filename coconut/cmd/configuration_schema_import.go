@@ -0,0 +1,49 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/configuration"
+	"github.com/spf13/cobra"
+)
+
+var configurationSchemaImportCmd = &cobra.Command{
+	Use:     "import <component> <schema> <file_path>",
+	Aliases: []string{"i", "imp"},
+	Example: `coconut conf schema import readout readout-ini readout-schema.json
+coconut conf schema import qc qc-json qc-schema.yaml`,
+	Short: "Import a configuration schema for the specified component",
+	Long: `The configuration schema import command saves a schema for the given
+component under the given name, replacing any existing schema with the same
+name. The schema file can be JSON or YAML, and is stored as JSON.
+Subsequent imports of configuration entries matched by the schema are
+validated against it.`,
+	Run:  configuration.WrapCall(configuration.ImportSchema),
+	Args: cobra.ExactArgs(3),
+}
+
+func init() {
+	configurationSchemaCmd.AddCommand(configurationSchemaImportCmd)
+}
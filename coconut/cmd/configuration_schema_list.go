@@ -0,0 +1,48 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/configuration"
+	"github.com/spf13/cobra"
+)
+
+var configurationSchemaListCmd = &cobra.Command{
+	Use:     "list <component> [schema]",
+	Aliases: []string{"l", "ls", "show"},
+	Example: `coconut conf schema list <component>
+coconut conf schema list <component> <schema>`,
+	Short: "List the configuration schemas for a component, or show one",
+	Long: `The configuration schema list command lists the names of all the
+schemas associated with the given component. If a schema name is also
+provided, the schema document is displayed instead.`,
+	Run:  configuration.WrapCall(configuration.ListSchemas),
+	Args: cobra.RangeArgs(1, 2),
+}
+
+func init() {
+	configurationSchemaCmd.AddCommand(configurationSchemaListCmd)
+	configurationSchemaListCmd.Flags().StringP("output", "o", "yaml", "output format for the schema list (yaml/json)")
+}
@@ -0,0 +1,53 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// configurationSecretCmd represents the configuration secret command
+var configurationSecretCmd = &cobra.Command{
+	Use:     "secret",
+	Aliases: []string{"secrets"},
+	Short:   "view or modify O² secrets",
+	Long: `The configuration secret command allows you to perform operations on the
+secrets which task templates reference as {{ secret("name") }} or as
+env: [VAR=secret:name], and which integration plugin settings reference as
+secret:name.
+
+Secrets are kept in a namespace of O² Configuration which cannot be read with
+the dump, export or show commands. Access requires the secrets token
+configured on the Apricot instance, passed with --token-file.
+Secret values can be written and deleted, but never read back.`,
+}
+
+func init() {
+	configurationCmd.AddCommand(configurationSecretCmd)
+
+	configurationSecretCmd.PersistentFlags().String("token-file", "", "file with the token to present to Apricot for secrets access")
+	_ = viper.BindPFlag("secretsTokenFile", configurationSecretCmd.PersistentFlags().Lookup("token-file"))
+}
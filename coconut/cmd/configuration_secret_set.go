@@ -0,0 +1,50 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/configuration"
+	"github.com/spf13/cobra"
+)
+
+var configurationSecretSetCmd = &cobra.Command{
+	Use:     "set <name>",
+	Aliases: []string{"s", "put"},
+	Example: `coconut conf secret set bookkeeping-token --token-file ~/.config/coconut/secrets.token < token.txt
+coconut conf secret set dcs-password --from-file /run/keys/dcs-password`,
+	Short: "store a secret",
+	Long: `The configuration secret set command stores a secret under the given name,
+replacing any previous value. The value is read from standard input, or from
+the file passed with --from-file, so that it does not end up in the shell
+history. A single trailing newline is removed.
+Secret names may contain letters, digits, '_', '.' and '-'.`,
+	Run:  configuration.WrapCall(configuration.SetSecret),
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	configurationSecretCmd.AddCommand(configurationSecretSetCmd)
+	configurationSecretSetCmd.Flags().String("from-file", "", "read the secret value from a file instead of standard input")
+}
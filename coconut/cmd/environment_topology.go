@@ -0,0 +1,57 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/AliceO2Group/Control/common/product"
+	"github.com/spf13/cobra"
+)
+
+// environmentTopologyCmd represents the environment topology command
+var environmentTopologyCmd = &cobra.Command{
+	Use:     "topology [environment id]",
+	Aliases: []string{"topo"},
+	Short:   "show the channel topology of an environment",
+	Long: fmt.Sprintf(`The environment topology command requests from %s the FairMQ channel graph
+of a deployed environment, as resolved for the configuration of its tasks.
+
+By default it prints a table of the channels along with any issues found, such as inbound channels
+nothing connects to, or channel type and transport mismatches. With --format, the topology is
+exported as JSON, Graphviz DOT or GraphML instead.`, product.PRETTY_SHORTNAME),
+	Example: `coconut environment topology 2oDvieFrVTi
+coconut env topo 2oDvieFrVTi -f dot | dot -Tsvg > topology.svg
+coconut env topo 2oDvieFrVTi -f graphml > topology.graphml`,
+	Run:  control.WrapCall(control.GetEnvironmentTopology),
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	environmentCmd.AddCommand(environmentTopologyCmd)
+
+	environmentTopologyCmd.Flags().StringP("format", "f", "", "export format for the topology (json/dot/graphml)")
+}
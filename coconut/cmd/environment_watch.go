@@ -0,0 +1,61 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/AliceO2Group/Control/common/product"
+	"github.com/spf13/cobra"
+)
+
+// environmentWatchCmd represents the environment watch command
+var environmentWatchCmd = &cobra.Command{
+	Use:     "watch [environment id]",
+	Aliases: []string{"w", "follow"},
+	Short:   "follow the events of an environment live",
+	Long: fmt.Sprintf(`The environment watch command subscribes to the events of an
+existing environment as they are emitted by %s, and prints them as a timeline until interrupted.
+
+The timeline shows environment transitions and their steps, the start and completion of hook
+calls with their duration and errors, integrated service operations and run events. Task state
+changes are aggregated per role, and printed as a count of tasks in each state.
+
+With --json, every event is printed as a single line JSON object instead, for use in scripts.`, product.PRETTY_SHORTNAME),
+	Example: ` * ` + "`coconut env watch 2oDvieFrVTi`" + ` follows environment 2oDvieFrVTi
+ * ` + "`coconut env watch 2oDvieFrVTi --topic call,environment`" + ` only shows transitions and hook calls
+ * ` + "`coconut env watch 2oDvieFrVTi --json | jq .callEvent`" + ` prints hook call events as JSON`,
+	Run:  control.WrapStreamCall(control.WatchEnvironment),
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	environmentCmd.AddCommand(environmentWatchCmd)
+
+	environmentWatchCmd.Flags().Bool("json", false, "print events as JSON, one per line")
+	environmentWatchCmd.Flags().StringSliceP("topic", "t", []string{}, "only print events of these topics (environment, task, call, role, run, integrated_service)")
+	environmentWatchCmd.Flags().StringSliceP("detector", "d", []string{}, "only print events if the environment includes any of these detectors")
+}
@@ -0,0 +1,62 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/AliceO2Group/Control/common/product"
+	"github.com/spf13/cobra"
+)
+
+// eventsCmd represents the events command
+var eventsCmd = &cobra.Command{
+	Use:     "events",
+	Aliases: []string{"ev"},
+	Short:   fmt.Sprintf("follow the events of all %s environments live", product.PRETTY_SHORTNAME),
+	Long: fmt.Sprintf(`The events command subscribes to all events emitted by %s, and
+prints them as a timeline until interrupted. Each line is prefixed with the ID of the environment
+the event belongs to.
+
+The output is the same as that of `+"`coconut environment watch`"+`, and it can be filtered by
+environment, topic and detector.
+
+With --json, every event is printed as a single line JSON object instead, for use in scripts.`, product.PRETTY_SHORTNAME),
+	Example: ` * ` + "`coconut events`" + ` follows all events
+ * ` + "`coconut events --detector TPC,ITS`" + ` only shows events of environments which include TPC or ITS
+ * ` + "`coconut events --topic run --json`" + ` prints run events as JSON`,
+	Run:  control.WrapStreamCall(control.WatchEvents),
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+
+	eventsCmd.Flags().Bool("json", false, "print events as JSON, one per line")
+	eventsCmd.Flags().StringP("environment", "e", "", "only print events of this environment")
+	eventsCmd.Flags().StringSliceP("topic", "t", []string{}, "only print events of these topics (environment, task, call, role, run, integrated_service, core)")
+	eventsCmd.Flags().StringSliceP("detector", "d", []string{}, "only print events of environments which include any of these detectors")
+}
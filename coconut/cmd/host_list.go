@@ -0,0 +1,44 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// hostListCmd represents the host list command
+var hostListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls", "l"},
+	Short:   "list cordoned hosts",
+	Long: `The host list command shows the hosts currently excluded from scheduling, along with
+the environments and tasks still running on them.`,
+	Run: control.WrapCall(control.GetCordonedHosts),
+}
+
+func init() {
+	hostCmd.AddCommand(hostListCmd)
+}
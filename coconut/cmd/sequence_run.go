@@ -0,0 +1,51 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// sequenceRunCmd represents the sequence run command
+var sequenceRunCmd = &cobra.Command{
+	Use:   "run [environment id] [sequence file]",
+	Short: "run a sequence on an environment",
+	Long: `The sequence run command reads a run sequence from a YAML file and starts executing it on
+the given environment. The sequence runs in the core, so it keeps going after this command returns.
+
+A sequence is made of steps. Each step either sets variables on the environment (set), pushes an
+environment transition (transition), waits for a duration or for an environment state (wait), or
+repeats a list of steps for each value of a loop variable (loop).
+
+Only one sequence can run on a given environment at a time.`,
+	Example: `coconut sequence run 2oDvieFrVTi threshold-scan.yaml`,
+	Run:     control.WrapCall(control.RunSequence),
+	Args:    cobra.ExactArgs(2),
+}
+
+func init() {
+	sequenceCmd.AddCommand(sequenceRunCmd)
+}
@@ -0,0 +1,49 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// sequenceStatusCmd represents the sequence status command
+var sequenceStatusCmd = &cobra.Command{
+	Use:     "status [sequence id]",
+	Aliases: []string{"list", "ls", "l", "s"},
+	Short:   "show the status of run sequences",
+	Long: `The sequence status command shows the progress of a single run sequence if a sequence id
+is given, or lists all known run sequences otherwise.`,
+	Example: `coconut sequence status
+coconut sequence status --environment 2oDvieFrVTi
+coconut sequence status 2oDwQ7t3Ywr`,
+	Run:  control.WrapCall(control.GetSequences),
+	Args: cobra.MaximumNArgs(1),
+}
+
+func init() {
+	sequenceCmd.AddCommand(sequenceStatusCmd)
+	sequenceStatusCmd.Flags().StringP("environment", "e", "", "only show sequences running on the given environment")
+}
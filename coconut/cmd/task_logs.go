@@ -0,0 +1,54 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// taskLogsCmd represents the task logs command
+var taskLogsCmd = &cobra.Command{
+	Use:     "logs [task id]",
+	Aliases: []string{"log"},
+	Short:   "show the output of a task",
+	Long: `The task logs command shows the output of a task, as kept in log files by its
+executor on the Mesos agent. Logs remain available for a while after the task is gone,
+as long as the executor retains them.
+
+By default the last 100 lines are shown, pass --tail 0 to show as much of the log as fits
+in a single reply. With --follow, new output is polled for and printed until interrupted.`,
+	Example: `coconut task logs 2oDvmzJRWJ1
+coconut task logs 2oDvmzJRWJ1 --tail 20 -f`,
+	Run:  control.WrapStreamCall(control.GetTaskLogs),
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	taskCmd.AddCommand(taskLogsCmd)
+
+	taskLogsCmd.Flags().BoolP("follow", "f", false, "keep printing new output until interrupted")
+	taskLogsCmd.Flags().Int32P("tail", "n", 100, "number of most recent lines to show, 0 for all")
+}
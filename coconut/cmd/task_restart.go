@@ -0,0 +1,48 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// taskRestartCmd represents the task restart command
+var taskRestartCmd = &cobra.Command{
+	Use:   "restart [task id]",
+	Short: "restart a task of an environment",
+	Long: `The task restart command kills a task which belongs to an environment, and deploys a
+replacement for it on the same host. The replacement is then brought to the state of the
+environment, which must be DEPLOYED, CONFIGURED or RUNNING.
+
+Tasks with inbound channels can only be restarted while their environment is DEPLOYED,
+because their peers only learn of the replacement's endpoints with the next CONFIGURE.`,
+	Run:  control.WrapCallWithTimeout(control.RestartTask, control.TASK_RESTART_CALL_TIMEOUT),
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	taskCmd.AddCommand(taskRestartCmd)
+}
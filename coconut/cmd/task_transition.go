@@ -0,0 +1,49 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// taskTransitionCmd represents the task transition command
+var taskTransitionCmd = &cobra.Command{
+	Use:     "transition [task id] [event]",
+	Aliases: []string{"t"},
+	Short:   "push a state machine transition to a task of an environment",
+	Long: `The task transition command pushes a single transition to a task which belongs to an
+environment, for example to catch up a task which failed to follow an environment transition.
+
+Valid events are CONFIGURE, RESET, START, STOP and RECOVER. Only transitions which bring the
+task closer to the state of its environment are allowed.`,
+	Example: `coconut task transition 2oDvmzJRWJ1 CONFIGURE`,
+	Run:     control.WrapCall(control.TransitionTask),
+	Args:    cobra.ExactArgs(2),
+}
+
+func init() {
+	taskCmd.AddCommand(taskTransitionCmd)
+}
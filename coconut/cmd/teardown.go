@@ -0,0 +1,57 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/AliceO2Group/Control/common/product"
+	"github.com/spf13/cobra"
+)
+
+// teardownCmd represents the teardown command
+var teardownCmd = &cobra.Command{
+	Use:   "teardown",
+	Short: fmt.Sprintf("shut down all environments and tasks of the %s instance", product.PRETTY_SHORTNAME),
+	Long: fmt.Sprintf(`The teardown command instructs %s to bring the whole instance to a clean state,
+for example before an intervention on the cluster.
+
+All running environments are stopped, so that end of run hooks are executed, and then all
+environments are destroyed. Finally, any leftover tasks are killed.
+Environments which cannot be shut down with regular transitions are destroyed by force.
+
+A reason must be given, and is included in all events and logs produced by the teardown.`, product.PRETTY_SHORTNAME),
+	Example: `coconut teardown --reason "network intervention on FLP racks"`,
+	Run:     control.WrapCallWithTimeout(control.Teardown, control.TEARDOWN_CALL_TIMEOUT),
+	Args:    cobra.NoArgs,
+}
+
+func init() {
+	rootCmd.AddCommand(teardownCmd)
+
+	teardownCmd.Flags().StringP("reason", "r", "", "reason for the teardown (required)")
+	_ = teardownCmd.MarkFlagRequired("reason")
+}
@@ -32,14 +32,17 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/AliceO2Group/Control/apricot"
 	apricotpb "github.com/AliceO2Group/Control/apricot/protos"
 	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/common/secrets"
 	"github.com/AliceO2Group/Control/common/utils"
 	"github.com/AliceO2Group/Control/configuration"
+	"github.com/AliceO2Group/Control/configuration/cfgbackend"
 	"github.com/AliceO2Group/Control/configuration/componentcfg"
 	"github.com/briandowns/spinner"
 	"github.com/naoina/toml"
@@ -281,8 +284,32 @@ func Import(svc configuration.Service, cmd *cobra.Command, args []string, o io.W
 		return err, EC_LOGIC_ERROR
 	}
 
+	checkOnly, err := cmd.Flags().GetBool("check")
+	if err != nil {
+		return err, EC_INVALID_ARGS
+	}
+
+	// Syntax and schema validation happens before any write, so that a broken payload never reaches the
+	// configuration store. With --check, we only report the validation result.
+	var result *componentcfg.ValidationResult
+	result, err = svc.ValidateComponentConfiguration(pushQuery, string(payload), extension, nil)
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+	if checkOnly {
+		printValidationResult(o, pushQuery, result)
+		if !result.IsValid() {
+			return result, EC_LOGIC_ERROR
+		}
+		return nil, EC_ZERO
+	}
+	if !result.IsValid() {
+		printValidationResult(o, pushQuery, result)
+		return errors.New("configuration payload not imported"), EC_LOGIC_ERROR
+	}
+
 	var existingComponentUpdated, existingEntryUpdated bool
-	existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(pushQuery, string(payload), useNewComponent)
+	existingComponentUpdated, existingEntryUpdated, err = svc.ImportComponentConfiguration(pushQuery, string(payload), useNewComponent, extension)
 	if err != nil {
 		return err, EC_LOGIC_ERROR
 	}
@@ -307,3 +334,203 @@ func Import(svc configuration.Service, cmd *cobra.Command, args []string, o io.W
 	_, _ = fmt.Fprintln(o, userMsg)
 	return nil, 0
 }
+
+// coconut conf schema list
+func ListSchemas(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer) (err error, code int) {
+	if !componentcfg.IsInputValidComponentName(args[0]) {
+		return errors.New(EC_INVALID_ARGS_MSG), EC_INVALID_ARGS
+	}
+
+	schemas, err := svc.ListComponentSchemas(args[0])
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+
+	if len(args) == 2 {
+		schema, ok := schemas[args[1]]
+		if !ok {
+			return fmt.Errorf("schema %s not found for component %s", args[1], args[0]), EC_EMPTY_DATA
+		}
+		_, _ = fmt.Fprintln(o, schema)
+		return nil, EC_ZERO
+	}
+
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	output, err := formatListOutput(cmd, names)
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+	_, _ = fmt.Fprintln(o, string(output))
+	return nil, EC_ZERO
+}
+
+// coconut conf schema import
+func ImportSchema(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer) (err error, code int) {
+	component, name, filePath := args[0], args[1], args[2]
+	if !componentcfg.IsInputValidComponentName(component) || !componentcfg.IsInputValidComponentName(name) {
+		return errors.New(EC_INVALID_ARGS_MSG), EC_INVALID_ARGS
+	}
+
+	var payload []byte
+	payload, err = getFileContent(filePath)
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+
+	// Schemas are stored as JSON, but writing them in YAML is more convenient
+	if strings.HasSuffix(filePath, ".yaml") || strings.HasSuffix(filePath, ".yml") {
+		var schema interface{}
+		err = yaml.Unmarshal(payload, &schema)
+		if err != nil {
+			return err, EC_INVALID_ARGS
+		}
+		payload, err = json.MarshalIndent(schema, "", "    ")
+		if err != nil {
+			return err, EC_LOGIC_ERROR
+		}
+	}
+
+	err = svc.ImportComponentSchema(component, name, string(payload))
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+
+	_, _ = fmt.Fprintln(o, "Schema imported: "+red(component)+componentcfg.SEPARATOR+blue(name))
+	return nil, EC_ZERO
+}
+
+// coconut conf export
+func Export(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer) (err error, code int) {
+	key, uri := args[0], args[1]
+
+	dataJson, err := svc.RawGetRecursive(key)
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+	if isEmptySubtree(dataJson) {
+		return errors.New(EC_EMPTY_DATA_MSG), EC_EMPTY_DATA
+	}
+
+	if strings.HasPrefix(uri, "file://") {
+		err = exportToFile(strings.TrimPrefix(uri, "file://"), key, dataJson)
+		if err != nil {
+			return err, EC_LOGIC_ERROR
+		}
+		_, _ = fmt.Fprintln(o, "Subtree "+blue(key)+" exported to "+blue(uri))
+		return nil, EC_ZERO
+	}
+
+	dst, err := cfgbackend.NewSource(uri)
+	if err != nil {
+		return err, EC_INVALID_ARGS
+	}
+	// JSON is valid YAML, so the destination backend can parse the dump as is
+	err = dst.PutRecursiveYaml(key, []byte(dataJson))
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+
+	_, _ = fmt.Fprintln(o, "Subtree "+blue(key)+" exported to "+blue(uri))
+	return nil, EC_ZERO
+}
+
+// coconut conf restore
+func Restore(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer) (err error, code int) {
+	uri, key := args[0], args[1]
+
+	src, err := cfgbackend.NewSource(uri)
+	if err != nil {
+		return err, EC_INVALID_ARGS
+	}
+	item, err := src.GetRecursive(key)
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+	payload, err := json.Marshal(item)
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+	if isEmptySubtree(string(payload)) {
+		return errors.New(EC_EMPTY_DATA_MSG), EC_EMPTY_DATA
+	}
+
+	err = svc.RawPutRecursive(key, string(payload))
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+
+	_, _ = fmt.Fprintln(o, "Subtree "+blue(key)+" restored from "+blue(uri))
+	return nil, EC_ZERO
+}
+
+// coconut conf secret list
+func ListSecrets(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer) (err error, code int) {
+	names, err := svc.ListSecrets()
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+
+	output, err := formatListOutput(cmd, names)
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+	_, _ = fmt.Fprintln(o, string(output))
+	return nil, EC_ZERO
+}
+
+// coconut conf secret set
+func SetSecret(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer) (err error, code int) {
+	name := args[0]
+	if err = secrets.ValidateName(name); err != nil {
+		return err, EC_INVALID_ARGS
+	}
+
+	fromFile, err := cmd.Flags().GetString("from-file")
+	if err != nil {
+		return err, EC_INVALID_ARGS
+	}
+	var value []byte
+	if len(fromFile) != 0 {
+		value, err = getFileContent(fromFile)
+	} else {
+		value, err = io.ReadAll(os.Stdin)
+	}
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+	value = []byte(strings.TrimSuffix(strings.TrimSuffix(string(value), "\n"), "\r"))
+	if len(value) == 0 {
+		return errors.New("refusing to store an empty secret"), EC_EMPTY_DATA
+	}
+
+	err = svc.SetSecret(name, string(value))
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+
+	_, _ = fmt.Fprintln(o, "Secret "+blue(name)+" stored")
+	return nil, EC_ZERO
+}
+
+// coconut conf secret delete
+func DeleteSecret(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer) (err error, code int) {
+	name := args[0]
+	if err = secrets.ValidateName(name); err != nil {
+		return err, EC_INVALID_ARGS
+	}
+
+	err = svc.DeleteSecret(name)
+	if errors.Is(err, secrets.ErrNotFound) {
+		return err, EC_EMPTY_DATA
+	} else if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+
+	_, _ = fmt.Fprintln(o, "Secret "+blue(name)+" deleted")
+	return nil, EC_ZERO
+}
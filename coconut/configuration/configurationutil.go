@@ -35,6 +35,7 @@ import (
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
+	"io"
 	"io/ioutil"
 	"os"
 	"regexp"
@@ -42,8 +43,9 @@ import (
 )
 
 var (
-	blue = color.New(color.FgHiBlue).SprintFunc()
-	red  = color.New(color.FgHiRed).SprintFunc()
+	blue  = color.New(color.FgHiBlue).SprintFunc()
+	red   = color.New(color.FgHiRed).SprintFunc()
+	green = color.New(color.FgHiGreen).SprintFunc()
 	//                                                 component        /RUNTYPE          /rolename             /entry
 	inputComponentEntryRegex = regexp.MustCompile(`^([a-zA-Z0-9-_]+)(\/[A-Z0-9-_]+){1}(\/[a-z-A-Z0-9-_]+){1}(\/[a-z-A-Z0-9-_]+){1}$`)
 )
@@ -156,3 +158,84 @@ func isFileExtensionValid(extension string) bool {
 	extension = strings.ToUpper(extension)
 	return extension == "JSON" || extension == "YAML" || extension == "YML" || extension == "INI" || extension == "TOML"
 }
+
+func printValidationResult(o io.Writer, query *componentcfg.Query, result *componentcfg.ValidationResult) {
+	format := result.Format
+	if len(format) == 0 {
+		format = "unknown format, syntax not checked"
+	}
+	schema := result.Schema
+	if len(schema) == 0 {
+		schema = "none"
+	}
+	_, _ = fmt.Fprintf(o, "entry:  %s\nformat: %s\nschema: %s\n", query.Path(), format, schema)
+	if result.IsValid() {
+		_, _ = fmt.Fprintln(o, green("payload valid"))
+		return
+	}
+	_, _ = fmt.Fprintln(o, red("payload invalid:"))
+	for _, e := range result.Errors {
+		_, _ = fmt.Fprintf(o, "  - %s\n", e)
+	}
+}
+
+// exportToFile writes the JSON dump of the subtree at key into the YAML or
+// JSON file at filePath, replacing whatever was at key and keeping the rest
+// of the file. The file is created if missing, and written as JSON if its
+// name ends in .json.
+func exportToFile(filePath string, key string, dataJson string) error {
+	var subtree interface{}
+	err := json.Unmarshal([]byte(dataJson), &subtree)
+	if err != nil {
+		return err
+	}
+
+	root := make(map[string]interface{})
+	existing, err := os.ReadFile(filePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if len(bytes.TrimSpace(existing)) > 0 {
+		// JSON is valid YAML, so this reads either format
+		err = yaml.Unmarshal(existing, &root)
+		if err != nil {
+			return fmt.Errorf("cannot parse %s: %w", filePath, err)
+		}
+	}
+
+	path := strings.Split(strings.Trim(key, "/"), "/")
+	if len(path) == 1 && path[0] == "" {
+		subtreeMap, ok := subtree.(map[string]interface{})
+		if !ok {
+			return errors.New("only a map can be exported as the root of the configuration tree")
+		}
+		root = subtreeMap
+	} else {
+		current := root
+		for _, k := range path[:len(path)-1] {
+			next, ok := current[k].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				current[k] = next
+			}
+			current = next
+		}
+		current[path[len(path)-1]] = subtree
+	}
+
+	var out []byte
+	if strings.HasSuffix(filePath, ".json") {
+		out, err = json.MarshalIndent(root, "", "  ")
+	} else {
+		out, err = yaml.Marshal(root)
+	}
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, out, 0644)
+}
+
+func isEmptySubtree(dataJson string) bool {
+	trimmed := strings.TrimSpace(dataJson)
+	return len(trimmed) == 0 || trimmed == "{}" || trimmed == "null"
+}
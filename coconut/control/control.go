@@ -33,10 +33,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"syscall"
 	"text/template"
 	"time"
 
@@ -61,6 +63,9 @@ import (
 
 const (
 	CALL_TIMEOUT              = 55 * time.Second
+	TEARDOWN_CALL_TIMEOUT     = 30 * time.Minute
+	TASK_RESTART_CALL_TIMEOUT = 5 * time.Minute
+	TASK_LOGS_POLL_INTERVAL   = 1 * time.Second
 	SPINNER_TICK              = 100 * time.Millisecond
 	HLCONFIG_COMPONENT_PREFIX = "COG-v1"
 	HLCONFIG_PATH_PREFIX      = "consul://o2/runtime/"
@@ -82,6 +87,12 @@ type ConfigurationPayload struct {
 }
 
 func WrapCall(call ControlCall) RunFunc {
+	return WrapCallWithTimeout(call, CALL_TIMEOUT)
+}
+
+// WrapCallWithTimeout is like WrapCall, but for calls which are expected to
+// take longer than CALL_TIMEOUT.
+func WrapCallWithTimeout(call ControlCall, timeout time.Duration) RunFunc {
 	return func(cmd *cobra.Command, args []string) {
 		endpoint := viper.GetString("endpoint")
 		log.WithPrefix(cmd.Use).
@@ -100,7 +111,7 @@ func WrapCall(call ControlCall) RunFunc {
 			color.NoColor = true
 		}
 
-		cxt, cancel := context.WithTimeout(context.Background(), CALL_TIMEOUT)
+		cxt, cancel := context.WithTimeout(context.Background(), timeout)
 		rpc := coconut.NewClient(cxt, cancel, endpoint)
 
 		var out strings.Builder
@@ -128,6 +139,37 @@ func WrapCall(call ControlCall) RunFunc {
 	}
 }
 
+// WrapStreamCall is like WrapCall, but for calls which keep printing until
+// interrupted: there is no spinner and no call timeout, and output is not
+// buffered.
+func WrapStreamCall(call ControlCall) RunFunc {
+	return func(cmd *cobra.Command, args []string) {
+		endpoint := viper.GetString("endpoint")
+		log.WithPrefix(cmd.Use).
+			WithField("endpoint", endpoint).
+			Debug("initializing gRPC client")
+
+		if viper.GetBool("nocolor") {
+			color.NoColor = true
+		}
+
+		cxt, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+		rpc := coconut.NewClient(cxt, cancel, endpoint)
+		if rpc == nil {
+			os.Exit(1)
+		}
+
+		err := call(cxt, rpc, cmd, args, os.Stdout)
+		if err != nil && cxt.Err() == nil {
+			log.WithPrefix(cmd.Use).
+				WithError(err).
+				Fatal("command finished with error")
+			os.Exit(1)
+		}
+	}
+}
+
 func GetInfo(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	var response *pb.GetFrameworkInfoReply
 	response, err = rpc.GetFrameworkInfo(cxt, &pb.GetFrameworkInfoRequest{}, grpc.EmptyCallOption{})
@@ -156,6 +198,9 @@ func GetInfo(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, ar
 	_, _ = fmt.Fprintf(o, "environments count:     %s\n", green(response.GetEnvironmentsCount()))
 	_, _ = fmt.Fprintf(o, "active tasks count:     %s\n", green(response.GetTasksCount()))
 	_, _ = fmt.Fprintf(o, "global state:           %s\n", colorGlobalState(response.GetState()))
+	if cordoned := response.GetCordonedHosts(); len(cordoned) > 0 {
+		_, _ = fmt.Fprintf(o, "cordoned hosts:         %s (%d total)\n", yellow(strings.Join(cordoned, " ")), len(cordoned))
+	}
 
 	allDetectors := response.GetDetectorsInInstance()
 	_, _ = fmt.Fprintf(o, "detectors in instance:  %s (%d total)\n", green(strings.Join(allDetectors, " ")), len(allDetectors))
@@ -228,8 +273,65 @@ func GetInfo(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, ar
 }
 
 func Teardown(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
-	log.Fatal("not implemented yet")
-	os.Exit(1)
+	reason, err := cmd.Flags().GetString("reason")
+	if err != nil {
+		return
+	}
+	if len(strings.TrimSpace(reason)) == 0 {
+		err = errors.New("a reason is required for teardown")
+		return
+	}
+
+	var response *pb.TeardownReply
+	response, err = rpc.Teardown(cxt, &pb.TeardownRequest{
+		Reason: reason,
+		RequestUser: &commonpb.User{
+			Name: getUserAndHost(),
+		},
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	if len(response.GetEnvironments()) == 0 {
+		fmt.Fprintln(o, "no environments to shut down")
+	} else {
+		table := tablewriter.NewWriter(o)
+		table.SetHeader([]string{"id", "initial state", "run number", "shutdown", "errors"})
+		table.SetBorder(false)
+		fg := tablewriter.Colors{tablewriter.Bold, tablewriter.FgYellowColor}
+		table.SetHeaderColor(fg, fg, fg, fg, fg)
+		table.SetAutoWrapText(false)
+
+		data := make([][]string, 0, len(response.GetEnvironments()))
+		for _, result := range response.GetEnvironments() {
+			shutdown := green("clean")
+			if result.GetForced() {
+				shutdown = yellow("forced")
+			}
+			if len(result.GetErrors()) > 0 && !result.GetForced() {
+				shutdown = red("errors")
+			}
+			data = append(data, []string{
+				result.GetEnvironmentId(),
+				colorState(result.GetInitialState()),
+				formatRunNumber(result.GetRunNumber()),
+				shutdown,
+				strings.Join(result.GetErrors(), "\n"),
+			})
+		}
+		table.AppendBulk(data)
+		table.Render()
+	}
+
+	cleanup := response.GetCleanupTasksReply()
+	fmt.Fprintf(o, "%d leftover tasks killed, %d tasks still running\n", len(cleanup.GetKilledTasks()), len(cleanup.GetRunningTasks()))
+
+	if !response.GetClean() {
+		err = errors.New("teardown did not complete cleanly")
+		return
+	}
+	fmt.Fprintln(o, "teardown complete")
 	return
 }
 
@@ -590,6 +692,76 @@ func ShowEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Com
 	return
 }
 
+func GetEnvironmentTopology(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+	format, _ := cmd.Flags().GetString("format")
+
+	var response *pb.GetEnvironmentTopologyReply
+	response, err = rpc.GetEnvironmentTopology(cxt, &pb.GetEnvironmentTopologyRequest{
+		Id:     args[0],
+		Format: format,
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	if len(format) != 0 {
+		_, _ = fmt.Fprint(o, response.GetDocument())
+		// JSON output already includes the issues, for the other formats we
+		// keep them out of the document
+		if !strings.EqualFold(format, "json") && len(response.GetIssues()) > 0 {
+			_, _ = fmt.Fprintf(os.Stderr, "%d channel topology issue(s) found, run without --format to list them\n", len(response.GetIssues()))
+		}
+		return
+	}
+
+	rolePaths := make(map[string]string, len(response.GetNodes()))
+	for _, node := range response.GetNodes() {
+		rolePaths[node.GetTaskId()] = node.GetRolePath()
+	}
+
+	if len(response.GetEdges()) == 0 {
+		fmt.Fprintln(o, "no channels found")
+	} else {
+		table := tablewriter.NewWriter(o)
+		table.SetHeader([]string{"connecting role", "channel", "binding role", "channel", "type", "transport", "address"})
+		table.SetBorder(false)
+		fg := tablewriter.Colors{tablewriter.Bold, tablewriter.FgYellowColor}
+		table.SetHeaderColor(fg, fg, fg, fg, fg, fg, fg)
+
+		data := make([][]string, 0, len(response.GetEdges()))
+		for _, edge := range response.GetEdges() {
+			data = append(data, []string{
+				rolePaths[edge.GetConnect().GetTaskId()],
+				edge.GetConnect().GetChannel(),
+				rolePaths[edge.GetBind().GetTaskId()],
+				edge.GetBind().GetChannel(),
+				fmt.Sprintf("%s/%s", edge.GetConnect().GetType(), edge.GetBind().GetType()),
+				edge.GetTransport(),
+				edge.GetAddress(),
+			})
+		}
+		table.AppendBulk(data)
+		table.Render()
+	}
+
+	for _, issue := range response.GetIssues() {
+		severity := yellow(issue.GetSeverity())
+		if issue.GetSeverity() == "ERROR" {
+			severity = red(issue.GetSeverity())
+		}
+		role, ok := rolePaths[issue.GetTaskId()]
+		if !ok {
+			role = issue.GetTaskId()
+		}
+		_, _ = fmt.Fprintf(o, "%s %s:%s %s\n", severity, role, issue.GetChannel(), issue.GetMessage())
+	}
+	return
+}
+
 func ControlEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	if len(args) != 1 {
 		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
@@ -832,6 +1004,421 @@ func CleanTasks(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command,
 	return
 }
 
+func ShowTask(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+
+	var response *pb.GetTaskReply
+	response, err = rpc.GetTask(cxt, &pb.GetTaskRequest{TaskId: args[0]}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	t := response.GetTask()
+	shortInfo := t.GetShortInfo()
+	envId := t.GetEnvId()
+	if len(envId) == 0 {
+		envId = "none"
+	}
+
+	_, _ = fmt.Fprintf(o, "task id:         %s\n", shortInfo.GetTaskId())
+	_, _ = fmt.Fprintf(o, "name:            %s\n", shortInfo.GetName())
+	_, _ = fmt.Fprintf(o, "class name:      %s\n", shortInfo.GetClassName())
+	_, _ = fmt.Fprintf(o, "environment id:  %s\n", envId)
+	if len(t.GetTaskPath()) != 0 {
+		_, _ = fmt.Fprintf(o, "role path:       %s\n", t.GetTaskPath())
+	}
+	_, _ = fmt.Fprintf(o, "hostname:        %s\n", shortInfo.GetDeploymentInfo().GetHostname())
+	_, _ = fmt.Fprintf(o, "pid:             %s\n", shortInfo.GetPid())
+	if affinity := shortInfo.GetDeploymentInfo().GetAffinity(); affinity != nil {
+		if len(affinity.GetCpus()) != 0 {
+			_, _ = fmt.Fprintf(o, "cpus:            %s\n", affinity.GetCpus())
+		}
+		if len(affinity.GetMemoryNodes()) != 0 {
+			_, _ = fmt.Fprintf(o, "memory nodes:    %s\n", affinity.GetMemoryNodes())
+		}
+	}
+	_, _ = fmt.Fprintf(o, "locked:          %t\n", shortInfo.GetLocked())
+	_, _ = fmt.Fprintf(o, "critical:        %t\n", shortInfo.GetCritical())
+	_, _ = fmt.Fprintf(o, "status:          %s\n", shortInfo.GetStatus())
+	_, _ = fmt.Fprintf(o, "state:           %s\n", colorState(shortInfo.GetState()))
+	_, _ = fmt.Fprintf(o, "stdout:          %s\n", shortInfo.GetSandboxStdout())
+
+	if commandInfo := t.GetCommandInfo(); commandInfo != nil {
+		_, _ = fmt.Fprintf(o, "command:         %s %s\n", commandInfo.GetValue(), strings.Join(commandInfo.GetArguments(), " "))
+		if len(commandInfo.GetUser()) != 0 {
+			_, _ = fmt.Fprintf(o, "user:            %s\n", commandInfo.GetUser())
+		}
+	}
+
+	for _, channels := range []struct {
+		title    string
+		channels []*pb.ChannelInfo
+	}{
+		{"inbound channels", t.GetInboundChannels()},
+		{"outbound channels", t.GetOutboundChannels()},
+	} {
+		if len(channels.channels) == 0 {
+			continue
+		}
+		_, _ = fmt.Fprintf(o, "%s:\n", channels.title)
+		for _, ch := range channels.channels {
+			_, _ = fmt.Fprintf(o, "\t%s\t%s\t%s\n", ch.GetName(), ch.GetType(), ch.GetTarget())
+		}
+	}
+
+	if propertiesStr := stringMapToString(t.GetProperties(), "\t"); len(propertiesStr) != 0 {
+		_, _ = fmt.Fprintf(o, "properties:\n%s\n", propertiesStr)
+	}
+	return
+}
+
+func RestartTask(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+
+	var response *pb.RestartTaskReply
+	response, err = rpc.RestartTask(cxt, &pb.RestartTaskRequest{TaskId: args[0]}, grpc.EmptyCallOption{})
+	if response != nil && response.GetTask() != nil {
+		replacement := response.GetTask()
+		_, _ = fmt.Fprintf(o, "task %s replaced by task %s on %s, state: %s\n",
+			response.GetPreviousTaskId(),
+			replacement.GetTaskId(),
+			replacement.GetDeploymentInfo().GetHostname(),
+			colorState(replacement.GetState()))
+	}
+	return
+}
+
+func TransitionTask(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 2 {
+		err = errors.New(fmt.Sprintf("accepts 2 arg(s), received %d", len(args)))
+		return
+	}
+	event := strings.ToUpper(args[1])
+
+	var response *pb.TransitionTaskReply
+	response, err = rpc.TransitionTask(cxt, &pb.TransitionTaskRequest{TaskId: args[0], Event: event}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	_, _ = fmt.Fprintf(o, "task %s transitioned with %s, state: %s\n",
+		args[0],
+		event,
+		colorState(response.GetTask().GetState()))
+	return
+}
+
+func GetTaskLogs(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+	taskId := args[0]
+
+	tail, err := cmd.Flags().GetInt32("tail")
+	if err != nil {
+		return
+	}
+	follow, err := cmd.Flags().GetBool("follow")
+	if err != nil {
+		return
+	}
+
+	getLogs := func(offset int64, follow bool) (*pb.GetTaskLogsReply, error) {
+		callCxt, cancel := context.WithTimeout(cxt, CALL_TIMEOUT)
+		defer cancel()
+		return rpc.GetTaskLogs(callCxt, &pb.GetTaskLogsRequest{
+			TaskId: taskId,
+			Tail:   tail,
+			Offset: offset,
+			Follow: follow,
+		}, grpc.EmptyCallOption{})
+	}
+
+	response, err := getLogs(0, false)
+	if err != nil {
+		return
+	}
+	_, _ = io.WriteString(o, response.GetContent())
+	if !follow {
+		return
+	}
+
+	offset := response.GetNextOffset()
+	ticker := time.NewTicker(TASK_LOGS_POLL_INTERVAL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-cxt.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		response, err = getLogs(offset, true)
+		if err != nil {
+			return
+		}
+		_, _ = io.WriteString(o, response.GetContent())
+		offset = response.GetNextOffset()
+	}
+}
+
+func RunSequence(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 2 {
+		err = errors.New(fmt.Sprintf("accepts 2 arg(s), received %d", len(args)))
+		return
+	}
+	envId := args[0]
+
+	var sequenceDoc []byte
+	sequenceDoc, err = os.ReadFile(args[1])
+	if err != nil {
+		err = fmt.Errorf("cannot read sequence file %s: %w", args[1], err)
+		return
+	}
+
+	var response *pb.RunSequenceReply
+	response, err = rpc.RunSequence(cxt, &pb.RunSequenceRequest{
+		EnvironmentId: envId,
+		Sequence:      string(sequenceDoc),
+		RequestUser: &commonpb.User{
+			Name: getUserAndHost(),
+		},
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	seq := response.GetSequence()
+	_, _ = fmt.Fprintf(o, "sequence %s (%s) started on environment %s, %d steps\n",
+		seq.GetId(),
+		seq.GetName(),
+		seq.GetEnvironmentId(),
+		seq.GetStepCount())
+	return
+}
+
+func GetSequences(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) > 1 {
+		err = errors.New(fmt.Sprintf("accepts at most 1 arg(s), received %d", len(args)))
+		return
+	}
+	request := &pb.GetSequencesRequest{}
+	if len(args) == 1 {
+		request.SequenceId = args[0]
+	}
+	request.EnvironmentId, _ = cmd.Flags().GetString("environment")
+
+	var response *pb.GetSequencesReply
+	response, err = rpc.GetSequences(cxt, request, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	sequences := response.GetSequences()
+	if len(sequences) == 0 {
+		fmt.Fprintln(o, "no sequences found")
+		return
+	}
+
+	if len(args) == 1 {
+		seq := sequences[0]
+		_, _ = fmt.Fprintf(o, "sequence id:     %s\n", seq.GetId())
+		_, _ = fmt.Fprintf(o, "name:            %s\n", seq.GetName())
+		_, _ = fmt.Fprintf(o, "environment id:  %s\n", seq.GetEnvironmentId())
+		_, _ = fmt.Fprintf(o, "state:           %s\n", colorSequenceState(seq.GetState()))
+		_, _ = fmt.Fprintf(o, "step:            %d/%d\n", seq.GetCurrentStep(), seq.GetStepCount())
+		if len(seq.GetStepDescription()) != 0 {
+			_, _ = fmt.Fprintf(o, "current step:    %s\n", seq.GetStepDescription())
+		}
+		_, _ = fmt.Fprintf(o, "created:         %s\n", formatMilliTimestamp(seq.GetCreatedWhen()))
+		_, _ = fmt.Fprintf(o, "last update:     %s\n", formatMilliTimestamp(seq.GetUpdatedWhen()))
+		if len(seq.GetError()) != 0 {
+			_, _ = fmt.Fprintf(o, "error:           %s\n", red(seq.GetError()))
+		}
+		return
+	}
+
+	table := tablewriter.NewWriter(o)
+	table.SetHeader([]string{"id", "name", "environment id", "created", "step", "state"})
+	table.SetBorder(false)
+	fg := tablewriter.Colors{tablewriter.Bold, tablewriter.FgYellowColor}
+	table.SetHeaderColor(fg, fg, fg, fg, fg, fg)
+
+	data := make([][]string, 0, len(sequences))
+	for _, seq := range sequences {
+		data = append(data, []string{
+			seq.GetId(),
+			seq.GetName(),
+			seq.GetEnvironmentId(),
+			formatMilliTimestamp(seq.GetCreatedWhen()),
+			fmt.Sprintf("%d/%d", seq.GetCurrentStep(), seq.GetStepCount()),
+			colorSequenceState(seq.GetState()),
+		})
+	}
+
+	table.AppendBulk(data)
+	table.Render()
+	return
+}
+
+func ControlSequence(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+
+	operation, ok := pb.ControlSequenceRequest_Optype_value[strings.ToUpper(cmd.Name())]
+	if !ok || operation == int32(pb.ControlSequenceRequest_NOOP) {
+		err = fmt.Errorf("invalid sequence operation %s", cmd.Name())
+		return
+	}
+
+	var response *pb.ControlSequenceReply
+	response, err = rpc.ControlSequence(cxt, &pb.ControlSequenceRequest{
+		SequenceId: args[0],
+		Type:       pb.ControlSequenceRequest_Optype(operation),
+		RequestUser: &commonpb.User{
+			Name: getUserAndHost(),
+		},
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	seq := response.GetSequence()
+	_, _ = fmt.Fprintf(o, "sequence %s state: %s, step %d/%d\n",
+		seq.GetId(),
+		colorSequenceState(seq.GetState()),
+		seq.GetCurrentStep(),
+		seq.GetStepCount())
+	return
+}
+
+func CordonHost(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+	reason, _ := cmd.Flags().GetString("reason")
+
+	request := &pb.CordonHostRequest{
+		Hostname: args[0],
+		Reason:   reason,
+		RequestUser: &commonpb.User{
+			Name: getUserAndHost(),
+		},
+	}
+	var response *pb.CordonHostReply
+	if cmd.Name() == "drain" {
+		response, err = rpc.DrainHost(cxt, request, grpc.EmptyCallOption{})
+	} else {
+		response, err = rpc.CordonHost(cxt, request, grpc.EmptyCallOption{})
+	}
+	if err != nil {
+		return
+	}
+
+	host := response.GetHost()
+	_, _ = fmt.Fprintf(o, "host %s cordoned since %s by %s\n",
+		host.GetHostname(),
+		formatMilliTimestamp(host.GetCordonedWhen()),
+		host.GetCordonedBy())
+	if cmd.Name() != "drain" {
+		return
+	}
+
+	if host.GetDrained() {
+		_, _ = fmt.Fprintf(o, "host %s is %s\n", host.GetHostname(), green("drained"))
+		return
+	}
+	for _, env := range host.GetEnvironments() {
+		_, _ = fmt.Fprintf(o, "environment %s still holds %s task(s): %s\n",
+			env.GetEnvironmentId(),
+			yellow(len(env.GetTaskIds())),
+			strings.Join(env.GetTaskIds(), " "))
+	}
+	if len(host.GetUnlockedTaskIds()) > 0 {
+		_, _ = fmt.Fprintf(o, "%s task(s) not belonging to any environment: %s\n",
+			yellow(len(host.GetUnlockedTaskIds())),
+			strings.Join(host.GetUnlockedTaskIds(), " "))
+	}
+	return
+}
+
+func UncordonHost(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+
+	_, err = rpc.UncordonHost(cxt, &pb.UncordonHostRequest{
+		Hostname: args[0],
+		RequestUser: &commonpb.User{
+			Name: getUserAndHost(),
+		},
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	_, _ = fmt.Fprintf(o, "host %s uncordoned\n", args[0])
+	return
+}
+
+func GetCordonedHosts(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	var response *pb.GetCordonedHostsReply
+	response, err = rpc.GetCordonedHosts(cxt, &pb.GetCordonedHostsRequest{}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	hosts := response.GetHosts()
+	if len(hosts) == 0 {
+		fmt.Fprintln(o, "no cordoned hosts")
+		return
+	}
+
+	table := tablewriter.NewWriter(o)
+	table.SetHeader([]string{"hostname", "since", "by", "reason", "environments", "tasks", "drained"})
+	table.SetBorder(false)
+	fg := tablewriter.Colors{tablewriter.Bold, tablewriter.FgYellowColor}
+	table.SetHeaderColor(fg, fg, fg, fg, fg, fg, fg)
+
+	data := make([][]string, 0, len(hosts))
+	for _, host := range hosts {
+		envIds := make([]string, len(host.GetEnvironments()))
+		taskCount := len(host.GetUnlockedTaskIds())
+		for i, env := range host.GetEnvironments() {
+			envIds[i] = env.GetEnvironmentId()
+			taskCount += len(env.GetTaskIds())
+		}
+		drained := yellow("NO")
+		if host.GetDrained() {
+			drained = green("YES")
+		}
+		data = append(data, []string{
+			host.GetHostname(),
+			formatMilliTimestamp(host.GetCordonedWhen()),
+			host.GetCordonedBy(),
+			host.GetReason(),
+			strings.Join(envIds, " "),
+			strconv.Itoa(taskCount),
+			drained,
+		})
+	}
+
+	table.AppendBulk(data)
+	table.Render()
+	return
+}
+
 func QueryRoles(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	if len(args) != 2 {
 		err = errors.New(fmt.Sprintf("accepts 2 arg(s), received %d", len(args)))
@@ -84,6 +84,19 @@ func colorGlobalState(st string) string {
 	}
 }
 
+func colorSequenceState(st string) string {
+	switch st {
+	case "DONE":
+		return blue(st)
+	case "RUNNING":
+		return green(st)
+	case "PAUSED":
+		return yellow(st)
+	default:
+		return red(st)
+	}
+}
+
 func colorStateFromNode(node *pb.RoleInfo) string {
 	return colorState(node.GetState())
 }
@@ -184,6 +197,10 @@ func formatTimestamp(int64timestamp int64) string {
 	return formatted
 }
 
+func formatMilliTimestamp(int64timestamp int64) string {
+	return time.UnixMilli(int64timestamp).Local().Format("2006-01-02 15:04:05 MST")
+}
+
 func formatNumber(numberOfMachines int32) string {
 	nMString := strconv.FormatInt(int64(numberOfMachines), 10)
 	if numberOfMachines == 0 {
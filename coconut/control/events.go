@@ -0,0 +1,358 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/coconut"
+	"github.com/AliceO2Group/Control/coconut/protos"
+	commonpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// task state changes are aggregated per role and printed at this interval
+const TASK_SUMMARY_INTERVAL = 500 * time.Millisecond
+
+func WatchEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+	envId := args[0]
+
+	// fail early on a typo rather than wait forever for events
+	_, err = rpc.GetEnvironment(cxt, &pb.GetEnvironmentRequest{Id: envId}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	return watchEvents(cxt, rpc, cmd, envId, o)
+}
+
+func WatchEvents(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	envId, err := cmd.Flags().GetString("environment")
+	if err != nil {
+		return
+	}
+	return watchEvents(cxt, rpc, cmd, envId, o)
+}
+
+func watchEvents(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, envId string, o io.Writer) (err error) {
+	jsonOutput, err := cmd.Flags().GetBool("json")
+	if err != nil {
+		return
+	}
+	topics, err := cmd.Flags().GetStringSlice("topic")
+	if err != nil {
+		return
+	}
+	detectors, err := cmd.Flags().GetStringSlice("detector")
+	if err != nil {
+		return
+	}
+
+	stream, err := rpc.Subscribe(cxt, &pb.SubscribeRequest{
+		EnvironmentId: envId,
+		Topics:        topics,
+		Detectors:     detectors,
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	events := make(chan *commonpb.Event)
+	streamErr := make(chan error, 1)
+	go func() {
+		defer close(events)
+		for {
+			ev, recvErr := stream.Recv()
+			if recvErr != nil {
+				if recvErr != io.EOF {
+					streamErr <- recvErr
+				}
+				return
+			}
+			events <- ev
+		}
+	}()
+
+	timeline := newEventTimeline(o, jsonOutput, len(envId) == 0)
+	ticker := time.NewTicker(TASK_SUMMARY_INTERVAL)
+	defer ticker.Stop()
+	defer timeline.flush()
+
+	for {
+		select {
+		case <-cxt.Done():
+			return nil
+		case ev, ok := <-events:
+			if !ok {
+				select {
+				case err = <-streamErr:
+				default:
+				}
+				return
+			}
+			timeline.handle(ev)
+		case <-ticker.C:
+			timeline.flush()
+		}
+	}
+}
+
+// eventTimeline renders a stream of core events as a human-readable,
+// colourised timeline, or as one JSON object per line.
+type eventTimeline struct {
+	o           io.Writer
+	jsonOutput  bool
+	showEnvId   bool
+	marshaler   protojson.MarshalOptions
+	transitions map[string]time.Time                    // envId -> transition start
+	calls       map[string]time.Time                    // envId/path/func -> call start
+	roleTasks   map[string]map[string]map[string]string // envId -> role path -> task id -> state
+	dirtyRoles  map[string]map[string]struct{}          // envId -> role paths changed since the last flush
+}
+
+func newEventTimeline(o io.Writer, jsonOutput bool, showEnvId bool) *eventTimeline {
+	return &eventTimeline{
+		o:           o,
+		jsonOutput:  jsonOutput,
+		showEnvId:   showEnvId,
+		transitions: make(map[string]time.Time),
+		calls:       make(map[string]time.Time),
+		roleTasks:   make(map[string]map[string]map[string]string),
+		dirtyRoles:  make(map[string]map[string]struct{}),
+	}
+}
+
+func eventTime(ev *commonpb.Event) time.Time {
+	if ev.GetTimestampNano() != 0 {
+		return time.Unix(0, ev.GetTimestampNano())
+	}
+	return time.UnixMilli(ev.GetTimestamp())
+}
+
+func colorOpStatus(st commonpb.OpStatus) string {
+	switch st {
+	case commonpb.OpStatus_STARTED, commonpb.OpStatus_ONGOING:
+		return yellow(st.String())
+	case commonpb.OpStatus_DONE_OK:
+		return green(st.String())
+	case commonpb.OpStatus_NULL:
+		return ""
+	default:
+		return red(st.String())
+	}
+}
+
+func formatDuration(d time.Duration) string {
+	return dark(d.Round(time.Millisecond).String())
+}
+
+func (t *eventTimeline) printLine(timestamp time.Time, envId string, kind string, format string, a ...interface{}) {
+	prefix := dark(timestamp.Format("15:04:05.000"))
+	if t.showEnvId {
+		if len(envId) == 0 {
+			envId = "-"
+		}
+		prefix += " " + blue(fmt.Sprintf("%-11s", envId))
+	}
+	_, _ = fmt.Fprintf(t.o, "%s %-8s %s\n", prefix, kind, fmt.Sprintf(format, a...))
+}
+
+func (t *eventTimeline) handle(ev *commonpb.Event) {
+	if ev == nil {
+		return
+	}
+	if t.jsonOutput {
+		if payload, err := t.marshaler.Marshal(ev); err == nil {
+			_, _ = fmt.Fprintln(t.o, string(payload))
+		}
+		return
+	}
+
+	switch {
+	case ev.GetEnvironmentEvent() != nil:
+		t.handleEnvironmentEvent(ev, ev.GetEnvironmentEvent())
+	case ev.GetTaskEvent() != nil:
+		t.handleTaskEvent(ev.GetTaskEvent())
+	case ev.GetCallEvent() != nil:
+		t.handleCallEvent(ev, ev.GetCallEvent())
+	case ev.GetRoleEvent() != nil:
+		re := ev.GetRoleEvent()
+		t.printLine(eventTime(ev), ev.GetEnvironmentId(), "role", "%s %s %s", re.GetRolePath(), colorState(re.GetState()), dark(re.GetStatus()))
+	case ev.GetIntegratedServiceEvent() != nil:
+		t.handleIntegratedServiceEvent(ev, ev.GetIntegratedServiceEvent())
+	case ev.GetRunEvent() != nil:
+		re := ev.GetRunEvent()
+		line := fmt.Sprintf("run %s %s %s", formatRunNumber(re.GetRunNumber()), re.GetTransition(), colorOpStatus(re.GetTransitionStatus()))
+		if len(re.GetError()) > 0 {
+			line += " " + red(re.GetError())
+		}
+		t.printLine(eventTime(ev), ev.GetEnvironmentId(), "run", "%s", line)
+	case ev.GetFrameworkEvent() != nil:
+		t.printLine(eventTime(ev), ev.GetEnvironmentId(), "core", "%s", ev.GetFrameworkEvent().GetMessage())
+	case ev.GetCoreStartEvent() != nil:
+		t.printLine(eventTime(ev), ev.GetEnvironmentId(), "core", "core started with framework ID %s", ev.GetCoreStartEvent().GetFrameworkId())
+	}
+}
+
+func (t *eventTimeline) handleEnvironmentEvent(ev *commonpb.Event, ee *commonpb.Ev_EnvironmentEvent) {
+	var line string
+	switch {
+	case len(ee.GetTransitionStep()) > 0:
+		line = fmt.Sprintf("  %s %s", ee.GetTransitionStep(), colorOpStatus(ee.GetTransitionStatus()))
+	case len(ee.GetTransition()) > 0:
+		line = fmt.Sprintf("%s %s", ee.GetTransition(), colorOpStatus(ee.GetTransitionStatus()))
+		switch ee.GetTransitionStatus() {
+		case commonpb.OpStatus_STARTED:
+			t.transitions[ee.GetEnvironmentId()] = eventTime(ev)
+		case commonpb.OpStatus_DONE_OK, commonpb.OpStatus_DONE_ERROR, commonpb.OpStatus_DONE_TIMEOUT:
+			if started, ok := t.transitions[ee.GetEnvironmentId()]; ok {
+				line += " in " + formatDuration(eventTime(ev).Sub(started))
+				delete(t.transitions, ee.GetEnvironmentId())
+			}
+			if len(ee.GetState()) > 0 {
+				line += " → " + colorState(ee.GetState())
+			}
+		}
+	case len(ee.GetState()) > 0:
+		line = "state " + colorState(ee.GetState())
+	}
+	if ee.GetRunNumber() != 0 {
+		line += " run " + formatRunNumber(ee.GetRunNumber())
+	}
+	if len(ee.GetMessage()) > 0 {
+		line += " " + grey(ee.GetMessage())
+	}
+	if len(ee.GetError()) > 0 {
+		line += " " + red(ee.GetError())
+	}
+	if len(strings.TrimSpace(line)) == 0 {
+		return
+	}
+	t.printLine(eventTime(ev), ev.GetEnvironmentId(), "env", "%s", line)
+}
+
+func (t *eventTimeline) handleCallEvent(ev *commonpb.Event, ce *commonpb.Ev_CallEvent) {
+	key := ce.GetEnvironmentId() + "/" + ce.GetPath() + "/" + ce.GetFunc()
+	line := fmt.Sprintf("%s %s %s", dark(ce.GetTraits().GetTrigger()), ce.GetPath(), ce.GetFunc())
+	line += " " + colorOpStatus(ce.GetCallStatus())
+
+	switch ce.GetCallStatus() {
+	case commonpb.OpStatus_STARTED:
+		t.calls[key] = eventTime(ev)
+	case commonpb.OpStatus_DONE_OK, commonpb.OpStatus_DONE_ERROR, commonpb.OpStatus_DONE_TIMEOUT:
+		if started, ok := t.calls[key]; ok {
+			line += " in " + formatDuration(eventTime(ev).Sub(started))
+			delete(t.calls, key)
+		}
+	}
+	if len(ce.GetError()) > 0 {
+		line += " " + red(ce.GetError())
+	}
+	t.printLine(eventTime(ev), ev.GetEnvironmentId(), "call", "%s", line)
+}
+
+func (t *eventTimeline) handleIntegratedServiceEvent(ev *commonpb.Event, ie *commonpb.Ev_IntegratedServiceEvent) {
+	line := fmt.Sprintf("%s %s", ie.GetOperationName(), colorOpStatus(ie.GetOperationStatus()))
+	if len(ie.GetOperationStep()) > 0 {
+		line += fmt.Sprintf(" %s %s", ie.GetOperationStep(), colorOpStatus(ie.GetOperationStepStatus()))
+	}
+	if len(ie.GetError()) > 0 {
+		line += " " + red(ie.GetError())
+	}
+	t.printLine(eventTime(ev), ev.GetEnvironmentId(), "service", "%s", line)
+}
+
+func (t *eventTimeline) handleTaskEvent(te *commonpb.Ev_TaskEvent) {
+	if len(te.GetState()) == 0 {
+		return
+	}
+	envId, rolePath := te.GetEnvironmentId(), te.GetPath()
+	if _, ok := t.roleTasks[envId]; !ok {
+		t.roleTasks[envId] = make(map[string]map[string]string)
+		t.dirtyRoles[envId] = make(map[string]struct{})
+	}
+	if _, ok := t.roleTasks[envId][rolePath]; !ok {
+		t.roleTasks[envId][rolePath] = make(map[string]string)
+	}
+	if t.roleTasks[envId][rolePath][te.GetTaskid()] == te.GetState() {
+		return
+	}
+	t.roleTasks[envId][rolePath][te.GetTaskid()] = te.GetState()
+	t.dirtyRoles[envId][rolePath] = struct{}{}
+}
+
+// flush prints one line per role whose tasks changed state since the last
+// flush, with the number of tasks in each state.
+func (t *eventTimeline) flush() {
+	now := time.Now()
+	envIds := make([]string, 0, len(t.dirtyRoles))
+	for envId := range t.dirtyRoles {
+		envIds = append(envIds, envId)
+	}
+	sort.Strings(envIds)
+
+	for _, envId := range envIds {
+		rolePaths := make([]string, 0, len(t.dirtyRoles[envId]))
+		for rolePath := range t.dirtyRoles[envId] {
+			rolePaths = append(rolePaths, rolePath)
+		}
+		sort.Strings(rolePaths)
+
+		for _, rolePath := range rolePaths {
+			counts := make(map[string]int)
+			for _, state := range t.roleTasks[envId][rolePath] {
+				counts[state]++
+			}
+			states := make([]string, 0, len(counts))
+			for state := range counts {
+				states = append(states, state)
+			}
+			sort.Strings(states)
+			summary := make([]string, len(states))
+			for i, state := range states {
+				summary[i] = fmt.Sprintf("%d %s", counts[state], colorState(state))
+			}
+
+			role := rolePath
+			if len(role) == 0 {
+				role = grey("(no role)")
+			}
+			t.printLine(now, envId, "tasks", "%s %s", role, strings.Join(summary, ", "))
+		}
+		clear(t.dirtyRoles[envId])
+	}
+}
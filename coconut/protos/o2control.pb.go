@@ -137,7 +137,7 @@ func (x ControlEnvironmentRequest_Optype) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ControlEnvironmentRequest_Optype.Descriptor instead.
 func (ControlEnvironmentRequest_Optype) EnumDescriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{15, 0}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{16, 0}
 }
 
 type EnvironmentOperation_Optype int32
@@ -186,7 +186,59 @@ func (x EnvironmentOperation_Optype) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EnvironmentOperation_Optype.Descriptor instead.
 func (EnvironmentOperation_Optype) EnumDescriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{18, 0}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type ControlSequenceRequest_Optype int32
+
+const (
+	ControlSequenceRequest_NOOP   ControlSequenceRequest_Optype = 0
+	ControlSequenceRequest_PAUSE  ControlSequenceRequest_Optype = 1
+	ControlSequenceRequest_RESUME ControlSequenceRequest_Optype = 2
+	ControlSequenceRequest_ABORT  ControlSequenceRequest_Optype = 3
+)
+
+// Enum value maps for ControlSequenceRequest_Optype.
+var (
+	ControlSequenceRequest_Optype_name = map[int32]string{
+		0: "NOOP",
+		1: "PAUSE",
+		2: "RESUME",
+		3: "ABORT",
+	}
+	ControlSequenceRequest_Optype_value = map[string]int32{
+		"NOOP":   0,
+		"PAUSE":  1,
+		"RESUME": 2,
+		"ABORT":  3,
+	}
+)
+
+func (x ControlSequenceRequest_Optype) Enum() *ControlSequenceRequest_Optype {
+	p := new(ControlSequenceRequest_Optype)
+	*p = x
+	return p
+}
+
+func (x ControlSequenceRequest_Optype) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ControlSequenceRequest_Optype) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_o2control_proto_enumTypes[2].Descriptor()
+}
+
+func (ControlSequenceRequest_Optype) Type() protoreflect.EnumType {
+	return &file_protos_o2control_proto_enumTypes[2]
+}
+
+func (x ControlSequenceRequest_Optype) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ControlSequenceRequest_Optype.Descriptor instead.
+func (ControlSequenceRequest_Optype) EnumDescriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{68, 0}
 }
 
 type VarSpecMessage_UiWidget int32
@@ -234,11 +286,11 @@ func (x VarSpecMessage_UiWidget) String() string {
 }
 
 func (VarSpecMessage_UiWidget) Descriptor() protoreflect.EnumDescriptor {
-	return file_protos_o2control_proto_enumTypes[2].Descriptor()
+	return file_protos_o2control_proto_enumTypes[3].Descriptor()
 }
 
 func (VarSpecMessage_UiWidget) Type() protoreflect.EnumType {
-	return &file_protos_o2control_proto_enumTypes[2]
+	return &file_protos_o2control_proto_enumTypes[3]
 }
 
 func (x VarSpecMessage_UiWidget) Number() protoreflect.EnumNumber {
@@ -247,7 +299,7 @@ func (x VarSpecMessage_UiWidget) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VarSpecMessage_UiWidget.Descriptor instead.
 func (VarSpecMessage_UiWidget) EnumDescriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{43, 0}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{74, 0}
 }
 
 type VarSpecMessage_Type int32
@@ -289,11 +341,11 @@ func (x VarSpecMessage_Type) String() string {
 }
 
 func (VarSpecMessage_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_protos_o2control_proto_enumTypes[3].Descriptor()
+	return file_protos_o2control_proto_enumTypes[4].Descriptor()
 }
 
 func (VarSpecMessage_Type) Type() protoreflect.EnumType {
-	return &file_protos_o2control_proto_enumTypes[3]
+	return &file_protos_o2control_proto_enumTypes[4]
 }
 
 func (x VarSpecMessage_Type) Number() protoreflect.EnumNumber {
@@ -302,7 +354,7 @@ func (x VarSpecMessage_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VarSpecMessage_Type.Descriptor instead.
 func (VarSpecMessage_Type) EnumDescriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{43, 1}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{74, 1}
 }
 
 type SubscribeRequest struct {
@@ -310,7 +362,18 @@ type SubscribeRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// ID of an environment being created with NewAutoEnvironment, whose
+	// events are streamed until its creation completes or fails.
+	// If empty, the events written by the core are streamed live instead,
+	// filtered by the fields below.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// only stream events of this environment
+	EnvironmentId string `protobuf:"bytes,2,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	// only stream events written to these topics or their subtopics, e.g.
+	// environment, task, call, role, run, integrated_service
+	Topics []string `protobuf:"bytes,3,rep,name=topics,proto3" json:"topics,omitempty"`
+	// only stream events of environments which include any of these detectors
+	Detectors []string `protobuf:"bytes,4,rep,name=detectors,proto3" json:"detectors,omitempty"`
 }
 
 func (x *SubscribeRequest) Reset() {
@@ -352,6 +415,27 @@ func (x *SubscribeRequest) GetId() string {
 	return ""
 }
 
+func (x *SubscribeRequest) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetDetectors() []string {
+	if x != nil {
+		return x.Detectors
+	}
+	return nil
+}
+
 // //////////////////////////////////////
 // Framework
 // //////////////////////////////////////
@@ -497,6 +581,7 @@ type GetFrameworkInfoReply struct {
 	ActiveDetectors       []string `protobuf:"bytes,10,rep,name=activeDetectors,proto3" json:"activeDetectors,omitempty"`
 	AvailableDetectors    []string `protobuf:"bytes,11,rep,name=availableDetectors,proto3" json:"availableDetectors,omitempty"`
 	Timestamp             int64    `protobuf:"varint,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+	CordonedHosts         []string `protobuf:"bytes,13,rep,name=cordonedHosts,proto3" json:"cordonedHosts,omitempty"`
 }
 
 func (x *GetFrameworkInfoReply) Reset() {
@@ -615,13 +700,20 @@ func (x *GetFrameworkInfoReply) GetTimestamp() int64 {
 	return 0
 }
 
-// Not implemented yet
+func (x *GetFrameworkInfoReply) GetCordonedHosts() []string {
+	if x != nil {
+		return x.CordonedHosts
+	}
+	return nil
+}
+
 type TeardownRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reason      string       `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"` // mandatory, included in all events and logs produced by the teardown
+	RequestUser *protos.User `protobuf:"bytes,2,opt,name=requestUser,proto3" json:"requestUser,omitempty"`
 }
 
 func (x *TeardownRequest) Reset() {
@@ -663,10 +755,22 @@ func (x *TeardownRequest) GetReason() string {
 	return ""
 }
 
+func (x *TeardownRequest) GetRequestUser() *protos.User {
+	if x != nil {
+		return x.RequestUser
+	}
+	return nil
+}
+
 type TeardownReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Environments      []*TeardownEnvironmentResult `protobuf:"bytes,1,rep,name=environments,proto3" json:"environments,omitempty"`
+	CleanupTasksReply *CleanupTasksReply           `protobuf:"bytes,2,opt,name=cleanupTasksReply,proto3" json:"cleanupTasksReply,omitempty"`
+	Clean             bool                         `protobuf:"varint,3,opt,name=clean,proto3" json:"clean,omitempty"`         // true if all environments were shut down without errors or forcing, and no tasks were left over
+	Timestamp         int64                        `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
 }
 
 func (x *TeardownReply) Reset() {
@@ -701,6 +805,113 @@ func (*TeardownReply) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{5}
 }
 
+func (x *TeardownReply) GetEnvironments() []*TeardownEnvironmentResult {
+	if x != nil {
+		return x.Environments
+	}
+	return nil
+}
+
+func (x *TeardownReply) GetCleanupTasksReply() *CleanupTasksReply {
+	if x != nil {
+		return x.CleanupTasksReply
+	}
+	return nil
+}
+
+func (x *TeardownReply) GetClean() bool {
+	if x != nil {
+		return x.Clean
+	}
+	return false
+}
+
+func (x *TeardownReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type TeardownEnvironmentResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentId string   `protobuf:"bytes,1,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	InitialState  string   `protobuf:"bytes,2,opt,name=initialState,proto3" json:"initialState,omitempty"`
+	RunNumber     uint32   `protobuf:"varint,3,opt,name=runNumber,proto3" json:"runNumber,omitempty"` // the run stopped by the teardown, if any
+	Forced        bool     `protobuf:"varint,4,opt,name=forced,proto3" json:"forced,omitempty"`       // true if the environment could not be shut down with regular transitions
+	Errors        []string `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *TeardownEnvironmentResult) Reset() {
+	*x = TeardownEnvironmentResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeardownEnvironmentResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeardownEnvironmentResult) ProtoMessage() {}
+
+func (x *TeardownEnvironmentResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeardownEnvironmentResult.ProtoReflect.Descriptor instead.
+func (*TeardownEnvironmentResult) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TeardownEnvironmentResult) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+func (x *TeardownEnvironmentResult) GetInitialState() string {
+	if x != nil {
+		return x.InitialState
+	}
+	return ""
+}
+
+func (x *TeardownEnvironmentResult) GetRunNumber() uint32 {
+	if x != nil {
+		return x.RunNumber
+	}
+	return 0
+}
+
+func (x *TeardownEnvironmentResult) GetForced() bool {
+	if x != nil {
+		return x.Forced
+	}
+	return false
+}
+
+func (x *TeardownEnvironmentResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
 // //////////////////////////////////////
 // Environment
 // //////////////////////////////////////
@@ -717,7 +928,7 @@ type GetEnvironmentsRequest struct {
 func (x *GetEnvironmentsRequest) Reset() {
 	*x = GetEnvironmentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[6]
+		mi := &file_protos_o2control_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -730,7 +941,7 @@ func (x *GetEnvironmentsRequest) String() string {
 func (*GetEnvironmentsRequest) ProtoMessage() {}
 
 func (x *GetEnvironmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[6]
+	mi := &file_protos_o2control_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +954,7 @@ func (x *GetEnvironmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnvironmentsRequest.ProtoReflect.Descriptor instead.
 func (*GetEnvironmentsRequest) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{6}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetEnvironmentsRequest) GetShowAll() bool {
@@ -780,7 +991,7 @@ type GetEnvironmentsReply struct {
 func (x *GetEnvironmentsReply) Reset() {
 	*x = GetEnvironmentsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[7]
+		mi := &file_protos_o2control_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -793,7 +1004,7 @@ func (x *GetEnvironmentsReply) String() string {
 func (*GetEnvironmentsReply) ProtoMessage() {}
 
 func (x *GetEnvironmentsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[7]
+	mi := &file_protos_o2control_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +1017,7 @@ func (x *GetEnvironmentsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnvironmentsReply.ProtoReflect.Descriptor instead.
 func (*GetEnvironmentsReply) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{7}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetEnvironmentsReply) GetFrameworkId() string {
@@ -858,7 +1069,7 @@ type EnvironmentInfo struct {
 func (x *EnvironmentInfo) Reset() {
 	*x = EnvironmentInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[8]
+		mi := &file_protos_o2control_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -871,7 +1082,7 @@ func (x *EnvironmentInfo) String() string {
 func (*EnvironmentInfo) ProtoMessage() {}
 
 func (x *EnvironmentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[8]
+	mi := &file_protos_o2control_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -884,7 +1095,7 @@ func (x *EnvironmentInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentInfo.ProtoReflect.Descriptor instead.
 func (*EnvironmentInfo) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{8}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EnvironmentInfo) GetId() string {
@@ -1028,7 +1239,7 @@ type NewEnvironmentRequest struct {
 func (x *NewEnvironmentRequest) Reset() {
 	*x = NewEnvironmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[9]
+		mi := &file_protos_o2control_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1041,7 +1252,7 @@ func (x *NewEnvironmentRequest) String() string {
 func (*NewEnvironmentRequest) ProtoMessage() {}
 
 func (x *NewEnvironmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[9]
+	mi := &file_protos_o2control_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,7 +1265,7 @@ func (x *NewEnvironmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewEnvironmentRequest.ProtoReflect.Descriptor instead.
 func (*NewEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{9}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *NewEnvironmentRequest) GetWorkflowTemplate() string {
@@ -1105,7 +1316,7 @@ type NewEnvironmentReply struct {
 func (x *NewEnvironmentReply) Reset() {
 	*x = NewEnvironmentReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[10]
+		mi := &file_protos_o2control_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1118,7 +1329,7 @@ func (x *NewEnvironmentReply) String() string {
 func (*NewEnvironmentReply) ProtoMessage() {}
 
 func (x *NewEnvironmentReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[10]
+	mi := &file_protos_o2control_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1342,7 @@ func (x *NewEnvironmentReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewEnvironmentReply.ProtoReflect.Descriptor instead.
 func (*NewEnvironmentReply) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{10}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *NewEnvironmentReply) GetEnvironment() *EnvironmentInfo {
@@ -1169,7 +1380,7 @@ type NewAutoEnvironmentRequest struct {
 func (x *NewAutoEnvironmentRequest) Reset() {
 	*x = NewAutoEnvironmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[11]
+		mi := &file_protos_o2control_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1182,7 +1393,7 @@ func (x *NewAutoEnvironmentRequest) String() string {
 func (*NewAutoEnvironmentRequest) ProtoMessage() {}
 
 func (x *NewAutoEnvironmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[11]
+	mi := &file_protos_o2control_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,7 +1406,7 @@ func (x *NewAutoEnvironmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewAutoEnvironmentRequest.ProtoReflect.Descriptor instead.
 func (*NewAutoEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{11}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *NewAutoEnvironmentRequest) GetWorkflowTemplate() string {
@@ -1237,7 +1448,7 @@ type NewAutoEnvironmentReply struct {
 func (x *NewAutoEnvironmentReply) Reset() {
 	*x = NewAutoEnvironmentReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[12]
+		mi := &file_protos_o2control_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1250,7 +1461,7 @@ func (x *NewAutoEnvironmentReply) String() string {
 func (*NewAutoEnvironmentReply) ProtoMessage() {}
 
 func (x *NewAutoEnvironmentReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[12]
+	mi := &file_protos_o2control_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,7 +1474,7 @@ func (x *NewAutoEnvironmentReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewAutoEnvironmentReply.ProtoReflect.Descriptor instead.
 func (*NewAutoEnvironmentReply) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{12}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *NewAutoEnvironmentReply) GetTimestamp() int64 {
@@ -1285,7 +1496,7 @@ type GetEnvironmentRequest struct {
 func (x *GetEnvironmentRequest) Reset() {
 	*x = GetEnvironmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[13]
+		mi := &file_protos_o2control_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1298,7 +1509,7 @@ func (x *GetEnvironmentRequest) String() string {
 func (*GetEnvironmentRequest) ProtoMessage() {}
 
 func (x *GetEnvironmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[13]
+	mi := &file_protos_o2control_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1522,7 @@ func (x *GetEnvironmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnvironmentRequest.ProtoReflect.Descriptor instead.
 func (*GetEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{13}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetEnvironmentRequest) GetId() string {
@@ -1342,7 +1553,7 @@ type GetEnvironmentReply struct {
 func (x *GetEnvironmentReply) Reset() {
 	*x = GetEnvironmentReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[14]
+		mi := &file_protos_o2control_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1355,7 +1566,7 @@ func (x *GetEnvironmentReply) String() string {
 func (*GetEnvironmentReply) ProtoMessage() {}
 
 func (x *GetEnvironmentReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[14]
+	mi := &file_protos_o2control_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1368,7 +1579,7 @@ func (x *GetEnvironmentReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnvironmentReply.ProtoReflect.Descriptor instead.
 func (*GetEnvironmentReply) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{14}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetEnvironmentReply) GetEnvironment() *EnvironmentInfo {
@@ -1412,7 +1623,7 @@ type ControlEnvironmentRequest struct {
 func (x *ControlEnvironmentRequest) Reset() {
 	*x = ControlEnvironmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[15]
+		mi := &file_protos_o2control_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1425,7 +1636,7 @@ func (x *ControlEnvironmentRequest) String() string {
 func (*ControlEnvironmentRequest) ProtoMessage() {}
 
 func (x *ControlEnvironmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[15]
+	mi := &file_protos_o2control_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,7 +1649,7 @@ func (x *ControlEnvironmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControlEnvironmentRequest.ProtoReflect.Descriptor instead.
 func (*ControlEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{15}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ControlEnvironmentRequest) GetId() string {
@@ -1480,7 +1691,7 @@ type ControlEnvironmentReply struct {
 func (x *ControlEnvironmentReply) Reset() {
 	*x = ControlEnvironmentReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[16]
+		mi := &file_protos_o2control_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1493,7 +1704,7 @@ func (x *ControlEnvironmentReply) String() string {
 func (*ControlEnvironmentReply) ProtoMessage() {}
 
 func (x *ControlEnvironmentReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[16]
+	mi := &file_protos_o2control_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1506,7 +1717,7 @@ func (x *ControlEnvironmentReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControlEnvironmentReply.ProtoReflect.Descriptor instead.
 func (*ControlEnvironmentReply) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{16}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ControlEnvironmentReply) GetId() string {
@@ -1571,7 +1782,7 @@ type ModifyEnvironmentRequest struct {
 func (x *ModifyEnvironmentRequest) Reset() {
 	*x = ModifyEnvironmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[17]
+		mi := &file_protos_o2control_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1584,7 +1795,7 @@ func (x *ModifyEnvironmentRequest) String() string {
 func (*ModifyEnvironmentRequest) ProtoMessage() {}
 
 func (x *ModifyEnvironmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[17]
+	mi := &file_protos_o2control_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1597,7 +1808,7 @@ func (x *ModifyEnvironmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyEnvironmentRequest.ProtoReflect.Descriptor instead.
 func (*ModifyEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{17}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ModifyEnvironmentRequest) GetId() string {
@@ -1633,7 +1844,7 @@ type EnvironmentOperation struct {
 func (x *EnvironmentOperation) Reset() {
 	*x = EnvironmentOperation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[18]
+		mi := &file_protos_o2control_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1646,7 +1857,7 @@ func (x *EnvironmentOperation) String() string {
 func (*EnvironmentOperation) ProtoMessage() {}
 
 func (x *EnvironmentOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[18]
+	mi := &file_protos_o2control_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,7 +1870,7 @@ func (x *EnvironmentOperation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentOperation.ProtoReflect.Descriptor instead.
 func (*EnvironmentOperation) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{18}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *EnvironmentOperation) GetType() EnvironmentOperation_Optype {
@@ -1689,7 +1900,7 @@ type ModifyEnvironmentReply struct {
 func (x *ModifyEnvironmentReply) Reset() {
 	*x = ModifyEnvironmentReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[19]
+		mi := &file_protos_o2control_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1702,7 +1913,7 @@ func (x *ModifyEnvironmentReply) String() string {
 func (*ModifyEnvironmentReply) ProtoMessage() {}
 
 func (x *ModifyEnvironmentReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[19]
+	mi := &file_protos_o2control_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1715,7 +1926,7 @@ func (x *ModifyEnvironmentReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyEnvironmentReply.ProtoReflect.Descriptor instead.
 func (*ModifyEnvironmentReply) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{19}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ModifyEnvironmentReply) GetFailedOperations() []*EnvironmentOperation {
@@ -1754,7 +1965,7 @@ type DestroyEnvironmentRequest struct {
 func (x *DestroyEnvironmentRequest) Reset() {
 	*x = DestroyEnvironmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[20]
+		mi := &file_protos_o2control_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1767,7 +1978,7 @@ func (x *DestroyEnvironmentRequest) String() string {
 func (*DestroyEnvironmentRequest) ProtoMessage() {}
 
 func (x *DestroyEnvironmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[20]
+	mi := &file_protos_o2control_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1780,7 +1991,7 @@ func (x *DestroyEnvironmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DestroyEnvironmentRequest.ProtoReflect.Descriptor instead.
 func (*DestroyEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{20}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DestroyEnvironmentRequest) GetId() string {
@@ -1830,7 +2041,7 @@ type DestroyEnvironmentReply struct {
 func (x *DestroyEnvironmentReply) Reset() {
 	*x = DestroyEnvironmentReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[21]
+		mi := &file_protos_o2control_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1843,7 +2054,7 @@ func (x *DestroyEnvironmentReply) String() string {
 func (*DestroyEnvironmentReply) ProtoMessage() {}
 
 func (x *DestroyEnvironmentReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[21]
+	mi := &file_protos_o2control_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1856,7 +2067,7 @@ func (x *DestroyEnvironmentReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DestroyEnvironmentReply.ProtoReflect.Descriptor instead.
 func (*DestroyEnvironmentReply) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{21}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DestroyEnvironmentReply) GetCleanupTasksReply() *CleanupTasksReply {
@@ -1885,7 +2096,7 @@ type GetActiveDetectorsReply struct {
 func (x *GetActiveDetectorsReply) Reset() {
 	*x = GetActiveDetectorsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[22]
+		mi := &file_protos_o2control_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1898,7 +2109,7 @@ func (x *GetActiveDetectorsReply) String() string {
 func (*GetActiveDetectorsReply) ProtoMessage() {}
 
 func (x *GetActiveDetectorsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[22]
+	mi := &file_protos_o2control_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1911,7 +2122,7 @@ func (x *GetActiveDetectorsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActiveDetectorsReply.ProtoReflect.Descriptor instead.
 func (*GetActiveDetectorsReply) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{22}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetActiveDetectorsReply) GetDetectors() []string {
@@ -1940,7 +2151,7 @@ type GetAvailableDetectorsReply struct {
 func (x *GetAvailableDetectorsReply) Reset() {
 	*x = GetAvailableDetectorsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[23]
+		mi := &file_protos_o2control_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1953,7 +2164,7 @@ func (x *GetAvailableDetectorsReply) String() string {
 func (*GetAvailableDetectorsReply) ProtoMessage() {}
 
 func (x *GetAvailableDetectorsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[23]
+	mi := &file_protos_o2control_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1966,7 +2177,7 @@ func (x *GetAvailableDetectorsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvailableDetectorsReply.ProtoReflect.Descriptor instead.
 func (*GetAvailableDetectorsReply) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{23}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetAvailableDetectorsReply) GetDetectors() []string {
@@ -1994,13 +2205,15 @@ type SetEnvironmentPropertiesRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// If properties == nil, the core sets nothing
 	// and reply ok
+	// Keys of the form "path.to.role:key" are set
+	// on the matching workflow roles only
 	Properties map[string]string `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *SetEnvironmentPropertiesRequest) Reset() {
 	*x = SetEnvironmentPropertiesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[24]
+		mi := &file_protos_o2control_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2013,7 +2226,7 @@ func (x *SetEnvironmentPropertiesRequest) String() string {
 func (*SetEnvironmentPropertiesRequest) ProtoMessage() {}
 
 func (x *SetEnvironmentPropertiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[24]
+	mi := &file_protos_o2control_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2026,7 +2239,7 @@ func (x *SetEnvironmentPropertiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetEnvironmentPropertiesRequest.ProtoReflect.Descriptor instead.
 func (*SetEnvironmentPropertiesRequest) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{24}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SetEnvironmentPropertiesRequest) GetId() string {
@@ -2052,7 +2265,7 @@ type SetEnvironmentPropertiesReply struct {
 func (x *SetEnvironmentPropertiesReply) Reset() {
 	*x = SetEnvironmentPropertiesReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[25]
+		mi := &file_protos_o2control_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2065,7 +2278,7 @@ func (x *SetEnvironmentPropertiesReply) String() string {
 func (*SetEnvironmentPropertiesReply) ProtoMessage() {}
 
 func (x *SetEnvironmentPropertiesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[25]
+	mi := &file_protos_o2control_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2078,7 +2291,7 @@ func (x *SetEnvironmentPropertiesReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetEnvironmentPropertiesReply.ProtoReflect.Descriptor instead.
 func (*SetEnvironmentPropertiesReply) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{25}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{26}
 }
 
 type GetEnvironmentPropertiesRequest struct {
@@ -2090,14 +2303,18 @@ type GetEnvironmentPropertiesRequest struct {
 	// If len(queries) == 0, we return an
 	// empty map.
 	// To retrieve all KVs, use query '*'
-	Queries        []string `protobuf:"bytes,2,rep,name=queries,proto3" json:"queries,omitempty"`
-	ExcludeGlobals bool     `protobuf:"varint,3,opt,name=excludeGlobals,proto3" json:"excludeGlobals,omitempty"`
+	// Queries are glob patterns matched against
+	// the keys of the consolidated var stack
+	Queries []string `protobuf:"bytes,2,rep,name=queries,proto3" json:"queries,omitempty"`
+	// If true, variables which only come from the
+	// global defaults and vars are not returned
+	ExcludeGlobals bool `protobuf:"varint,3,opt,name=excludeGlobals,proto3" json:"excludeGlobals,omitempty"`
 }
 
 func (x *GetEnvironmentPropertiesRequest) Reset() {
 	*x = GetEnvironmentPropertiesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[26]
+		mi := &file_protos_o2control_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2110,7 +2327,7 @@ func (x *GetEnvironmentPropertiesRequest) String() string {
 func (*GetEnvironmentPropertiesRequest) ProtoMessage() {}
 
 func (x *GetEnvironmentPropertiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[26]
+	mi := &file_protos_o2control_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2123,7 +2340,7 @@ func (x *GetEnvironmentPropertiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnvironmentPropertiesRequest.ProtoReflect.Descriptor instead.
 func (*GetEnvironmentPropertiesRequest) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{26}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetEnvironmentPropertiesRequest) GetId() string {
@@ -2158,7 +2375,7 @@ type GetEnvironmentPropertiesReply struct {
 func (x *GetEnvironmentPropertiesReply) Reset() {
 	*x = GetEnvironmentPropertiesReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[27]
+		mi := &file_protos_o2control_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2171,7 +2388,7 @@ func (x *GetEnvironmentPropertiesReply) String() string {
 func (*GetEnvironmentPropertiesReply) ProtoMessage() {}
 
 func (x *GetEnvironmentPropertiesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[27]
+	mi := &file_protos_o2control_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2184,7 +2401,7 @@ func (x *GetEnvironmentPropertiesReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnvironmentPropertiesReply.ProtoReflect.Descriptor instead.
 func (*GetEnvironmentPropertiesReply) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{27}
+	return file_protos_o2control_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetEnvironmentPropertiesReply) GetProperties() map[string]string {
@@ -2195,43 +2412,37 @@ func (x *GetEnvironmentPropertiesReply) GetProperties() map[string]string {
 }
 
 // //////////////////////////////////////
-// Tasks
+// Environment, channel topology
 // //////////////////////////////////////
-type ShortTaskInfo struct {
+type TopologyNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Locked         bool                `protobuf:"varint,2,opt,name=locked,proto3" json:"locked,omitempty"`
-	TaskId         string              `protobuf:"bytes,3,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	Status         string              `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	State          string              `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
-	ClassName      string              `protobuf:"bytes,6,opt,name=className,proto3" json:"className,omitempty"`
-	DeploymentInfo *TaskDeploymentInfo `protobuf:"bytes,7,opt,name=deploymentInfo,proto3" json:"deploymentInfo,omitempty"`
-	Pid            string              `protobuf:"bytes,8,opt,name=pid,proto3" json:"pid,omitempty"`
-	SandboxStdout  string              `protobuf:"bytes,9,opt,name=sandboxStdout,proto3" json:"sandboxStdout,omitempty"`
-	Claimable      bool                `protobuf:"varint,10,opt,name=claimable,proto3" json:"claimable,omitempty"`
-	Critical       bool                `protobuf:"varint,11,opt,name=critical,proto3" json:"critical,omitempty"`
+	TaskId    string `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ClassName string `protobuf:"bytes,3,opt,name=className,proto3" json:"className,omitempty"`
+	Hostname  string `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	RolePath  string `protobuf:"bytes,5,opt,name=rolePath,proto3" json:"rolePath,omitempty"`
 }
 
-func (x *ShortTaskInfo) Reset() {
-	*x = ShortTaskInfo{}
+func (x *TopologyNode) Reset() {
+	*x = TopologyNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[28]
+		mi := &file_protos_o2control_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ShortTaskInfo) String() string {
+func (x *TopologyNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortTaskInfo) ProtoMessage() {}
+func (*TopologyNode) ProtoMessage() {}
 
-func (x *ShortTaskInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[28]
+func (x *TopologyNode) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2242,116 +2453,77 @@ func (x *ShortTaskInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortTaskInfo.ProtoReflect.Descriptor instead.
-func (*ShortTaskInfo) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *ShortTaskInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ShortTaskInfo) GetLocked() bool {
-	if x != nil {
-		return x.Locked
-	}
-	return false
+// Deprecated: Use TopologyNode.ProtoReflect.Descriptor instead.
+func (*TopologyNode) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ShortTaskInfo) GetTaskId() string {
+func (x *TopologyNode) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
 	return ""
 }
 
-func (x *ShortTaskInfo) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *ShortTaskInfo) GetState() string {
+func (x *TopologyNode) GetName() string {
 	if x != nil {
-		return x.State
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ShortTaskInfo) GetClassName() string {
+func (x *TopologyNode) GetClassName() string {
 	if x != nil {
 		return x.ClassName
 	}
 	return ""
 }
 
-func (x *ShortTaskInfo) GetDeploymentInfo() *TaskDeploymentInfo {
-	if x != nil {
-		return x.DeploymentInfo
-	}
-	return nil
-}
-
-func (x *ShortTaskInfo) GetPid() string {
+func (x *TopologyNode) GetHostname() string {
 	if x != nil {
-		return x.Pid
+		return x.Hostname
 	}
 	return ""
 }
 
-func (x *ShortTaskInfo) GetSandboxStdout() string {
+func (x *TopologyNode) GetRolePath() string {
 	if x != nil {
-		return x.SandboxStdout
+		return x.RolePath
 	}
 	return ""
 }
 
-func (x *ShortTaskInfo) GetClaimable() bool {
-	if x != nil {
-		return x.Claimable
-	}
-	return false
-}
-
-func (x *ShortTaskInfo) GetCritical() bool {
-	if x != nil {
-		return x.Critical
-	}
-	return false
-}
-
-type TaskDeploymentInfo struct {
+type TopologyChannelEnd struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hostname   string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	AgentId    string `protobuf:"bytes,2,opt,name=agentId,proto3" json:"agentId,omitempty"`
-	OfferId    string `protobuf:"bytes,3,opt,name=offerId,proto3" json:"offerId,omitempty"`
-	ExecutorId string `protobuf:"bytes,4,opt,name=executorId,proto3" json:"executorId,omitempty"`
+	TaskId  string `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// One of push, pull, pub, sub
+	Type       string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Transport  string `protobuf:"bytes,4,opt,name=transport,proto3" json:"transport,omitempty"`
+	SndBufSize int32  `protobuf:"varint,5,opt,name=sndBufSize,proto3" json:"sndBufSize,omitempty"`
+	RcvBufSize int32  `protobuf:"varint,6,opt,name=rcvBufSize,proto3" json:"rcvBufSize,omitempty"`
 }
 
-func (x *TaskDeploymentInfo) Reset() {
-	*x = TaskDeploymentInfo{}
+func (x *TopologyChannelEnd) Reset() {
+	*x = TopologyChannelEnd{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[29]
+		mi := &file_protos_o2control_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskDeploymentInfo) String() string {
+func (x *TopologyChannelEnd) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskDeploymentInfo) ProtoMessage() {}
+func (*TopologyChannelEnd) ProtoMessage() {}
 
-func (x *TaskDeploymentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[29]
+func (x *TopologyChannelEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2362,88 +2534,67 @@ func (x *TaskDeploymentInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskDeploymentInfo.ProtoReflect.Descriptor instead.
-func (*TaskDeploymentInfo) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use TopologyChannelEnd.ProtoReflect.Descriptor instead.
+func (*TopologyChannelEnd) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *TaskDeploymentInfo) GetHostname() string {
+func (x *TopologyChannelEnd) GetTaskId() string {
 	if x != nil {
-		return x.Hostname
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *TaskDeploymentInfo) GetAgentId() string {
+func (x *TopologyChannelEnd) GetChannel() string {
 	if x != nil {
-		return x.AgentId
+		return x.Channel
 	}
 	return ""
 }
 
-func (x *TaskDeploymentInfo) GetOfferId() string {
+func (x *TopologyChannelEnd) GetType() string {
 	if x != nil {
-		return x.OfferId
+		return x.Type
 	}
 	return ""
 }
 
-func (x *TaskDeploymentInfo) GetExecutorId() string {
+func (x *TopologyChannelEnd) GetTransport() string {
 	if x != nil {
-		return x.ExecutorId
+		return x.Transport
 	}
 	return ""
 }
 
-type GetTasksRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *GetTasksRequest) Reset() {
-	*x = GetTasksRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TopologyChannelEnd) GetSndBufSize() int32 {
+	if x != nil {
+		return x.SndBufSize
 	}
+	return 0
 }
 
-func (x *GetTasksRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetTasksRequest) ProtoMessage() {}
-
-func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_o2control_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TopologyChannelEnd) GetRcvBufSize() int32 {
+	if x != nil {
+		return x.RcvBufSize
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetTasksRequest) Descriptor() ([]byte, []int) {
-	return file_protos_o2control_proto_rawDescGZIP(), []int{30}
+	return 0
 }
 
-type GetTasksReply struct {
+type TopologyEdge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tasks     []*ShortTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	Timestamp int64            `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+	// The outbound channel, which connects to the inbound one
+	Connect   *TopologyChannelEnd `protobuf:"bytes,1,opt,name=connect,proto3" json:"connect,omitempty"`
+	Bind      *TopologyChannelEnd `protobuf:"bytes,2,opt,name=bind,proto3" json:"bind,omitempty"`
+	Address   string              `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Transport string              `protobuf:"bytes,4,opt,name=transport,proto3" json:"transport,omitempty"`
 }
 
-func (x *GetTasksReply) Reset() {
-	*x = GetTasksReply{}
+func (x *TopologyEdge) Reset() {
+	*x = TopologyEdge{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2451,13 +2602,13 @@ func (x *GetTasksReply) Reset() {
 	}
 }
 
-func (x *GetTasksReply) String() string {
+func (x *TopologyEdge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksReply) ProtoMessage() {}
+func (*TopologyEdge) ProtoMessage() {}
 
-func (x *GetTasksReply) ProtoReflect() protoreflect.Message {
+func (x *TopologyEdge) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2469,35 +2620,53 @@ func (x *GetTasksReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksReply.ProtoReflect.Descriptor instead.
-func (*GetTasksReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use TopologyEdge.ProtoReflect.Descriptor instead.
+func (*TopologyEdge) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetTasksReply) GetTasks() []*ShortTaskInfo {
+func (x *TopologyEdge) GetConnect() *TopologyChannelEnd {
 	if x != nil {
-		return x.Tasks
+		return x.Connect
 	}
 	return nil
 }
 
-func (x *GetTasksReply) GetTimestamp() int64 {
+func (x *TopologyEdge) GetBind() *TopologyChannelEnd {
 	if x != nil {
-		return x.Timestamp
+		return x.Bind
 	}
-	return 0
+	return nil
 }
 
-type GetTaskRequest struct {
+func (x *TopologyEdge) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TopologyEdge) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+type TopologyIssue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskId string `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	// One of WARNING, ERROR
+	Severity string `protobuf:"bytes,1,opt,name=severity,proto3" json:"severity,omitempty"`
+	TaskId   string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Channel  string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Message  string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetTaskRequest) Reset() {
-	*x = GetTaskRequest{}
+func (x *TopologyIssue) Reset() {
+	*x = TopologyIssue{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2505,13 +2674,13 @@ func (x *GetTaskRequest) Reset() {
 	}
 }
 
-func (x *GetTaskRequest) String() string {
+func (x *TopologyIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskRequest) ProtoMessage() {}
+func (*TopologyIssue) ProtoMessage() {}
 
-func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *TopologyIssue) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2523,29 +2692,52 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TopologyIssue.ProtoReflect.Descriptor instead.
+func (*TopologyIssue) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetTaskRequest) GetTaskId() string {
+func (x *TopologyIssue) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *TopologyIssue) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
 	return ""
 }
 
-type GetTaskReply struct {
+func (x *TopologyIssue) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *TopologyIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetEnvironmentTopologyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Task      *TaskInfo `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
-	Timestamp int64     `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If set to json, dot or graphml, the reply also carries
+	// the topology rendered in that format
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
 }
 
-func (x *GetTaskReply) Reset() {
-	*x = GetTaskReply{}
+func (x *GetEnvironmentTopologyRequest) Reset() {
+	*x = GetEnvironmentTopologyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2553,13 +2745,13 @@ func (x *GetTaskReply) Reset() {
 	}
 }
 
-func (x *GetTaskReply) String() string {
+func (x *GetEnvironmentTopologyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskReply) ProtoMessage() {}
+func (*GetEnvironmentTopologyRequest) ProtoMessage() {}
 
-func (x *GetTaskReply) ProtoReflect() protoreflect.Message {
+func (x *GetEnvironmentTopologyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2571,39 +2763,39 @@ func (x *GetTaskReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskReply.ProtoReflect.Descriptor instead.
-func (*GetTaskReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEnvironmentTopologyRequest.ProtoReflect.Descriptor instead.
+func (*GetEnvironmentTopologyRequest) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetTaskReply) GetTask() *TaskInfo {
+func (x *GetEnvironmentTopologyRequest) GetId() string {
 	if x != nil {
-		return x.Task
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTaskReply) GetTimestamp() int64 {
+func (x *GetEnvironmentTopologyRequest) GetFormat() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-type CommandInfo struct {
+type GetEnvironmentTopologyReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Env       []string `protobuf:"bytes,1,rep,name=env,proto3" json:"env,omitempty"`
-	Shell     bool     `protobuf:"varint,2,opt,name=shell,proto3" json:"shell,omitempty"`
-	Value     string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	Arguments []string `protobuf:"bytes,4,rep,name=arguments,proto3" json:"arguments,omitempty"`
-	User      string   `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	Nodes     []*TopologyNode  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges     []*TopologyEdge  `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	Issues    []*TopologyIssue `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	Document  string           `protobuf:"bytes,4,opt,name=document,proto3" json:"document,omitempty"`
+	Timestamp int64            `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
 }
 
-func (x *CommandInfo) Reset() {
-	*x = CommandInfo{}
+func (x *GetEnvironmentTopologyReply) Reset() {
+	*x = GetEnvironmentTopologyReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2611,13 +2803,13 @@ func (x *CommandInfo) Reset() {
 	}
 }
 
-func (x *CommandInfo) String() string {
+func (x *GetEnvironmentTopologyReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommandInfo) ProtoMessage() {}
+func (*GetEnvironmentTopologyReply) ProtoMessage() {}
 
-func (x *CommandInfo) ProtoReflect() protoreflect.Message {
+func (x *GetEnvironmentTopologyReply) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2629,58 +2821,69 @@ func (x *CommandInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommandInfo.ProtoReflect.Descriptor instead.
-func (*CommandInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEnvironmentTopologyReply.ProtoReflect.Descriptor instead.
+func (*GetEnvironmentTopologyReply) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CommandInfo) GetEnv() []string {
+func (x *GetEnvironmentTopologyReply) GetNodes() []*TopologyNode {
 	if x != nil {
-		return x.Env
+		return x.Nodes
 	}
 	return nil
 }
 
-func (x *CommandInfo) GetShell() bool {
-	if x != nil {
-		return x.Shell
-	}
-	return false
-}
-
-func (x *CommandInfo) GetValue() string {
+func (x *GetEnvironmentTopologyReply) GetEdges() []*TopologyEdge {
 	if x != nil {
-		return x.Value
+		return x.Edges
 	}
-	return ""
+	return nil
 }
 
-func (x *CommandInfo) GetArguments() []string {
+func (x *GetEnvironmentTopologyReply) GetIssues() []*TopologyIssue {
 	if x != nil {
-		return x.Arguments
+		return x.Issues
 	}
 	return nil
 }
 
-func (x *CommandInfo) GetUser() string {
+func (x *GetEnvironmentTopologyReply) GetDocument() string {
 	if x != nil {
-		return x.User
+		return x.Document
 	}
 	return ""
 }
 
-type ChannelInfo struct {
+func (x *GetEnvironmentTopologyReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// //////////////////////////////////////
+// Tasks
+// //////////////////////////////////////
+type ShortTaskInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Name           string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Locked         bool                `protobuf:"varint,2,opt,name=locked,proto3" json:"locked,omitempty"`
+	TaskId         string              `protobuf:"bytes,3,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Status         string              `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	State          string              `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	ClassName      string              `protobuf:"bytes,6,opt,name=className,proto3" json:"className,omitempty"`
+	DeploymentInfo *TaskDeploymentInfo `protobuf:"bytes,7,opt,name=deploymentInfo,proto3" json:"deploymentInfo,omitempty"`
+	Pid            string              `protobuf:"bytes,8,opt,name=pid,proto3" json:"pid,omitempty"`
+	SandboxStdout  string              `protobuf:"bytes,9,opt,name=sandboxStdout,proto3" json:"sandboxStdout,omitempty"`
+	Claimable      bool                `protobuf:"varint,10,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	Critical       bool                `protobuf:"varint,11,opt,name=critical,proto3" json:"critical,omitempty"`
 }
 
-func (x *ChannelInfo) Reset() {
-	*x = ChannelInfo{}
+func (x *ShortTaskInfo) Reset() {
+	*x = ShortTaskInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2688,13 +2891,13 @@ func (x *ChannelInfo) Reset() {
 	}
 }
 
-func (x *ChannelInfo) String() string {
+func (x *ShortTaskInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelInfo) ProtoMessage() {}
+func (*ShortTaskInfo) ProtoMessage() {}
 
-func (x *ChannelInfo) ProtoReflect() protoreflect.Message {
+func (x *ShortTaskInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2706,48 +2909,102 @@ func (x *ChannelInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelInfo.ProtoReflect.Descriptor instead.
-func (*ChannelInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShortTaskInfo.ProtoReflect.Descriptor instead.
+func (*ShortTaskInfo) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ChannelInfo) GetName() string {
+func (x *ShortTaskInfo) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ChannelInfo) GetType() string {
+func (x *ShortTaskInfo) GetLocked() bool {
 	if x != nil {
-		return x.Type
+		return x.Locked
+	}
+	return false
+}
+
+func (x *ShortTaskInfo) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *ChannelInfo) GetTarget() string {
+func (x *ShortTaskInfo) GetStatus() string {
 	if x != nil {
-		return x.Target
+		return x.Status
 	}
 	return ""
 }
 
-type TaskInfo struct {
+func (x *ShortTaskInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ShortTaskInfo) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *ShortTaskInfo) GetDeploymentInfo() *TaskDeploymentInfo {
+	if x != nil {
+		return x.DeploymentInfo
+	}
+	return nil
+}
+
+func (x *ShortTaskInfo) GetPid() string {
+	if x != nil {
+		return x.Pid
+	}
+	return ""
+}
+
+func (x *ShortTaskInfo) GetSandboxStdout() string {
+	if x != nil {
+		return x.SandboxStdout
+	}
+	return ""
+}
+
+func (x *ShortTaskInfo) GetClaimable() bool {
+	if x != nil {
+		return x.Claimable
+	}
+	return false
+}
+
+func (x *ShortTaskInfo) GetCritical() bool {
+	if x != nil {
+		return x.Critical
+	}
+	return false
+}
+
+type TaskDeploymentInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ShortInfo        *ShortTaskInfo    `protobuf:"bytes,1,opt,name=shortInfo,proto3" json:"shortInfo,omitempty"`
-	InboundChannels  []*ChannelInfo    `protobuf:"bytes,3,rep,name=inboundChannels,proto3" json:"inboundChannels,omitempty"`
-	OutboundChannels []*ChannelInfo    `protobuf:"bytes,4,rep,name=outboundChannels,proto3" json:"outboundChannels,omitempty"`
-	CommandInfo      *CommandInfo      `protobuf:"bytes,5,opt,name=commandInfo,proto3" json:"commandInfo,omitempty"`
-	TaskPath         string            `protobuf:"bytes,6,opt,name=taskPath,proto3" json:"taskPath,omitempty"`
-	EnvId            string            `protobuf:"bytes,7,opt,name=envId,proto3" json:"envId,omitempty"`
-	Properties       map[string]string `protobuf:"bytes,9,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Hostname   string            `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	AgentId    string            `protobuf:"bytes,2,opt,name=agentId,proto3" json:"agentId,omitempty"`
+	OfferId    string            `protobuf:"bytes,3,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	ExecutorId string            `protobuf:"bytes,4,opt,name=executorId,proto3" json:"executorId,omitempty"`
+	Affinity   *TaskAffinityInfo `protobuf:"bytes,5,opt,name=affinity,proto3" json:"affinity,omitempty"`
 }
 
-func (x *TaskInfo) Reset() {
-	*x = TaskInfo{}
+func (x *TaskDeploymentInfo) Reset() {
+	*x = TaskDeploymentInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2755,13 +3012,13 @@ func (x *TaskInfo) Reset() {
 	}
 }
 
-func (x *TaskInfo) String() string {
+func (x *TaskDeploymentInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskInfo) ProtoMessage() {}
+func (*TaskDeploymentInfo) ProtoMessage() {}
 
-func (x *TaskInfo) ProtoReflect() protoreflect.Message {
+func (x *TaskDeploymentInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2773,70 +3030,57 @@ func (x *TaskInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskInfo.ProtoReflect.Descriptor instead.
-func (*TaskInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskDeploymentInfo.ProtoReflect.Descriptor instead.
+func (*TaskDeploymentInfo) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *TaskInfo) GetShortInfo() *ShortTaskInfo {
-	if x != nil {
-		return x.ShortInfo
-	}
-	return nil
-}
-
-func (x *TaskInfo) GetInboundChannels() []*ChannelInfo {
-	if x != nil {
-		return x.InboundChannels
-	}
-	return nil
-}
-
-func (x *TaskInfo) GetOutboundChannels() []*ChannelInfo {
+func (x *TaskDeploymentInfo) GetHostname() string {
 	if x != nil {
-		return x.OutboundChannels
+		return x.Hostname
 	}
-	return nil
+	return ""
 }
 
-func (x *TaskInfo) GetCommandInfo() *CommandInfo {
+func (x *TaskDeploymentInfo) GetAgentId() string {
 	if x != nil {
-		return x.CommandInfo
+		return x.AgentId
 	}
-	return nil
+	return ""
 }
 
-func (x *TaskInfo) GetTaskPath() string {
+func (x *TaskDeploymentInfo) GetOfferId() string {
 	if x != nil {
-		return x.TaskPath
+		return x.OfferId
 	}
 	return ""
 }
 
-func (x *TaskInfo) GetEnvId() string {
+func (x *TaskDeploymentInfo) GetExecutorId() string {
 	if x != nil {
-		return x.EnvId
+		return x.ExecutorId
 	}
 	return ""
 }
 
-func (x *TaskInfo) GetProperties() map[string]string {
+func (x *TaskDeploymentInfo) GetAffinity() *TaskAffinityInfo {
 	if x != nil {
-		return x.Properties
+		return x.Affinity
 	}
 	return nil
 }
 
-type CleanupTasksRequest struct {
+type TaskAffinityInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskIds []string `protobuf:"bytes,1,rep,name=taskIds,proto3" json:"taskIds,omitempty"`
+	Cpus        string `protobuf:"bytes,1,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	MemoryNodes string `protobuf:"bytes,2,opt,name=memoryNodes,proto3" json:"memoryNodes,omitempty"`
 }
 
-func (x *CleanupTasksRequest) Reset() {
-	*x = CleanupTasksRequest{}
+func (x *TaskAffinityInfo) Reset() {
+	*x = TaskAffinityInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2844,13 +3088,13 @@ func (x *CleanupTasksRequest) Reset() {
 	}
 }
 
-func (x *CleanupTasksRequest) String() string {
+func (x *TaskAffinityInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanupTasksRequest) ProtoMessage() {}
+func (*TaskAffinityInfo) ProtoMessage() {}
 
-func (x *CleanupTasksRequest) ProtoReflect() protoreflect.Message {
+func (x *TaskAffinityInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2862,30 +3106,33 @@ func (x *CleanupTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanupTasksRequest.ProtoReflect.Descriptor instead.
-func (*CleanupTasksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskAffinityInfo.ProtoReflect.Descriptor instead.
+func (*TaskAffinityInfo) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CleanupTasksRequest) GetTaskIds() []string {
+func (x *TaskAffinityInfo) GetCpus() string {
 	if x != nil {
-		return x.TaskIds
+		return x.Cpus
 	}
-	return nil
+	return ""
 }
 
-type CleanupTasksReply struct {
+func (x *TaskAffinityInfo) GetMemoryNodes() string {
+	if x != nil {
+		return x.MemoryNodes
+	}
+	return ""
+}
+
+type GetTasksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	KilledTasks  []*ShortTaskInfo `protobuf:"bytes,1,rep,name=killedTasks,proto3" json:"killedTasks,omitempty"`
-	RunningTasks []*ShortTaskInfo `protobuf:"bytes,2,rep,name=runningTasks,proto3" json:"runningTasks,omitempty"`
-	Timestamp    int64            `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
 }
 
-func (x *CleanupTasksReply) Reset() {
-	*x = CleanupTasksReply{}
+func (x *GetTasksRequest) Reset() {
+	*x = GetTasksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2893,13 +3140,13 @@ func (x *CleanupTasksReply) Reset() {
 	}
 }
 
-func (x *CleanupTasksReply) String() string {
+func (x *GetTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanupTasksReply) ProtoMessage() {}
+func (*GetTasksRequest) ProtoMessage() {}
 
-func (x *CleanupTasksReply) ProtoReflect() protoreflect.Message {
+func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2911,46 +3158,22 @@ func (x *CleanupTasksReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanupTasksReply.ProtoReflect.Descriptor instead.
-func (*CleanupTasksReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetTasksRequest) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CleanupTasksReply) GetKilledTasks() []*ShortTaskInfo {
-	if x != nil {
-		return x.KilledTasks
-	}
-	return nil
-}
-
-func (x *CleanupTasksReply) GetRunningTasks() []*ShortTaskInfo {
-	if x != nil {
-		return x.RunningTasks
-	}
-	return nil
-}
-
-func (x *CleanupTasksReply) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-// //////////////////////////////////////
-// Roles
-// //////////////////////////////////////
-type GetRolesRequest struct {
+type GetTasksReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EnvId    string `protobuf:"bytes,1,opt,name=envId,proto3" json:"envId,omitempty"`
-	PathSpec string `protobuf:"bytes,2,opt,name=pathSpec,proto3" json:"pathSpec,omitempty"`
+	Tasks     []*ShortTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Timestamp int64            `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
 }
 
-func (x *GetRolesRequest) Reset() {
-	*x = GetRolesRequest{}
+func (x *GetTasksReply) Reset() {
+	*x = GetTasksReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2958,13 +3181,13 @@ func (x *GetRolesRequest) Reset() {
 	}
 }
 
-func (x *GetRolesRequest) String() string {
+func (x *GetTasksReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRolesRequest) ProtoMessage() {}
+func (*GetTasksReply) ProtoMessage() {}
 
-func (x *GetRolesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTasksReply) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2976,45 +3199,35 @@ func (x *GetRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRolesRequest.ProtoReflect.Descriptor instead.
-func (*GetRolesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTasksReply.ProtoReflect.Descriptor instead.
+func (*GetTasksReply) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetRolesRequest) GetEnvId() string {
+func (x *GetTasksReply) GetTasks() []*ShortTaskInfo {
 	if x != nil {
-		return x.EnvId
+		return x.Tasks
 	}
-	return ""
+	return nil
 }
 
-func (x *GetRolesRequest) GetPathSpec() string {
+func (x *GetTasksReply) GetTimestamp() int64 {
 	if x != nil {
-		return x.PathSpec
+		return x.Timestamp
 	}
-	return ""
+	return 0
 }
 
-type RoleInfo struct {
+type GetTaskRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name              string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Status            string            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	State             string            `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	FullPath          string            `protobuf:"bytes,4,opt,name=fullPath,proto3" json:"fullPath,omitempty"`
-	TaskIds           []string          `protobuf:"bytes,5,rep,name=taskIds,proto3" json:"taskIds,omitempty"`
-	Roles             []*RoleInfo       `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
-	Defaults          map[string]string `protobuf:"bytes,7,rep,name=defaults,proto3" json:"defaults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Vars              map[string]string `protobuf:"bytes,8,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	UserVars          map[string]string `protobuf:"bytes,9,rep,name=userVars,proto3" json:"userVars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	ConsolidatedStack map[string]string `protobuf:"bytes,10,rep,name=consolidatedStack,proto3" json:"consolidatedStack,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Description       string            `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	TaskId string `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
 }
 
-func (x *RoleInfo) Reset() {
-	*x = RoleInfo{}
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3022,13 +3235,13 @@ func (x *RoleInfo) Reset() {
 	}
 }
 
-func (x *RoleInfo) String() string {
+func (x *GetTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleInfo) ProtoMessage() {}
+func (*GetTaskRequest) ProtoMessage() {}
 
-func (x *RoleInfo) ProtoReflect() protoreflect.Message {
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3040,99 +3253,29 @@ func (x *RoleInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleInfo.ProtoReflect.Descriptor instead.
-func (*RoleInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *RoleInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RoleInfo) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *RoleInfo) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
-}
-
-func (x *RoleInfo) GetFullPath() string {
-	if x != nil {
-		return x.FullPath
-	}
-	return ""
-}
-
-func (x *RoleInfo) GetTaskIds() []string {
-	if x != nil {
-		return x.TaskIds
-	}
-	return nil
-}
-
-func (x *RoleInfo) GetRoles() []*RoleInfo {
-	if x != nil {
-		return x.Roles
-	}
-	return nil
-}
-
-func (x *RoleInfo) GetDefaults() map[string]string {
-	if x != nil {
-		return x.Defaults
-	}
-	return nil
-}
-
-func (x *RoleInfo) GetVars() map[string]string {
-	if x != nil {
-		return x.Vars
-	}
-	return nil
-}
-
-func (x *RoleInfo) GetUserVars() map[string]string {
-	if x != nil {
-		return x.UserVars
-	}
-	return nil
-}
-
-func (x *RoleInfo) GetConsolidatedStack() map[string]string {
-	if x != nil {
-		return x.ConsolidatedStack
-	}
-	return nil
-}
-
-func (x *RoleInfo) GetDescription() string {
+func (x *GetTaskRequest) GetTaskId() string {
 	if x != nil {
-		return x.Description
+		return x.TaskId
 	}
 	return ""
 }
 
-type GetRolesReply struct {
+type GetTaskReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Roles     []*RoleInfo `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
-	Timestamp int64       `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+	Task      *TaskInfo `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Timestamp int64     `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
 }
 
-func (x *GetRolesReply) Reset() {
-	*x = GetRolesReply{}
+func (x *GetTaskReply) Reset() {
+	*x = GetTaskReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3140,13 +3283,13 @@ func (x *GetRolesReply) Reset() {
 	}
 }
 
-func (x *GetRolesReply) String() string {
+func (x *GetTaskReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRolesReply) ProtoMessage() {}
+func (*GetTaskReply) ProtoMessage() {}
 
-func (x *GetRolesReply) ProtoReflect() protoreflect.Message {
+func (x *GetTaskReply) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3158,39 +3301,39 @@ func (x *GetRolesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRolesReply.ProtoReflect.Descriptor instead.
-func (*GetRolesReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTaskReply.ProtoReflect.Descriptor instead.
+func (*GetTaskReply) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetRolesReply) GetRoles() []*RoleInfo {
+func (x *GetTaskReply) GetTask() *TaskInfo {
 	if x != nil {
-		return x.Roles
+		return x.Task
 	}
 	return nil
 }
 
-func (x *GetRolesReply) GetTimestamp() int64 {
+func (x *GetTaskReply) GetTimestamp() int64 {
 	if x != nil {
 		return x.Timestamp
 	}
 	return 0
 }
 
-type GetWorkflowTemplatesRequest struct {
+type CommandInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RepoPattern     string `protobuf:"bytes,1,opt,name=repoPattern,proto3" json:"repoPattern,omitempty"`
-	RevisionPattern string `protobuf:"bytes,2,opt,name=revisionPattern,proto3" json:"revisionPattern,omitempty"`
-	AllBranches     bool   `protobuf:"varint,3,opt,name=allBranches,proto3" json:"allBranches,omitempty"`
-	AllTags         bool   `protobuf:"varint,4,opt,name=allTags,proto3" json:"allTags,omitempty"`
-	AllWorkflows    bool   `protobuf:"varint,5,opt,name=allWorkflows,proto3" json:"allWorkflows,omitempty"`
+	Env       []string `protobuf:"bytes,1,rep,name=env,proto3" json:"env,omitempty"`
+	Shell     bool     `protobuf:"varint,2,opt,name=shell,proto3" json:"shell,omitempty"`
+	Value     string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Arguments []string `protobuf:"bytes,4,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	User      string   `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *GetWorkflowTemplatesRequest) Reset() {
-	*x = GetWorkflowTemplatesRequest{}
+func (x *CommandInfo) Reset() {
+	*x = CommandInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3198,13 +3341,13 @@ func (x *GetWorkflowTemplatesRequest) Reset() {
 	}
 }
 
-func (x *GetWorkflowTemplatesRequest) String() string {
+func (x *CommandInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowTemplatesRequest) ProtoMessage() {}
+func (*CommandInfo) ProtoMessage() {}
 
-func (x *GetWorkflowTemplatesRequest) ProtoReflect() protoreflect.Message {
+func (x *CommandInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3216,66 +3359,58 @@ func (x *GetWorkflowTemplatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowTemplatesRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkflowTemplatesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommandInfo.ProtoReflect.Descriptor instead.
+func (*CommandInfo) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetWorkflowTemplatesRequest) GetRepoPattern() string {
+func (x *CommandInfo) GetEnv() []string {
 	if x != nil {
-		return x.RepoPattern
+		return x.Env
 	}
-	return ""
+	return nil
 }
 
-func (x *GetWorkflowTemplatesRequest) GetRevisionPattern() string {
+func (x *CommandInfo) GetShell() bool {
 	if x != nil {
-		return x.RevisionPattern
+		return x.Shell
 	}
-	return ""
+	return false
 }
 
-func (x *GetWorkflowTemplatesRequest) GetAllBranches() bool {
+func (x *CommandInfo) GetValue() string {
 	if x != nil {
-		return x.AllBranches
+		return x.Value
 	}
-	return false
+	return ""
 }
 
-func (x *GetWorkflowTemplatesRequest) GetAllTags() bool {
+func (x *CommandInfo) GetArguments() []string {
 	if x != nil {
-		return x.AllTags
+		return x.Arguments
 	}
-	return false
+	return nil
 }
 
-func (x *GetWorkflowTemplatesRequest) GetAllWorkflows() bool {
+func (x *CommandInfo) GetUser() string {
 	if x != nil {
-		return x.AllWorkflows
+		return x.User
 	}
-	return false
+	return ""
 }
 
-type VarSpecMessage struct {
+type ChannelInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DefaultValue  string                  `protobuf:"bytes,1,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`
-	Type          VarSpecMessage_Type     `protobuf:"varint,2,opt,name=type,proto3,enum=o2control.VarSpecMessage_Type" json:"type,omitempty"`
-	Label         string                  `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
-	Description   string                  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Widget        VarSpecMessage_UiWidget `protobuf:"varint,5,opt,name=widget,proto3,enum=o2control.VarSpecMessage_UiWidget" json:"widget,omitempty"`
-	Panel         string                  `protobuf:"bytes,6,opt,name=panel,proto3" json:"panel,omitempty"`                 // hint for the UI on where to put or group the given variable input
-	AllowedValues []string                `protobuf:"bytes,7,rep,name=allowedValues,proto3" json:"allowedValues,omitempty"` // list of offered values from which to choose (only for some UiWidgets)
-	Index         int32                   `protobuf:"varint,8,opt,name=index,proto3" json:"index,omitempty"`
-	VisibleIf     string                  `protobuf:"bytes,9,opt,name=visibleIf,proto3" json:"visibleIf,omitempty"`  // JS expression that evaluates to bool
-	EnabledIf     string                  `protobuf:"bytes,10,opt,name=enabledIf,proto3" json:"enabledIf,omitempty"` // JS expression that evaluates to bool
-	Rows          uint32                  `protobuf:"varint,11,opt,name=rows,proto3" json:"rows,omitempty"`          // this field is used only if widget == editBox
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (x *VarSpecMessage) Reset() {
-	*x = VarSpecMessage{}
+func (x *ChannelInfo) Reset() {
+	*x = ChannelInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3283,13 +3418,13 @@ func (x *VarSpecMessage) Reset() {
 	}
 }
 
-func (x *VarSpecMessage) String() string {
+func (x *ChannelInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VarSpecMessage) ProtoMessage() {}
+func (*ChannelInfo) ProtoMessage() {}
 
-func (x *VarSpecMessage) ProtoReflect() protoreflect.Message {
+func (x *ChannelInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3301,116 +3436,62 @@ func (x *VarSpecMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VarSpecMessage.ProtoReflect.Descriptor instead.
-func (*VarSpecMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChannelInfo.ProtoReflect.Descriptor instead.
+func (*ChannelInfo) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *VarSpecMessage) GetDefaultValue() string {
+func (x *ChannelInfo) GetName() string {
 	if x != nil {
-		return x.DefaultValue
+		return x.Name
 	}
 	return ""
 }
 
-func (x *VarSpecMessage) GetType() VarSpecMessage_Type {
+func (x *ChannelInfo) GetType() string {
 	if x != nil {
 		return x.Type
 	}
-	return VarSpecMessage_string
+	return ""
 }
 
-func (x *VarSpecMessage) GetLabel() string {
+func (x *ChannelInfo) GetTarget() string {
 	if x != nil {
-		return x.Label
+		return x.Target
 	}
 	return ""
 }
 
-func (x *VarSpecMessage) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type TaskInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortInfo        *ShortTaskInfo    `protobuf:"bytes,1,opt,name=shortInfo,proto3" json:"shortInfo,omitempty"`
+	InboundChannels  []*ChannelInfo    `protobuf:"bytes,3,rep,name=inboundChannels,proto3" json:"inboundChannels,omitempty"`
+	OutboundChannels []*ChannelInfo    `protobuf:"bytes,4,rep,name=outboundChannels,proto3" json:"outboundChannels,omitempty"`
+	CommandInfo      *CommandInfo      `protobuf:"bytes,5,opt,name=commandInfo,proto3" json:"commandInfo,omitempty"`
+	TaskPath         string            `protobuf:"bytes,6,opt,name=taskPath,proto3" json:"taskPath,omitempty"`
+	EnvId            string            `protobuf:"bytes,7,opt,name=envId,proto3" json:"envId,omitempty"`
+	Properties       map[string]string `protobuf:"bytes,9,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *VarSpecMessage) GetWidget() VarSpecMessage_UiWidget {
-	if x != nil {
-		return x.Widget
+func (x *TaskInfo) Reset() {
+	*x = TaskInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return VarSpecMessage_editBox
 }
 
-func (x *VarSpecMessage) GetPanel() string {
-	if x != nil {
-		return x.Panel
-	}
-	return ""
-}
-
-func (x *VarSpecMessage) GetAllowedValues() []string {
-	if x != nil {
-		return x.AllowedValues
-	}
-	return nil
-}
-
-func (x *VarSpecMessage) GetIndex() int32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *VarSpecMessage) GetVisibleIf() string {
-	if x != nil {
-		return x.VisibleIf
-	}
-	return ""
-}
-
-func (x *VarSpecMessage) GetEnabledIf() string {
-	if x != nil {
-		return x.EnabledIf
-	}
-	return ""
-}
-
-func (x *VarSpecMessage) GetRows() uint32 {
-	if x != nil {
-		return x.Rows
-	}
-	return 0
-}
-
-type WorkflowTemplateInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Repo        string                     `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Template    string                     `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
-	Revision    string                     `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
-	VarSpecMap  map[string]*VarSpecMessage `protobuf:"bytes,4,rep,name=varSpecMap,proto3" json:"varSpecMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Description string                     `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-}
-
-func (x *WorkflowTemplateInfo) Reset() {
-	*x = WorkflowTemplateInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_o2control_proto_msgTypes[44]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *WorkflowTemplateInfo) String() string {
+func (x *TaskInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkflowTemplateInfo) ProtoMessage() {}
+func (*TaskInfo) ProtoMessage() {}
 
-func (x *WorkflowTemplateInfo) ProtoReflect() protoreflect.Message {
+func (x *TaskInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3422,57 +3503,70 @@ func (x *WorkflowTemplateInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkflowTemplateInfo.ProtoReflect.Descriptor instead.
-func (*WorkflowTemplateInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskInfo.ProtoReflect.Descriptor instead.
+func (*TaskInfo) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *WorkflowTemplateInfo) GetRepo() string {
+func (x *TaskInfo) GetShortInfo() *ShortTaskInfo {
 	if x != nil {
-		return x.Repo
+		return x.ShortInfo
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkflowTemplateInfo) GetTemplate() string {
+func (x *TaskInfo) GetInboundChannels() []*ChannelInfo {
 	if x != nil {
-		return x.Template
+		return x.InboundChannels
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkflowTemplateInfo) GetRevision() string {
+func (x *TaskInfo) GetOutboundChannels() []*ChannelInfo {
 	if x != nil {
-		return x.Revision
+		return x.OutboundChannels
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkflowTemplateInfo) GetVarSpecMap() map[string]*VarSpecMessage {
+func (x *TaskInfo) GetCommandInfo() *CommandInfo {
 	if x != nil {
-		return x.VarSpecMap
+		return x.CommandInfo
 	}
 	return nil
 }
 
-func (x *WorkflowTemplateInfo) GetDescription() string {
+func (x *TaskInfo) GetTaskPath() string {
 	if x != nil {
-		return x.Description
+		return x.TaskPath
 	}
 	return ""
 }
 
-type GetWorkflowTemplatesReply struct {
+func (x *TaskInfo) GetEnvId() string {
+	if x != nil {
+		return x.EnvId
+	}
+	return ""
+}
+
+func (x *TaskInfo) GetProperties() map[string]string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+type CleanupTasksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkflowTemplates []*WorkflowTemplateInfo `protobuf:"bytes,1,rep,name=workflowTemplates,proto3" json:"workflowTemplates,omitempty"`
-	Timestamp         int64                   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+	TaskIds []string `protobuf:"bytes,1,rep,name=taskIds,proto3" json:"taskIds,omitempty"`
 }
 
-func (x *GetWorkflowTemplatesReply) Reset() {
-	*x = GetWorkflowTemplatesReply{}
+func (x *CleanupTasksRequest) Reset() {
+	*x = CleanupTasksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3480,13 +3574,13 @@ func (x *GetWorkflowTemplatesReply) Reset() {
 	}
 }
 
-func (x *GetWorkflowTemplatesReply) String() string {
+func (x *CleanupTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowTemplatesReply) ProtoMessage() {}
+func (*CleanupTasksRequest) ProtoMessage() {}
 
-func (x *GetWorkflowTemplatesReply) ProtoReflect() protoreflect.Message {
+func (x *CleanupTasksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3498,35 +3592,30 @@ func (x *GetWorkflowTemplatesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowTemplatesReply.ProtoReflect.Descriptor instead.
-func (*GetWorkflowTemplatesReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use CleanupTasksRequest.ProtoReflect.Descriptor instead.
+func (*CleanupTasksRequest) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetWorkflowTemplatesReply) GetWorkflowTemplates() []*WorkflowTemplateInfo {
+func (x *CleanupTasksRequest) GetTaskIds() []string {
 	if x != nil {
-		return x.WorkflowTemplates
+		return x.TaskIds
 	}
 	return nil
 }
 
-func (x *GetWorkflowTemplatesReply) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-type ListReposRequest struct {
+type CleanupTasksReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GetRevisions bool `protobuf:"varint,1,opt,name=getRevisions,proto3" json:"getRevisions,omitempty"`
+	KilledTasks  []*ShortTaskInfo `protobuf:"bytes,1,rep,name=killedTasks,proto3" json:"killedTasks,omitempty"`
+	RunningTasks []*ShortTaskInfo `protobuf:"bytes,2,rep,name=runningTasks,proto3" json:"runningTasks,omitempty"`
+	Timestamp    int64            `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
 }
 
-func (x *ListReposRequest) Reset() {
-	*x = ListReposRequest{}
+func (x *CleanupTasksReply) Reset() {
+	*x = CleanupTasksReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3534,13 +3623,13 @@ func (x *ListReposRequest) Reset() {
 	}
 }
 
-func (x *ListReposRequest) String() string {
+func (x *CleanupTasksReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReposRequest) ProtoMessage() {}
+func (*CleanupTasksReply) ProtoMessage() {}
 
-func (x *ListReposRequest) ProtoReflect() protoreflect.Message {
+func (x *CleanupTasksReply) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3552,31 +3641,43 @@ func (x *ListReposRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReposRequest.ProtoReflect.Descriptor instead.
-func (*ListReposRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CleanupTasksReply.ProtoReflect.Descriptor instead.
+func (*CleanupTasksReply) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListReposRequest) GetGetRevisions() bool {
+func (x *CleanupTasksReply) GetKilledTasks() []*ShortTaskInfo {
 	if x != nil {
-		return x.GetRevisions
+		return x.KilledTasks
 	}
-	return false
+	return nil
 }
 
-type RepoInfo struct {
+func (x *CleanupTasksReply) GetRunningTasks() []*ShortTaskInfo {
+	if x != nil {
+		return x.RunningTasks
+	}
+	return nil
+}
+
+func (x *CleanupTasksReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type RestartTaskRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Default         bool     `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
-	DefaultRevision string   `protobuf:"bytes,3,opt,name=defaultRevision,proto3" json:"defaultRevision,omitempty"`
-	Revisions       []string `protobuf:"bytes,4,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	TaskId      string       `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	RequestUser *protos.User `protobuf:"bytes,2,opt,name=requestUser,proto3" json:"requestUser,omitempty"`
 }
 
-func (x *RepoInfo) Reset() {
-	*x = RepoInfo{}
+func (x *RestartTaskRequest) Reset() {
+	*x = RestartTaskRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3584,13 +3685,13 @@ func (x *RepoInfo) Reset() {
 	}
 }
 
-func (x *RepoInfo) String() string {
+func (x *RestartTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoInfo) ProtoMessage() {}
+func (*RestartTaskRequest) ProtoMessage() {}
 
-func (x *RepoInfo) ProtoReflect() protoreflect.Message {
+func (x *RestartTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3602,51 +3703,38 @@ func (x *RepoInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoInfo.ProtoReflect.Descriptor instead.
-func (*RepoInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestartTaskRequest.ProtoReflect.Descriptor instead.
+func (*RestartTaskRequest) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *RepoInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RepoInfo) GetDefault() bool {
-	if x != nil {
-		return x.Default
-	}
-	return false
-}
-
-func (x *RepoInfo) GetDefaultRevision() string {
+func (x *RestartTaskRequest) GetTaskId() string {
 	if x != nil {
-		return x.DefaultRevision
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *RepoInfo) GetRevisions() []string {
+func (x *RestartTaskRequest) GetRequestUser() *protos.User {
 	if x != nil {
-		return x.Revisions
+		return x.RequestUser
 	}
 	return nil
 }
 
-type ListReposReply struct {
+type RestartTaskReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Repos                 []*RepoInfo `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
-	GlobalDefaultRevision string      `protobuf:"bytes,2,opt,name=globalDefaultRevision,proto3" json:"globalDefaultRevision,omitempty"`
-	Timestamp             int64       `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+	// The replacement task, if it could be deployed
+	Task           *ShortTaskInfo `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	PreviousTaskId string         `protobuf:"bytes,2,opt,name=previousTaskId,proto3" json:"previousTaskId,omitempty"`
+	Timestamp      int64          `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
 }
 
-func (x *ListReposReply) Reset() {
-	*x = ListReposReply{}
+func (x *RestartTaskReply) Reset() {
+	*x = RestartTaskReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3654,13 +3742,13 @@ func (x *ListReposReply) Reset() {
 	}
 }
 
-func (x *ListReposReply) String() string {
+func (x *RestartTaskReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReposReply) ProtoMessage() {}
+func (*RestartTaskReply) ProtoMessage() {}
 
-func (x *ListReposReply) ProtoReflect() protoreflect.Message {
+func (x *RestartTaskReply) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3672,43 +3760,45 @@ func (x *ListReposReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReposReply.ProtoReflect.Descriptor instead.
-func (*ListReposReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestartTaskReply.ProtoReflect.Descriptor instead.
+func (*RestartTaskReply) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ListReposReply) GetRepos() []*RepoInfo {
+func (x *RestartTaskReply) GetTask() *ShortTaskInfo {
 	if x != nil {
-		return x.Repos
+		return x.Task
 	}
 	return nil
 }
 
-func (x *ListReposReply) GetGlobalDefaultRevision() string {
+func (x *RestartTaskReply) GetPreviousTaskId() string {
 	if x != nil {
-		return x.GlobalDefaultRevision
+		return x.PreviousTaskId
 	}
 	return ""
 }
 
-func (x *ListReposReply) GetTimestamp() int64 {
+func (x *RestartTaskReply) GetTimestamp() int64 {
 	if x != nil {
 		return x.Timestamp
 	}
 	return 0
 }
 
-type AddRepoRequest struct {
+type TransitionTaskRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	DefaultRevision string `protobuf:"bytes,2,opt,name=defaultRevision,proto3" json:"defaultRevision,omitempty"`
+	TaskId string `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	// One of CONFIGURE, RESET, START, STOP, RECOVER
+	Event       string       `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	RequestUser *protos.User `protobuf:"bytes,3,opt,name=requestUser,proto3" json:"requestUser,omitempty"`
 }
 
-func (x *AddRepoRequest) Reset() {
-	*x = AddRepoRequest{}
+func (x *TransitionTaskRequest) Reset() {
+	*x = TransitionTaskRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3716,13 +3806,13 @@ func (x *AddRepoRequest) Reset() {
 	}
 }
 
-func (x *AddRepoRequest) String() string {
+func (x *TransitionTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRepoRequest) ProtoMessage() {}
+func (*TransitionTaskRequest) ProtoMessage() {}
 
-func (x *AddRepoRequest) ProtoReflect() protoreflect.Message {
+func (x *TransitionTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3734,37 +3824,43 @@ func (x *AddRepoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRepoRequest.ProtoReflect.Descriptor instead.
-func (*AddRepoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransitionTaskRequest.ProtoReflect.Descriptor instead.
+func (*TransitionTaskRequest) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *AddRepoRequest) GetName() string {
+func (x *TransitionTaskRequest) GetTaskId() string {
 	if x != nil {
-		return x.Name
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *AddRepoRequest) GetDefaultRevision() string {
+func (x *TransitionTaskRequest) GetEvent() string {
 	if x != nil {
-		return x.DefaultRevision
+		return x.Event
 	}
 	return ""
 }
 
-type AddRepoReply struct {
+func (x *TransitionTaskRequest) GetRequestUser() *protos.User {
+	if x != nil {
+		return x.RequestUser
+	}
+	return nil
+}
+
+type TransitionTaskReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewDefaultRevision string `protobuf:"bytes,1,opt,name=newDefaultRevision,proto3" json:"newDefaultRevision,omitempty"`
-	Info               string `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
-	Timestamp          int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+	Task      *ShortTaskInfo `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Timestamp int64          `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
 }
 
-func (x *AddRepoReply) Reset() {
-	*x = AddRepoReply{}
+func (x *TransitionTaskReply) Reset() {
+	*x = TransitionTaskReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3772,13 +3868,13 @@ func (x *AddRepoReply) Reset() {
 	}
 }
 
-func (x *AddRepoReply) String() string {
+func (x *TransitionTaskReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRepoReply) ProtoMessage() {}
+func (*TransitionTaskReply) ProtoMessage() {}
 
-func (x *AddRepoReply) ProtoReflect() protoreflect.Message {
+func (x *TransitionTaskReply) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3790,42 +3886,39 @@ func (x *AddRepoReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRepoReply.ProtoReflect.Descriptor instead.
-func (*AddRepoReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransitionTaskReply.ProtoReflect.Descriptor instead.
+func (*TransitionTaskReply) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *AddRepoReply) GetNewDefaultRevision() string {
-	if x != nil {
-		return x.NewDefaultRevision
-	}
-	return ""
-}
-
-func (x *AddRepoReply) GetInfo() string {
+func (x *TransitionTaskReply) GetTask() *ShortTaskInfo {
 	if x != nil {
-		return x.Info
+		return x.Task
 	}
-	return ""
+	return nil
 }
 
-func (x *AddRepoReply) GetTimestamp() int64 {
+func (x *TransitionTaskReply) GetTimestamp() int64 {
 	if x != nil {
 		return x.Timestamp
 	}
 	return 0
 }
 
-type RemoveRepoRequest struct {
+type SetTaskPropertiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	TaskId string `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	// Property values override the templated ones for as long
+	// as the task lives, a replacement task does not inherit them
+	Properties  map[string]string `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RequestUser *protos.User      `protobuf:"bytes,3,opt,name=requestUser,proto3" json:"requestUser,omitempty"`
 }
 
-func (x *RemoveRepoRequest) Reset() {
-	*x = RemoveRepoRequest{}
+func (x *SetTaskPropertiesRequest) Reset() {
+	*x = SetTaskPropertiesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3833,13 +3926,13 @@ func (x *RemoveRepoRequest) Reset() {
 	}
 }
 
-func (x *RemoveRepoRequest) String() string {
+func (x *SetTaskPropertiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRepoRequest) ProtoMessage() {}
+func (*SetTaskPropertiesRequest) ProtoMessage() {}
 
-func (x *RemoveRepoRequest) ProtoReflect() protoreflect.Message {
+func (x *SetTaskPropertiesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3851,29 +3944,42 @@ func (x *RemoveRepoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRepoRequest.ProtoReflect.Descriptor instead.
-func (*RemoveRepoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetTaskPropertiesRequest.ProtoReflect.Descriptor instead.
+func (*SetTaskPropertiesRequest) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *RemoveRepoRequest) GetIndex() int32 {
+func (x *SetTaskPropertiesRequest) GetTaskId() string {
 	if x != nil {
-		return x.Index
+		return x.TaskId
 	}
-	return 0
+	return ""
 }
 
-type RemoveRepoReply struct {
+func (x *SetTaskPropertiesRequest) GetProperties() map[string]string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *SetTaskPropertiesRequest) GetRequestUser() *protos.User {
+	if x != nil {
+		return x.RequestUser
+	}
+	return nil
+}
+
+type SetTaskPropertiesReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewDefaultRepo string `protobuf:"bytes,1,opt,name=newDefaultRepo,proto3" json:"newDefaultRepo,omitempty"`
-	Timestamp      int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
 }
 
-func (x *RemoveRepoReply) Reset() {
-	*x = RemoveRepoReply{}
+func (x *SetTaskPropertiesReply) Reset() {
+	*x = SetTaskPropertiesReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3881,13 +3987,13 @@ func (x *RemoveRepoReply) Reset() {
 	}
 }
 
-func (x *RemoveRepoReply) String() string {
+func (x *SetTaskPropertiesReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRepoReply) ProtoMessage() {}
+func (*SetTaskPropertiesReply) ProtoMessage() {}
 
-func (x *RemoveRepoReply) ProtoReflect() protoreflect.Message {
+func (x *SetTaskPropertiesReply) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3899,35 +4005,34 @@ func (x *RemoveRepoReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRepoReply.ProtoReflect.Descriptor instead.
-func (*RemoveRepoReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetTaskPropertiesReply.ProtoReflect.Descriptor instead.
+func (*SetTaskPropertiesReply) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *RemoveRepoReply) GetNewDefaultRepo() string {
-	if x != nil {
-		return x.NewDefaultRepo
-	}
-	return ""
-}
-
-func (x *RemoveRepoReply) GetTimestamp() int64 {
+func (x *SetTaskPropertiesReply) GetTimestamp() int64 {
 	if x != nil {
 		return x.Timestamp
 	}
 	return 0
 }
 
-type RefreshReposRequest struct {
+type GetTaskLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	TaskId string `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	// Number of most recent lines to return when reading from the start, 0 for the whole log
+	Tail int32 `protobuf:"varint,2,opt,name=tail,proto3" json:"tail,omitempty"`
+	// Byte offset where the previous read ended, as returned in nextOffset
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Read on from offset, including any new output written since the previous read
+	Follow bool `protobuf:"varint,4,opt,name=follow,proto3" json:"follow,omitempty"`
 }
 
-func (x *RefreshReposRequest) Reset() {
-	*x = RefreshReposRequest{}
+func (x *GetTaskLogsRequest) Reset() {
+	*x = GetTaskLogsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3935,13 +4040,13 @@ func (x *RefreshReposRequest) Reset() {
 	}
 }
 
-func (x *RefreshReposRequest) String() string {
+func (x *GetTaskLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshReposRequest) ProtoMessage() {}
+func (*GetTaskLogsRequest) ProtoMessage() {}
 
-func (x *RefreshReposRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTaskLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3953,28 +4058,53 @@ func (x *RefreshReposRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshReposRequest.ProtoReflect.Descriptor instead.
-func (*RefreshReposRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTaskLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskLogsRequest) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *RefreshReposRequest) GetIndex() int32 {
+func (x *GetTaskLogsRequest) GetTaskId() string {
 	if x != nil {
-		return x.Index
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetTaskLogsRequest) GetTail() int32 {
+	if x != nil {
+		return x.Tail
 	}
 	return 0
 }
 
-type SetDefaultRepoRequest struct {
+func (x *GetTaskLogsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetTaskLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+type GetTaskLogsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	TaskId     string `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Hostname   string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Content    string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	NextOffset int64  `protobuf:"varint,4,opt,name=nextOffset,proto3" json:"nextOffset,omitempty"`
+	Timestamp  int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
 }
 
-func (x *SetDefaultRepoRequest) Reset() {
-	*x = SetDefaultRepoRequest{}
+func (x *GetTaskLogsReply) Reset() {
+	*x = GetTaskLogsReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3982,13 +4112,13 @@ func (x *SetDefaultRepoRequest) Reset() {
 	}
 }
 
-func (x *SetDefaultRepoRequest) String() string {
+func (x *GetTaskLogsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDefaultRepoRequest) ProtoMessage() {}
+func (*GetTaskLogsReply) ProtoMessage() {}
 
-func (x *SetDefaultRepoRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTaskLogsReply) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4000,28 +4130,60 @@ func (x *SetDefaultRepoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDefaultRepoRequest.ProtoReflect.Descriptor instead.
-func (*SetDefaultRepoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTaskLogsReply.ProtoReflect.Descriptor instead.
+func (*GetTaskLogsReply) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *SetDefaultRepoRequest) GetIndex() int32 {
+func (x *GetTaskLogsReply) GetTaskId() string {
 	if x != nil {
-		return x.Index
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetTaskLogsReply) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *GetTaskLogsReply) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GetTaskLogsReply) GetNextOffset() int64 {
+	if x != nil {
+		return x.NextOffset
 	}
 	return 0
 }
 
-type SetGlobalDefaultRevisionRequest struct {
+func (x *GetTaskLogsReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// //////////////////////////////////////
+// Hosts
+// //////////////////////////////////////
+type HostDrainEnvironment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Revision string `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	EnvironmentId string   `protobuf:"bytes,1,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	TaskIds       []string `protobuf:"bytes,2,rep,name=taskIds,proto3" json:"taskIds,omitempty"`
 }
 
-func (x *SetGlobalDefaultRevisionRequest) Reset() {
-	*x = SetGlobalDefaultRevisionRequest{}
+func (x *HostDrainEnvironment) Reset() {
+	*x = HostDrainEnvironment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4029,13 +4191,13 @@ func (x *SetGlobalDefaultRevisionRequest) Reset() {
 	}
 }
 
-func (x *SetGlobalDefaultRevisionRequest) String() string {
+func (x *HostDrainEnvironment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetGlobalDefaultRevisionRequest) ProtoMessage() {}
+func (*HostDrainEnvironment) ProtoMessage() {}
 
-func (x *SetGlobalDefaultRevisionRequest) ProtoReflect() protoreflect.Message {
+func (x *HostDrainEnvironment) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4047,29 +4209,44 @@ func (x *SetGlobalDefaultRevisionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetGlobalDefaultRevisionRequest.ProtoReflect.Descriptor instead.
-func (*SetGlobalDefaultRevisionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostDrainEnvironment.ProtoReflect.Descriptor instead.
+func (*HostDrainEnvironment) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *SetGlobalDefaultRevisionRequest) GetRevision() string {
+func (x *HostDrainEnvironment) GetEnvironmentId() string {
 	if x != nil {
-		return x.Revision
+		return x.EnvironmentId
 	}
 	return ""
 }
 
-type SetRepoDefaultRevisionRequest struct {
+func (x *HostDrainEnvironment) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+type CordonedHostInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index    int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Hostname     string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Reason       string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	CordonedBy   string `protobuf:"bytes,3,opt,name=cordonedBy,proto3" json:"cordonedBy,omitempty"`
+	CordonedWhen int64  `protobuf:"varint,4,opt,name=cordonedWhen,proto3" json:"cordonedWhen,omitempty"` // unix milliseconds
+	// Environments which still hold tasks on this host
+	Environments []*HostDrainEnvironment `protobuf:"bytes,5,rep,name=environments,proto3" json:"environments,omitempty"`
+	// Tasks on this host which do not belong to any environment
+	UnlockedTaskIds []string `protobuf:"bytes,6,rep,name=unlockedTaskIds,proto3" json:"unlockedTaskIds,omitempty"`
+	// True if no tasks are left on this host
+	Drained bool `protobuf:"varint,7,opt,name=drained,proto3" json:"drained,omitempty"`
 }
 
-func (x *SetRepoDefaultRevisionRequest) Reset() {
-	*x = SetRepoDefaultRevisionRequest{}
+func (x *CordonedHostInfo) Reset() {
+	*x = CordonedHostInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4077,13 +4254,13 @@ func (x *SetRepoDefaultRevisionRequest) Reset() {
 	}
 }
 
-func (x *SetRepoDefaultRevisionRequest) String() string {
+func (x *CordonedHostInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetRepoDefaultRevisionRequest) ProtoMessage() {}
+func (*CordonedHostInfo) ProtoMessage() {}
 
-func (x *SetRepoDefaultRevisionRequest) ProtoReflect() protoreflect.Message {
+func (x *CordonedHostInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4095,36 +4272,72 @@ func (x *SetRepoDefaultRevisionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetRepoDefaultRevisionRequest.ProtoReflect.Descriptor instead.
-func (*SetRepoDefaultRevisionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CordonedHostInfo.ProtoReflect.Descriptor instead.
+func (*CordonedHostInfo) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *SetRepoDefaultRevisionRequest) GetIndex() int32 {
+func (x *CordonedHostInfo) GetHostname() string {
 	if x != nil {
-		return x.Index
+		return x.Hostname
 	}
-	return 0
+	return ""
 }
 
-func (x *SetRepoDefaultRevisionRequest) GetRevision() string {
+func (x *CordonedHostInfo) GetReason() string {
 	if x != nil {
-		return x.Revision
+		return x.Reason
 	}
 	return ""
 }
 
-type SetRepoDefaultRevisionReply struct {
+func (x *CordonedHostInfo) GetCordonedBy() string {
+	if x != nil {
+		return x.CordonedBy
+	}
+	return ""
+}
+
+func (x *CordonedHostInfo) GetCordonedWhen() int64 {
+	if x != nil {
+		return x.CordonedWhen
+	}
+	return 0
+}
+
+func (x *CordonedHostInfo) GetEnvironments() []*HostDrainEnvironment {
+	if x != nil {
+		return x.Environments
+	}
+	return nil
+}
+
+func (x *CordonedHostInfo) GetUnlockedTaskIds() []string {
+	if x != nil {
+		return x.UnlockedTaskIds
+	}
+	return nil
+}
+
+func (x *CordonedHostInfo) GetDrained() bool {
+	if x != nil {
+		return x.Drained
+	}
+	return false
+}
+
+type CordonHostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Info      string `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
-	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+	Hostname    string       `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Reason      string       `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestUser *protos.User `protobuf:"bytes,3,opt,name=requestUser,proto3" json:"requestUser,omitempty"`
 }
 
-func (x *SetRepoDefaultRevisionReply) Reset() {
-	*x = SetRepoDefaultRevisionReply{}
+func (x *CordonHostRequest) Reset() {
+	*x = CordonHostRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4132,13 +4345,13 @@ func (x *SetRepoDefaultRevisionReply) Reset() {
 	}
 }
 
-func (x *SetRepoDefaultRevisionReply) String() string {
+func (x *CordonHostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetRepoDefaultRevisionReply) ProtoMessage() {}
+func (*CordonHostRequest) ProtoMessage() {}
 
-func (x *SetRepoDefaultRevisionReply) ProtoReflect() protoreflect.Message {
+func (x *CordonHostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4150,33 +4363,43 @@ func (x *SetRepoDefaultRevisionReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetRepoDefaultRevisionReply.ProtoReflect.Descriptor instead.
-func (*SetRepoDefaultRevisionReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use CordonHostRequest.ProtoReflect.Descriptor instead.
+func (*CordonHostRequest) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *SetRepoDefaultRevisionReply) GetInfo() string {
+func (x *CordonHostRequest) GetHostname() string {
 	if x != nil {
-		return x.Info
+		return x.Hostname
 	}
 	return ""
 }
 
-func (x *SetRepoDefaultRevisionReply) GetTimestamp() int64 {
+func (x *CordonHostRequest) GetReason() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type Empty struct {
+func (x *CordonHostRequest) GetRequestUser() *protos.User {
+	if x != nil {
+		return x.RequestUser
+	}
+	return nil
+}
+
+type CordonHostReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Host      *CordonedHostInfo `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Timestamp int64             `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *CordonHostReply) Reset() {
+	*x = CordonHostReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4184,13 +4407,13 @@ func (x *Empty) Reset() {
 	}
 }
 
-func (x *Empty) String() string {
+func (x *CordonHostReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*CordonHostReply) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *CordonHostReply) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4202,22 +4425,36 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
+// Deprecated: Use CordonHostReply.ProtoReflect.Descriptor instead.
+func (*CordonHostReply) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{58}
 }
 
-type ListIntegratedServicesReply struct {
+func (x *CordonHostReply) GetHost() *CordonedHostInfo {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *CordonHostReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type UncordonHostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Services  map[string]*IntegratedServiceInfo `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // keys are IDs (e.g. "ddsched"), the service name should be displayed to users instead
-	Timestamp int64                             `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                                                                      // timestamp of when this object was sent in unix milliseconds
+	Hostname    string       `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	RequestUser *protos.User `protobuf:"bytes,2,opt,name=requestUser,proto3" json:"requestUser,omitempty"`
 }
 
-func (x *ListIntegratedServicesReply) Reset() {
-	*x = ListIntegratedServicesReply{}
+func (x *UncordonHostRequest) Reset() {
+	*x = UncordonHostRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4225,13 +4462,13 @@ func (x *ListIntegratedServicesReply) Reset() {
 	}
 }
 
-func (x *ListIntegratedServicesReply) String() string {
+func (x *UncordonHostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIntegratedServicesReply) ProtoMessage() {}
+func (*UncordonHostRequest) ProtoMessage() {}
 
-func (x *ListIntegratedServicesReply) ProtoReflect() protoreflect.Message {
+func (x *UncordonHostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4243,39 +4480,35 @@ func (x *ListIntegratedServicesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIntegratedServicesReply.ProtoReflect.Descriptor instead.
-func (*ListIntegratedServicesReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use UncordonHostRequest.ProtoReflect.Descriptor instead.
+func (*UncordonHostRequest) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ListIntegratedServicesReply) GetServices() map[string]*IntegratedServiceInfo {
+func (x *UncordonHostRequest) GetHostname() string {
 	if x != nil {
-		return x.Services
+		return x.Hostname
 	}
-	return nil
+	return ""
 }
 
-func (x *ListIntegratedServicesReply) GetTimestamp() int64 {
+func (x *UncordonHostRequest) GetRequestUser() *protos.User {
 	if x != nil {
-		return x.Timestamp
+		return x.RequestUser
 	}
-	return 0
+	return nil
 }
 
-type IntegratedServiceInfo struct {
+type UncordonHostReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // user-visible service name, e.g. "DD scheduler"
-	Enabled         bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Endpoint        string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	ConnectionState string `protobuf:"bytes,4,opt,name=connectionState,proto3" json:"connectionState,omitempty"` // allowed values: READY, CONNECTING, TRANSIENT_FAILURE, IDLE, SHUTDOWN
-	Data            string `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`                       // always a JSON payload with a map<string, string> inside.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
 }
 
-func (x *IntegratedServiceInfo) Reset() {
-	*x = IntegratedServiceInfo{}
+func (x *UncordonHostReply) Reset() {
+	*x = UncordonHostReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_o2control_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4283,13 +4516,13 @@ func (x *IntegratedServiceInfo) Reset() {
 	}
 }
 
-func (x *IntegratedServiceInfo) String() string {
+func (x *UncordonHostReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntegratedServiceInfo) ProtoMessage() {}
+func (*UncordonHostReply) ProtoMessage() {}
 
-func (x *IntegratedServiceInfo) ProtoReflect() protoreflect.Message {
+func (x *UncordonHostReply) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_o2control_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4301,1059 +4534,3706 @@ func (x *IntegratedServiceInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntegratedServiceInfo.ProtoReflect.Descriptor instead.
-func (*IntegratedServiceInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use UncordonHostReply.ProtoReflect.Descriptor instead.
+func (*UncordonHostReply) Descriptor() ([]byte, []int) {
 	return file_protos_o2control_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *IntegratedServiceInfo) GetName() string {
+func (x *UncordonHostReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type GetCordonedHostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCordonedHostsRequest) Reset() {
+	*x = GetCordonedHostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCordonedHostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCordonedHostsRequest) ProtoMessage() {}
+
+func (x *GetCordonedHostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCordonedHostsRequest.ProtoReflect.Descriptor instead.
+func (*GetCordonedHostsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{61}
+}
+
+type GetCordonedHostsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hosts     []*CordonedHostInfo `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	Timestamp int64               `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+}
+
+func (x *GetCordonedHostsReply) Reset() {
+	*x = GetCordonedHostsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCordonedHostsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCordonedHostsReply) ProtoMessage() {}
+
+func (x *GetCordonedHostsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCordonedHostsReply.ProtoReflect.Descriptor instead.
+func (*GetCordonedHostsReply) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetCordonedHostsReply) GetHosts() []*CordonedHostInfo {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *GetCordonedHostsReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// //////////////////////////////////////
+// Run sequences
+// //////////////////////////////////////
+type SequenceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	EnvironmentId string `protobuf:"bytes,3,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	// One of RUNNING, PAUSED, DONE, FAILED, ABORTED
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// Number of steps completed so far, out of stepCount after loop expansion
+	CurrentStep     int32  `protobuf:"varint,5,opt,name=currentStep,proto3" json:"currentStep,omitempty"`
+	StepCount       int32  `protobuf:"varint,6,opt,name=stepCount,proto3" json:"stepCount,omitempty"`
+	StepDescription string `protobuf:"bytes,7,opt,name=stepDescription,proto3" json:"stepDescription,omitempty"`
+	Error           string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedWhen     int64  `protobuf:"varint,9,opt,name=createdWhen,proto3" json:"createdWhen,omitempty"`  // unix milliseconds
+	UpdatedWhen     int64  `protobuf:"varint,10,opt,name=updatedWhen,proto3" json:"updatedWhen,omitempty"` // unix milliseconds
+}
+
+func (x *SequenceInfo) Reset() {
+	*x = SequenceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SequenceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SequenceInfo) ProtoMessage() {}
+
+func (x *SequenceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SequenceInfo.ProtoReflect.Descriptor instead.
+func (*SequenceInfo) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SequenceInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SequenceInfo) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *IntegratedServiceInfo) GetEnabled() bool {
+func (x *SequenceInfo) GetEnvironmentId() string {
 	if x != nil {
-		return x.Enabled
+		return x.EnvironmentId
 	}
-	return false
+	return ""
 }
 
-func (x *IntegratedServiceInfo) GetEndpoint() string {
+func (x *SequenceInfo) GetState() string {
 	if x != nil {
-		return x.Endpoint
+		return x.State
 	}
 	return ""
 }
 
-func (x *IntegratedServiceInfo) GetConnectionState() string {
+func (x *SequenceInfo) GetCurrentStep() int32 {
 	if x != nil {
-		return x.ConnectionState
+		return x.CurrentStep
+	}
+	return 0
+}
+
+func (x *SequenceInfo) GetStepCount() int32 {
+	if x != nil {
+		return x.StepCount
+	}
+	return 0
+}
+
+func (x *SequenceInfo) GetStepDescription() string {
+	if x != nil {
+		return x.StepDescription
 	}
 	return ""
 }
 
-func (x *IntegratedServiceInfo) GetData() string {
+func (x *SequenceInfo) GetError() string {
 	if x != nil {
-		return x.Data
+		return x.Error
 	}
 	return ""
 }
 
-var File_protos_o2control_proto protoreflect.FileDescriptor
+func (x *SequenceInfo) GetCreatedWhen() int64 {
+	if x != nil {
+		return x.CreatedWhen
+	}
+	return 0
+}
 
-var file_protos_o2control_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x1a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x22, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x22, 0xef, 0x03,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65,
-	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72,
-	0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x73,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x73,
-	0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x2c, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x34, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x73, 0x49, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x49, 0x6e, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x73, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
-	0x29, 0x0a, 0x0f, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x54, 0x65,
-	0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xa0, 0x01, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x6f, 0x77, 0x41, 0x6c,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x68, 0x6f, 0x77, 0x41, 0x6c, 0x6c,
-	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x68, 0x6f, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x77, 0x54, 0x61, 0x73,
-	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x46, 0x0a, 0x1e, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1e,
-	0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65,
-	0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x96,
-	0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65,
-	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72,
-	0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x65, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x65, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xdb, 0x08, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53,
-	0x68, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x61,
-	0x73, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12,
-	0x2a, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x08, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x73, 0x12, 0x38, 0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x56, 0x61, 0x72, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x76, 0x61, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x56, 0x61, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x61,
-	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x56, 0x61, 0x72,
-	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x46, 0x6c, 0x70,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f,
-	0x66, 0x46, 0x6c, 0x70, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x64, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f,
-	0x66, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x6e, 0x0a, 0x16, 0x69,
-	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6f, 0x32,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
-	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x54, 0x61, 0x73, 0x6b,
-	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x30, 0x0a, 0x13, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x73, 0x12, 0x34, 0x0a, 0x15, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x49, 0x6e, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x15, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a,
-	0x0d, 0x55, 0x73, 0x65, 0x72, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x49, 0x0a, 0x1b, 0x49, 0x6e,
-	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xac, 0x02, 0x0a, 0x15, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2a, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x66,
-	0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x76,
-	0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x32, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x72, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x76, 0x61, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x75, 0x74,
-	0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0b, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x37, 0x0a, 0x09, 0x56,
-	0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x0b,
-	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6e,
-	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x65,
-	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x22, 0x84, 0x02, 0x0a, 0x19, 0x4e, 0x65, 0x77, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a,
-	0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
-	0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x04, 0x76, 0x61,
-	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56,
-	0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x76, 0x61, 0x72, 0x73, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e,
-	0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x37,
-	0x0a, 0x09, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x37, 0x0a, 0x17, 0x4e, 0x65, 0x77, 0x41, 0x75,
-	0x74, 0x6f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x22, 0x53, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x68, 0x6f,
-	0x77, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x77, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
-	0x77, 0x54, 0x72, 0x65, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a,
-	0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45,
-	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b,
-	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x77,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x22, 0x8b, 0x02, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x6e,
-	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b,
-	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x22, 0x6d, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e,
-	0x4f, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x41,
-	0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x4f,
-	0x50, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
-	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x52,
-	0x45, 0x53, 0x45, 0x54, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x4f, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x10, 0x06,
-	0x22, 0x91, 0x02, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c,
-	0x0a, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f,
-	0x65, 0x6e, 0x64, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0x93, 0x01, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x45,
-	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x65, 0x41, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x41, 0x6c, 0x6c, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x45,
-	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x26, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6e,
-	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x06, 0x4f,
-	0x70, 0x74, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4f, 0x50, 0x10, 0x00, 0x12,
-	0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x03,
-	0x12, 0x0c, 0x0a, 0x08, 0x41, 0x44, 0x44, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x04, 0x22, 0x8b,
-	0x01, 0x0a, 0x16, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4b, 0x0a, 0x10, 0x66, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
-	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xc1, 0x01, 0x0a,
-	0x19, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65,
-	0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6b,
-	0x65, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x49, 0x6e, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x52, 0x75,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x12, 0x2e, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x22, 0x83, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4a, 0x0a, 0x11,
-	0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x11, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x55, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x58, 0x0a,
-	0x1a, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x64,
-	0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
-	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xcc, 0x01, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x45,
-	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5a, 0x0a, 0x0a, 0x70,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x45,
-	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x73, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x45, 0x6e,
-	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x47,
-	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x78,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x73, 0x22, 0xb8, 0x01, 0x0a,
-	0x1d, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x58,
-	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47,
-	0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x50, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd8, 0x02, 0x0a, 0x0d, 0x53, 0x68, 0x6f, 0x72,
-	0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c,
-	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x64, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70,
-	0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x53, 0x74, 0x64,
-	0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x61, 0x6e, 0x64, 0x62,
-	0x6f, 0x78, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x69,
-	0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6c, 0x61,
-	0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63,
-	0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63,
-	0x61, 0x6c, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
-	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73,
-	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a,
-	0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f,
-	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x54, 0x61,
-	0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x28, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
-	0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x7d, 0x0a, 0x0b,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x65,
-	0x6e, 0x76, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x68,
-	0x65, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x67,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72,
-	0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x0b, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xbe, 0x03, 0x0a, 0x08, 0x54,
-	0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x32, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x40, 0x0a, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x73, 0x12, 0x42, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x32,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x32, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x1a, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6e, 0x76, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6e, 0x76, 0x49,
-	0x64, 0x12, 0x43, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x2f, 0x0a, 0x13, 0x43,
-	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x22, 0xab, 0x01, 0x0a,
-	0x11, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x3c,
-	0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
-	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x43, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6e, 0x76, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6e,
-	0x76, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x68, 0x53, 0x70, 0x65, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x53, 0x70, 0x65, 0x63, 0x22,
-	0xd3, 0x05, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61,
-	0x73, 0x6b, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73,
-	0x6b, 0x49, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
-	0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12,
-	0x3d, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x6f,
-	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x31,
-	0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f,
-	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x76, 0x61, 0x72,
-	0x73, 0x12, 0x3d, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x56, 0x61, 0x72, 0x73, 0x18, 0x09, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
-	0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x61, 0x72,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x56, 0x61, 0x72, 0x73,
-	0x12, 0x58, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x53, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x32,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61,
-	0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3b, 0x0a, 0x0d,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x56, 0x61, 0x72,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x44, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65,
-	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
-	0xc9, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
-	0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74,
-	0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61,
-	0x6c, 0x6c, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x6c, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x61, 0x6c, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x57, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61,
-	0x6c, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0xae, 0x04, 0x0a, 0x0e,
-	0x56, 0x61, 0x72, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22,
-	0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x56, 0x61, 0x72,
-	0x53, 0x70, 0x65, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a,
-	0x0a, 0x06, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
-	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x53, 0x70,
-	0x65, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x69, 0x57, 0x69, 0x64, 0x67,
-	0x65, 0x74, 0x52, 0x06, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09,
-	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x49, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x49, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x49, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x49, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x71, 0x0a, 0x08,
-	0x55, 0x69, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x65, 0x64, 0x69, 0x74,
-	0x42, 0x6f, 0x78, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x6c, 0x69, 0x64, 0x65, 0x72, 0x10,
-	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x78, 0x10, 0x02, 0x12, 0x0f,
-	0x0a, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x44, 0x6f, 0x77, 0x6e, 0x42, 0x6f, 0x78, 0x10, 0x03, 0x12,
-	0x0c, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x42, 0x6f, 0x78, 0x10, 0x04, 0x12, 0x12, 0x0a,
-	0x0e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x42, 0x6f, 0x78, 0x10,
-	0x05, 0x12, 0x0c, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6f, 0x78, 0x10, 0x06, 0x22,
-	0x3b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x10, 0x01, 0x12,
-	0x08, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x6c, 0x69, 0x73,
-	0x74, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x10, 0x04, 0x22, 0xaf, 0x02, 0x0a,
-	0x14, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x4f, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x61, 0x70, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x56, 0x61, 0x72, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x61,
-	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x76, 0x61, 0x72, 0x53, 0x70, 0x65, 0x63, 0x4d,
-	0x61, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x58, 0x0a, 0x0f, 0x56, 0x61, 0x72, 0x53, 0x70, 0x65, 0x63, 0x4d,
-	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x88,
-	0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4d, 0x0a, 0x11,
-	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
-	0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x36, 0x0a, 0x10, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x67, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0c, 0x67, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x80, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x0f,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x65, 0x70,
-	0x6f, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x15, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x4e, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70,
-	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x29, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x22, 0x57, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x6e, 0x65, 0x77, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2b, 0x0a, 0x13,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2d, 0x0a, 0x15, 0x53, 0x65, 0x74,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3d, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x47,
-	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x52, 0x65,
-	0x70, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x1b, 0x53, 0x65,
-	0x74, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x07, 0x0a, 0x05, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0xec, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x50, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x1a, 0x5d, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
-	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0xeb, 0x10, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72,
-	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x32, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f,
-	0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x21, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
-	0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x4e, 0x65, 0x77, 0x41, 0x75, 0x74,
-	0x6f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x6f,
-	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x75, 0x74, 0x6f,
-	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4e,
-	0x65, 0x77, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x45,
-	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x6f, 0x32, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f,
-	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x20, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47,
-	0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45,
-	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x6f, 0x32, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x6e,
-	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f,
-	0x79, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x6f,
-	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
-	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x44,
-	0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x10,
-	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x22, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12,
-	0x10, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x25, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x13, 0x4e, 0x65,
-	0x77, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x79, 0x6e,
-	0x63, 0x12, 0x20, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4e, 0x65,
-	0x77, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
-	0x4e, 0x65, 0x77, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b,
-	0x73, 0x12, 0x1a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65,
-	0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x54, 0x61, 0x73, 0x6b, 0x12, 0x19, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x61, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0c, 0x43, 0x6c,
-	0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1e, 0x2e, 0x6f, 0x32, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x32, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x08, 0x47, 0x65,
-	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6f, 0x73, 0x12, 0x1b, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a,
-	0x07, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x19, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
-	0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48,
-	0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1c, 0x2e, 0x6f,
-	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x32, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x1e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0e,
-	0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x20,
-	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x10, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61,
-	0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x2a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74,
-	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x6f,
-	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x6c, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6f, 0x32, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3b,
-	0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0x2e, 0x6f, 0x32,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x42, 0x0a, 0x08, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1a, 0x2e, 0x6f,
-	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x45, 0x6e,
-	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x6f, 0x32, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
-	0x79, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x42, 0x54, 0x0a, 0x22, 0x63, 0x68, 0x2e, 0x63, 0x65, 0x72, 0x6e, 0x2e, 0x61,
-	0x6c, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
-	0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6c, 0x69, 0x63, 0x65, 0x4f, 0x32, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x2f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x3b, 0x70, 0x62, 0x50, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+func (x *SequenceInfo) GetUpdatedWhen() int64 {
+	if x != nil {
+		return x.UpdatedWhen
+	}
+	return 0
 }
 
-var (
-	file_protos_o2control_proto_rawDescOnce sync.Once
-	file_protos_o2control_proto_rawDescData = file_protos_o2control_proto_rawDesc
-)
+type RunSequenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_protos_o2control_proto_rawDescGZIP() []byte {
-	file_protos_o2control_proto_rawDescOnce.Do(func() {
-		file_protos_o2control_proto_rawDescData = protoimpl.X.CompressGZIP(file_protos_o2control_proto_rawDescData)
-	})
-	return file_protos_o2control_proto_rawDescData
+	EnvironmentId string `protobuf:"bytes,1,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	// YAML run sequence, see the AliECS handbook for its syntax
+	Sequence    string       `protobuf:"bytes,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	RequestUser *protos.User `protobuf:"bytes,3,opt,name=requestUser,proto3" json:"requestUser,omitempty"`
 }
 
-var file_protos_o2control_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_protos_o2control_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
-var file_protos_o2control_proto_goTypes = []interface{}{
-	(ControlEnvironmentRequest_Optype)(0),   // 0: o2control.ControlEnvironmentRequest.Optype
-	(EnvironmentOperation_Optype)(0),        // 1: o2control.EnvironmentOperation.Optype
-	(VarSpecMessage_UiWidget)(0),            // 2: o2control.VarSpecMessage.UiWidget
-	(VarSpecMessage_Type)(0),                // 3: o2control.VarSpecMessage.Type
-	(*SubscribeRequest)(nil),                // 4: o2control.SubscribeRequest
-	(*GetFrameworkInfoRequest)(nil),         // 5: o2control.GetFrameworkInfoRequest
-	(*Version)(nil),                         // 6: o2control.Version
-	(*GetFrameworkInfoReply)(nil),           // 7: o2control.GetFrameworkInfoReply
-	(*TeardownRequest)(nil),                 // 8: o2control.TeardownRequest
-	(*TeardownReply)(nil),                   // 9: o2control.TeardownReply
-	(*GetEnvironmentsRequest)(nil),          // 10: o2control.GetEnvironmentsRequest
-	(*GetEnvironmentsReply)(nil),            // 11: o2control.GetEnvironmentsReply
-	(*EnvironmentInfo)(nil),                 // 12: o2control.EnvironmentInfo
-	(*NewEnvironmentRequest)(nil),           // 13: o2control.NewEnvironmentRequest
-	(*NewEnvironmentReply)(nil),             // 14: o2control.NewEnvironmentReply
-	(*NewAutoEnvironmentRequest)(nil),       // 15: o2control.NewAutoEnvironmentRequest
-	(*NewAutoEnvironmentReply)(nil),         // 16: o2control.NewAutoEnvironmentReply
-	(*GetEnvironmentRequest)(nil),           // 17: o2control.GetEnvironmentRequest
-	(*GetEnvironmentReply)(nil),             // 18: o2control.GetEnvironmentReply
-	(*ControlEnvironmentRequest)(nil),       // 19: o2control.ControlEnvironmentRequest
-	(*ControlEnvironmentReply)(nil),         // 20: o2control.ControlEnvironmentReply
-	(*ModifyEnvironmentRequest)(nil),        // 21: o2control.ModifyEnvironmentRequest
-	(*EnvironmentOperation)(nil),            // 22: o2control.EnvironmentOperation
-	(*ModifyEnvironmentReply)(nil),          // 23: o2control.ModifyEnvironmentReply
-	(*DestroyEnvironmentRequest)(nil),       // 24: o2control.DestroyEnvironmentRequest
-	(*DestroyEnvironmentReply)(nil),         // 25: o2control.DestroyEnvironmentReply
-	(*GetActiveDetectorsReply)(nil),         // 26: o2control.GetActiveDetectorsReply
-	(*GetAvailableDetectorsReply)(nil),      // 27: o2control.GetAvailableDetectorsReply
-	(*SetEnvironmentPropertiesRequest)(nil), // 28: o2control.SetEnvironmentPropertiesRequest
-	(*SetEnvironmentPropertiesReply)(nil),   // 29: o2control.SetEnvironmentPropertiesReply
-	(*GetEnvironmentPropertiesRequest)(nil), // 30: o2control.GetEnvironmentPropertiesRequest
-	(*GetEnvironmentPropertiesReply)(nil),   // 31: o2control.GetEnvironmentPropertiesReply
-	(*ShortTaskInfo)(nil),                   // 32: o2control.ShortTaskInfo
-	(*TaskDeploymentInfo)(nil),              // 33: o2control.TaskDeploymentInfo
-	(*GetTasksRequest)(nil),                 // 34: o2control.GetTasksRequest
-	(*GetTasksReply)(nil),                   // 35: o2control.GetTasksReply
-	(*GetTaskRequest)(nil),                  // 36: o2control.GetTaskRequest
-	(*GetTaskReply)(nil),                    // 37: o2control.GetTaskReply
-	(*CommandInfo)(nil),                     // 38: o2control.CommandInfo
-	(*ChannelInfo)(nil),                     // 39: o2control.ChannelInfo
-	(*TaskInfo)(nil),                        // 40: o2control.TaskInfo
-	(*CleanupTasksRequest)(nil),             // 41: o2control.CleanupTasksRequest
-	(*CleanupTasksReply)(nil),               // 42: o2control.CleanupTasksReply
-	(*GetRolesRequest)(nil),                 // 43: o2control.GetRolesRequest
-	(*RoleInfo)(nil),                        // 44: o2control.RoleInfo
-	(*GetRolesReply)(nil),                   // 45: o2control.GetRolesReply
-	(*GetWorkflowTemplatesRequest)(nil),     // 46: o2control.GetWorkflowTemplatesRequest
-	(*VarSpecMessage)(nil),                  // 47: o2control.VarSpecMessage
-	(*WorkflowTemplateInfo)(nil),            // 48: o2control.WorkflowTemplateInfo
-	(*GetWorkflowTemplatesReply)(nil),       // 49: o2control.GetWorkflowTemplatesReply
-	(*ListReposRequest)(nil),                // 50: o2control.ListReposRequest
-	(*RepoInfo)(nil),                        // 51: o2control.RepoInfo
-	(*ListReposReply)(nil),                  // 52: o2control.ListReposReply
-	(*AddRepoRequest)(nil),                  // 53: o2control.AddRepoRequest
-	(*AddRepoReply)(nil),                    // 54: o2control.AddRepoReply
-	(*RemoveRepoRequest)(nil),               // 55: o2control.RemoveRepoRequest
-	(*RemoveRepoReply)(nil),                 // 56: o2control.RemoveRepoReply
-	(*RefreshReposRequest)(nil),             // 57: o2control.RefreshReposRequest
-	(*SetDefaultRepoRequest)(nil),           // 58: o2control.SetDefaultRepoRequest
-	(*SetGlobalDefaultRevisionRequest)(nil), // 59: o2control.SetGlobalDefaultRevisionRequest
-	(*SetRepoDefaultRevisionRequest)(nil),   // 60: o2control.SetRepoDefaultRevisionRequest
-	(*SetRepoDefaultRevisionReply)(nil),     // 61: o2control.SetRepoDefaultRevisionReply
-	(*Empty)(nil),                           // 62: o2control.Empty
-	(*ListIntegratedServicesReply)(nil),     // 63: o2control.ListIntegratedServicesReply
-	(*IntegratedServiceInfo)(nil),           // 64: o2control.IntegratedServiceInfo
-	nil,                                     // 65: o2control.EnvironmentInfo.DefaultsEntry
-	nil,                                     // 66: o2control.EnvironmentInfo.VarsEntry
-	nil,                                     // 67: o2control.EnvironmentInfo.UserVarsEntry
-	nil,                                     // 68: o2control.EnvironmentInfo.IntegratedServicesDataEntry
-	nil,                                     // 69: o2control.NewEnvironmentRequest.VarsEntry
-	nil,                                     // 70: o2control.NewAutoEnvironmentRequest.VarsEntry
-	nil,                                     // 71: o2control.SetEnvironmentPropertiesRequest.PropertiesEntry
-	nil,                                     // 72: o2control.GetEnvironmentPropertiesReply.PropertiesEntry
-	nil,                                     // 73: o2control.TaskInfo.PropertiesEntry
-	nil,                                     // 74: o2control.RoleInfo.DefaultsEntry
-	nil,                                     // 75: o2control.RoleInfo.VarsEntry
-	nil,                                     // 76: o2control.RoleInfo.UserVarsEntry
-	nil,                                     // 77: o2control.RoleInfo.ConsolidatedStackEntry
-	nil,                                     // 78: o2control.WorkflowTemplateInfo.VarSpecMapEntry
-	nil,                                     // 79: o2control.ListIntegratedServicesReply.ServicesEntry
-	(*protos.User)(nil),                     // 80: common.User
-	(*protos.Event)(nil),                    // 81: events.Event
+func (x *RunSequenceRequest) Reset() {
+	*x = RunSequenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_protos_o2control_proto_depIdxs = []int32{
-	6,  // 0: o2control.GetFrameworkInfoReply.version:type_name -> o2control.Version
-	12, // 1: o2control.GetEnvironmentsReply.environments:type_name -> o2control.EnvironmentInfo
-	32, // 2: o2control.EnvironmentInfo.tasks:type_name -> o2control.ShortTaskInfo
-	65, // 3: o2control.EnvironmentInfo.defaults:type_name -> o2control.EnvironmentInfo.DefaultsEntry
-	66, // 4: o2control.EnvironmentInfo.vars:type_name -> o2control.EnvironmentInfo.VarsEntry
-	67, // 5: o2control.EnvironmentInfo.userVars:type_name -> o2control.EnvironmentInfo.UserVarsEntry
-	68, // 6: o2control.EnvironmentInfo.integratedServicesData:type_name -> o2control.EnvironmentInfo.IntegratedServicesDataEntry
-	69, // 7: o2control.NewEnvironmentRequest.vars:type_name -> o2control.NewEnvironmentRequest.VarsEntry
-	80, // 8: o2control.NewEnvironmentRequest.requestUser:type_name -> common.User
-	12, // 9: o2control.NewEnvironmentReply.environment:type_name -> o2control.EnvironmentInfo
-	70, // 10: o2control.NewAutoEnvironmentRequest.vars:type_name -> o2control.NewAutoEnvironmentRequest.VarsEntry
-	80, // 11: o2control.NewAutoEnvironmentRequest.requestUser:type_name -> common.User
-	12, // 12: o2control.GetEnvironmentReply.environment:type_name -> o2control.EnvironmentInfo
-	44, // 13: o2control.GetEnvironmentReply.workflow:type_name -> o2control.RoleInfo
-	0,  // 14: o2control.ControlEnvironmentRequest.type:type_name -> o2control.ControlEnvironmentRequest.Optype
-	80, // 15: o2control.ControlEnvironmentRequest.requestUser:type_name -> common.User
-	22, // 16: o2control.ModifyEnvironmentRequest.operations:type_name -> o2control.EnvironmentOperation
-	1,  // 17: o2control.EnvironmentOperation.type:type_name -> o2control.EnvironmentOperation.Optype
-	22, // 18: o2control.ModifyEnvironmentReply.failedOperations:type_name -> o2control.EnvironmentOperation
-	80, // 19: o2control.DestroyEnvironmentRequest.requestUser:type_name -> common.User
-	42, // 20: o2control.DestroyEnvironmentReply.cleanupTasksReply:type_name -> o2control.CleanupTasksReply
-	71, // 21: o2control.SetEnvironmentPropertiesRequest.properties:type_name -> o2control.SetEnvironmentPropertiesRequest.PropertiesEntry
-	72, // 22: o2control.GetEnvironmentPropertiesReply.properties:type_name -> o2control.GetEnvironmentPropertiesReply.PropertiesEntry
-	33, // 23: o2control.ShortTaskInfo.deploymentInfo:type_name -> o2control.TaskDeploymentInfo
-	32, // 24: o2control.GetTasksReply.tasks:type_name -> o2control.ShortTaskInfo
-	40, // 25: o2control.GetTaskReply.task:type_name -> o2control.TaskInfo
-	32, // 26: o2control.TaskInfo.shortInfo:type_name -> o2control.ShortTaskInfo
-	39, // 27: o2control.TaskInfo.inboundChannels:type_name -> o2control.ChannelInfo
-	39, // 28: o2control.TaskInfo.outboundChannels:type_name -> o2control.ChannelInfo
-	38, // 29: o2control.TaskInfo.commandInfo:type_name -> o2control.CommandInfo
-	73, // 30: o2control.TaskInfo.properties:type_name -> o2control.TaskInfo.PropertiesEntry
-	32, // 31: o2control.CleanupTasksReply.killedTasks:type_name -> o2control.ShortTaskInfo
-	32, // 32: o2control.CleanupTasksReply.runningTasks:type_name -> o2control.ShortTaskInfo
-	44, // 33: o2control.RoleInfo.roles:type_name -> o2control.RoleInfo
-	74, // 34: o2control.RoleInfo.defaults:type_name -> o2control.RoleInfo.DefaultsEntry
-	75, // 35: o2control.RoleInfo.vars:type_name -> o2control.RoleInfo.VarsEntry
-	76, // 36: o2control.RoleInfo.userVars:type_name -> o2control.RoleInfo.UserVarsEntry
-	77, // 37: o2control.RoleInfo.consolidatedStack:type_name -> o2control.RoleInfo.ConsolidatedStackEntry
-	44, // 38: o2control.GetRolesReply.roles:type_name -> o2control.RoleInfo
-	3,  // 39: o2control.VarSpecMessage.type:type_name -> o2control.VarSpecMessage.Type
-	2,  // 40: o2control.VarSpecMessage.widget:type_name -> o2control.VarSpecMessage.UiWidget
-	78, // 41: o2control.WorkflowTemplateInfo.varSpecMap:type_name -> o2control.WorkflowTemplateInfo.VarSpecMapEntry
-	48, // 42: o2control.GetWorkflowTemplatesReply.workflowTemplates:type_name -> o2control.WorkflowTemplateInfo
-	51, // 43: o2control.ListReposReply.repos:type_name -> o2control.RepoInfo
-	79, // 44: o2control.ListIntegratedServicesReply.services:type_name -> o2control.ListIntegratedServicesReply.ServicesEntry
-	47, // 45: o2control.WorkflowTemplateInfo.VarSpecMapEntry.value:type_name -> o2control.VarSpecMessage
-	64, // 46: o2control.ListIntegratedServicesReply.ServicesEntry.value:type_name -> o2control.IntegratedServiceInfo
-	5,  // 47: o2control.Control.GetFrameworkInfo:input_type -> o2control.GetFrameworkInfoRequest
-	10, // 48: o2control.Control.GetEnvironments:input_type -> o2control.GetEnvironmentsRequest
-	15, // 49: o2control.Control.NewAutoEnvironment:input_type -> o2control.NewAutoEnvironmentRequest
-	13, // 50: o2control.Control.NewEnvironment:input_type -> o2control.NewEnvironmentRequest
-	17, // 51: o2control.Control.GetEnvironment:input_type -> o2control.GetEnvironmentRequest
-	19, // 52: o2control.Control.ControlEnvironment:input_type -> o2control.ControlEnvironmentRequest
-	24, // 53: o2control.Control.DestroyEnvironment:input_type -> o2control.DestroyEnvironmentRequest
-	62, // 54: o2control.Control.GetActiveDetectors:input_type -> o2control.Empty
-	62, // 55: o2control.Control.GetAvailableDetectors:input_type -> o2control.Empty
-	13, // 56: o2control.Control.NewEnvironmentAsync:input_type -> o2control.NewEnvironmentRequest
-	34, // 57: o2control.Control.GetTasks:input_type -> o2control.GetTasksRequest
-	36, // 58: o2control.Control.GetTask:input_type -> o2control.GetTaskRequest
-	41, // 59: o2control.Control.CleanupTasks:input_type -> o2control.CleanupTasksRequest
-	43, // 60: o2control.Control.GetRoles:input_type -> o2control.GetRolesRequest
-	46, // 61: o2control.Control.GetWorkflowTemplates:input_type -> o2control.GetWorkflowTemplatesRequest
-	50, // 62: o2control.Control.ListRepos:input_type -> o2control.ListReposRequest
-	53, // 63: o2control.Control.AddRepo:input_type -> o2control.AddRepoRequest
-	55, // 64: o2control.Control.RemoveRepo:input_type -> o2control.RemoveRepoRequest
-	57, // 65: o2control.Control.RefreshRepos:input_type -> o2control.RefreshReposRequest
-	58, // 66: o2control.Control.SetDefaultRepo:input_type -> o2control.SetDefaultRepoRequest
-	59, // 67: o2control.Control.SetGlobalDefaultRevision:input_type -> o2control.SetGlobalDefaultRevisionRequest
-	60, // 68: o2control.Control.SetRepoDefaultRevision:input_type -> o2control.SetRepoDefaultRevisionRequest
-	4,  // 69: o2control.Control.Subscribe:input_type -> o2control.SubscribeRequest
-	62, // 70: o2control.Control.GetIntegratedServices:input_type -> o2control.Empty
-	8,  // 71: o2control.Control.Teardown:input_type -> o2control.TeardownRequest
-	21, // 72: o2control.Control.ModifyEnvironment:input_type -> o2control.ModifyEnvironmentRequest
-	7,  // 73: o2control.Control.GetFrameworkInfo:output_type -> o2control.GetFrameworkInfoReply
-	11, // 74: o2control.Control.GetEnvironments:output_type -> o2control.GetEnvironmentsReply
-	16, // 75: o2control.Control.NewAutoEnvironment:output_type -> o2control.NewAutoEnvironmentReply
-	14, // 76: o2control.Control.NewEnvironment:output_type -> o2control.NewEnvironmentReply
-	18, // 77: o2control.Control.GetEnvironment:output_type -> o2control.GetEnvironmentReply
-	20, // 78: o2control.Control.ControlEnvironment:output_type -> o2control.ControlEnvironmentReply
-	25, // 79: o2control.Control.DestroyEnvironment:output_type -> o2control.DestroyEnvironmentReply
-	26, // 80: o2control.Control.GetActiveDetectors:output_type -> o2control.GetActiveDetectorsReply
-	27, // 81: o2control.Control.GetAvailableDetectors:output_type -> o2control.GetAvailableDetectorsReply
-	14, // 82: o2control.Control.NewEnvironmentAsync:output_type -> o2control.NewEnvironmentReply
-	35, // 83: o2control.Control.GetTasks:output_type -> o2control.GetTasksReply
-	37, // 84: o2control.Control.GetTask:output_type -> o2control.GetTaskReply
-	42, // 85: o2control.Control.CleanupTasks:output_type -> o2control.CleanupTasksReply
-	45, // 86: o2control.Control.GetRoles:output_type -> o2control.GetRolesReply
-	49, // 87: o2control.Control.GetWorkflowTemplates:output_type -> o2control.GetWorkflowTemplatesReply
-	52, // 88: o2control.Control.ListRepos:output_type -> o2control.ListReposReply
-	54, // 89: o2control.Control.AddRepo:output_type -> o2control.AddRepoReply
-	56, // 90: o2control.Control.RemoveRepo:output_type -> o2control.RemoveRepoReply
-	62, // 91: o2control.Control.RefreshRepos:output_type -> o2control.Empty
-	62, // 92: o2control.Control.SetDefaultRepo:output_type -> o2control.Empty
-	62, // 93: o2control.Control.SetGlobalDefaultRevision:output_type -> o2control.Empty
-	61, // 94: o2control.Control.SetRepoDefaultRevision:output_type -> o2control.SetRepoDefaultRevisionReply
-	81, // 95: o2control.Control.Subscribe:output_type -> events.Event
-	63, // 96: o2control.Control.GetIntegratedServices:output_type -> o2control.ListIntegratedServicesReply
-	9,  // 97: o2control.Control.Teardown:output_type -> o2control.TeardownReply
-	23, // 98: o2control.Control.ModifyEnvironment:output_type -> o2control.ModifyEnvironmentReply
-	73, // [73:99] is the sub-list for method output_type
-	47, // [47:73] is the sub-list for method input_type
-	47, // [47:47] is the sub-list for extension type_name
-	47, // [47:47] is the sub-list for extension extendee
-	0,  // [0:47] is the sub-list for field type_name
+
+func (x *RunSequenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_protos_o2control_proto_init() }
-func file_protos_o2control_proto_init() {
-	if File_protos_o2control_proto != nil {
-		return
+func (*RunSequenceRequest) ProtoMessage() {}
+
+func (x *RunSequenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_protos_o2control_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeRequest); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSequenceRequest.ProtoReflect.Descriptor instead.
+func (*RunSequenceRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RunSequenceRequest) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+func (x *RunSequenceRequest) GetSequence() string {
+	if x != nil {
+		return x.Sequence
+	}
+	return ""
+}
+
+func (x *RunSequenceRequest) GetRequestUser() *protos.User {
+	if x != nil {
+		return x.RequestUser
+	}
+	return nil
+}
+
+type RunSequenceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence  *SequenceInfo `protobuf:"bytes,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp int64         `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+}
+
+func (x *RunSequenceReply) Reset() {
+	*x = RunSequenceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunSequenceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSequenceReply) ProtoMessage() {}
+
+func (x *RunSequenceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSequenceReply.ProtoReflect.Descriptor instead.
+func (*RunSequenceReply) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RunSequenceReply) GetSequence() *SequenceInfo {
+	if x != nil {
+		return x.Sequence
+	}
+	return nil
+}
+
+func (x *RunSequenceReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type GetSequencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only this sequence is returned, even if it is no longer running
+	SequenceId string `protobuf:"bytes,1,opt,name=sequenceId,proto3" json:"sequenceId,omitempty"`
+	// If set, only the sequences of this environment are returned
+	EnvironmentId string `protobuf:"bytes,2,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+}
+
+func (x *GetSequencesRequest) Reset() {
+	*x = GetSequencesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSequencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSequencesRequest) ProtoMessage() {}
+
+func (x *GetSequencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSequencesRequest.ProtoReflect.Descriptor instead.
+func (*GetSequencesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetSequencesRequest) GetSequenceId() string {
+	if x != nil {
+		return x.SequenceId
+	}
+	return ""
+}
+
+func (x *GetSequencesRequest) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+type GetSequencesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequences []*SequenceInfo `protobuf:"bytes,1,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	Timestamp int64           `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+}
+
+func (x *GetSequencesReply) Reset() {
+	*x = GetSequencesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSequencesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSequencesReply) ProtoMessage() {}
+
+func (x *GetSequencesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSequencesReply.ProtoReflect.Descriptor instead.
+func (*GetSequencesReply) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetSequencesReply) GetSequences() []*SequenceInfo {
+	if x != nil {
+		return x.Sequences
+	}
+	return nil
+}
+
+func (x *GetSequencesReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ControlSequenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SequenceId  string                        `protobuf:"bytes,1,opt,name=sequenceId,proto3" json:"sequenceId,omitempty"`
+	Type        ControlSequenceRequest_Optype `protobuf:"varint,2,opt,name=type,proto3,enum=o2control.ControlSequenceRequest_Optype" json:"type,omitempty"`
+	RequestUser *protos.User                  `protobuf:"bytes,3,opt,name=requestUser,proto3" json:"requestUser,omitempty"`
+}
+
+func (x *ControlSequenceRequest) Reset() {
+	*x = ControlSequenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlSequenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlSequenceRequest) ProtoMessage() {}
+
+func (x *ControlSequenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlSequenceRequest.ProtoReflect.Descriptor instead.
+func (*ControlSequenceRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ControlSequenceRequest) GetSequenceId() string {
+	if x != nil {
+		return x.SequenceId
+	}
+	return ""
+}
+
+func (x *ControlSequenceRequest) GetType() ControlSequenceRequest_Optype {
+	if x != nil {
+		return x.Type
+	}
+	return ControlSequenceRequest_NOOP
+}
+
+func (x *ControlSequenceRequest) GetRequestUser() *protos.User {
+	if x != nil {
+		return x.RequestUser
+	}
+	return nil
+}
+
+type ControlSequenceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence  *SequenceInfo `protobuf:"bytes,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp int64         `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+}
+
+func (x *ControlSequenceReply) Reset() {
+	*x = ControlSequenceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlSequenceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlSequenceReply) ProtoMessage() {}
+
+func (x *ControlSequenceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlSequenceReply.ProtoReflect.Descriptor instead.
+func (*ControlSequenceReply) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ControlSequenceReply) GetSequence() *SequenceInfo {
+	if x != nil {
+		return x.Sequence
+	}
+	return nil
+}
+
+func (x *ControlSequenceReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// //////////////////////////////////////
+// Roles
+// //////////////////////////////////////
+type GetRolesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvId    string `protobuf:"bytes,1,opt,name=envId,proto3" json:"envId,omitempty"`
+	PathSpec string `protobuf:"bytes,2,opt,name=pathSpec,proto3" json:"pathSpec,omitempty"`
+}
+
+func (x *GetRolesRequest) Reset() {
+	*x = GetRolesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRolesRequest) ProtoMessage() {}
+
+func (x *GetRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRolesRequest.ProtoReflect.Descriptor instead.
+func (*GetRolesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetRolesRequest) GetEnvId() string {
+	if x != nil {
+		return x.EnvId
+	}
+	return ""
+}
+
+func (x *GetRolesRequest) GetPathSpec() string {
+	if x != nil {
+		return x.PathSpec
+	}
+	return ""
+}
+
+type RoleInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status            string            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	State             string            `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	FullPath          string            `protobuf:"bytes,4,opt,name=fullPath,proto3" json:"fullPath,omitempty"`
+	TaskIds           []string          `protobuf:"bytes,5,rep,name=taskIds,proto3" json:"taskIds,omitempty"`
+	Roles             []*RoleInfo       `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
+	Defaults          map[string]string `protobuf:"bytes,7,rep,name=defaults,proto3" json:"defaults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Vars              map[string]string `protobuf:"bytes,8,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	UserVars          map[string]string `protobuf:"bytes,9,rep,name=userVars,proto3" json:"userVars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ConsolidatedStack map[string]string `protobuf:"bytes,10,rep,name=consolidatedStack,proto3" json:"consolidatedStack,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Description       string            `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *RoleInfo) Reset() {
+	*x = RoleInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleInfo) ProtoMessage() {}
+
+func (x *RoleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleInfo.ProtoReflect.Descriptor instead.
+func (*RoleInfo) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RoleInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetFullPath() string {
+	if x != nil {
+		return x.FullPath
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *RoleInfo) GetRoles() []*RoleInfo {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *RoleInfo) GetDefaults() map[string]string {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+func (x *RoleInfo) GetVars() map[string]string {
+	if x != nil {
+		return x.Vars
+	}
+	return nil
+}
+
+func (x *RoleInfo) GetUserVars() map[string]string {
+	if x != nil {
+		return x.UserVars
+	}
+	return nil
+}
+
+func (x *RoleInfo) GetConsolidatedStack() map[string]string {
+	if x != nil {
+		return x.ConsolidatedStack
+	}
+	return nil
+}
+
+func (x *RoleInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetRolesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles     []*RoleInfo `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Timestamp int64       `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+}
+
+func (x *GetRolesReply) Reset() {
+	*x = GetRolesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRolesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRolesReply) ProtoMessage() {}
+
+func (x *GetRolesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRolesReply.ProtoReflect.Descriptor instead.
+func (*GetRolesReply) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetRolesReply) GetRoles() []*RoleInfo {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GetRolesReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type GetWorkflowTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RepoPattern     string `protobuf:"bytes,1,opt,name=repoPattern,proto3" json:"repoPattern,omitempty"`
+	RevisionPattern string `protobuf:"bytes,2,opt,name=revisionPattern,proto3" json:"revisionPattern,omitempty"`
+	AllBranches     bool   `protobuf:"varint,3,opt,name=allBranches,proto3" json:"allBranches,omitempty"`
+	AllTags         bool   `protobuf:"varint,4,opt,name=allTags,proto3" json:"allTags,omitempty"`
+	AllWorkflows    bool   `protobuf:"varint,5,opt,name=allWorkflows,proto3" json:"allWorkflows,omitempty"`
+}
+
+func (x *GetWorkflowTemplatesRequest) Reset() {
+	*x = GetWorkflowTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkflowTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowTemplatesRequest) ProtoMessage() {}
+
+func (x *GetWorkflowTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetWorkflowTemplatesRequest) GetRepoPattern() string {
+	if x != nil {
+		return x.RepoPattern
+	}
+	return ""
+}
+
+func (x *GetWorkflowTemplatesRequest) GetRevisionPattern() string {
+	if x != nil {
+		return x.RevisionPattern
+	}
+	return ""
+}
+
+func (x *GetWorkflowTemplatesRequest) GetAllBranches() bool {
+	if x != nil {
+		return x.AllBranches
+	}
+	return false
+}
+
+func (x *GetWorkflowTemplatesRequest) GetAllTags() bool {
+	if x != nil {
+		return x.AllTags
+	}
+	return false
+}
+
+func (x *GetWorkflowTemplatesRequest) GetAllWorkflows() bool {
+	if x != nil {
+		return x.AllWorkflows
+	}
+	return false
+}
+
+type VarSpecMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DefaultValue  string                  `protobuf:"bytes,1,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`
+	Type          VarSpecMessage_Type     `protobuf:"varint,2,opt,name=type,proto3,enum=o2control.VarSpecMessage_Type" json:"type,omitempty"`
+	Label         string                  `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Description   string                  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Widget        VarSpecMessage_UiWidget `protobuf:"varint,5,opt,name=widget,proto3,enum=o2control.VarSpecMessage_UiWidget" json:"widget,omitempty"`
+	Panel         string                  `protobuf:"bytes,6,opt,name=panel,proto3" json:"panel,omitempty"`                 // hint for the UI on where to put or group the given variable input
+	AllowedValues []string                `protobuf:"bytes,7,rep,name=allowedValues,proto3" json:"allowedValues,omitempty"` // list of offered values from which to choose (only for some UiWidgets)
+	Index         int32                   `protobuf:"varint,8,opt,name=index,proto3" json:"index,omitempty"`
+	VisibleIf     string                  `protobuf:"bytes,9,opt,name=visibleIf,proto3" json:"visibleIf,omitempty"`  // JS expression that evaluates to bool
+	EnabledIf     string                  `protobuf:"bytes,10,opt,name=enabledIf,proto3" json:"enabledIf,omitempty"` // JS expression that evaluates to bool
+	Rows          uint32                  `protobuf:"varint,11,opt,name=rows,proto3" json:"rows,omitempty"`          // this field is used only if widget == editBox
+}
+
+func (x *VarSpecMessage) Reset() {
+	*x = VarSpecMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VarSpecMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VarSpecMessage) ProtoMessage() {}
+
+func (x *VarSpecMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VarSpecMessage.ProtoReflect.Descriptor instead.
+func (*VarSpecMessage) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *VarSpecMessage) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *VarSpecMessage) GetType() VarSpecMessage_Type {
+	if x != nil {
+		return x.Type
+	}
+	return VarSpecMessage_string
+}
+
+func (x *VarSpecMessage) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *VarSpecMessage) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VarSpecMessage) GetWidget() VarSpecMessage_UiWidget {
+	if x != nil {
+		return x.Widget
+	}
+	return VarSpecMessage_editBox
+}
+
+func (x *VarSpecMessage) GetPanel() string {
+	if x != nil {
+		return x.Panel
+	}
+	return ""
+}
+
+func (x *VarSpecMessage) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *VarSpecMessage) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *VarSpecMessage) GetVisibleIf() string {
+	if x != nil {
+		return x.VisibleIf
+	}
+	return ""
+}
+
+func (x *VarSpecMessage) GetEnabledIf() string {
+	if x != nil {
+		return x.EnabledIf
+	}
+	return ""
+}
+
+func (x *VarSpecMessage) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+type WorkflowTemplateInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo        string                     `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Template    string                     `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	Revision    string                     `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	VarSpecMap  map[string]*VarSpecMessage `protobuf:"bytes,4,rep,name=varSpecMap,proto3" json:"varSpecMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Description string                     `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *WorkflowTemplateInfo) Reset() {
+	*x = WorkflowTemplateInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkflowTemplateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowTemplateInfo) ProtoMessage() {}
+
+func (x *WorkflowTemplateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowTemplateInfo.ProtoReflect.Descriptor instead.
+func (*WorkflowTemplateInfo) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *WorkflowTemplateInfo) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *WorkflowTemplateInfo) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *WorkflowTemplateInfo) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *WorkflowTemplateInfo) GetVarSpecMap() map[string]*VarSpecMessage {
+	if x != nil {
+		return x.VarSpecMap
+	}
+	return nil
+}
+
+func (x *WorkflowTemplateInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetWorkflowTemplatesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkflowTemplates []*WorkflowTemplateInfo `protobuf:"bytes,1,rep,name=workflowTemplates,proto3" json:"workflowTemplates,omitempty"`
+	Timestamp         int64                   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+}
+
+func (x *GetWorkflowTemplatesReply) Reset() {
+	*x = GetWorkflowTemplatesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkflowTemplatesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowTemplatesReply) ProtoMessage() {}
+
+func (x *GetWorkflowTemplatesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowTemplatesReply.ProtoReflect.Descriptor instead.
+func (*GetWorkflowTemplatesReply) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetWorkflowTemplatesReply) GetWorkflowTemplates() []*WorkflowTemplateInfo {
+	if x != nil {
+		return x.WorkflowTemplates
+	}
+	return nil
+}
+
+func (x *GetWorkflowTemplatesReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ListReposRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GetRevisions bool `protobuf:"varint,1,opt,name=getRevisions,proto3" json:"getRevisions,omitempty"`
+}
+
+func (x *ListReposRequest) Reset() {
+	*x = ListReposRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReposRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReposRequest) ProtoMessage() {}
+
+func (x *ListReposRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReposRequest.ProtoReflect.Descriptor instead.
+func (*ListReposRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListReposRequest) GetGetRevisions() bool {
+	if x != nil {
+		return x.GetRevisions
+	}
+	return false
+}
+
+type RepoInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Default         bool     `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
+	DefaultRevision string   `protobuf:"bytes,3,opt,name=defaultRevision,proto3" json:"defaultRevision,omitempty"`
+	Revisions       []string `protobuf:"bytes,4,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *RepoInfo) Reset() {
+	*x = RepoInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoInfo) ProtoMessage() {}
+
+func (x *RepoInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoInfo.ProtoReflect.Descriptor instead.
+func (*RepoInfo) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RepoInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepoInfo) GetDefault() bool {
+	if x != nil {
+		return x.Default
+	}
+	return false
+}
+
+func (x *RepoInfo) GetDefaultRevision() string {
+	if x != nil {
+		return x.DefaultRevision
+	}
+	return ""
+}
+
+func (x *RepoInfo) GetRevisions() []string {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type ListReposReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repos                 []*RepoInfo `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	GlobalDefaultRevision string      `protobuf:"bytes,2,opt,name=globalDefaultRevision,proto3" json:"globalDefaultRevision,omitempty"`
+	Timestamp             int64       `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+}
+
+func (x *ListReposReply) Reset() {
+	*x = ListReposReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReposReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReposReply) ProtoMessage() {}
+
+func (x *ListReposReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReposReply.ProtoReflect.Descriptor instead.
+func (*ListReposReply) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListReposReply) GetRepos() []*RepoInfo {
+	if x != nil {
+		return x.Repos
+	}
+	return nil
+}
+
+func (x *ListReposReply) GetGlobalDefaultRevision() string {
+	if x != nil {
+		return x.GlobalDefaultRevision
+	}
+	return ""
+}
+
+func (x *ListReposReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type AddRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DefaultRevision string `protobuf:"bytes,2,opt,name=defaultRevision,proto3" json:"defaultRevision,omitempty"`
+}
+
+func (x *AddRepoRequest) Reset() {
+	*x = AddRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRepoRequest) ProtoMessage() {}
+
+func (x *AddRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRepoRequest.ProtoReflect.Descriptor instead.
+func (*AddRepoRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AddRepoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddRepoRequest) GetDefaultRevision() string {
+	if x != nil {
+		return x.DefaultRevision
+	}
+	return ""
+}
+
+type AddRepoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewDefaultRevision string `protobuf:"bytes,1,opt,name=newDefaultRevision,proto3" json:"newDefaultRevision,omitempty"`
+	Info               string `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	Timestamp          int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+}
+
+func (x *AddRepoReply) Reset() {
+	*x = AddRepoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRepoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRepoReply) ProtoMessage() {}
+
+func (x *AddRepoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRepoReply.ProtoReflect.Descriptor instead.
+func (*AddRepoReply) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AddRepoReply) GetNewDefaultRevision() string {
+	if x != nil {
+		return x.NewDefaultRevision
+	}
+	return ""
+}
+
+func (x *AddRepoReply) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+func (x *AddRepoReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type RemoveRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *RemoveRepoRequest) Reset() {
+	*x = RemoveRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRepoRequest) ProtoMessage() {}
+
+func (x *RemoveRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRepoRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRepoRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RemoveRepoRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type RemoveRepoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewDefaultRepo string `protobuf:"bytes,1,opt,name=newDefaultRepo,proto3" json:"newDefaultRepo,omitempty"`
+	Timestamp      int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+}
+
+func (x *RemoveRepoReply) Reset() {
+	*x = RemoveRepoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveRepoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRepoReply) ProtoMessage() {}
+
+func (x *RemoveRepoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRepoReply.ProtoReflect.Descriptor instead.
+func (*RemoveRepoReply) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RemoveRepoReply) GetNewDefaultRepo() string {
+	if x != nil {
+		return x.NewDefaultRepo
+	}
+	return ""
+}
+
+func (x *RemoveRepoReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type RefreshReposRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *RefreshReposRequest) Reset() {
+	*x = RefreshReposRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshReposRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshReposRequest) ProtoMessage() {}
+
+func (x *RefreshReposRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshReposRequest.ProtoReflect.Descriptor instead.
+func (*RefreshReposRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RefreshReposRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type SetDefaultRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *SetDefaultRepoRequest) Reset() {
+	*x = SetDefaultRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDefaultRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultRepoRequest) ProtoMessage() {}
+
+func (x *SetDefaultRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultRepoRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultRepoRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SetDefaultRepoRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type SetGlobalDefaultRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision string `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *SetGlobalDefaultRevisionRequest) Reset() {
+	*x = SetGlobalDefaultRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGlobalDefaultRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGlobalDefaultRevisionRequest) ProtoMessage() {}
+
+func (x *SetGlobalDefaultRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGlobalDefaultRevisionRequest.ProtoReflect.Descriptor instead.
+func (*SetGlobalDefaultRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SetGlobalDefaultRevisionRequest) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+type SetRepoDefaultRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index    int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *SetRepoDefaultRevisionRequest) Reset() {
+	*x = SetRepoDefaultRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRepoDefaultRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoDefaultRevisionRequest) ProtoMessage() {}
+
+func (x *SetRepoDefaultRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoDefaultRevisionRequest.ProtoReflect.Descriptor instead.
+func (*SetRepoDefaultRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SetRepoDefaultRevisionRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SetRepoDefaultRevisionRequest) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+type SetRepoDefaultRevisionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info      string `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // timestamp of when this object was sent in unix milliseconds
+}
+
+func (x *SetRepoDefaultRevisionReply) Reset() {
+	*x = SetRepoDefaultRevisionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRepoDefaultRevisionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoDefaultRevisionReply) ProtoMessage() {}
+
+func (x *SetRepoDefaultRevisionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoDefaultRevisionReply.ProtoReflect.Descriptor instead.
+func (*SetRepoDefaultRevisionReply) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SetRepoDefaultRevisionReply) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+func (x *SetRepoDefaultRevisionReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{89}
+}
+
+type ListIntegratedServicesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Services  map[string]*IntegratedServiceInfo `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // keys are IDs (e.g. "ddsched"), the service name should be displayed to users instead
+	Timestamp int64                             `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                                                                      // timestamp of when this object was sent in unix milliseconds
+}
+
+func (x *ListIntegratedServicesReply) Reset() {
+	*x = ListIntegratedServicesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIntegratedServicesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntegratedServicesReply) ProtoMessage() {}
+
+func (x *ListIntegratedServicesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntegratedServicesReply.ProtoReflect.Descriptor instead.
+func (*ListIntegratedServicesReply) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListIntegratedServicesReply) GetServices() map[string]*IntegratedServiceInfo {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *ListIntegratedServicesReply) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type IntegratedServiceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // user-visible service name, e.g. "DD scheduler"
+	Enabled         bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Endpoint        string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	ConnectionState string `protobuf:"bytes,4,opt,name=connectionState,proto3" json:"connectionState,omitempty"` // allowed values: READY, CONNECTING, TRANSIENT_FAILURE, IDLE, SHUTDOWN
+	Data            string `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`                       // always a JSON payload with a map<string, string> inside.
+}
+
+func (x *IntegratedServiceInfo) Reset() {
+	*x = IntegratedServiceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_o2control_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntegratedServiceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegratedServiceInfo) ProtoMessage() {}
+
+func (x *IntegratedServiceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_o2control_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegratedServiceInfo.ProtoReflect.Descriptor instead.
+func (*IntegratedServiceInfo) Descriptor() ([]byte, []int) {
+	return file_protos_o2control_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *IntegratedServiceInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IntegratedServiceInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *IntegratedServiceInfo) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *IntegratedServiceInfo) GetConnectionState() string {
+	if x != nil {
+		return x.ConnectionState
+	}
+	return ""
+}
+
+func (x *IntegratedServiceInfo) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+var File_protos_o2control_proto protoreflect.FileDescriptor
+
+var file_protos_o2control_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x1a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7e, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x22, 0x95, 0x04, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x73,
+	0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x68,
+	0x6f, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x49, 0x6e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x49, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2e, 0x0a,
+	0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74,
+	0x73, 0x22, 0x59, 0x0a, 0x0f, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0b,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x22, 0xd9, 0x01, 0x0a,
+	0x0d, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x48,
+	0x0a, 0x0c, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x11, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x52, 0x11, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb3, 0x01, 0x0a, 0x19, 0x54, 0x65, 0x61,
+	0x72, 0x64, 0x6f, 0x77, 0x6e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xa0,
+	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x6f,
+	0x77, 0x41, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x68, 0x6f, 0x77,
+	0x41, 0x6c, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x68, 0x6f, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x77,
+	0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x46, 0x0a, 0x1e, 0x73, 0x68, 0x6f,
+	0x77, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x1e, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x22, 0x96, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0c,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xdb, 0x08, 0x0a, 0x0f, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x57, 0x68, 0x65, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x44,
+	0x0a, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x56,
+	0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x76, 0x61, 0x72, 0x73, 0x12, 0x44,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x56, 0x61, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x56, 0x61, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66,
+	0x46, 0x6c, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x4f, 0x66, 0x46, 0x6c, 0x70, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x4f, 0x66, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x6e,
+	0x0a, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24,
+	0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x13, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x13, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66,
+	0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x15, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x49, 0x6e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x56, 0x61, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x49, 0x0a,
+	0x1b, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xac, 0x02, 0x0a, 0x15, 0x4e, 0x65, 0x77,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x3e,
+	0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f,
+	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56,
+	0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x76, 0x61, 0x72, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x61, 0x75, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e,
+	0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x37,
+	0x0a, 0x09, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x77, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x3c, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x84, 0x02, 0x0a, 0x19, 0x4e, 0x65, 0x77, 0x41, 0x75, 0x74, 0x6f, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2a, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a,
+	0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6f, 0x32,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x75, 0x74, 0x6f, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x76, 0x61, 0x72,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2e, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x1a, 0x37, 0x0a, 0x09, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x37, 0x0a, 0x17, 0x4e, 0x65,
+	0x77, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x53, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x10,
+	0x73, 0x68, 0x6f, 0x77, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x72, 0x65, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x77, 0x57, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x54, 0x72, 0x65, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3c, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f,
+	0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x8b, 0x02, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2b, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x22, 0x6d, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x79, 0x70, 0x65, 0x12, 0x08,
+	0x0a, 0x04, 0x4e, 0x4f, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x54, 0x4f, 0x50, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12,
+	0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x09,
+	0x0a, 0x05, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x4f, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x50, 0x4c, 0x4f,
+	0x59, 0x10, 0x06, 0x22, 0x91, 0x02, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x93, 0x01, 0x0a, 0x18, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x79, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x65, 0x41, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x41, 0x6c, 0x6c, 0x22, 0xa1, 0x01,
+	0x0a, 0x14, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x31,
+	0x0a, 0x06, 0x4f, 0x70, 0x74, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4f, 0x50,
+	0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x52, 0x4f, 0x4c,
+	0x45, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x44, 0x44, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10,
+	0x04, 0x22, 0x8b, 0x01, 0x0a, 0x16, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x45, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4b, 0x0a, 0x10,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0xc1, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x6b, 0x65, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49,
+	0x6e, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x22, 0x83, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x4a, 0x0a, 0x11, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x32, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x11, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75,
+	0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x55, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x58, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xcc, 0x01, 0x0a, 0x1f, 0x53,
+	0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5a,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53,
+	0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x65, 0x74,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x73, 0x0a, 0x1f, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x73, 0x22,
+	0xb8, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x58, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x90, 0x01, 0x0a, 0x0c, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73,
+	0x6b, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0xb8, 0x01,
+	0x0a, 0x12, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x45, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e, 0x64, 0x42,
+	0x75, 0x66, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x6e,
+	0x64, 0x42, 0x75, 0x66, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x63, 0x76, 0x42,
+	0x75, 0x66, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x63,
+	0x76, 0x42, 0x75, 0x66, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x45, 0x64, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x32, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x64, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x62, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x64, 0x52,
+	0x04, 0x62, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x77, 0x0a,
+	0x0d, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
+	0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22,
+	0xe7, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x2d, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2d,
+	0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x30, 0x0a,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xd8, 0x02, 0x0a, 0x0d, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x70, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x53,
+	0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x61, 0x6e,
+	0x64, 0x62, 0x6f, 0x78, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x22, 0xbd, 0x01, 0x0a, 0x12, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x61,
+	0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x66,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x61, 0x66, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x79, 0x22, 0x48, 0x0a, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x66, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x70, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x70, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x11,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x5d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x61, 0x73,
+	0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x61,
+	0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x74,
+	0x61, 0x73, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x7d, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65,
+	0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x22, 0x4d, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22,
+	0xbe, 0x03, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x09,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x76, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6e, 0x76, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x32, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x22, 0x2f, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x73, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f,
+	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x5c, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x22, 0x86, 0x01,
+	0x0a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b,
+	0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x54, 0x61, 0x73, 0x6b,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x75, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
+	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x22, 0x61, 0x0a,
+	0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x74, 0x61,
+	0x73, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0xf6, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6f, 0x32, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x36, 0x0a, 0x16, 0x53, 0x65, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x70, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
+	0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x56, 0x0a, 0x14, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x22, 0x93, 0x02, 0x0a,
+	0x10, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65,
+	0x64, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x72, 0x64, 0x6f,
+	0x6e, 0x65, 0x64, 0x42, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65,
+	0x64, 0x57, 0x68, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x72,
+	0x64, 0x6f, 0x6e, 0x65, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x12, 0x43, 0x0a, 0x0c, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x44, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0c, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x61, 0x69,
+	0x6e, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x72, 0x61, 0x69, 0x6e,
+	0x65, 0x64, 0x22, 0x77, 0x0a, 0x11, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x22, 0x60, 0x0a, 0x0f, 0x43,
+	0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f,
+	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65,
+	0x64, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x61, 0x0a,
+	0x13, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2e, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x22, 0x31, 0x0a, 0x11, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e,
+	0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x6f, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb2, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x65, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x65, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x73, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x74, 0x65,
+	0x70, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x73, 0x74, 0x65, 0x70, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x22, 0x86, 0x01,
+	0x0a, 0x12, 0x52, 0x75, 0x6e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x22, 0x65, 0x0a, 0x10, 0x52, 0x75, 0x6e, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
+	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5b, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x35, 0x0a, 0x09, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0xdc, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x3c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e,
+	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4f, 0x70, 0x74, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a,
+	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x22, 0x34, 0x0a,
+	0x06, 0x4f, 0x70, 0x74, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4f, 0x50, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x52, 0x45, 0x53, 0x55, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x42, 0x4f, 0x52,
+	0x54, 0x10, 0x03, 0x22, 0x69, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x43,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x76, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6e, 0x76, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x68, 0x53,
+	0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x53,
+	0x70, 0x65, 0x63, 0x22, 0xd3, 0x05, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x04, 0x76, 0x61, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x56, 0x61, 0x72,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x56, 0x61, 0x72, 0x73, 0x12, 0x58, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x63, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a,
+	0x09, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x56, 0x61,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x32, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0xc9, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x6c, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22,
+	0xae, 0x04, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x56, 0x61, 0x72, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x06, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x56,
+	0x61, 0x72, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x69,
+	0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x61, 0x6e, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1c, 0x0a, 0x09, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x49, 0x66, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x49, 0x66, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x49, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x49, 0x66, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x22, 0x71, 0x0a, 0x08, 0x55, 0x69, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x12, 0x0b, 0x0a, 0x07,
+	0x65, 0x64, 0x69, 0x74, 0x42, 0x6f, 0x78, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x6c, 0x69,
+	0x64, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x78,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x44, 0x6f, 0x77, 0x6e, 0x42, 0x6f,
+	0x78, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x42, 0x6f, 0x78, 0x10,
+	0x04, 0x12, 0x12, 0x0a, 0x0e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e,
+	0x42, 0x6f, 0x78, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6f,
+	0x78, 0x10, 0x06, 0x22, 0x3b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x10, 0x02, 0x12, 0x08, 0x0a,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x10, 0x04,
+	0x22, 0xaf, 0x02, 0x0a, 0x14, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x53, 0x70, 0x65, 0x63,
+	0x4d, 0x61, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6f, 0x32, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x56, 0x61, 0x72, 0x53, 0x70,
+	0x65, 0x63, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x76, 0x61, 0x72, 0x53,
+	0x70, 0x65, 0x63, 0x4d, 0x61, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x58, 0x0a, 0x0f, 0x56, 0x61, 0x72, 0x53,
+	0x70, 0x65, 0x63, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f,
+	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x53, 0x70, 0x65, 0x63,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x88, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x4d, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x32,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x36, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x67, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x32, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x4e, 0x0a, 0x0e, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x0c, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65,
+	0x77, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x29, 0x0a, 0x11,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x57, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65,
+	0x77, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x2b, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2d, 0x0a,
+	0x15, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3d, 0x0a, 0x1f,
+	0x53, 0x65, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x1d, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4f,
+	0x0a, 0x1b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xec, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x50, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x32, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x5d, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x32, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0xc5, 0x1a, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x6f, 0x32, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x57, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x4e, 0x65,
+	0x77, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x24, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4e, 0x65, 0x77,
+	0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e,
+	0x4e, 0x65, 0x77, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20,
+	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4e, 0x65, 0x77,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24,
+	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x44, 0x65,
+	0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x24, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x44, 0x65, 0x73,
+	0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x10, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59,
+	0x0a, 0x13, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x20, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x18, 0x53, 0x65, 0x74,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65,
+	0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x72, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x32, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x28, 0x2e, 0x6f, 0x32,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x42, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x6f, 0x32,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x19,
+	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x32, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0c, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x1e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x1d, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x54, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x20, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x6f,
+	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0a, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x48, 0x6f, 0x73,
+	0x74, 0x12, 0x1c, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f,
+	0x72, 0x64, 0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x72, 0x64,
+	0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a,
+	0x0c, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e,
+	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x64,
+	0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x64,
+	0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a,
+	0x09, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x6f, 0x32, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72,
+	0x64, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x6f, 0x32, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x72, 0x64, 0x6f, 0x6e,
+	0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1d, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x75,
+	0x6e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x75, 0x6e,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x1e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x57, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x21, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f,
+	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x12, 0x1b, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x07, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x19, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0a,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1c, 0x2e, 0x6f, 0x32, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x1e, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0e, 0x53, 0x65,
+	0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x20, 0x2e, 0x6f,
+	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
+	0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x6f, 0x32, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6c,
+	0x0a, 0x16, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x09,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0x2e, 0x6f, 0x32, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x10, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x08, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1a, 0x2e, 0x6f, 0x32, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2e, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x5d, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x45, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x6f, 0x32, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f,
+	0x32, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x42, 0x54, 0x0a, 0x22, 0x63, 0x68, 0x2e, 0x63, 0x65, 0x72, 0x6e, 0x2e, 0x61, 0x6c, 0x69,
+	0x63, 0x65, 0x2e, 0x6f, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x72, 0x70,
+	0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6c, 0x69, 0x63, 0x65, 0x4f, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x2f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x3b, 0x70, 0x62, 0x50, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_protos_o2control_proto_rawDescOnce sync.Once
+	file_protos_o2control_proto_rawDescData = file_protos_o2control_proto_rawDesc
+)
+
+func file_protos_o2control_proto_rawDescGZIP() []byte {
+	file_protos_o2control_proto_rawDescOnce.Do(func() {
+		file_protos_o2control_proto_rawDescData = protoimpl.X.CompressGZIP(file_protos_o2control_proto_rawDescData)
+	})
+	return file_protos_o2control_proto_rawDescData
+}
+
+var file_protos_o2control_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_protos_o2control_proto_msgTypes = make([]protoimpl.MessageInfo, 108)
+var file_protos_o2control_proto_goTypes = []interface{}{
+	(ControlEnvironmentRequest_Optype)(0),   // 0: o2control.ControlEnvironmentRequest.Optype
+	(EnvironmentOperation_Optype)(0),        // 1: o2control.EnvironmentOperation.Optype
+	(ControlSequenceRequest_Optype)(0),      // 2: o2control.ControlSequenceRequest.Optype
+	(VarSpecMessage_UiWidget)(0),            // 3: o2control.VarSpecMessage.UiWidget
+	(VarSpecMessage_Type)(0),                // 4: o2control.VarSpecMessage.Type
+	(*SubscribeRequest)(nil),                // 5: o2control.SubscribeRequest
+	(*GetFrameworkInfoRequest)(nil),         // 6: o2control.GetFrameworkInfoRequest
+	(*Version)(nil),                         // 7: o2control.Version
+	(*GetFrameworkInfoReply)(nil),           // 8: o2control.GetFrameworkInfoReply
+	(*TeardownRequest)(nil),                 // 9: o2control.TeardownRequest
+	(*TeardownReply)(nil),                   // 10: o2control.TeardownReply
+	(*TeardownEnvironmentResult)(nil),       // 11: o2control.TeardownEnvironmentResult
+	(*GetEnvironmentsRequest)(nil),          // 12: o2control.GetEnvironmentsRequest
+	(*GetEnvironmentsReply)(nil),            // 13: o2control.GetEnvironmentsReply
+	(*EnvironmentInfo)(nil),                 // 14: o2control.EnvironmentInfo
+	(*NewEnvironmentRequest)(nil),           // 15: o2control.NewEnvironmentRequest
+	(*NewEnvironmentReply)(nil),             // 16: o2control.NewEnvironmentReply
+	(*NewAutoEnvironmentRequest)(nil),       // 17: o2control.NewAutoEnvironmentRequest
+	(*NewAutoEnvironmentReply)(nil),         // 18: o2control.NewAutoEnvironmentReply
+	(*GetEnvironmentRequest)(nil),           // 19: o2control.GetEnvironmentRequest
+	(*GetEnvironmentReply)(nil),             // 20: o2control.GetEnvironmentReply
+	(*ControlEnvironmentRequest)(nil),       // 21: o2control.ControlEnvironmentRequest
+	(*ControlEnvironmentReply)(nil),         // 22: o2control.ControlEnvironmentReply
+	(*ModifyEnvironmentRequest)(nil),        // 23: o2control.ModifyEnvironmentRequest
+	(*EnvironmentOperation)(nil),            // 24: o2control.EnvironmentOperation
+	(*ModifyEnvironmentReply)(nil),          // 25: o2control.ModifyEnvironmentReply
+	(*DestroyEnvironmentRequest)(nil),       // 26: o2control.DestroyEnvironmentRequest
+	(*DestroyEnvironmentReply)(nil),         // 27: o2control.DestroyEnvironmentReply
+	(*GetActiveDetectorsReply)(nil),         // 28: o2control.GetActiveDetectorsReply
+	(*GetAvailableDetectorsReply)(nil),      // 29: o2control.GetAvailableDetectorsReply
+	(*SetEnvironmentPropertiesRequest)(nil), // 30: o2control.SetEnvironmentPropertiesRequest
+	(*SetEnvironmentPropertiesReply)(nil),   // 31: o2control.SetEnvironmentPropertiesReply
+	(*GetEnvironmentPropertiesRequest)(nil), // 32: o2control.GetEnvironmentPropertiesRequest
+	(*GetEnvironmentPropertiesReply)(nil),   // 33: o2control.GetEnvironmentPropertiesReply
+	(*TopologyNode)(nil),                    // 34: o2control.TopologyNode
+	(*TopologyChannelEnd)(nil),              // 35: o2control.TopologyChannelEnd
+	(*TopologyEdge)(nil),                    // 36: o2control.TopologyEdge
+	(*TopologyIssue)(nil),                   // 37: o2control.TopologyIssue
+	(*GetEnvironmentTopologyRequest)(nil),   // 38: o2control.GetEnvironmentTopologyRequest
+	(*GetEnvironmentTopologyReply)(nil),     // 39: o2control.GetEnvironmentTopologyReply
+	(*ShortTaskInfo)(nil),                   // 40: o2control.ShortTaskInfo
+	(*TaskDeploymentInfo)(nil),              // 41: o2control.TaskDeploymentInfo
+	(*TaskAffinityInfo)(nil),                // 42: o2control.TaskAffinityInfo
+	(*GetTasksRequest)(nil),                 // 43: o2control.GetTasksRequest
+	(*GetTasksReply)(nil),                   // 44: o2control.GetTasksReply
+	(*GetTaskRequest)(nil),                  // 45: o2control.GetTaskRequest
+	(*GetTaskReply)(nil),                    // 46: o2control.GetTaskReply
+	(*CommandInfo)(nil),                     // 47: o2control.CommandInfo
+	(*ChannelInfo)(nil),                     // 48: o2control.ChannelInfo
+	(*TaskInfo)(nil),                        // 49: o2control.TaskInfo
+	(*CleanupTasksRequest)(nil),             // 50: o2control.CleanupTasksRequest
+	(*CleanupTasksReply)(nil),               // 51: o2control.CleanupTasksReply
+	(*RestartTaskRequest)(nil),              // 52: o2control.RestartTaskRequest
+	(*RestartTaskReply)(nil),                // 53: o2control.RestartTaskReply
+	(*TransitionTaskRequest)(nil),           // 54: o2control.TransitionTaskRequest
+	(*TransitionTaskReply)(nil),             // 55: o2control.TransitionTaskReply
+	(*SetTaskPropertiesRequest)(nil),        // 56: o2control.SetTaskPropertiesRequest
+	(*SetTaskPropertiesReply)(nil),          // 57: o2control.SetTaskPropertiesReply
+	(*GetTaskLogsRequest)(nil),              // 58: o2control.GetTaskLogsRequest
+	(*GetTaskLogsReply)(nil),                // 59: o2control.GetTaskLogsReply
+	(*HostDrainEnvironment)(nil),            // 60: o2control.HostDrainEnvironment
+	(*CordonedHostInfo)(nil),                // 61: o2control.CordonedHostInfo
+	(*CordonHostRequest)(nil),               // 62: o2control.CordonHostRequest
+	(*CordonHostReply)(nil),                 // 63: o2control.CordonHostReply
+	(*UncordonHostRequest)(nil),             // 64: o2control.UncordonHostRequest
+	(*UncordonHostReply)(nil),               // 65: o2control.UncordonHostReply
+	(*GetCordonedHostsRequest)(nil),         // 66: o2control.GetCordonedHostsRequest
+	(*GetCordonedHostsReply)(nil),           // 67: o2control.GetCordonedHostsReply
+	(*SequenceInfo)(nil),                    // 68: o2control.SequenceInfo
+	(*RunSequenceRequest)(nil),              // 69: o2control.RunSequenceRequest
+	(*RunSequenceReply)(nil),                // 70: o2control.RunSequenceReply
+	(*GetSequencesRequest)(nil),             // 71: o2control.GetSequencesRequest
+	(*GetSequencesReply)(nil),               // 72: o2control.GetSequencesReply
+	(*ControlSequenceRequest)(nil),          // 73: o2control.ControlSequenceRequest
+	(*ControlSequenceReply)(nil),            // 74: o2control.ControlSequenceReply
+	(*GetRolesRequest)(nil),                 // 75: o2control.GetRolesRequest
+	(*RoleInfo)(nil),                        // 76: o2control.RoleInfo
+	(*GetRolesReply)(nil),                   // 77: o2control.GetRolesReply
+	(*GetWorkflowTemplatesRequest)(nil),     // 78: o2control.GetWorkflowTemplatesRequest
+	(*VarSpecMessage)(nil),                  // 79: o2control.VarSpecMessage
+	(*WorkflowTemplateInfo)(nil),            // 80: o2control.WorkflowTemplateInfo
+	(*GetWorkflowTemplatesReply)(nil),       // 81: o2control.GetWorkflowTemplatesReply
+	(*ListReposRequest)(nil),                // 82: o2control.ListReposRequest
+	(*RepoInfo)(nil),                        // 83: o2control.RepoInfo
+	(*ListReposReply)(nil),                  // 84: o2control.ListReposReply
+	(*AddRepoRequest)(nil),                  // 85: o2control.AddRepoRequest
+	(*AddRepoReply)(nil),                    // 86: o2control.AddRepoReply
+	(*RemoveRepoRequest)(nil),               // 87: o2control.RemoveRepoRequest
+	(*RemoveRepoReply)(nil),                 // 88: o2control.RemoveRepoReply
+	(*RefreshReposRequest)(nil),             // 89: o2control.RefreshReposRequest
+	(*SetDefaultRepoRequest)(nil),           // 90: o2control.SetDefaultRepoRequest
+	(*SetGlobalDefaultRevisionRequest)(nil), // 91: o2control.SetGlobalDefaultRevisionRequest
+	(*SetRepoDefaultRevisionRequest)(nil),   // 92: o2control.SetRepoDefaultRevisionRequest
+	(*SetRepoDefaultRevisionReply)(nil),     // 93: o2control.SetRepoDefaultRevisionReply
+	(*Empty)(nil),                           // 94: o2control.Empty
+	(*ListIntegratedServicesReply)(nil),     // 95: o2control.ListIntegratedServicesReply
+	(*IntegratedServiceInfo)(nil),           // 96: o2control.IntegratedServiceInfo
+	nil,                                     // 97: o2control.EnvironmentInfo.DefaultsEntry
+	nil,                                     // 98: o2control.EnvironmentInfo.VarsEntry
+	nil,                                     // 99: o2control.EnvironmentInfo.UserVarsEntry
+	nil,                                     // 100: o2control.EnvironmentInfo.IntegratedServicesDataEntry
+	nil,                                     // 101: o2control.NewEnvironmentRequest.VarsEntry
+	nil,                                     // 102: o2control.NewAutoEnvironmentRequest.VarsEntry
+	nil,                                     // 103: o2control.SetEnvironmentPropertiesRequest.PropertiesEntry
+	nil,                                     // 104: o2control.GetEnvironmentPropertiesReply.PropertiesEntry
+	nil,                                     // 105: o2control.TaskInfo.PropertiesEntry
+	nil,                                     // 106: o2control.SetTaskPropertiesRequest.PropertiesEntry
+	nil,                                     // 107: o2control.RoleInfo.DefaultsEntry
+	nil,                                     // 108: o2control.RoleInfo.VarsEntry
+	nil,                                     // 109: o2control.RoleInfo.UserVarsEntry
+	nil,                                     // 110: o2control.RoleInfo.ConsolidatedStackEntry
+	nil,                                     // 111: o2control.WorkflowTemplateInfo.VarSpecMapEntry
+	nil,                                     // 112: o2control.ListIntegratedServicesReply.ServicesEntry
+	(*protos.User)(nil),                     // 113: common.User
+	(*protos.Event)(nil),                    // 114: events.Event
+}
+var file_protos_o2control_proto_depIdxs = []int32{
+	7,   // 0: o2control.GetFrameworkInfoReply.version:type_name -> o2control.Version
+	113, // 1: o2control.TeardownRequest.requestUser:type_name -> common.User
+	11,  // 2: o2control.TeardownReply.environments:type_name -> o2control.TeardownEnvironmentResult
+	51,  // 3: o2control.TeardownReply.cleanupTasksReply:type_name -> o2control.CleanupTasksReply
+	14,  // 4: o2control.GetEnvironmentsReply.environments:type_name -> o2control.EnvironmentInfo
+	40,  // 5: o2control.EnvironmentInfo.tasks:type_name -> o2control.ShortTaskInfo
+	97,  // 6: o2control.EnvironmentInfo.defaults:type_name -> o2control.EnvironmentInfo.DefaultsEntry
+	98,  // 7: o2control.EnvironmentInfo.vars:type_name -> o2control.EnvironmentInfo.VarsEntry
+	99,  // 8: o2control.EnvironmentInfo.userVars:type_name -> o2control.EnvironmentInfo.UserVarsEntry
+	100, // 9: o2control.EnvironmentInfo.integratedServicesData:type_name -> o2control.EnvironmentInfo.IntegratedServicesDataEntry
+	101, // 10: o2control.NewEnvironmentRequest.vars:type_name -> o2control.NewEnvironmentRequest.VarsEntry
+	113, // 11: o2control.NewEnvironmentRequest.requestUser:type_name -> common.User
+	14,  // 12: o2control.NewEnvironmentReply.environment:type_name -> o2control.EnvironmentInfo
+	102, // 13: o2control.NewAutoEnvironmentRequest.vars:type_name -> o2control.NewAutoEnvironmentRequest.VarsEntry
+	113, // 14: o2control.NewAutoEnvironmentRequest.requestUser:type_name -> common.User
+	14,  // 15: o2control.GetEnvironmentReply.environment:type_name -> o2control.EnvironmentInfo
+	76,  // 16: o2control.GetEnvironmentReply.workflow:type_name -> o2control.RoleInfo
+	0,   // 17: o2control.ControlEnvironmentRequest.type:type_name -> o2control.ControlEnvironmentRequest.Optype
+	113, // 18: o2control.ControlEnvironmentRequest.requestUser:type_name -> common.User
+	24,  // 19: o2control.ModifyEnvironmentRequest.operations:type_name -> o2control.EnvironmentOperation
+	1,   // 20: o2control.EnvironmentOperation.type:type_name -> o2control.EnvironmentOperation.Optype
+	24,  // 21: o2control.ModifyEnvironmentReply.failedOperations:type_name -> o2control.EnvironmentOperation
+	113, // 22: o2control.DestroyEnvironmentRequest.requestUser:type_name -> common.User
+	51,  // 23: o2control.DestroyEnvironmentReply.cleanupTasksReply:type_name -> o2control.CleanupTasksReply
+	103, // 24: o2control.SetEnvironmentPropertiesRequest.properties:type_name -> o2control.SetEnvironmentPropertiesRequest.PropertiesEntry
+	104, // 25: o2control.GetEnvironmentPropertiesReply.properties:type_name -> o2control.GetEnvironmentPropertiesReply.PropertiesEntry
+	35,  // 26: o2control.TopologyEdge.connect:type_name -> o2control.TopologyChannelEnd
+	35,  // 27: o2control.TopologyEdge.bind:type_name -> o2control.TopologyChannelEnd
+	34,  // 28: o2control.GetEnvironmentTopologyReply.nodes:type_name -> o2control.TopologyNode
+	36,  // 29: o2control.GetEnvironmentTopologyReply.edges:type_name -> o2control.TopologyEdge
+	37,  // 30: o2control.GetEnvironmentTopologyReply.issues:type_name -> o2control.TopologyIssue
+	41,  // 31: o2control.ShortTaskInfo.deploymentInfo:type_name -> o2control.TaskDeploymentInfo
+	42,  // 32: o2control.TaskDeploymentInfo.affinity:type_name -> o2control.TaskAffinityInfo
+	40,  // 33: o2control.GetTasksReply.tasks:type_name -> o2control.ShortTaskInfo
+	49,  // 34: o2control.GetTaskReply.task:type_name -> o2control.TaskInfo
+	40,  // 35: o2control.TaskInfo.shortInfo:type_name -> o2control.ShortTaskInfo
+	48,  // 36: o2control.TaskInfo.inboundChannels:type_name -> o2control.ChannelInfo
+	48,  // 37: o2control.TaskInfo.outboundChannels:type_name -> o2control.ChannelInfo
+	47,  // 38: o2control.TaskInfo.commandInfo:type_name -> o2control.CommandInfo
+	105, // 39: o2control.TaskInfo.properties:type_name -> o2control.TaskInfo.PropertiesEntry
+	40,  // 40: o2control.CleanupTasksReply.killedTasks:type_name -> o2control.ShortTaskInfo
+	40,  // 41: o2control.CleanupTasksReply.runningTasks:type_name -> o2control.ShortTaskInfo
+	113, // 42: o2control.RestartTaskRequest.requestUser:type_name -> common.User
+	40,  // 43: o2control.RestartTaskReply.task:type_name -> o2control.ShortTaskInfo
+	113, // 44: o2control.TransitionTaskRequest.requestUser:type_name -> common.User
+	40,  // 45: o2control.TransitionTaskReply.task:type_name -> o2control.ShortTaskInfo
+	106, // 46: o2control.SetTaskPropertiesRequest.properties:type_name -> o2control.SetTaskPropertiesRequest.PropertiesEntry
+	113, // 47: o2control.SetTaskPropertiesRequest.requestUser:type_name -> common.User
+	60,  // 48: o2control.CordonedHostInfo.environments:type_name -> o2control.HostDrainEnvironment
+	113, // 49: o2control.CordonHostRequest.requestUser:type_name -> common.User
+	61,  // 50: o2control.CordonHostReply.host:type_name -> o2control.CordonedHostInfo
+	113, // 51: o2control.UncordonHostRequest.requestUser:type_name -> common.User
+	61,  // 52: o2control.GetCordonedHostsReply.hosts:type_name -> o2control.CordonedHostInfo
+	113, // 53: o2control.RunSequenceRequest.requestUser:type_name -> common.User
+	68,  // 54: o2control.RunSequenceReply.sequence:type_name -> o2control.SequenceInfo
+	68,  // 55: o2control.GetSequencesReply.sequences:type_name -> o2control.SequenceInfo
+	2,   // 56: o2control.ControlSequenceRequest.type:type_name -> o2control.ControlSequenceRequest.Optype
+	113, // 57: o2control.ControlSequenceRequest.requestUser:type_name -> common.User
+	68,  // 58: o2control.ControlSequenceReply.sequence:type_name -> o2control.SequenceInfo
+	76,  // 59: o2control.RoleInfo.roles:type_name -> o2control.RoleInfo
+	107, // 60: o2control.RoleInfo.defaults:type_name -> o2control.RoleInfo.DefaultsEntry
+	108, // 61: o2control.RoleInfo.vars:type_name -> o2control.RoleInfo.VarsEntry
+	109, // 62: o2control.RoleInfo.userVars:type_name -> o2control.RoleInfo.UserVarsEntry
+	110, // 63: o2control.RoleInfo.consolidatedStack:type_name -> o2control.RoleInfo.ConsolidatedStackEntry
+	76,  // 64: o2control.GetRolesReply.roles:type_name -> o2control.RoleInfo
+	4,   // 65: o2control.VarSpecMessage.type:type_name -> o2control.VarSpecMessage.Type
+	3,   // 66: o2control.VarSpecMessage.widget:type_name -> o2control.VarSpecMessage.UiWidget
+	111, // 67: o2control.WorkflowTemplateInfo.varSpecMap:type_name -> o2control.WorkflowTemplateInfo.VarSpecMapEntry
+	80,  // 68: o2control.GetWorkflowTemplatesReply.workflowTemplates:type_name -> o2control.WorkflowTemplateInfo
+	83,  // 69: o2control.ListReposReply.repos:type_name -> o2control.RepoInfo
+	112, // 70: o2control.ListIntegratedServicesReply.services:type_name -> o2control.ListIntegratedServicesReply.ServicesEntry
+	79,  // 71: o2control.WorkflowTemplateInfo.VarSpecMapEntry.value:type_name -> o2control.VarSpecMessage
+	96,  // 72: o2control.ListIntegratedServicesReply.ServicesEntry.value:type_name -> o2control.IntegratedServiceInfo
+	6,   // 73: o2control.Control.GetFrameworkInfo:input_type -> o2control.GetFrameworkInfoRequest
+	12,  // 74: o2control.Control.GetEnvironments:input_type -> o2control.GetEnvironmentsRequest
+	17,  // 75: o2control.Control.NewAutoEnvironment:input_type -> o2control.NewAutoEnvironmentRequest
+	15,  // 76: o2control.Control.NewEnvironment:input_type -> o2control.NewEnvironmentRequest
+	19,  // 77: o2control.Control.GetEnvironment:input_type -> o2control.GetEnvironmentRequest
+	21,  // 78: o2control.Control.ControlEnvironment:input_type -> o2control.ControlEnvironmentRequest
+	26,  // 79: o2control.Control.DestroyEnvironment:input_type -> o2control.DestroyEnvironmentRequest
+	94,  // 80: o2control.Control.GetActiveDetectors:input_type -> o2control.Empty
+	94,  // 81: o2control.Control.GetAvailableDetectors:input_type -> o2control.Empty
+	15,  // 82: o2control.Control.NewEnvironmentAsync:input_type -> o2control.NewEnvironmentRequest
+	30,  // 83: o2control.Control.SetEnvironmentProperties:input_type -> o2control.SetEnvironmentPropertiesRequest
+	32,  // 84: o2control.Control.GetEnvironmentProperties:input_type -> o2control.GetEnvironmentPropertiesRequest
+	38,  // 85: o2control.Control.GetEnvironmentTopology:input_type -> o2control.GetEnvironmentTopologyRequest
+	43,  // 86: o2control.Control.GetTasks:input_type -> o2control.GetTasksRequest
+	45,  // 87: o2control.Control.GetTask:input_type -> o2control.GetTaskRequest
+	50,  // 88: o2control.Control.CleanupTasks:input_type -> o2control.CleanupTasksRequest
+	52,  // 89: o2control.Control.RestartTask:input_type -> o2control.RestartTaskRequest
+	54,  // 90: o2control.Control.TransitionTask:input_type -> o2control.TransitionTaskRequest
+	56,  // 91: o2control.Control.SetTaskProperties:input_type -> o2control.SetTaskPropertiesRequest
+	58,  // 92: o2control.Control.GetTaskLogs:input_type -> o2control.GetTaskLogsRequest
+	62,  // 93: o2control.Control.CordonHost:input_type -> o2control.CordonHostRequest
+	64,  // 94: o2control.Control.UncordonHost:input_type -> o2control.UncordonHostRequest
+	62,  // 95: o2control.Control.DrainHost:input_type -> o2control.CordonHostRequest
+	66,  // 96: o2control.Control.GetCordonedHosts:input_type -> o2control.GetCordonedHostsRequest
+	69,  // 97: o2control.Control.RunSequence:input_type -> o2control.RunSequenceRequest
+	71,  // 98: o2control.Control.GetSequences:input_type -> o2control.GetSequencesRequest
+	73,  // 99: o2control.Control.ControlSequence:input_type -> o2control.ControlSequenceRequest
+	75,  // 100: o2control.Control.GetRoles:input_type -> o2control.GetRolesRequest
+	78,  // 101: o2control.Control.GetWorkflowTemplates:input_type -> o2control.GetWorkflowTemplatesRequest
+	82,  // 102: o2control.Control.ListRepos:input_type -> o2control.ListReposRequest
+	85,  // 103: o2control.Control.AddRepo:input_type -> o2control.AddRepoRequest
+	87,  // 104: o2control.Control.RemoveRepo:input_type -> o2control.RemoveRepoRequest
+	89,  // 105: o2control.Control.RefreshRepos:input_type -> o2control.RefreshReposRequest
+	90,  // 106: o2control.Control.SetDefaultRepo:input_type -> o2control.SetDefaultRepoRequest
+	91,  // 107: o2control.Control.SetGlobalDefaultRevision:input_type -> o2control.SetGlobalDefaultRevisionRequest
+	92,  // 108: o2control.Control.SetRepoDefaultRevision:input_type -> o2control.SetRepoDefaultRevisionRequest
+	5,   // 109: o2control.Control.Subscribe:input_type -> o2control.SubscribeRequest
+	94,  // 110: o2control.Control.GetIntegratedServices:input_type -> o2control.Empty
+	9,   // 111: o2control.Control.Teardown:input_type -> o2control.TeardownRequest
+	23,  // 112: o2control.Control.ModifyEnvironment:input_type -> o2control.ModifyEnvironmentRequest
+	8,   // 113: o2control.Control.GetFrameworkInfo:output_type -> o2control.GetFrameworkInfoReply
+	13,  // 114: o2control.Control.GetEnvironments:output_type -> o2control.GetEnvironmentsReply
+	18,  // 115: o2control.Control.NewAutoEnvironment:output_type -> o2control.NewAutoEnvironmentReply
+	16,  // 116: o2control.Control.NewEnvironment:output_type -> o2control.NewEnvironmentReply
+	20,  // 117: o2control.Control.GetEnvironment:output_type -> o2control.GetEnvironmentReply
+	22,  // 118: o2control.Control.ControlEnvironment:output_type -> o2control.ControlEnvironmentReply
+	27,  // 119: o2control.Control.DestroyEnvironment:output_type -> o2control.DestroyEnvironmentReply
+	28,  // 120: o2control.Control.GetActiveDetectors:output_type -> o2control.GetActiveDetectorsReply
+	29,  // 121: o2control.Control.GetAvailableDetectors:output_type -> o2control.GetAvailableDetectorsReply
+	16,  // 122: o2control.Control.NewEnvironmentAsync:output_type -> o2control.NewEnvironmentReply
+	31,  // 123: o2control.Control.SetEnvironmentProperties:output_type -> o2control.SetEnvironmentPropertiesReply
+	33,  // 124: o2control.Control.GetEnvironmentProperties:output_type -> o2control.GetEnvironmentPropertiesReply
+	39,  // 125: o2control.Control.GetEnvironmentTopology:output_type -> o2control.GetEnvironmentTopologyReply
+	44,  // 126: o2control.Control.GetTasks:output_type -> o2control.GetTasksReply
+	46,  // 127: o2control.Control.GetTask:output_type -> o2control.GetTaskReply
+	51,  // 128: o2control.Control.CleanupTasks:output_type -> o2control.CleanupTasksReply
+	53,  // 129: o2control.Control.RestartTask:output_type -> o2control.RestartTaskReply
+	55,  // 130: o2control.Control.TransitionTask:output_type -> o2control.TransitionTaskReply
+	57,  // 131: o2control.Control.SetTaskProperties:output_type -> o2control.SetTaskPropertiesReply
+	59,  // 132: o2control.Control.GetTaskLogs:output_type -> o2control.GetTaskLogsReply
+	63,  // 133: o2control.Control.CordonHost:output_type -> o2control.CordonHostReply
+	65,  // 134: o2control.Control.UncordonHost:output_type -> o2control.UncordonHostReply
+	63,  // 135: o2control.Control.DrainHost:output_type -> o2control.CordonHostReply
+	67,  // 136: o2control.Control.GetCordonedHosts:output_type -> o2control.GetCordonedHostsReply
+	70,  // 137: o2control.Control.RunSequence:output_type -> o2control.RunSequenceReply
+	72,  // 138: o2control.Control.GetSequences:output_type -> o2control.GetSequencesReply
+	74,  // 139: o2control.Control.ControlSequence:output_type -> o2control.ControlSequenceReply
+	77,  // 140: o2control.Control.GetRoles:output_type -> o2control.GetRolesReply
+	81,  // 141: o2control.Control.GetWorkflowTemplates:output_type -> o2control.GetWorkflowTemplatesReply
+	84,  // 142: o2control.Control.ListRepos:output_type -> o2control.ListReposReply
+	86,  // 143: o2control.Control.AddRepo:output_type -> o2control.AddRepoReply
+	88,  // 144: o2control.Control.RemoveRepo:output_type -> o2control.RemoveRepoReply
+	94,  // 145: o2control.Control.RefreshRepos:output_type -> o2control.Empty
+	94,  // 146: o2control.Control.SetDefaultRepo:output_type -> o2control.Empty
+	94,  // 147: o2control.Control.SetGlobalDefaultRevision:output_type -> o2control.Empty
+	93,  // 148: o2control.Control.SetRepoDefaultRevision:output_type -> o2control.SetRepoDefaultRevisionReply
+	114, // 149: o2control.Control.Subscribe:output_type -> events.Event
+	95,  // 150: o2control.Control.GetIntegratedServices:output_type -> o2control.ListIntegratedServicesReply
+	10,  // 151: o2control.Control.Teardown:output_type -> o2control.TeardownReply
+	25,  // 152: o2control.Control.ModifyEnvironment:output_type -> o2control.ModifyEnvironmentReply
+	113, // [113:153] is the sub-list for method output_type
+	73,  // [73:113] is the sub-list for method input_type
+	73,  // [73:73] is the sub-list for extension type_name
+	73,  // [73:73] is the sub-list for extension extendee
+	0,   // [0:73] is the sub-list for field type_name
+}
+
+func init() { file_protos_o2control_proto_init() }
+func file_protos_o2control_proto_init() {
+	if File_protos_o2control_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protos_o2control_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFrameworkInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Version); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFrameworkInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeardownRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeardownReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeardownEnvironmentResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnvironmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnvironmentsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvironmentInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewEnvironmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewEnvironmentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewAutoEnvironmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewAutoEnvironmentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnvironmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnvironmentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlEnvironmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlEnvironmentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyEnvironmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvironmentOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyEnvironmentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestroyEnvironmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5364,8 +8244,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFrameworkInfoRequest); i {
+		file_protos_o2control_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestroyEnvironmentReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5376,8 +8256,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Version); i {
+		file_protos_o2control_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActiveDetectorsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5388,8 +8268,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFrameworkInfoReply); i {
+		file_protos_o2control_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAvailableDetectorsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5400,8 +8280,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TeardownRequest); i {
+		file_protos_o2control_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEnvironmentPropertiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5412,8 +8292,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TeardownReply); i {
+		file_protos_o2control_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEnvironmentPropertiesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5424,8 +8304,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnvironmentsRequest); i {
+		file_protos_o2control_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnvironmentPropertiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5436,8 +8316,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnvironmentsReply); i {
+		file_protos_o2control_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnvironmentPropertiesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5448,8 +8328,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnvironmentInfo); i {
+		file_protos_o2control_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologyNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5460,8 +8340,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewEnvironmentRequest); i {
+		file_protos_o2control_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologyChannelEnd); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5472,8 +8352,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewEnvironmentReply); i {
+		file_protos_o2control_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologyEdge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5484,8 +8364,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewAutoEnvironmentRequest); i {
+		file_protos_o2control_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologyIssue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5496,8 +8376,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewAutoEnvironmentReply); i {
+		file_protos_o2control_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnvironmentTopologyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5508,8 +8388,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnvironmentRequest); i {
+		file_protos_o2control_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnvironmentTopologyReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5520,8 +8400,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnvironmentReply); i {
+		file_protos_o2control_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortTaskInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5532,8 +8412,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControlEnvironmentRequest); i {
+		file_protos_o2control_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskDeploymentInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5544,8 +8424,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ControlEnvironmentReply); i {
+		file_protos_o2control_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskAffinityInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5556,8 +8436,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyEnvironmentRequest); i {
+		file_protos_o2control_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTasksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5568,8 +8448,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnvironmentOperation); i {
+		file_protos_o2control_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTasksReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5580,8 +8460,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyEnvironmentReply); i {
+		file_protos_o2control_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5592,8 +8472,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestroyEnvironmentRequest); i {
+		file_protos_o2control_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTaskReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5604,8 +8484,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestroyEnvironmentReply); i {
+		file_protos_o2control_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5616,8 +8496,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetActiveDetectorsReply); i {
+		file_protos_o2control_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5628,8 +8508,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAvailableDetectorsReply); i {
+		file_protos_o2control_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5640,8 +8520,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetEnvironmentPropertiesRequest); i {
+		file_protos_o2control_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupTasksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5652,8 +8532,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetEnvironmentPropertiesReply); i {
+		file_protos_o2control_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupTasksReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5664,8 +8544,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnvironmentPropertiesRequest); i {
+		file_protos_o2control_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartTaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5676,8 +8556,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnvironmentPropertiesReply); i {
+		file_protos_o2control_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartTaskReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5688,8 +8568,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShortTaskInfo); i {
+		file_protos_o2control_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitionTaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5700,8 +8580,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskDeploymentInfo); i {
+		file_protos_o2control_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitionTaskReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5712,8 +8592,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTasksRequest); i {
+		file_protos_o2control_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTaskPropertiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5724,8 +8604,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTasksReply); i {
+		file_protos_o2control_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTaskPropertiesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5736,8 +8616,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTaskRequest); i {
+		file_protos_o2control_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTaskLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5748,8 +8628,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTaskReply); i {
+		file_protos_o2control_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTaskLogsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5760,8 +8640,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommandInfo); i {
+		file_protos_o2control_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostDrainEnvironment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5772,8 +8652,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelInfo); i {
+		file_protos_o2control_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CordonedHostInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5784,8 +8664,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskInfo); i {
+		file_protos_o2control_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CordonHostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5796,8 +8676,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanupTasksRequest); i {
+		file_protos_o2control_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CordonHostReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5808,8 +8688,8 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanupTasksReply); i {
+		file_protos_o2control_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UncordonHostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5820,7 +8700,127 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UncordonHostReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCordonedHostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCordonedHostsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SequenceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunSequenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunSequenceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSequencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSequencesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlSequenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlSequenceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_o2control_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRolesRequest); i {
 			case 0:
 				return &v.state
@@ -5832,7 +8832,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RoleInfo); i {
 			case 0:
 				return &v.state
@@ -5844,7 +8844,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRolesReply); i {
 			case 0:
 				return &v.state
@@ -5856,7 +8856,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetWorkflowTemplatesRequest); i {
 			case 0:
 				return &v.state
@@ -5868,7 +8868,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VarSpecMessage); i {
 			case 0:
 				return &v.state
@@ -5880,7 +8880,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WorkflowTemplateInfo); i {
 			case 0:
 				return &v.state
@@ -5892,7 +8892,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetWorkflowTemplatesReply); i {
 			case 0:
 				return &v.state
@@ -5904,7 +8904,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListReposRequest); i {
 			case 0:
 				return &v.state
@@ -5916,7 +8916,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepoInfo); i {
 			case 0:
 				return &v.state
@@ -5928,7 +8928,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListReposReply); i {
 			case 0:
 				return &v.state
@@ -5940,7 +8940,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddRepoRequest); i {
 			case 0:
 				return &v.state
@@ -5952,7 +8952,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddRepoReply); i {
 			case 0:
 				return &v.state
@@ -5964,7 +8964,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveRepoRequest); i {
 			case 0:
 				return &v.state
@@ -5976,7 +8976,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveRepoReply); i {
 			case 0:
 				return &v.state
@@ -5988,7 +8988,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefreshReposRequest); i {
 			case 0:
 				return &v.state
@@ -6000,7 +9000,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetDefaultRepoRequest); i {
 			case 0:
 				return &v.state
@@ -6012,7 +9012,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetGlobalDefaultRevisionRequest); i {
 			case 0:
 				return &v.state
@@ -6024,7 +9024,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetRepoDefaultRevisionRequest); i {
 			case 0:
 				return &v.state
@@ -6036,7 +9036,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetRepoDefaultRevisionReply); i {
 			case 0:
 				return &v.state
@@ -6048,7 +9048,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
@@ -6060,7 +9060,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListIntegratedServicesReply); i {
 			case 0:
 				return &v.state
@@ -6072,7 +9072,7 @@ func file_protos_o2control_proto_init() {
 				return nil
 			}
 		}
-		file_protos_o2control_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_o2control_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IntegratedServiceInfo); i {
 			case 0:
 				return &v.state
@@ -6090,8 +9090,8 @@ func file_protos_o2control_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_o2control_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   76,
+			NumEnums:      5,
+			NumMessages:   108,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
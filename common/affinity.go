@@ -0,0 +1,115 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TaskAffinity is the CPU and memory placement the executor applies to a
+// task and all of its children. Cpus is a Linux CPU list (e.g. "0-7,16-23"),
+// MemoryNodes is the list of NUMA nodes memory is allocated from.
+type TaskAffinity struct {
+	Cpus        string `json:"cpus,omitempty"`
+	MemoryNodes string `json:"memoryNodes,omitempty"`
+}
+
+func (a *TaskAffinity) IsEmpty() bool {
+	return a == nil || (len(a.Cpus) == 0 && len(a.MemoryNodes) == 0)
+}
+
+// ParseCpuList parses a Linux CPU list such as "0-3,8,10-11", as found in
+// /sys/devices/system/node/node*/cpulist, into a sorted list of unique IDs.
+func ParseCpuList(list string) ([]int, error) {
+	seen := make(map[int]struct{})
+	for _, item := range strings.Split(strings.TrimSpace(list), ",") {
+		item = strings.TrimSpace(item)
+		if len(item) == 0 {
+			continue
+		}
+		first, last, isRange := strings.Cut(item, "-")
+		begin, err := strconv.Atoi(strings.TrimSpace(first))
+		if err != nil || begin < 0 {
+			return nil, fmt.Errorf("invalid CPU list item %q", item)
+		}
+		end := begin
+		if isRange {
+			end, err = strconv.Atoi(strings.TrimSpace(last))
+			if err != nil || end < begin {
+				return nil, fmt.Errorf("invalid CPU list item %q", item)
+			}
+		}
+		for i := begin; i <= end; i++ {
+			seen[i] = struct{}{}
+		}
+	}
+	if len(seen) == 0 {
+		return nil, fmt.Errorf("empty CPU list %q", list)
+	}
+
+	ids := make([]int, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids, nil
+}
+
+// FormatCpuList is the inverse of ParseCpuList, it collapses consecutive IDs
+// into ranges.
+func FormatCpuList(ids []int) string {
+	if len(ids) == 0 {
+		return ""
+	}
+	sorted := make([]int, len(ids))
+	copy(sorted, ids)
+	sort.Ints(sorted)
+
+	items := make([]string, 0)
+	begin, end := sorted[0], sorted[0]
+	flush := func() {
+		if begin == end {
+			items = append(items, strconv.Itoa(begin))
+		} else {
+			items = append(items, fmt.Sprintf("%d-%d", begin, end))
+		}
+	}
+	for _, id := range sorted[1:] {
+		if id == end {
+			continue
+		}
+		if id == end+1 {
+			end = id
+			continue
+		}
+		flush()
+		begin, end = id, id
+	}
+	flush()
+	return strings.Join(items, ",")
+}
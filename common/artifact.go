@@ -0,0 +1,216 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package common
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+const (
+	ARTIFACT_CHECKSUM_PREFIX = "sha256:"
+
+	ARTIFACT_SCHEME_HTTP    = "http"
+	ARTIFACT_SCHEME_HTTPS   = "https"
+	ARTIFACT_SCHEME_FILE    = "file"
+	ARTIFACT_SCHEME_APRICOT = "apricot"
+)
+
+// artifactArchiveSuffixes are the archive formats which can be extracted
+var artifactArchiveSuffixes = []string{".tar", ".tar.gz", ".tgz", ".tar.bz2", ".tbz2", ".zip"}
+
+// TaskArtifact is a file which the executor fetches and places in the
+// sandbox directory of the task before launching it. Files are cached on
+// each host by checksum, so the same artifact is only downloaded once.
+//
+// The URI is one of http(s)://..., file:///... for a file already present
+// on the host, or apricot://component/RUNTYPE/rolename/entry for a component
+// configuration entry, which the core retrieves at launch time.
+type TaskArtifact struct {
+	Uri string `json:"uri" yaml:"uri"`
+	// sha256:<hex digest> of the file, mandatory for http(s) URIs
+	Checksum string `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	// Path of the file, or of the directory an archive is extracted into,
+	// relative to the sandbox; defaults to the last element of the URI for
+	// files and to the sandbox itself for archives
+	Dest       string `json:"dest,omitempty" yaml:"dest,omitempty"`
+	Extract    bool   `json:"extract,omitempty" yaml:"extract,omitempty"`
+	Executable bool   `json:"executable,omitempty" yaml:"executable,omitempty"`
+
+	// Content of apricot entries, only filled in the copy which is sent to
+	// the executor at launch time, never in the task state kept by the core
+	Content *string `json:"content,omitempty" yaml:"-"`
+}
+
+type TaskArtifacts []TaskArtifact
+
+func (a TaskArtifacts) Copy() TaskArtifacts {
+	if a == nil {
+		return nil
+	}
+	artifacts := make(TaskArtifacts, len(a))
+	for i := range a {
+		artifacts[i] = a[i]
+		artifacts[i].Content = nil
+	}
+	return artifacts
+}
+
+// Validate checks the artifacts once their fields have been templated.
+func (a TaskArtifacts) Validate() error {
+	dests := make(map[string]string, len(a))
+	for i := range a {
+		if err := a[i].Validate(); err != nil {
+			return fmt.Errorf("invalid artifact %s: %w", a[i].Uri, err)
+		}
+		if a[i].Extract {
+			continue
+		}
+		dest := a[i].DestPath()
+		if other, ok := dests[dest]; ok {
+			return fmt.Errorf("artifacts %s and %s have the same destination %s", other, a[i].Uri, dest)
+		}
+		dests[dest] = a[i].Uri
+	}
+	return nil
+}
+
+// Validate checks the artifact once its fields have been templated.
+func (a *TaskArtifact) Validate() error {
+	u, err := url.Parse(strings.TrimSpace(a.Uri))
+	if err != nil {
+		return fmt.Errorf("bad URI: %w", err)
+	}
+	switch u.Scheme {
+	case ARTIFACT_SCHEME_HTTP, ARTIFACT_SCHEME_HTTPS:
+		if len(a.Checksum) == 0 {
+			return fmt.Errorf("a checksum is required for %s URIs", u.Scheme)
+		}
+	case ARTIFACT_SCHEME_FILE:
+		if (len(u.Host) > 0 && u.Host != "localhost") || !path.IsAbs(u.Path) {
+			return fmt.Errorf("file URIs must have an absolute path, as in file:///path/to/file")
+		}
+	case ARTIFACT_SCHEME_APRICOT:
+		if a.Extract {
+			return fmt.Errorf("apricot entries cannot be extracted")
+		}
+	default:
+		return fmt.Errorf("unsupported scheme %q, allowed values: http, https, file, apricot", u.Scheme)
+	}
+
+	if len(a.Checksum) > 0 {
+		if _, err = a.ChecksumDigest(); err != nil {
+			return err
+		}
+	}
+	if a.Extract && a.Executable {
+		return fmt.Errorf("an extracted archive cannot be executable")
+	}
+	if a.Extract && len(a.ArchiveSuffix()) == 0 {
+		return fmt.Errorf("cannot extract %s, supported archives: %s", a.Name(), strings.Join(artifactArchiveSuffixes, ", "))
+	}
+	if len(a.Dest) > 0 {
+		if dest := path.Clean(a.Dest); path.IsAbs(dest) || dest == ".." || strings.HasPrefix(dest, "../") {
+			return fmt.Errorf("destination %s must be relative to the sandbox", a.Dest)
+		}
+	} else if !a.Extract && len(a.Name()) == 0 {
+		return fmt.Errorf("cannot determine a file name from the URI, please set a destination")
+	}
+	return nil
+}
+
+// Scheme returns the lowercase URI scheme of the artifact.
+func (a *TaskArtifact) Scheme() string {
+	u, err := url.Parse(strings.TrimSpace(a.Uri))
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Scheme)
+}
+
+// Name returns the last element of the URI path.
+func (a *TaskArtifact) Name() string {
+	u, err := url.Parse(strings.TrimSpace(a.Uri))
+	if err != nil {
+		return ""
+	}
+	uriPath := u.Path
+	if strings.EqualFold(u.Scheme, ARTIFACT_SCHEME_APRICOT) {
+		uriPath = path.Join(u.Host, u.Path)
+	}
+	name := path.Base(uriPath)
+	if name == "." || name == "/" {
+		return ""
+	}
+	return name
+}
+
+// ApricotPath returns the component configuration query path of an apricot
+// artifact, i.e. component/RUNTYPE/rolename/entry.
+func (a *TaskArtifact) ApricotPath() string {
+	return strings.TrimPrefix(strings.TrimSpace(a.Uri), ARTIFACT_SCHEME_APRICOT+"://")
+}
+
+// DestPath returns the cleaned destination of the artifact relative to the
+// sandbox, "." for archives extracted into the sandbox itself.
+func (a *TaskArtifact) DestPath() string {
+	if len(a.Dest) > 0 {
+		return path.Clean(a.Dest)
+	}
+	if a.Extract {
+		return "."
+	}
+	return a.Name()
+}
+
+// ArchiveSuffix returns the archive format of the artifact based on its
+// name, or an empty string if it is not a supported archive.
+func (a *TaskArtifact) ArchiveSuffix() string {
+	name := strings.ToLower(a.Name())
+	// longest first, so that .tar.gz is not taken for .tar
+	suffix := ""
+	for _, s := range artifactArchiveSuffixes {
+		if strings.HasSuffix(name, s) && len(s) > len(suffix) {
+			suffix = s
+		}
+	}
+	return suffix
+}
+
+// ChecksumDigest returns the hex-encoded sha256 digest of the checksum.
+func (a *TaskArtifact) ChecksumDigest() (string, error) {
+	checksum := strings.ToLower(strings.TrimSpace(a.Checksum))
+	if !strings.HasPrefix(checksum, ARTIFACT_CHECKSUM_PREFIX) {
+		return "", fmt.Errorf("unsupported checksum %s, must be %s<hex digest>", a.Checksum, ARTIFACT_CHECKSUM_PREFIX)
+	}
+	digest := strings.TrimPrefix(checksum, ARTIFACT_CHECKSUM_PREFIX)
+	if decoded, err := hex.DecodeString(digest); err != nil || len(decoded) != 32 {
+		return "", fmt.Errorf("invalid sha256 digest %s", digest)
+	}
+	return digest, nil
+}
@@ -41,6 +41,10 @@ type CommandInfo struct {
 	Arguments []string `json:"arguments,omitempty" yaml:"arguments,omitempty"`
 	Stdout    *string  `json:"stdout,omitempty" yaml:"stdout,omitempty"`
 	Stderr    *string  `json:"stderr,omitempty" yaml:"stderr,omitempty"`
+
+	WorkingDir *string `json:"workingDir,omitempty" yaml:"workingDir,omitempty"`
+	Rlimits    Rlimits `json:"rlimits,omitempty" yaml:"rlimits,omitempty"`
+	Umask      *string `json:"umask,omitempty" yaml:"umask,omitempty"`
 }
 
 func (m *CommandInfo) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
@@ -53,6 +57,10 @@ func (m *CommandInfo) UnmarshalYAML(unmarshal func(interface{}) error) (err erro
 		Log       *string  `json:"log,omitempty" yaml:"log,omitempty"`
 		Stdout    *string  `json:"stdout,omitempty" yaml:"stdout,omitempty"`
 		Stderr    *string  `json:"stderr,omitempty" yaml:"stderr,omitempty"`
+
+		WorkingDir *string `json:"workingDir,omitempty" yaml:"workingDir,omitempty"`
+		Rlimits    Rlimits `json:"rlimits,omitempty" yaml:"rlimits,omitempty"`
+		Umask      *string `json:"umask,omitempty" yaml:"umask,omitempty"`
 	}
 	aux := _commandInfo{}
 	err = unmarshal(&aux)
@@ -70,6 +78,9 @@ func (m *CommandInfo) UnmarshalYAML(unmarshal func(interface{}) error) (err erro
 	m.User = aux.User
 	m.Stdout = aux.Stdout
 	m.Stderr = aux.Stderr
+	m.WorkingDir = aux.WorkingDir
+	m.Rlimits = aux.Rlimits
+	m.Umask = aux.Umask
 
 	// the stdout field used to be called log
 	if aux.Log != nil && aux.Stdout == nil {
@@ -92,6 +103,7 @@ func (m *CommandInfo) Copy() *CommandInfo {
 		Arguments: append([]string{}, m.Arguments...),
 		Stdout:    m.Stdout,
 		Stderr:    m.Stderr,
+		Rlimits:   m.Rlimits.Copy(),
 	}
 	if m.Shell != nil {
 		*cmd.Shell = *m.Shell
@@ -102,6 +114,14 @@ func (m *CommandInfo) Copy() *CommandInfo {
 	if m.User != nil {
 		*cmd.User = *m.User
 	}
+	if m.WorkingDir != nil {
+		cmd.WorkingDir = new(string)
+		*cmd.WorkingDir = *m.WorkingDir
+	}
+	if m.Umask != nil {
+		cmd.Umask = new(string)
+		*cmd.Umask = *m.Umask
+	}
 	return &cmd
 }
 
@@ -146,6 +166,22 @@ func (m *CommandInfo) Equals(other *CommandInfo) (response bool) {
 		*m.Stderr == *other.Stderr) {
 		return false
 	}
+	if !((m.WorkingDir == nil && other.WorkingDir == nil) ||
+		(m.WorkingDir != nil && other.WorkingDir != nil && *m.WorkingDir == *other.WorkingDir)) {
+		return false
+	}
+	if !((m.Umask == nil && other.Umask == nil) ||
+		(m.Umask != nil && other.Umask != nil && *m.Umask == *other.Umask)) {
+		return false
+	}
+	if len(m.Rlimits) != len(other.Rlimits) {
+		return false
+	}
+	for name, limit := range m.Rlimits {
+		if otherLimit, ok := other.Rlimits[name]; !ok || limit != otherLimit {
+			return false
+		}
+	}
 	return
 }
 
@@ -173,6 +209,17 @@ func (m *CommandInfo) UpdateFrom(n *CommandInfo) {
 	if n.Stderr != nil {
 		*m.Stderr = *n.Stderr
 	}
+	if n.WorkingDir != nil {
+		m.WorkingDir = new(string)
+		*m.WorkingDir = *n.WorkingDir
+	}
+	if n.Rlimits != nil {
+		m.Rlimits = n.Rlimits.Copy()
+	}
+	if n.Umask != nil {
+		m.Umask = new(string)
+		*m.Umask = *n.Umask
+	}
 }
 
 const defaultCommandInfoShell = false
@@ -0,0 +1,62 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import (
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/utils"
+)
+
+// AnnounceTaskAffinityEvent is sent by the executor to report the CPU and
+// NUMA placement it applied to a task.
+type AnnounceTaskAffinityEvent struct {
+	eventBase
+	TaskId   string              `json:"taskId"`
+	Affinity common.TaskAffinity `json:"affinity"`
+}
+
+func (e *AnnounceTaskAffinityEvent) GetName() string {
+	return "ANNOUNCE_TASK_AFFINITY"
+}
+
+func (e *AnnounceTaskAffinityEvent) GetTaskId() string {
+	return e.TaskId
+}
+
+func (e *AnnounceTaskAffinityEvent) GetAffinity() common.TaskAffinity {
+	return e.Affinity
+}
+
+func NewAnnounceTaskAffinityEvent(id string, affinity common.TaskAffinity) (e *AnnounceTaskAffinityEvent) {
+	e = &AnnounceTaskAffinityEvent{
+		eventBase: eventBase{
+			Timestamp:   utils.NewUnixTimestamp(),
+			MessageType: "AnnounceTaskAffinityEvent",
+		},
+		TaskId:   id,
+		Affinity: affinity,
+	}
+	return e
+}
@@ -88,6 +88,14 @@ func NewDeviceEvent(origin DeviceEventOrigin, t pb.DeviceEventType) (de DeviceEv
 				Origin:    origin,
 			},
 		}
+	case pb.DeviceEventType_DEVICE_STATE_CHANGED:
+		de = &DeviceStateChanged{
+			DeviceEventBase: DeviceEventBase{
+				eventBase: *newDeviceEventBase("DeviceEvent", nil),
+				Type:      t,
+				Origin:    origin,
+			},
+		}
 	case pb.DeviceEventType_NULL_DEVICE_EVENT:
 		de = nil
 	}
@@ -122,3 +130,16 @@ type TaskInternalError struct {
 func (e *TaskInternalError) GetName() string {
 	return "TASK_INTERNAL_ERROR"
 }
+
+// DeviceStateChanged is sent by the executor when a controlled task reports
+// a state change which was not requested by a transition. States are in
+// terms of the O² task state machine.
+type DeviceStateChanged struct {
+	DeviceEventBase
+	State         string `json:"state"`
+	PreviousState string `json:"previousState"`
+}
+
+func (e *DeviceStateChanged) GetName() string {
+	return "DEVICE_STATE_CHANGED"
+}
@@ -0,0 +1,68 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import (
+	"encoding/json"
+
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeviceEvent", func() {
+	origin := DeviceEventOrigin{TaskId: mesos.TaskID{Value: "2oDvieFrVTi"}}
+
+	It("carries unsolicited state changes from the executor to the core", func() {
+		sent := NewDeviceEvent(origin, pb.DeviceEventType_DEVICE_STATE_CHANGED)
+		Expect(sent).To(BeAssignableToTypeOf(&DeviceStateChanged{}))
+		sent.(*DeviceStateChanged).State = "ERROR"
+		sent.(*DeviceStateChanged).PreviousState = "RUNNING"
+
+		data, err := json.Marshal(sent)
+		Expect(err).NotTo(HaveOccurred())
+
+		// decode the way the scheduler does: allocate by type, then fill in
+		var incoming struct {
+			Type   pb.DeviceEventType `json:"type"`
+			Origin DeviceEventOrigin  `json:"origin"`
+		}
+		Expect(json.Unmarshal(data, &incoming)).To(Succeed())
+		received := NewDeviceEvent(incoming.Origin, incoming.Type)
+		Expect(json.Unmarshal(data, &received)).To(Succeed())
+
+		dsc, ok := received.(*DeviceStateChanged)
+		Expect(ok).To(BeTrue())
+		Expect(dsc.GetName()).To(Equal("DEVICE_STATE_CHANGED"))
+		Expect(dsc.GetOrigin().TaskId.Value).To(Equal("2oDvieFrVTi"))
+		Expect(dsc.State).To(Equal("ERROR"))
+		Expect(dsc.PreviousState).To(Equal("RUNNING"))
+	})
+
+	It("has no event for NULL_DEVICE_EVENT", func() {
+		Expect(NewDeviceEvent(origin, pb.DeviceEventType_NULL_DEVICE_EVENT)).To(BeNil())
+	})
+})
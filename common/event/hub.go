@@ -0,0 +1,164 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event/topic"
+	pb "github.com/AliceO2Group/Control/common/protos"
+)
+
+// TopicEvent is an event as published on a Hub, along with the topic it was
+// written to.
+type TopicEvent struct {
+	Topic topic.Topic
+	Event *pb.Event
+}
+
+// MatchesTopic returns true if the event was written to the given topic or
+// to one of its subtopics. Topics may be given with or without the root
+// prefix, i.e. both "aliecs.environment" and "environment" match
+// environment events.
+func (e TopicEvent) MatchesTopic(t string) bool {
+	if !strings.HasPrefix(t, string(topic.Root)+topic.Separator) {
+		t = string(topic.Root) + topic.Separator + t
+	}
+	return string(e.Topic) == t || strings.HasPrefix(string(e.Topic), t+topic.Separator)
+}
+
+// Hub fans out events written within this process to any number of
+// in-process subscribers, e.g. gRPC event streams.
+// Publishing never blocks: if a subscriber does not keep up, the events it
+// cannot buffer are dropped for that subscriber only.
+type Hub struct {
+	mu          sync.RWMutex
+	subscribers map[*HubSubscription]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		subscribers: make(map[*HubSubscription]struct{}),
+	}
+}
+
+type HubSubscription struct {
+	hub     *Hub
+	ch      chan TopicEvent
+	filter  func(TopicEvent) bool
+	dropped atomic.Uint64
+	once    sync.Once
+}
+
+// Subscribe registers a new subscriber which receives all events for which
+// filter returns true, or all events if filter is nil. The returned
+// subscription must be released with Unsubscribe.
+func (h *Hub) Subscribe(bufferSize int, filter func(TopicEvent) bool) *HubSubscription {
+	sub := &HubSubscription{
+		hub:    h,
+		ch:     make(chan TopicEvent, bufferSize),
+		filter: filter,
+	}
+	h.mu.Lock()
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+	return sub
+}
+
+func (h *Hub) Publish(t topic.Topic, e *pb.Event) {
+	if h == nil || e == nil {
+		return
+	}
+	te := TopicEvent{Topic: t, Event: e}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.subscribers {
+		if sub.filter != nil && !sub.filter(te) {
+			continue
+		}
+		select {
+		case sub.ch <- te:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+}
+
+// Events returns the channel on which the subscriber receives events. The
+// channel is closed on Unsubscribe.
+func (s *HubSubscription) Events() <-chan TopicEvent {
+	return s.ch
+}
+
+// Dropped returns the number of events which were not delivered to this
+// subscriber because its buffer was full.
+func (s *HubSubscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+func (s *HubSubscription) Unsubscribe() {
+	s.once.Do(func() {
+		s.hub.mu.Lock()
+		delete(s.hub.subscribers, s)
+		s.hub.mu.Unlock()
+		close(s.ch)
+	})
+}
+
+// HubWriter is a Writer which publishes every event on a Hub before
+// passing it on to the wrapped Writer.
+type HubWriter struct {
+	Writer
+	hub   *Hub
+	topic topic.Topic
+}
+
+func NewHubWriter(writer Writer, hub *Hub, t topic.Topic) *HubWriter {
+	return &HubWriter{
+		Writer: writer,
+		hub:    hub,
+		topic:  t,
+	}
+}
+
+func (w *HubWriter) WriteEvent(e interface{}) {
+	if w != nil {
+		w.WriteEventWithTimestamp(e, time.Now())
+	}
+}
+
+func (w *HubWriter) WriteEventWithTimestamp(e interface{}, timestamp time.Time) {
+	if w == nil {
+		return
+	}
+	if wrappedEvent, _, err := internalEventToKafkaEvent(e, timestamp); err == nil {
+		w.hub.Publish(w.topic, wrappedEvent)
+	}
+	w.Writer.WriteEventWithTimestamp(e, timestamp)
+}
@@ -0,0 +1,106 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import (
+	"github.com/AliceO2Group/Control/common/event/topic"
+	pb "github.com/AliceO2Group/Control/common/protos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Hub", func() {
+	var hub *Hub
+
+	BeforeEach(func() {
+		hub = NewHub()
+	})
+
+	When("an event is written through a hub writer", func() {
+		It("is published to subscribers and passed on to the wrapped writer", func() {
+			sub := hub.Subscribe(10, nil)
+			defer sub.Unsubscribe()
+
+			writer := NewHubWriter(&DummyWriter{}, hub, topic.Environment)
+			writer.WriteEvent(&pb.Ev_EnvironmentEvent{EnvironmentId: "2oDvieFrVTi", State: "CONFIGURED"})
+
+			var received TopicEvent
+			Eventually(sub.Events()).Should(Receive(&received))
+			Expect(received.Topic).To(Equal(topic.Environment))
+			Expect(received.Event.GetEnvironmentEvent().GetState()).To(Equal("CONFIGURED"))
+			Expect(received.Event.GetTimestamp()).NotTo(BeZero())
+		})
+	})
+
+	When("a subscriber has a filter", func() {
+		It("only receives matching events", func() {
+			sub := hub.Subscribe(10, func(e TopicEvent) bool {
+				return e.MatchesTopic("task")
+			})
+			defer sub.Unsubscribe()
+
+			hub.Publish(topic.Environment, pb.WrapEvent(&pb.Event_EnvironmentEvent{EnvironmentEvent: &pb.Ev_EnvironmentEvent{EnvironmentId: "2oDvieFrVTi"}}))
+			hub.Publish(topic.Task, pb.WrapEvent(&pb.Event_TaskEvent{TaskEvent: &pb.Ev_TaskEvent{Taskid: "2oDvmzJRWJ1"}}))
+
+			var received TopicEvent
+			Eventually(sub.Events()).Should(Receive(&received))
+			Expect(received.Event.GetTaskEvent().GetTaskid()).To(Equal("2oDvmzJRWJ1"))
+			Consistently(sub.Events()).ShouldNot(Receive())
+		})
+	})
+
+	When("a subscriber does not keep up", func() {
+		It("drops events for that subscriber without blocking the publisher", func() {
+			slow := hub.Subscribe(1, nil)
+			defer slow.Unsubscribe()
+
+			for i := 0; i < 5; i++ {
+				hub.Publish(topic.Call, pb.WrapEvent(&pb.Event_CallEvent{CallEvent: &pb.Ev_CallEvent{Func: "noop()"}}))
+			}
+			Expect(slow.Dropped()).To(Equal(uint64(4)))
+		})
+	})
+
+	When("a subscriber unsubscribes", func() {
+		It("has its channel closed and receives no further events", func() {
+			sub := hub.Subscribe(10, nil)
+			sub.Unsubscribe()
+			sub.Unsubscribe()
+			hub.Publish(topic.Environment, pb.WrapEvent(&pb.Event_EnvironmentEvent{EnvironmentEvent: &pb.Ev_EnvironmentEvent{}}))
+			Expect(sub.Events()).To(BeClosed())
+		})
+	})
+})
+
+var _ = Describe("TopicEvent", func() {
+	It("matches topics with or without the root prefix, and subtopics", func() {
+		e := TopicEvent{Topic: topic.IntegratedService + ".dcs"}
+		Expect(e.MatchesTopic("integrated_service")).To(BeTrue())
+		Expect(e.MatchesTopic("aliecs.integrated_service")).To(BeTrue())
+		Expect(e.MatchesTopic("integrated_service.dcs")).To(BeTrue())
+		Expect(e.MatchesTopic("integrated")).To(BeFalse())
+		Expect(e.MatchesTopic("environment")).To(BeFalse())
+	})
+})
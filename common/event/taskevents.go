@@ -32,6 +32,9 @@ type TaskEvent struct {
 	State     string
 	Hostname  string
 	ClassName string
+	// Set if the event reports the outcome of a probe
+	Probe      string
+	ProbeError string
 }
 
 func (r *TaskEvent) GetName() string {
@@ -57,3 +60,11 @@ func (r *TaskEvent) GetHostname() string {
 func (r *TaskEvent) GetClassName() string {
 	return r.ClassName
 }
+
+func (r *TaskEvent) GetProbe() string {
+	return r.Probe
+}
+
+func (r *TaskEvent) GetProbeError() string {
+	return r.ProbeError
+}
@@ -0,0 +1,73 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import (
+	"github.com/AliceO2Group/Control/common/utils"
+)
+
+// TaskProbeEvent is sent by the executor when a probe of a task fails, and
+// when a failed readiness probe succeeds again.
+type TaskProbeEvent struct {
+	eventBase
+	TaskId  string `json:"taskId"`
+	Probe   string `json:"probe"`
+	Healthy bool   `json:"healthy"`
+	Message string `json:"message,omitempty"`
+}
+
+func (e *TaskProbeEvent) GetName() string {
+	return "TASK_PROBE"
+}
+
+func (e *TaskProbeEvent) GetTaskId() string {
+	return e.TaskId
+}
+
+func (e *TaskProbeEvent) GetProbe() string {
+	return e.Probe
+}
+
+func (e *TaskProbeEvent) IsHealthy() bool {
+	return e.Healthy
+}
+
+func (e *TaskProbeEvent) GetMessage() string {
+	return e.Message
+}
+
+func NewTaskProbeEvent(id string, probe string, healthy bool, message string) (e *TaskProbeEvent) {
+	e = &TaskProbeEvent{
+		eventBase: eventBase{
+			Timestamp:   utils.NewUnixTimestamp(),
+			MessageType: "TaskProbeEvent",
+		},
+		TaskId:  id,
+		Probe:   probe,
+		Healthy: healthy,
+		Message: message,
+	}
+	return e
+}
@@ -0,0 +1,76 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/common/utils"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+)
+
+// TaskRestartRequestedEvent is emitted by the task manager when a task of an
+// environment dies unexpectedly and its restart policy allows relaunching it.
+type TaskRestartRequestedEvent struct {
+	eventBase
+	EnvironmentId uid.ID        `json:"environmentId"`
+	TaskId        string        `json:"taskId"`
+	Attempt       int           `json:"attempt"`
+	MaxAttempts   int           `json:"maxAttempts"`
+	Backoff       time.Duration `json:"backoff"`
+}
+
+func (tr *TaskRestartRequestedEvent) GetName() string {
+	return "TASK_RESTART_REQUESTED"
+}
+
+func (tr *TaskRestartRequestedEvent) GetEnvironmentId() uid.ID {
+	if tr == nil {
+		return ""
+	}
+	return tr.EnvironmentId
+}
+
+func (tr *TaskRestartRequestedEvent) GetTaskId() string {
+	if tr == nil {
+		return ""
+	}
+	return tr.TaskId
+}
+
+func NewTaskRestartRequestedEvent(envId uid.ID, taskId string, attempt int, maxAttempts int, backoff time.Duration) (tr *TaskRestartRequestedEvent) {
+	tr = &TaskRestartRequestedEvent{
+		eventBase: eventBase{
+			Timestamp:   utils.NewUnixTimestamp(),
+			MessageType: "TaskRestartRequestedEvent",
+		},
+		EnvironmentId: envId,
+		TaskId:        taskId,
+		Attempt:       attempt,
+		MaxAttempts:   maxAttempts,
+		Backoff:       backoff,
+	}
+	return tr
+}
@@ -0,0 +1,286 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DEFAULT_PROBE_PERIOD            = 10 * time.Second
+	DEFAULT_PROBE_TIMEOUT           = 1 * time.Second
+	DEFAULT_PROBE_FAILURE_THRESHOLD = 3
+)
+
+const (
+	LIVENESS_PROBE  = "liveness"
+	READINESS_PROBE = "readiness"
+)
+
+// TaskProbes are the health checks the executor runs on a task for as long
+// as its process lives. A failing liveness probe means the task is hung and
+// must be handled as if it died, a failing readiness probe means the task is
+// alive but not able to do its job at the moment.
+type TaskProbes struct {
+	Liveness  *TaskProbe `json:"liveness,omitempty" yaml:"liveness,omitempty"`
+	Readiness *TaskProbe `json:"readiness,omitempty" yaml:"readiness,omitempty"`
+}
+
+// TaskProbe is a single health check, exactly one of Exec, HttpGet,
+// TcpSocket and Occ must be set. The probe fails after FailureThreshold
+// consecutive unsuccessful attempts, made every Period.
+type TaskProbe struct {
+	Exec      *ExecProbe      `json:"exec,omitempty" yaml:"exec,omitempty"`
+	HttpGet   *HttpGetProbe   `json:"httpGet,omitempty" yaml:"httpGet,omitempty"`
+	TcpSocket *TcpSocketProbe `json:"tcpSocket,omitempty" yaml:"tcpSocket,omitempty"`
+	Occ       *OccProbe       `json:"occ,omitempty" yaml:"occ,omitempty"`
+
+	InitialDelay     time.Duration `json:"initialDelay,omitempty" yaml:"-"`
+	Period           time.Duration `json:"period" yaml:"-"`
+	Timeout          time.Duration `json:"timeout" yaml:"-"`
+	FailureThreshold int           `json:"failureThreshold" yaml:"-"`
+}
+
+// ExecProbe succeeds if the command exits with status 0.
+type ExecProbe struct {
+	Command []string `json:"command" yaml:"command"`
+}
+
+// HttpGetProbe succeeds if a GET on the given path returns a status code
+// between 200 and 399.
+type HttpGetProbe struct {
+	Host string `json:"host,omitempty" yaml:"host,omitempty"`
+	Port string `json:"port" yaml:"port"`
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+// TcpSocketProbe succeeds if a TCP connection can be opened.
+type TcpSocketProbe struct {
+	Host string `json:"host,omitempty" yaml:"host,omitempty"`
+	Port string `json:"port" yaml:"port"`
+}
+
+// OccProbe succeeds if the task answers GetState with one of the given
+// states. It only applies to controllable tasks.
+type OccProbe struct {
+	States []string `json:"states" yaml:"states"`
+}
+
+func (p *TaskProbe) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _taskProbe struct {
+		Exec             *ExecProbe      `yaml:"exec"`
+		HttpGet          *HttpGetProbe   `yaml:"httpGet"`
+		TcpSocket        *TcpSocketProbe `yaml:"tcpSocket"`
+		Occ              *OccProbe       `yaml:"occ"`
+		InitialDelay     string          `yaml:"initialDelay"`
+		Period           string          `yaml:"period"`
+		Timeout          string          `yaml:"timeout"`
+		FailureThreshold *int            `yaml:"failureThreshold"`
+	}
+	aux := _taskProbe{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+
+	probe := TaskProbe{
+		Exec:             aux.Exec,
+		HttpGet:          aux.HttpGet,
+		TcpSocket:        aux.TcpSocket,
+		Occ:              aux.Occ,
+		Period:           DEFAULT_PROBE_PERIOD,
+		Timeout:          DEFAULT_PROBE_TIMEOUT,
+		FailureThreshold: DEFAULT_PROBE_FAILURE_THRESHOLD,
+	}
+	if kinds := probe.kinds(); len(kinds) != 1 {
+		return fmt.Errorf("a probe must have exactly one of exec, httpGet, tcpSocket, occ, got %d", len(kinds))
+	}
+
+	for _, d := range []struct {
+		name  string
+		value string
+		dest  *time.Duration
+	}{
+		{"initialDelay", aux.InitialDelay, &probe.InitialDelay},
+		{"period", aux.Period, &probe.Period},
+		{"timeout", aux.Timeout, &probe.Timeout},
+	} {
+		if len(d.value) == 0 {
+			continue
+		}
+		*d.dest, err = time.ParseDuration(d.value)
+		if err != nil {
+			return fmt.Errorf("invalid probe %s %s: %w", d.name, d.value, err)
+		}
+		if *d.dest < 0 || (*d.dest == 0 && d.name != "initialDelay") {
+			return fmt.Errorf("invalid probe %s %s, must be positive", d.name, d.value)
+		}
+	}
+	if aux.FailureThreshold != nil {
+		if *aux.FailureThreshold < 1 {
+			return fmt.Errorf("invalid probe failureThreshold %d, must be at least 1", *aux.FailureThreshold)
+		}
+		probe.FailureThreshold = *aux.FailureThreshold
+	}
+
+	*p = probe
+	return
+}
+
+func (p *TaskProbe) MarshalYAML() (interface{}, error) {
+	type _taskProbe struct {
+		Exec             *ExecProbe      `yaml:"exec,omitempty"`
+		HttpGet          *HttpGetProbe   `yaml:"httpGet,omitempty"`
+		TcpSocket        *TcpSocketProbe `yaml:"tcpSocket,omitempty"`
+		Occ              *OccProbe       `yaml:"occ,omitempty"`
+		InitialDelay     string          `yaml:"initialDelay,omitempty"`
+		Period           string          `yaml:"period"`
+		Timeout          string          `yaml:"timeout"`
+		FailureThreshold int             `yaml:"failureThreshold"`
+	}
+	aux := _taskProbe{
+		Exec:             p.Exec,
+		HttpGet:          p.HttpGet,
+		TcpSocket:        p.TcpSocket,
+		Occ:              p.Occ,
+		Period:           p.Period.String(),
+		Timeout:          p.Timeout.String(),
+		FailureThreshold: p.FailureThreshold,
+	}
+	if p.InitialDelay > 0 {
+		aux.InitialDelay = p.InitialDelay.String()
+	}
+	return aux, nil
+}
+
+func (p *TaskProbe) kinds() (kinds []string) {
+	if p.Exec != nil {
+		kinds = append(kinds, "exec")
+	}
+	if p.HttpGet != nil {
+		kinds = append(kinds, "httpGet")
+	}
+	if p.TcpSocket != nil {
+		kinds = append(kinds, "tcpSocket")
+	}
+	if p.Occ != nil {
+		kinds = append(kinds, "occ")
+	}
+	return
+}
+
+// Kind returns which check the probe runs: exec, httpGet, tcpSocket or occ.
+func (p *TaskProbe) Kind() string {
+	if p == nil {
+		return ""
+	}
+	return strings.Join(p.kinds(), ",")
+}
+
+// Validate checks the probe once its fields have been templated.
+func (p *TaskProbe) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if kinds := p.kinds(); len(kinds) != 1 {
+		return fmt.Errorf("a probe must have exactly one of exec, httpGet, tcpSocket, occ, got %d", len(kinds))
+	}
+	switch {
+	case p.Exec != nil:
+		if len(p.Exec.Command) == 0 || len(strings.TrimSpace(p.Exec.Command[0])) == 0 {
+			return fmt.Errorf("exec probe has no command")
+		}
+	case p.HttpGet != nil:
+		return validateProbePort(p.HttpGet.Port)
+	case p.TcpSocket != nil:
+		return validateProbePort(p.TcpSocket.Port)
+	case p.Occ != nil:
+		if len(p.Occ.States) == 0 {
+			return fmt.Errorf("occ probe has no expected states")
+		}
+	}
+	return nil
+}
+
+func validateProbePort(port string) error {
+	n, err := strconv.ParseUint(strings.TrimSpace(port), 10, 16)
+	if err != nil || n == 0 {
+		return fmt.Errorf("invalid probe port %q", port)
+	}
+	return nil
+}
+
+func (p *TaskProbe) Copy() *TaskProbe {
+	if p == nil {
+		return nil
+	}
+	probe := *p
+	if p.Exec != nil {
+		probe.Exec = &ExecProbe{Command: append([]string{}, p.Exec.Command...)}
+	}
+	if p.HttpGet != nil {
+		httpGet := *p.HttpGet
+		probe.HttpGet = &httpGet
+	}
+	if p.TcpSocket != nil {
+		tcpSocket := *p.TcpSocket
+		probe.TcpSocket = &tcpSocket
+	}
+	if p.Occ != nil {
+		probe.Occ = &OccProbe{States: append([]string{}, p.Occ.States...)}
+	}
+	return &probe
+}
+
+func (p *TaskProbes) Copy() *TaskProbes {
+	if p == nil {
+		return nil
+	}
+	return &TaskProbes{
+		Liveness:  p.Liveness.Copy(),
+		Readiness: p.Readiness.Copy(),
+	}
+}
+
+func (p *TaskProbes) IsEmpty() bool {
+	return p == nil || (p.Liveness == nil && p.Readiness == nil)
+}
+
+// Validate checks both probes once their fields have been templated.
+func (p *TaskProbes) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if err := p.Liveness.Validate(); err != nil {
+		return fmt.Errorf("invalid %s probe: %w", LIVENESS_PROBE, err)
+	}
+	if err := p.Readiness.Validate(); err != nil {
+		return fmt.Errorf("invalid %s probe: %w", READINESS_PROBE, err)
+	}
+	return nil
+}
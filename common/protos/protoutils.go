@@ -34,3 +34,24 @@ func WrapEvent(ce isEvent_Payload) *Event {
 		Payload:   ce,
 	}
 }
+
+// GetEnvironmentId returns the ID of the environment the event's payload
+// refers to, or an empty string for events not tied to an environment.
+func (x *Event) GetEnvironmentId() string {
+	switch {
+	case x.GetEnvironmentEvent() != nil:
+		return x.GetEnvironmentEvent().GetEnvironmentId()
+	case x.GetTaskEvent() != nil:
+		return x.GetTaskEvent().GetEnvironmentId()
+	case x.GetRoleEvent() != nil:
+		return x.GetRoleEvent().GetEnvironmentId()
+	case x.GetCallEvent() != nil:
+		return x.GetCallEvent().GetEnvironmentId()
+	case x.GetIntegratedServiceEvent() != nil:
+		return x.GetIntegratedServiceEvent().GetEnvironmentId()
+	case x.GetRunEvent() != nil:
+		return x.GetRunEvent().GetEnvironmentId()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,151 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package common
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const RLIMIT_UNLIMITED = "unlimited"
+
+// rlimitNames are the resource limits a task command can set, named like
+// the RLIMIT_* constants of setrlimit(2) without the prefix
+var rlimitNames = []string{
+	"as", "core", "cpu", "data", "fsize", "locks", "memlock", "msgqueue",
+	"nice", "nofile", "nproc", "rss", "rtprio", "rttime", "sigpending", "stack",
+}
+
+// Rlimit is a resource limit of a task process, as in setrlimit(2). Soft
+// and hard are either a number or "unlimited". In a task template a single
+// value sets both, and a missing soft or hard value defaults to the other.
+type Rlimit struct {
+	Soft string `json:"soft" yaml:"soft"`
+	Hard string `json:"hard" yaml:"hard"`
+}
+
+func (r *Rlimit) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	var value string
+	if err = unmarshal(&value); err == nil {
+		r.Soft, r.Hard = value, value
+		return
+	}
+
+	type _rlimit Rlimit
+	aux := _rlimit{}
+	if err = unmarshal(&aux); err != nil {
+		return
+	}
+	*r = Rlimit(aux)
+	if len(r.Soft) == 0 {
+		r.Soft = r.Hard
+	}
+	if len(r.Hard) == 0 {
+		r.Hard = r.Soft
+	}
+	return
+}
+
+// Values returns the soft and hard limits, with math.MaxUint64 standing for
+// unlimited.
+func (r Rlimit) Values() (soft uint64, hard uint64, err error) {
+	if soft, err = parseRlimitValue(r.Soft); err != nil {
+		return
+	}
+	if hard, err = parseRlimitValue(r.Hard); err != nil {
+		return
+	}
+	if soft > hard {
+		err = fmt.Errorf("soft limit %s is above hard limit %s", r.Soft, r.Hard)
+	}
+	return
+}
+
+func parseRlimitValue(value string) (uint64, error) {
+	value = strings.TrimSpace(value)
+	switch strings.ToLower(value) {
+	case RLIMIT_UNLIMITED, "infinity":
+		return math.MaxUint64, nil
+	case "":
+		return 0, fmt.Errorf("missing limit value")
+	}
+	parsed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("bad limit value %q, expected a number or %s", value, RLIMIT_UNLIMITED)
+	}
+	return parsed, nil
+}
+
+type Rlimits map[string]Rlimit
+
+func (r Rlimits) Copy() Rlimits {
+	if r == nil {
+		return nil
+	}
+	rlimits := make(Rlimits, len(r))
+	for name, limit := range r {
+		rlimits[name] = limit
+	}
+	return rlimits
+}
+
+// Names returns the names of the limits in alphabetical order.
+func (r Rlimits) Names() []string {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks the limits once their values have been templated.
+func (r Rlimits) Validate() error {
+	for _, name := range r.Names() {
+		known := false
+		for _, rlimitName := range rlimitNames {
+			known = known || name == rlimitName
+		}
+		if !known {
+			return fmt.Errorf("unknown resource limit %s, allowed values: %s", name, strings.Join(rlimitNames, ", "))
+		}
+		if _, _, err := r[name].Values(); err != nil {
+			return fmt.Errorf("invalid resource limit %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// ParseUmask parses an octal file mode creation mask such as "0022".
+func ParseUmask(umask string) (int, error) {
+	parsed, err := strconv.ParseUint(strings.TrimSpace(umask), 8, 32)
+	if err != nil || parsed > 0777 {
+		return 0, fmt.Errorf("bad umask %q, expected an octal value between 0000 and 0777", umask)
+	}
+	return int(parsed), nil
+}
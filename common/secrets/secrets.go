@@ -0,0 +1,156 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package secrets provides the notation with which task templates and
+// configuration values refer to secrets stored in the configuration service,
+// as well as the functions to find and resolve such references.
+//
+// A secret is referenced either by a placeholder ${secret:name} anywhere in a
+// string (as produced by the secret template function), or by a value which
+// consists entirely of secret:name (as in env: [FOO=secret:name]).
+// References are kept as they are throughout the core, and only replaced with
+// the actual secret values by the executor right before launching a task.
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	REFERENCE_PREFIX = "secret:"
+	REDACTED         = "******"
+)
+
+const namePattern = `[A-Za-z0-9][A-Za-z0-9_.-]*`
+
+var (
+	nameRegexp        = regexp.MustCompile(`^` + namePattern + `$`)
+	placeholderRegexp = regexp.MustCompile(`\$\{secret:(` + namePattern + `)\}`)
+)
+
+var ErrNotFound = errors.New("secret not found")
+
+// ValidateName checks that name is usable as a secret name, i.e. it is not
+// empty and only contains letters, digits, '_', '.' and '-'.
+func ValidateName(name string) error {
+	if !nameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid secret name %q", name)
+	}
+	return nil
+}
+
+// Placeholder returns the placeholder which stands for the secret name in
+// a string until it is resolved.
+func Placeholder(name string) string {
+	return "${" + REFERENCE_PREFIX + name + "}"
+}
+
+// ParseReference returns the secret name if value is a whole-value
+// reference of the form secret:name.
+func ParseReference(value string) (name string, ok bool) {
+	if !strings.HasPrefix(value, REFERENCE_PREFIX) {
+		return "", false
+	}
+	name = strings.TrimPrefix(value, REFERENCE_PREFIX)
+	if ValidateName(name) != nil {
+		return "", false
+	}
+	return name, true
+}
+
+// ReferencedNames returns the sorted, deduplicated names of all secrets
+// referenced in values, either by placeholder or by whole-value reference.
+func ReferencedNames(values ...string) []string {
+	found := make(map[string]struct{})
+	for _, value := range values {
+		if name, ok := ParseReference(value); ok {
+			found[name] = struct{}{}
+		}
+		for _, match := range placeholderRegexp.FindAllStringSubmatch(value, -1) {
+			found[match[1]] = struct{}{}
+		}
+	}
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ContainsPlaceholder returns true if value contains at least one secret
+// placeholder.
+func ContainsPlaceholder(value string) bool {
+	return placeholderRegexp.MatchString(value)
+}
+
+// Resolve replaces all secret references in value with the corresponding
+// entries in values, and fails if any referenced secret is missing.
+func Resolve(value string, values map[string]string) (string, error) {
+	if name, ok := ParseReference(value); ok {
+		secret, found := values[name]
+		if !found {
+			return "", fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+		return secret, nil
+	}
+
+	var missing []string
+	resolved := placeholderRegexp.ReplaceAllStringFunc(value, func(match string) string {
+		name := placeholderRegexp.FindStringSubmatch(match)[1]
+		secret, found := values[name]
+		if !found {
+			missing = append(missing, name)
+			return match
+		}
+		return secret
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, strings.Join(missing, ", "))
+	}
+	return resolved, nil
+}
+
+// ResolveEnv resolves the secret references in the values of a list of
+// KEY=value environment entries.
+func ResolveEnv(env []string, values map[string]string) ([]string, error) {
+	resolved := make([]string, len(env))
+	for i, entry := range env {
+		key, value, hasValue := strings.Cut(entry, "=")
+		if !hasValue {
+			resolved[i] = entry
+			continue
+		}
+		secret, err := Resolve(value, values)
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve environment variable %s: %w", key, err)
+		}
+		resolved[i] = key + "=" + secret
+	}
+	return resolved, nil
+}
@@ -0,0 +1,107 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package secrets
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("secrets", func() {
+	values := map[string]string{
+		"bkp-token": "s3cr3t",
+		"dcs.pass":  "hunter2",
+	}
+
+	Describe("ValidateName", func() {
+		It("should accept names made of letters, digits, '_', '.' and '-'", func() {
+			Expect(ValidateName("bkp-token")).To(Succeed())
+			Expect(ValidateName("dcs.pass_2")).To(Succeed())
+		})
+
+		It("should reject empty names and names with other characters", func() {
+			Expect(ValidateName("")).NotTo(Succeed())
+			Expect(ValidateName("a/b")).NotTo(Succeed())
+			Expect(ValidateName("-a")).NotTo(Succeed())
+			Expect(ValidateName("a b")).NotTo(Succeed())
+		})
+	})
+
+	Describe("ReferencedNames", func() {
+		It("should find placeholders and whole-value references", func() {
+			Expect(ReferencedNames(
+				"--token="+Placeholder("bkp-token"),
+				"secret:dcs.pass",
+				Placeholder("bkp-token")+":"+Placeholder("dcs.pass"),
+			)).To(Equal([]string{"bkp-token", "dcs.pass"}))
+		})
+
+		It("should ignore references which are not at the start of a value", func() {
+			Expect(ReferencedNames("not a secret:foo")).To(BeEmpty())
+		})
+
+		It("should tell placeholders apart from whole-value references", func() {
+			Expect(ContainsPlaceholder("--token=" + Placeholder("bkp-token"))).To(BeTrue())
+			Expect(ContainsPlaceholder("secret:bkp-token")).To(BeFalse())
+		})
+	})
+
+	Describe("Resolve", func() {
+		It("should resolve whole-value references", func() {
+			Expect(Resolve("secret:bkp-token", values)).To(Equal("s3cr3t"))
+		})
+
+		It("should resolve placeholders within a string", func() {
+			Expect(Resolve("--user=ecs --password="+Placeholder("dcs.pass"), values)).
+				To(Equal("--user=ecs --password=hunter2"))
+		})
+
+		It("should leave strings without references untouched", func() {
+			Expect(Resolve("plain value", values)).To(Equal("plain value"))
+		})
+
+		It("should fail if a referenced secret is missing", func() {
+			_, err := Resolve("--token="+Placeholder("missing"), values)
+			Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+			_, err = Resolve("secret:missing", values)
+			Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+		})
+
+		It("should resolve environment entries by value", func() {
+			Expect(ResolveEnv([]string{"TOKEN=secret:bkp-token", "PLAIN=value", "EMPTY"}, values)).
+				To(Equal([]string{"TOKEN=s3cr3t", "PLAIN=value", "EMPTY"}))
+			_, err := ResolveEnv([]string{"TOKEN=secret:missing"}, values)
+			Expect(err).To(MatchError(ContainSubstring("TOKEN")))
+		})
+	})
+})
+
+func TestSecrets(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Secrets Test Suite")
+}
@@ -0,0 +1,226 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package common
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	DEFAULT_STOP_TRANSITION_TIMEOUT = 5 * time.Second
+	DEFAULT_STOP_SIGNAL_GRACE       = 5 * time.Second
+	DEFAULT_PRE_STOP_TIMEOUT        = 10 * time.Second
+)
+
+// stopSignals are the signals a stop policy can send
+var stopSignals = []string{"SIGHUP", "SIGINT", "SIGQUIT", "SIGUSR1", "SIGUSR2", "SIGTERM", "SIGKILL"}
+
+// TaskStopPolicy is how the executor stops a task process, whether the task
+// is killed, its environment torn down or a basic task stopped. The steps are
+// the PreStop hook, the OCC teardown transitions down to DONE for
+// controllable tasks if Transition is set, then each of the Signals in turn,
+// as long as the process is still alive. A SIGKILL always comes last.
+//
+// If Signals is nil the executor uses the default sequence of the control
+// mode, an empty list means the process is killed straight away.
+type TaskStopPolicy struct {
+	Transition        bool             `json:"transition"`
+	TransitionTimeout time.Duration    `json:"transitionTimeout"`
+	PreStop           *TaskStopHook    `json:"preStop,omitempty"`
+	Signals           []TaskStopSignal `json:"signals"`
+}
+
+// TaskStopHook is a command run with the environment and user of the task
+// before it is stopped.
+type TaskStopHook struct {
+	Command []string      `json:"command"`
+	Timeout time.Duration `json:"timeout"`
+}
+
+// TaskStopSignal is a signal sent to the task process, followed by a grace
+// period for the process to exit before the next step.
+type TaskStopSignal struct {
+	Signal string        `json:"signal"`
+	Grace  time.Duration `json:"grace"`
+}
+
+func (p *TaskStopPolicy) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _taskStopHook struct {
+		Command []string `yaml:"command"`
+		Timeout string   `yaml:"timeout"`
+	}
+	type _taskStopSignal struct {
+		Signal string `yaml:"signal"`
+		Grace  string `yaml:"grace"`
+	}
+	type _taskStopPolicy struct {
+		Transition        *bool             `yaml:"transition"`
+		TransitionTimeout string            `yaml:"transitionTimeout"`
+		PreStop           *_taskStopHook    `yaml:"preStop"`
+		Signals           []_taskStopSignal `yaml:"signals"`
+	}
+	aux := _taskStopPolicy{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+
+	policy := TaskStopPolicy{
+		Transition:        true,
+		TransitionTimeout: DEFAULT_STOP_TRANSITION_TIMEOUT,
+	}
+	if aux.Transition != nil {
+		policy.Transition = *aux.Transition
+	}
+	if len(aux.TransitionTimeout) > 0 {
+		if policy.TransitionTimeout, err = parseStopDuration("transitionTimeout", aux.TransitionTimeout); err != nil {
+			return
+		}
+		if policy.TransitionTimeout == 0 {
+			return fmt.Errorf("invalid stop transitionTimeout %s, must be positive", aux.TransitionTimeout)
+		}
+	}
+	if aux.PreStop != nil {
+		policy.PreStop = &TaskStopHook{
+			Command: aux.PreStop.Command,
+			Timeout: DEFAULT_PRE_STOP_TIMEOUT,
+		}
+		if len(aux.PreStop.Timeout) > 0 {
+			if policy.PreStop.Timeout, err = parseStopDuration("preStop timeout", aux.PreStop.Timeout); err != nil {
+				return
+			}
+			if policy.PreStop.Timeout == 0 {
+				return fmt.Errorf("invalid stop preStop timeout %s, must be positive", aux.PreStop.Timeout)
+			}
+		}
+	}
+	if aux.Signals != nil {
+		policy.Signals = make([]TaskStopSignal, len(aux.Signals))
+		for i, s := range aux.Signals {
+			policy.Signals[i] = TaskStopSignal{Signal: s.Signal, Grace: DEFAULT_STOP_SIGNAL_GRACE}
+			if policy.Signals[i].Signal, err = NormalizeStopSignal(s.Signal); err != nil {
+				return
+			}
+			if len(s.Grace) > 0 {
+				if policy.Signals[i].Grace, err = parseStopDuration("signal grace", s.Grace); err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	*p = policy
+	return
+}
+
+func (p *TaskStopPolicy) MarshalYAML() (interface{}, error) {
+	type _taskStopHook struct {
+		Command []string `yaml:"command"`
+		Timeout string   `yaml:"timeout"`
+	}
+	type _taskStopSignal struct {
+		Signal string `yaml:"signal"`
+		Grace  string `yaml:"grace"`
+	}
+	type _taskStopPolicy struct {
+		Transition        bool              `yaml:"transition"`
+		TransitionTimeout string            `yaml:"transitionTimeout"`
+		PreStop           *_taskStopHook    `yaml:"preStop,omitempty"`
+		Signals           []_taskStopSignal `yaml:"signals,omitempty"`
+	}
+	aux := _taskStopPolicy{
+		Transition:        p.Transition,
+		TransitionTimeout: p.TransitionTimeout.String(),
+	}
+	if p.PreStop != nil {
+		aux.PreStop = &_taskStopHook{
+			Command: p.PreStop.Command,
+			Timeout: p.PreStop.Timeout.String(),
+		}
+	}
+	if p.Signals != nil {
+		aux.Signals = make([]_taskStopSignal, len(p.Signals))
+		for i, s := range p.Signals {
+			aux.Signals[i] = _taskStopSignal{Signal: s.Signal, Grace: s.Grace.String()}
+		}
+	}
+	return aux, nil
+}
+
+func parseStopDuration(name string, value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid stop %s %s: %w", name, value, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid stop %s %s, must not be negative", name, value)
+	}
+	return d, nil
+}
+
+// NormalizeStopSignal returns the name of a signal which a stop policy can
+// send, as in SIGTERM, accepting TERM or sigterm as well.
+func NormalizeStopSignal(signal string) (string, error) {
+	name := strings.ToUpper(strings.TrimSpace(signal))
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
+	}
+	for _, s := range stopSignals {
+		if s == name {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported stop signal %q, allowed values: %s", signal, strings.Join(stopSignals, ", "))
+}
+
+func (p *TaskStopPolicy) Copy() *TaskStopPolicy {
+	if p == nil {
+		return nil
+	}
+	policy := *p
+	if p.PreStop != nil {
+		policy.PreStop = &TaskStopHook{
+			Command: append([]string{}, p.PreStop.Command...),
+			Timeout: p.PreStop.Timeout,
+		}
+	}
+	if p.Signals != nil {
+		policy.Signals = append([]TaskStopSignal{}, p.Signals...)
+	}
+	return &policy
+}
+
+// Validate checks the stop policy once its fields have been templated.
+func (p *TaskStopPolicy) Validate() error {
+	if p == nil || p.PreStop == nil {
+		return nil
+	}
+	if len(p.PreStop.Command) == 0 || len(strings.TrimSpace(p.PreStop.Command[0])) == 0 {
+		return fmt.Errorf("stop preStop hook has no command")
+	}
+	return nil
+}
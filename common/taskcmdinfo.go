@@ -25,9 +25,13 @@
 package common
 
 import (
+	"fmt"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/common/secrets"
 )
 
 type TaskCommandInfo struct {
@@ -37,4 +41,63 @@ type TaskCommandInfo struct {
 
 	// Only applies to hooks and basic tasks
 	Timeout time.Duration `json:"timeout,omitempty"`
+
+	// CPU and NUMA placement, applied by the executor if set
+	Affinity *TaskAffinity `json:"affinity,omitempty"`
+
+	// Health checks run by the executor while the task process lives
+	Probes *TaskProbes `json:"probes,omitempty"`
+
+	// Files fetched by the executor into the task sandbox before launch
+	Fetch TaskArtifacts `json:"fetch,omitempty"`
+
+	// How the executor stops the task process, its defaults apply if unset
+	Stop *TaskStopPolicy `json:"stop,omitempty"`
+
+	// Values of the secrets referenced by the command, only filled in the copy
+	// which is sent to the executor at launch time, never in the task state
+	// kept by the core
+	Secrets map[string]string `json:"secrets,omitempty"`
+}
+
+// ReferencedSecrets returns the names of the secrets referenced in the
+// command line and environment of the task.
+func (m *TaskCommandInfo) ReferencedSecrets() []string {
+	if m == nil {
+		return nil
+	}
+	values := append([]string{}, m.Arguments...)
+	if m.Value != nil {
+		values = append(values, *m.Value)
+	}
+	for _, entry := range m.Env {
+		if _, value, hasValue := strings.Cut(entry, "="); hasValue {
+			values = append(values, value)
+		}
+	}
+	return secrets.ReferencedNames(values...)
+}
+
+// ValidateProcessSettings checks the working directory, resource limits and
+// umask of the command once they have been templated. A relative working
+// directory is resolved against the artifact sandbox of the task, so it
+// requires the task to have one.
+func (m *TaskCommandInfo) ValidateProcessSettings() error {
+	if m.WorkingDir != nil && len(*m.WorkingDir) > 0 && !path.IsAbs(*m.WorkingDir) {
+		if len(m.Fetch) == 0 {
+			return fmt.Errorf("relative working directory %s requires task artifacts to be fetched", *m.WorkingDir)
+		}
+		if dir := path.Clean(*m.WorkingDir); dir == ".." || strings.HasPrefix(dir, "../") {
+			return fmt.Errorf("relative working directory %s must be within the sandbox", *m.WorkingDir)
+		}
+	}
+	if err := m.Rlimits.Validate(); err != nil {
+		return err
+	}
+	if m.Umask != nil && len(*m.Umask) > 0 {
+		if _, err := ParseUmask(*m.Umask); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -27,6 +27,7 @@ package cfgbackend
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -34,9 +35,15 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+// maxTxnOps is the maximum number of operations Consul accepts in a single
+// transaction, larger recursive writes are split into several transactions
+// and are therefore not atomic.
+const maxTxnOps = 64
+
 type ConsulSource struct {
 	uri string
 	kv  *api.KV
+	txn *api.Txn
 }
 
 func NewConsulSource(uri string) (cc *ConsulSource, err error) {
@@ -49,6 +56,7 @@ func NewConsulSource(uri string) (cc *ConsulSource, err error) {
 	cc = &ConsulSource{
 		uri: uri,
 		kv:  cli.KV(),
+		txn: cli.Txn(),
 	}
 	return
 }
@@ -136,10 +144,18 @@ func (cc *ConsulSource) GetRecursive(key string) (value Item, err error) {
 	if err != nil {
 		return
 	}
+	subtreeKvps := make(api.KVPairs, 0, len(kvps))
 	for _, kvp := range kvps {
+		// Consul lists by string prefix, so we must skip sibling keys which
+		// merely start with the same characters, e.g. "qcx" for "qc"
+		if len(requestKey) > 0 && !strings.HasSuffix(requestKey, "/") &&
+			kvp.Key != requestKey && !strings.HasPrefix(kvp.Key, requestKey+"/") {
+			continue
+		}
 		kvp.Key = stripRequestKey(requestKey, kvp.Key)
+		subtreeKvps = append(subtreeKvps, kvp)
 	}
-	return mapify(kvps), nil
+	return mapify(subtreeKvps), nil
 }
 
 func (cc *ConsulSource) GetRecursiveYaml(key string) (value []byte, err error) {
@@ -158,14 +174,129 @@ func (cc *ConsulSource) Put(key string, value string) (err error) {
 	return
 }
 
-func (cc *ConsulSource) PutRecursive(string, Item) error {
-	// FIXME
-	panic("implement me")
+// PutRecursive replaces the subtree at key with the given item.
+// Maps become Consul folders, and since Consul has no notion of arrays,
+// an item which contains an Array is rejected.
+// The write is only atomic if it fits in a single Consul transaction, i.e.
+// if deleting the old subtree and setting the new keys takes at most
+// maxTxnOps operations. Larger writes are split into several transactions,
+// and other clients may observe a partially written subtree while they are
+// being committed. The whole item is checked before anything is written,
+// and should any transaction fail after the first one was committed, the
+// previous contents of the subtree are put back. If that also fails, the
+// returned error says so, and the subtree must be restored by hand.
+func (cc *ConsulSource) PutRecursive(key string, value Item) (err error) {
+	requestKey := strings.TrimSuffix(formatKey(key), "/")
+	if value == nil {
+		return fmt.Errorf("cannot put nil item at key %s", key)
+	}
+	if len(requestKey) == 0 && value.Type() != IT_Map {
+		return errors.New("only a map can be put at the root of the configuration tree")
+	}
+
+	var kvps api.KVPairs
+	kvps, err = flattenItem(requestKey, value)
+	if err != nil {
+		return
+	}
+
+	// Snapshot what we are about to replace, in case we need to roll back
+	var previous api.KVPairs
+	previous, err = cc.listSubtree(requestKey)
+	if err != nil {
+		return
+	}
+
+	ops := append(deleteSubtreeOps(requestKey), setOps(kvps)...)
+	if len(ops) <= maxTxnOps {
+		return cc.commitTxn(ops)
+	}
+
+	committed := 0
+	for ; committed < len(ops); committed += maxTxnOps {
+		err = cc.commitTxn(ops[committed:min(committed+maxTxnOps, len(ops))])
+		if err != nil {
+			break
+		}
+	}
+	if err != nil && committed == 0 {
+		// the first transaction was rolled back by Consul, nothing to undo
+		return fmt.Errorf("recursive put failed, contents of %s unchanged: %w", key, err)
+	}
+	if err != nil {
+		rollbackOps := append(deleteSubtreeOps(requestKey), setOps(previous)...)
+		for i := 0; i < len(rollbackOps); i += maxTxnOps {
+			rollbackErr := cc.commitTxn(rollbackOps[i:min(i+maxTxnOps, len(rollbackOps))])
+			if rollbackErr != nil {
+				return fmt.Errorf("recursive put failed (%w), and restoring the previous contents of %s also failed: %s", err, key, rollbackErr.Error())
+			}
+		}
+		return fmt.Errorf("recursive put failed, previous contents of %s restored: %w", key, err)
+	}
+	return
+}
+
+func (cc *ConsulSource) PutRecursiveYaml(key string, value []byte) (err error) {
+	var (
+		raw    interface{}
+		cooked Item
+	)
+	err = yaml.Unmarshal(value, &raw)
+	if err != nil {
+		return
+	}
+
+	cooked, err = intfToItem(raw)
+	if err != nil {
+		return
+	}
+
+	err = cc.PutRecursive(key, cooked)
+	return
+}
+
+func (cc *ConsulSource) listSubtree(requestKey string) (kvps api.KVPairs, err error) {
+	if len(requestKey) == 0 {
+		kvps, _, err = cc.kv.List("", &api.QueryOptions{RequireConsistent: true})
+		return
+	}
+	var kvp *api.KVPair
+	kvp, _, err = cc.kv.Get(requestKey, &api.QueryOptions{RequireConsistent: true})
+	if err != nil {
+		return
+	}
+	if kvp != nil {
+		kvps = append(kvps, kvp)
+	}
+	var children api.KVPairs
+	children, _, err = cc.kv.List(requestKey+"/", &api.QueryOptions{RequireConsistent: true})
+	if err != nil {
+		return
+	}
+	kvps = append(kvps, children...)
+	return
 }
 
-func (cc *ConsulSource) PutRecursiveYaml(string, []byte) error {
-	// FIXME
-	panic("implement me")
+func (cc *ConsulSource) commitTxn(kvOps api.KVTxnOps) error {
+	ops := make(api.TxnOps, len(kvOps))
+	for i, op := range kvOps {
+		ops[i] = &api.TxnOp{KV: op}
+	}
+	ok, response, _, err := cc.txn.Txn(ops, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if response != nil && len(response.Errors) > 0 {
+			msgs := make([]string, len(response.Errors))
+			for i, txnErr := range response.Errors {
+				msgs[i] = fmt.Sprintf("operation %d: %s", txnErr.OpIndex, txnErr.What)
+			}
+			return fmt.Errorf("consul transaction rolled back: %s", strings.Join(msgs, ", "))
+		}
+		return errors.New("consul transaction rolled back")
+	}
+	return nil
 }
 
 func (cc *ConsulSource) Exists(key string) (exists bool, err error) {
@@ -189,6 +320,58 @@ func (cc *ConsulSource) IsDir(key string) (isDir bool) {
 	return
 }
 
+// flattenItem turns an Item into the list of Consul key-value pairs that
+// represent it at the given key, including the folder keys for maps.
+func flattenItem(key string, item Item) (kvps api.KVPairs, err error) {
+	switch item.Type() {
+	case IT_Value:
+		kvps = append(kvps, &api.KVPair{Key: key, Value: []byte(item.Value())})
+	case IT_Map:
+		prefix := ""
+		if len(key) > 0 {
+			prefix = key + "/"
+			kvps = append(kvps, &api.KVPair{Key: prefix})
+		}
+		childKeys := make([]string, 0, len(item.Map()))
+		for childKey := range item.Map() {
+			childKeys = append(childKeys, childKey)
+		}
+		sort.Strings(childKeys)
+		for _, childKey := range childKeys {
+			if len(childKey) == 0 || strings.Contains(childKey, "/") {
+				return nil, fmt.Errorf("bad key %s in subtree %s", childKey, key)
+			}
+			var childKvps api.KVPairs
+			childKvps, err = flattenItem(prefix+childKey, item.Map()[childKey])
+			if err != nil {
+				return nil, err
+			}
+			kvps = append(kvps, childKvps...)
+		}
+	case IT_Array:
+		return nil, fmt.Errorf("cannot put array at key %s, arrays are not supported by the Consul backend", key)
+	}
+	return
+}
+
+func deleteSubtreeOps(key string) api.KVTxnOps {
+	if len(key) == 0 {
+		return api.KVTxnOps{{Verb: api.KVDeleteTree, Key: ""}}
+	}
+	return api.KVTxnOps{
+		{Verb: api.KVDelete, Key: key},
+		{Verb: api.KVDeleteTree, Key: key + "/"},
+	}
+}
+
+func setOps(kvps api.KVPairs) api.KVTxnOps {
+	ops := make(api.KVTxnOps, len(kvps))
+	for i, kvp := range kvps {
+		ops[i] = &api.KVTxnOp{Verb: api.KVSet, Key: kvp.Key, Value: kvp.Value, Flags: kvp.Flags}
+	}
+	return ops
+}
+
 func formatKey(key string) (consulKey string) {
 	// Trim leading slashes
 	consulKey = strings.TrimLeft(key, "/")
@@ -0,0 +1,233 @@
+package cfgbackend_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/AliceO2Group/Control/configuration/cfgbackend"
+	"github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+)
+
+// fakeConsul implements the subset of the Consul KV and transaction HTTP
+// API used by ConsulSource, including the transaction size limit.
+type fakeConsul struct {
+	mu       sync.Mutex
+	kv       map[string][]byte
+	txnCount int
+	// a transaction which sets this key is rolled back
+	failKey string
+}
+
+func (f *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.URL.Path == "/v1/txn" && r.Method == http.MethodPut:
+		f.serveTxn(w, r)
+	case strings.HasPrefix(r.URL.Path, "/v1/kv/") && r.Method == http.MethodGet:
+		f.serveGet(w, r)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func (f *fakeConsul) serveTxn(w http.ResponseWriter, r *http.Request) {
+	var ops api.TxnOps
+	if err := json.NewDecoder(r.Body).Decode(&ops); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if len(ops) > 64 {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		_, _ = fmt.Fprintf(w, "Transaction contains too many operations (%d > 64)", len(ops))
+		return
+	}
+
+	staged := make(map[string][]byte, len(f.kv))
+	for k, v := range f.kv {
+		staged[k] = v
+	}
+	for i, op := range ops {
+		switch op.KV.Verb {
+		case api.KVSet:
+			if f.failKey != "" && op.KV.Key == f.failKey {
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(api.TxnResponse{Errors: api.TxnErrors{{OpIndex: i, What: "injected failure"}}})
+				return
+			}
+			staged[op.KV.Key] = op.KV.Value
+		case api.KVDelete:
+			delete(staged, op.KV.Key)
+		case api.KVDeleteTree:
+			for k := range staged {
+				if strings.HasPrefix(k, op.KV.Key) {
+					delete(staged, k)
+				}
+			}
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+	f.kv = staged
+	f.txnCount++
+	_ = json.NewEncoder(w).Encode(api.TxnResponse{})
+}
+
+func (f *fakeConsul) serveGet(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+	query := r.URL.Query()
+
+	var keys []string
+	for k := range f.kv {
+		if k == key || ((query.Has("recurse") || query.Has("keys")) && strings.HasPrefix(k, key)) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	if query.Has("keys") {
+		_ = json.NewEncoder(w).Encode(keys)
+		return
+	}
+	if len(keys) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	kvps := make(api.KVPairs, len(keys))
+	for i, k := range keys {
+		kvps[i] = &api.KVPair{Key: k, Value: f.kv[k]}
+	}
+	_ = json.NewEncoder(w).Encode(kvps)
+}
+
+var _ = Describe("ConsulSource", func() {
+	var (
+		fake   *fakeConsul
+		server *httptest.Server
+		c      *cfgbackend.ConsulSource
+		err    error
+	)
+
+	BeforeEach(func() {
+		fake = &fakeConsul{kv: map[string][]byte{
+			"o2/":                 nil,
+			"o2/components/":      nil,
+			"o2/components/qc/":   nil,
+			"o2/components/qc/a":  []byte("old a"),
+			"o2/components/qc/b":  []byte("old b"),
+			"o2/components/qcx":   []byte("sibling"),
+			"o2/runtime/":         nil,
+			"o2/runtime/counters": []byte("42"),
+		}}
+		server = httptest.NewServer(fake)
+		c, err = cfgbackend.NewConsulSource(strings.TrimPrefix(server.URL, "http://"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("recursively putting a subtree", func() {
+		It("should replace the subtree and create folders for maps", func() {
+			err = c.PutRecursive("o2/components/qc", cfgbackend.Map{
+				"a": cfgbackend.String("new a"),
+				"c": cfgbackend.Map{
+					"d": cfgbackend.String("new d"),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fake.txnCount).To(Equal(1))
+
+			Expect(c.GetRecursive("o2/components/qc")).To(Equal(cfgbackend.Map{
+				"a": cfgbackend.String("new a"),
+				"c": cfgbackend.Map{
+					"d": cfgbackend.String("new d"),
+				},
+			}))
+			Expect(c.IsDir("o2/components/qc/c")).To(BeTrue())
+			Expect(c.Exists("o2/components/qc/b")).To(BeFalse())
+			Expect(c.Get("o2/components/qcx")).To(Equal("sibling"))
+			Expect(c.Get("o2/runtime/counters")).To(Equal("42"))
+		})
+
+		It("should put a single value", func() {
+			err = c.PutRecursive("o2/components/qc", cfgbackend.String("flat"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Get("o2/components/qc")).To(Equal("flat"))
+			Expect(c.Exists("o2/components/qc/a")).To(BeFalse())
+		})
+
+		It("should reject arrays", func() {
+			err = c.PutRecursive("o2/components/qc", cfgbackend.Map{
+				"a": cfgbackend.Array{cfgbackend.String("x")},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(fake.txnCount).To(BeZero())
+			Expect(c.Get("o2/components/qc/a")).To(Equal("old a"))
+		})
+
+		It("should put a YAML subtree", func() {
+			err = c.PutRecursiveYaml("o2/components/qc", []byte("a: 1\nc:\n  d: true\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Get("o2/components/qc/a")).To(Equal("1"))
+			Expect(c.Get("o2/components/qc/c/d")).To(Equal("true"))
+		})
+
+		It("should replace the whole tree when putting at the root", func() {
+			marshalled, marshErr := yaml.Marshal(cfgbackend.Map{"o2": cfgbackend.Map{"x": cfgbackend.String("y")}})
+			Expect(marshErr).NotTo(HaveOccurred())
+			err = c.PutRecursiveYaml("/", marshalled)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.GetRecursive("")).To(Equal(cfgbackend.Map{"o2": cfgbackend.Map{"x": cfgbackend.String("y")}}))
+		})
+
+		When("the subtree does not fit in a single transaction", func() {
+			var big cfgbackend.Map
+			BeforeEach(func() {
+				big = make(cfgbackend.Map)
+				for i := 0; i < 150; i++ {
+					big[fmt.Sprintf("key%03d", i)] = cfgbackend.String(fmt.Sprintf("value %d", i))
+				}
+			})
+
+			It("should split the write into several transactions", func() {
+				err = c.PutRecursive("o2/components/qc", big)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fake.txnCount).To(Equal(3))
+				Expect(c.GetRecursive("o2/components/qc")).To(Equal(big))
+			})
+
+			It("should leave the subtree untouched if the first transaction fails", func() {
+				fake.failKey = "o2/components/qc/key000"
+				err = c.PutRecursive("o2/components/qc", big)
+				Expect(err).To(MatchError(ContainSubstring("unchanged")))
+				Expect(fake.txnCount).To(BeZero())
+				Expect(c.GetRecursive("o2/components/qc")).To(Equal(cfgbackend.Map{
+					"a": cfgbackend.String("old a"),
+					"b": cfgbackend.String("old b"),
+				}))
+			})
+
+			It("should restore the previous contents if a later transaction fails", func() {
+				fake.failKey = "o2/components/qc/key140"
+				err = c.PutRecursive("o2/components/qc", big)
+				Expect(err).To(HaveOccurred())
+				Expect(c.GetRecursive("o2/components/qc")).To(Equal(cfgbackend.Map{
+					"a": cfgbackend.String("old a"),
+					"b": cfgbackend.String("old b"),
+				}))
+				Expect(c.IsDir("o2/components/qc")).To(BeTrue())
+			})
+		})
+	})
+})
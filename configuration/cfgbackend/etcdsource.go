@@ -0,0 +1,430 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cfgbackend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	etcdDialTimeout    = 5 * time.Second
+	etcdRequestTimeout = 10 * time.Second
+	// maxEtcdTxnOps is the default value of etcd's --max-txn-ops, larger
+	// recursive writes are split into several transactions and are
+	// therefore not atomic.
+	maxEtcdTxnOps = 128
+)
+
+// EtcdSource stores the configuration tree in etcd, with the same key layout
+// as ConsulSource: keys are separated by "/", and maps are also represented
+// by folder keys ending with "/".
+type EtcdSource struct {
+	uri string
+	cli *clientv3.Client
+}
+
+// NewEtcdSource connects to etcd, uri is a comma-separated list of
+// HOST:PORT endpoints.
+func NewEtcdSource(uri string) (ec *EtcdSource, err error) {
+	var cli *clientv3.Client
+	cli, err = clientv3.New(clientv3.Config{
+		Endpoints:   strings.Split(uri, ","),
+		DialTimeout: etcdDialTimeout,
+	})
+	if err != nil {
+		return
+	}
+	ec = &EtcdSource{
+		uri: uri,
+		cli: cli,
+	}
+	return
+}
+
+func (ec *EtcdSource) Close() error {
+	return ec.cli.Close()
+}
+
+// GetNextUInt32 atomically increments the counter at key and returns the new
+// value. If the counter is concurrently modified, the increment is retried
+// with the value read back from the failed transaction until it succeeds.
+func (ec *EtcdSource) GetNextUInt32(key string) (value uint32, err error) {
+	requestKey := formatKey(key)
+
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+
+	var resp *clientv3.GetResponse
+	resp, err = ec.cli.Get(ctx, requestKey)
+	if err != nil {
+		return
+	}
+	kvs := resp.Kvs
+	for {
+		var (
+			current uint64
+			cmp     clientv3.Cmp
+		)
+		if len(kvs) == 0 {
+			// Key doesn't exist, we create it only if nobody else did in the meantime
+			cmp = clientv3.Compare(clientv3.CreateRevision(requestKey), "=", 0)
+		} else {
+			current, err = strconv.ParseUint(string(kvs[0].Value), 10, 32)
+			if err != nil {
+				return
+			}
+			cmp = clientv3.Compare(clientv3.ModRevision(requestKey), "=", kvs[0].ModRevision)
+		}
+		if current >= math.MaxUint32 {
+			return 0, fmt.Errorf("counter %s overflow", key)
+		}
+		value = uint32(current + 1)
+
+		var txnResp *clientv3.TxnResponse
+		txnResp, err = ec.cli.Txn(ctx).
+			If(cmp).
+			Then(clientv3.OpPut(requestKey, strconv.FormatUint(uint64(value), 10))).
+			Else(clientv3.OpGet(requestKey)).
+			Commit()
+		if err != nil {
+			return 0, fmt.Errorf("cannot write back incremented CAS key: %w", err)
+		}
+		if txnResp.Succeeded {
+			return
+		}
+		kvs = txnResp.Responses[0].GetResponseRange().Kvs
+	}
+}
+
+func (ec *EtcdSource) Get(key string) (value string, err error) {
+	value, _, err = ec.GetWithLastIndex(key)
+	return
+}
+
+// GetWithLastIndex returns the value at key along with the etcd revision of
+// its last modification.
+func (ec *EtcdSource) GetWithLastIndex(key string) (value string, lastIndex uint64, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+
+	var resp *clientv3.GetResponse
+	resp, err = ec.cli.Get(ctx, formatKey(key))
+	if err != nil {
+		return
+	}
+	if len(resp.Kvs) == 0 {
+		err = fmt.Errorf("nil response for key %s", key)
+		return
+	}
+	value = string(resp.Kvs[0].Value)
+	lastIndex = uint64(resp.Kvs[0].ModRevision)
+	return
+}
+
+func (ec *EtcdSource) GetKeysByPrefix(keyPrefix string) (keys []string, err error) {
+	if len(keyPrefix) > 0 {
+		keyPrefix = strings.TrimSuffix(formatKey(keyPrefix), "/") + "/"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+
+	var resp *clientv3.GetResponse
+	resp, err = ec.cli.Get(ctx, keyPrefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return
+	}
+
+	// Folder keys might be missing if the tree wasn't written by PutRecursive,
+	// so we add them for every intermediate level, like the YAML backend does.
+	keySet := make(map[string]struct{})
+	for _, kv := range resp.Kvs {
+		key := string(kv.Key)
+		keySet[key] = struct{}{}
+		for i := len(keyPrefix); i < len(key)-1; i++ {
+			if key[i] == '/' {
+				keySet[key[:i+1]] = struct{}{}
+			}
+		}
+	}
+	keys = make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return
+}
+
+func (ec *EtcdSource) GetRecursive(key string) (value Item, err error) {
+	requestKey := strings.TrimSuffix(formatKey(key), "/")
+
+	var kvps api.KVPairs
+	kvps, err = ec.listSubtree(requestKey)
+	if err != nil {
+		return
+	}
+
+	subtreeKvps := make(api.KVPairs, 0, len(kvps))
+	for _, kvp := range kvps {
+		if len(requestKey) > 0 && kvp.Key == requestKey {
+			if len(kvps) == 1 {
+				// a single value, not a subtree
+				return String(kvp.Value), nil
+			}
+			continue
+		}
+		kvp.Key = stripRequestKey(requestKey, kvp.Key)
+		subtreeKvps = append(subtreeKvps, kvp)
+	}
+	return mapify(subtreeKvps), nil
+}
+
+func (ec *EtcdSource) GetRecursiveYaml(key string) (value []byte, err error) {
+	var item Item
+	item, err = ec.GetRecursive(key)
+	if err != nil {
+		return
+	}
+	value, err = yaml.Marshal(item)
+	return
+}
+
+func (ec *EtcdSource) Put(key string, value string) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+
+	_, err = ec.cli.Put(ctx, formatKey(key), value)
+	return
+}
+
+// PutRecursive replaces the subtree at key with the given item, with the
+// same semantics as ConsulSource.PutRecursive: the write is only atomic if
+// it fits in a single transaction of maxEtcdTxnOps operations.
+// Since etcd rejects transactions which put a key within a range deleted by
+// the same transaction, stale keys are deleted one by one.
+func (ec *EtcdSource) PutRecursive(key string, value Item) (err error) {
+	requestKey := strings.TrimSuffix(formatKey(key), "/")
+	if value == nil {
+		return fmt.Errorf("cannot put nil item at key %s", key)
+	}
+	if len(requestKey) == 0 && value.Type() != IT_Map {
+		return errors.New("only a map can be put at the root of the configuration tree")
+	}
+
+	var kvps api.KVPairs
+	kvps, err = flattenItem(requestKey, value)
+	if err != nil {
+		return
+	}
+
+	// Snapshot what we are about to replace, in case we need to roll back
+	var previous api.KVPairs
+	previous, err = ec.listSubtree(requestKey)
+	if err != nil {
+		return
+	}
+
+	ops := replaceOps(previous, kvps)
+	if len(ops) <= maxEtcdTxnOps {
+		return ec.commitTxn(ops)
+	}
+
+	committed := 0
+	for ; committed < len(ops); committed += maxEtcdTxnOps {
+		err = ec.commitTxn(ops[committed:min(committed+maxEtcdTxnOps, len(ops))])
+		if err != nil {
+			break
+		}
+	}
+	if err != nil && committed == 0 {
+		// the first transaction was not applied, nothing to undo
+		return fmt.Errorf("recursive put failed, contents of %s unchanged: %w", key, err)
+	}
+	if err != nil {
+		rollbackOps := replaceOps(kvps, previous)
+		for i := 0; i < len(rollbackOps); i += maxEtcdTxnOps {
+			rollbackErr := ec.commitTxn(rollbackOps[i:min(i+maxEtcdTxnOps, len(rollbackOps))])
+			if rollbackErr != nil {
+				return fmt.Errorf("recursive put failed (%w), and restoring the previous contents of %s also failed: %s", err, key, rollbackErr.Error())
+			}
+		}
+		return fmt.Errorf("recursive put failed, previous contents of %s restored: %w", key, err)
+	}
+	return
+}
+
+func (ec *EtcdSource) PutRecursiveYaml(key string, value []byte) (err error) {
+	var (
+		raw    interface{}
+		cooked Item
+	)
+	err = yaml.Unmarshal(value, &raw)
+	if err != nil {
+		return
+	}
+
+	cooked, err = intfToItem(raw)
+	if err != nil {
+		return
+	}
+
+	err = ec.PutRecursive(key, cooked)
+	return
+}
+
+func (ec *EtcdSource) Exists(key string) (exists bool, err error) {
+	requestKey := strings.TrimSuffix(formatKey(key), "/")
+	if len(requestKey) == 0 {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+
+	var resp *clientv3.GetResponse
+	resp, err = ec.cli.Get(ctx, requestKey, clientv3.WithCountOnly())
+	if err != nil {
+		return
+	}
+	if resp.Count > 0 {
+		return true, nil
+	}
+	return ec.IsDir(requestKey), nil
+}
+
+func (ec *EtcdSource) IsDir(key string) (isDir bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+
+	resp, err := ec.cli.Get(ctx, strings.TrimSuffix(formatKey(key), "/")+"/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return false
+	}
+	return resp.Count > 0
+}
+
+// WatchRecursive notifies about changes to any key in the subtree at key.
+// Each notification carries the list of changed keys, and the channel is
+// closed when ctx is done or the watch is cancelled by the server.
+func (ec *EtcdSource) WatchRecursive(ctx context.Context, key string) (<-chan []string, error) {
+	requestKey := strings.TrimSuffix(formatKey(key), "/")
+
+	watchChan := ec.cli.Watch(clientv3.WithRequireLeader(ctx), requestKey, clientv3.WithPrefix())
+	changes := make(chan []string)
+	go func() {
+		defer close(changes)
+		for watchResp := range watchChan {
+			if watchResp.Canceled {
+				return
+			}
+			changedKeys := make([]string, 0, len(watchResp.Events))
+			for _, ev := range watchResp.Events {
+				changedKey := string(ev.Kv.Key)
+				if !isInSubtree(requestKey, changedKey) {
+					continue
+				}
+				changedKeys = append(changedKeys, changedKey)
+			}
+			if len(changedKeys) == 0 {
+				continue
+			}
+			select {
+			case changes <- changedKeys:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return changes, nil
+}
+
+// listSubtree returns the key at requestKey, if any, and all the keys under it.
+// The results are returned as Consul KVPairs, so we can reuse the same tree
+// building and flattening functions.
+func (ec *EtcdSource) listSubtree(requestKey string) (kvps api.KVPairs, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+
+	var resp *clientv3.GetResponse
+	resp, err = ec.cli.Get(ctx, requestKey, clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return
+	}
+	for _, kv := range resp.Kvs {
+		if !isInSubtree(requestKey, string(kv.Key)) {
+			continue
+		}
+		kvps = append(kvps, &api.KVPair{Key: string(kv.Key), Value: kv.Value})
+	}
+	return
+}
+
+func (ec *EtcdSource) commitTxn(ops []clientv3.Op) error {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+
+	resp, err := ec.cli.Txn(ctx).Then(ops...).Commit()
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		return errors.New("etcd transaction rolled back")
+	}
+	return nil
+}
+
+// replaceOps returns the operations which turn the from key-values into the
+// to key-values, without overlapping puts and deletes.
+func replaceOps(from api.KVPairs, to api.KVPairs) []clientv3.Op {
+	toKeys := make(map[string]struct{}, len(to))
+	for _, kvp := range to {
+		toKeys[kvp.Key] = struct{}{}
+	}
+	ops := make([]clientv3.Op, 0, len(from)+len(to))
+	for _, kvp := range from {
+		if _, ok := toKeys[kvp.Key]; !ok {
+			ops = append(ops, clientv3.OpDelete(kvp.Key))
+		}
+	}
+	for _, kvp := range to {
+		ops = append(ops, clientv3.OpPut(kvp.Key, string(kvp.Value)))
+	}
+	return ops
+}
+
+func isInSubtree(requestKey string, key string) bool {
+	return len(requestKey) == 0 || key == requestKey || strings.HasPrefix(key, requestKey+"/")
+}
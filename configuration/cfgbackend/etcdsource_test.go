@@ -0,0 +1,230 @@
+package cfgbackend_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/configuration/cfgbackend"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.etcd.io/etcd/server/v3/embed"
+	"gopkg.in/yaml.v3"
+)
+
+func freeLocalUrl() url.URL {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+	defer lis.Close()
+	return url.URL{Scheme: "http", Host: lis.Addr().String()}
+}
+
+var _ = Describe("EtcdSource", Ordered, func() {
+	var (
+		etcd    *embed.Etcd
+		etcdDir string
+		c       *cfgbackend.EtcdSource
+		err     error
+	)
+
+	BeforeAll(func() {
+		etcdDir, err = os.MkdirTemp("", "o2control-etcd")
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := embed.NewConfig()
+		cfg.Dir = etcdDir
+		cfg.LogLevel = "error"
+		clientUrl, peerUrl := freeLocalUrl(), freeLocalUrl()
+		cfg.ListenClientUrls, cfg.AdvertiseClientUrls = []url.URL{clientUrl}, []url.URL{clientUrl}
+		cfg.ListenPeerUrls, cfg.AdvertisePeerUrls = []url.URL{peerUrl}, []url.URL{peerUrl}
+		cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
+
+		etcd, err = embed.StartEtcd(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(etcd.Server.ReadyNotify()).WithTimeout(30 * time.Second).Should(BeClosed())
+
+		var src cfgbackend.Source
+		src, err = cfgbackend.NewSource("etcd://" + clientUrl.Host)
+		Expect(err).NotTo(HaveOccurred())
+		c = src.(*cfgbackend.EtcdSource)
+	})
+
+	AfterAll(func() {
+		if c != nil {
+			_ = c.Close()
+		}
+		if etcd != nil {
+			etcd.Close()
+		}
+		Expect(os.RemoveAll(etcdDir)).To(Succeed())
+	})
+
+	BeforeEach(func() {
+		err = c.PutRecursiveYaml("/", []byte(`
+o2:
+  components:
+    qc:
+      ANY:
+        any:
+          entry: config
+    qcx: sibling
+  runtime:
+    aliecs:
+      run_number: "41"
+`))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("reading the configuration tree", func() {
+		It("should get a single value", func() {
+			Expect(c.Get("o2/components/qc/ANY/any/entry")).To(Equal("config"))
+			_, err = c.Get("o2/components/qc/ANY/any/nope")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should get a value with its last modification index", func() {
+			_, firstIndex, err := c.GetWithLastIndex("o2/components/qc/ANY/any/entry")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Put("o2/components/qc/ANY/any/entry", "changed")).To(Succeed())
+			value, secondIndex, err := c.GetWithLastIndex("o2/components/qc/ANY/any/entry")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("changed"))
+			Expect(secondIndex).To(BeNumerically(">", firstIndex))
+		})
+
+		It("should get a subtree without its siblings", func() {
+			Expect(c.GetRecursive("o2/components/qc")).To(Equal(cfgbackend.Map{
+				"ANY": cfgbackend.Map{
+					"any": cfgbackend.Map{
+						"entry": cfgbackend.String("config"),
+					},
+				},
+			}))
+			Expect(c.GetRecursive("o2/components/qcx")).To(Equal(cfgbackend.String("sibling")))
+		})
+
+		It("should list keys by prefix, including folders", func() {
+			Expect(c.GetKeysByPrefix("o2/components")).To(ConsistOf(
+				"o2/components/",
+				"o2/components/qc/",
+				"o2/components/qc/ANY/",
+				"o2/components/qc/ANY/any/",
+				"o2/components/qc/ANY/any/entry",
+				"o2/components/qcx",
+			))
+		})
+
+		It("should list folders even if they were not written explicitly", func() {
+			Expect(c.Put("o2/components/readout/ANY/any/cru", "x")).To(Succeed())
+			Expect(c.GetKeysByPrefix("o2/components/readout")).To(ConsistOf(
+				"o2/components/readout/ANY/",
+				"o2/components/readout/ANY/any/",
+				"o2/components/readout/ANY/any/cru",
+			))
+			Expect(c.IsDir("o2/components/readout/ANY")).To(BeTrue())
+		})
+
+		It("should tell values and folders apart", func() {
+			Expect(c.Exists("o2/components/qc")).To(BeTrue())
+			Expect(c.Exists("o2/components/qcx")).To(BeTrue())
+			Expect(c.Exists("o2/components/q")).To(BeFalse())
+			Expect(c.IsDir("o2/components/qc")).To(BeTrue())
+			Expect(c.IsDir("o2/components/qcx")).To(BeFalse())
+		})
+	})
+
+	Describe("recursively putting a subtree", func() {
+		It("should replace the subtree", func() {
+			err = c.PutRecursive("o2/components/qc", cfgbackend.Map{
+				"PHYSICS": cfgbackend.Map{
+					"any": cfgbackend.Map{
+						"entry": cfgbackend.String("physics config"),
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Exists("o2/components/qc/ANY/any/entry")).To(BeFalse())
+			Expect(c.Get("o2/components/qc/PHYSICS/any/entry")).To(Equal("physics config"))
+			Expect(c.Get("o2/components/qcx")).To(Equal("sibling"))
+		})
+
+		It("should round-trip a subtree as YAML", func() {
+			marshalled, marshErr := yaml.Marshal(cfgbackend.Map{"a": cfgbackend.String("1"), "b": cfgbackend.Map{"c": cfgbackend.String("2")}})
+			Expect(marshErr).NotTo(HaveOccurred())
+			Expect(c.PutRecursiveYaml("o2/components/yaml", marshalled)).To(Succeed())
+			Expect(c.GetRecursiveYaml("o2/components/yaml")).To(Equal(marshalled))
+		})
+
+		It("should split large subtrees into several transactions", func() {
+			big := make(cfgbackend.Map)
+			for i := 0; i < 300; i++ {
+				big[fmt.Sprintf("key%03d", i)] = cfgbackend.String(fmt.Sprintf("value %d", i))
+			}
+			Expect(c.PutRecursive("o2/components/big", big)).To(Succeed())
+			Expect(c.GetRecursive("o2/components/big")).To(Equal(big))
+		})
+
+		It("should reject arrays", func() {
+			err = c.PutRecursive("o2/components/qc", cfgbackend.Array{cfgbackend.String("x")})
+			Expect(err).To(HaveOccurred())
+			Expect(c.Get("o2/components/qc/ANY/any/entry")).To(Equal("config"))
+		})
+	})
+
+	Describe("incrementing a counter", func() {
+		It("should increment an existing counter", func() {
+			Expect(c.GetNextUInt32("o2/runtime/aliecs/run_number")).To(Equal(uint32(42)))
+			Expect(c.GetNextUInt32("o2/runtime/aliecs/run_number")).To(Equal(uint32(43)))
+		})
+
+		It("should create a counter which does not exist yet", func() {
+			Expect(c.GetNextUInt32("o2/runtime/aliecs/new_counter")).To(Equal(uint32(1)))
+		})
+
+		It("should never hand out the same value twice under contention", func() {
+			const workers, perWorker = 4, 5
+			var (
+				mu     sync.Mutex
+				wg     sync.WaitGroup
+				values = make(map[uint32]struct{})
+			)
+			for w := 0; w < workers; w++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					for i := 0; i < perWorker; i++ {
+						value, incErr := c.GetNextUInt32("o2/runtime/aliecs/contended")
+						Expect(incErr).NotTo(HaveOccurred())
+						mu.Lock()
+						values[value] = struct{}{}
+						mu.Unlock()
+					}
+				}()
+			}
+			wg.Wait()
+			Expect(values).To(HaveLen(workers * perWorker))
+		})
+	})
+
+	Describe("watching a subtree", func() {
+		It("should notify about changes in the subtree only", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			changes, watchErr := c.WatchRecursive(ctx, "o2/components/qc")
+			Expect(watchErr).NotTo(HaveOccurred())
+
+			Expect(c.Put("o2/components/qcx", "ignored")).To(Succeed())
+			Expect(c.Put("o2/components/qc/ANY/any/entry", "changed")).To(Succeed())
+			Eventually(changes).WithTimeout(10 * time.Second).Should(Receive(Equal([]string{"o2/components/qc/ANY/any/entry"})))
+
+			cancel()
+			Eventually(changes).WithTimeout(10 * time.Second).Should(BeClosed())
+		})
+	})
+})
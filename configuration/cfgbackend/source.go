@@ -24,10 +24,11 @@
 
 // Package configuration defines the Source interface as the
 // main access point to O² Configuration backends.
-// Consul and YAML backends are also provided.
+// Consul, etcd and YAML backends are also provided.
 package cfgbackend
 
 import (
+	"context"
 	"errors"
 	"strings"
 )
@@ -45,14 +46,39 @@ type ROSource interface {
 type Source interface {
 	ROSource
 	Put(string, string) error
+	// PutRecursive and PutRecursiveYaml replace the whole subtree at the
+	// given key. Backends with a limit on the size of a transaction only
+	// guarantee atomicity for writes within that limit, see the
+	// documentation of each backend.
 	PutRecursive(string, Item) error
 	PutRecursiveYaml(string, []byte) error
 }
 
+// CounterSource is implemented by backends which provide an atomic
+// counter, suitable for allocating run numbers.
+type CounterSource interface {
+	GetNextUInt32(string) (uint32, error)
+}
+
+// IndexedSource is implemented by backends which keep track of the
+// modification index of each key.
+type IndexedSource interface {
+	GetWithLastIndex(string) (string, uint64, error)
+}
+
+// WatchableSource is implemented by backends which can notify about
+// changes in a subtree.
+type WatchableSource interface {
+	WatchRecursive(context.Context, string) (<-chan []string, error)
+}
+
 func NewSource(uri string) (configuration Source, err error) {
 	if strings.HasPrefix(uri, "consul://") {
 		configuration, err = NewConsulSource(strings.TrimPrefix(uri, "consul://"))
 		return
+	} else if strings.HasPrefix(uri, "etcd://") {
+		configuration, err = NewEtcdSource(strings.TrimPrefix(uri, "etcd://"))
+		return
 	} else if strings.HasPrefix(uri, "file://") &&
 		(strings.HasSuffix(uri, ".yaml") || strings.HasSuffix(uri, ".json")) {
 		configuration, err = newYamlSource(uri)
@@ -0,0 +1,321 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package componentcfg
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	apricotpb "github.com/AliceO2Group/Control/apricot/protos"
+	"github.com/naoina/toml"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/ini.v1"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ConfigSchemasPath = "o2/schemas/"
+)
+
+const (
+	FORMAT_JSON = "json"
+	FORMAT_YAML = "yaml"
+	FORMAT_INI  = "ini"
+	FORMAT_TOML = "toml"
+)
+
+// NormalizeFormat maps a user-provided format or file extension to one of
+// the known payload formats, or returns an empty string if unknown.
+func NormalizeFormat(format string) string {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "json":
+		return FORMAT_JSON
+	case "yaml", "yml":
+		return FORMAT_YAML
+	case "ini":
+		return FORMAT_INI
+	case "toml":
+		return FORMAT_TOML
+	}
+	return ""
+}
+
+// IniKeySchema describes a single key within an INI section.
+// Type can be one of string, int, float or bool, empty means string.
+type IniKeySchema struct {
+	Type     string `json:"type,omitempty"`
+	Required bool   `json:"required,omitempty"`
+}
+
+// Schema is associated with a component, and optionally restricted to a
+// subset of its entries through EntryPattern, a path.Match pattern over
+// RUNTYPE/rolename/entry (e.g. "*/*/readout-*").
+// Schemas are stored as JSON documents under o2/schemas/<component>/<name>.
+type Schema struct {
+	EntryPattern   string                             `json:"entryPattern,omitempty"`
+	Format         string                             `json:"format,omitempty"`
+	JsonSchema     json.RawMessage                    `json:"jsonSchema,omitempty"`
+	IniSchema      map[string]map[string]IniKeySchema `json:"iniSchema,omitempty"`
+	SampleVarStack map[string]string                  `json:"sampleVarStack,omitempty"`
+}
+
+func ParseSchema(payload string) (schema *Schema, err error) {
+	schema = &Schema{}
+	err = json.Unmarshal([]byte(payload), schema)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse schema: %w", err)
+	}
+	if schema.Format != "" {
+		format := NormalizeFormat(schema.Format)
+		if format == "" {
+			return nil, fmt.Errorf("unsupported schema format %s", schema.Format)
+		}
+		schema.Format = format
+	}
+	if schema.EntryPattern != "" {
+		if _, err = path.Match(schema.EntryPattern, ""); err != nil {
+			return nil, fmt.Errorf("bad schema entry pattern %s: %w", schema.EntryPattern, err)
+		}
+	}
+	if len(schema.JsonSchema) > 0 {
+		_, err = gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema.JsonSchema))
+		if err != nil {
+			return nil, fmt.Errorf("bad JSON schema: %w", err)
+		}
+	}
+	for section, keys := range schema.IniSchema {
+		for key, keySchema := range keys {
+			switch keySchema.Type {
+			case "", "string", "int", "float", "bool":
+			default:
+				return nil, fmt.Errorf("bad INI schema type %s for key %s in section %s", keySchema.Type, key, section)
+			}
+		}
+	}
+	return
+}
+
+// Matches returns true if the schema applies to the given query, which is
+// assumed to be for the schema's component.
+func (s *Schema) Matches(query *Query) bool {
+	if s == nil || query == nil {
+		return false
+	}
+	if s.EntryPattern == "" {
+		return true
+	}
+	entryPath := apricotpb.RunType_name[int32(query.RunType)] + SEPARATOR + query.RoleName + SEPARATOR + query.EntryKey
+	matched, _ := path.Match(s.EntryPattern, entryPath)
+	return matched
+}
+
+// ValidationResult collects all the problems found when validating a
+// configuration payload. An empty Errors list means the payload is valid.
+type ValidationResult struct {
+	Format string   `json:"format,omitempty"`
+	Schema string   `json:"schema,omitempty"`
+	Errors []string `json:"errors"`
+}
+
+func (r *ValidationResult) IsValid() bool {
+	return r != nil && len(r.Errors) == 0
+}
+
+func (r *ValidationResult) Error() string {
+	if r.IsValid() {
+		return ""
+	}
+	return "configuration payload validation failed:\n- " + strings.Join(r.Errors, "\n- ")
+}
+
+// ValidatePayload checks the syntax of the payload for the given format and,
+// if a schema is provided, checks the parsed payload against it.
+// The payload is expected to have been processed by the template system.
+func ValidatePayload(payload string, format string, schema *Schema) (errs []string) {
+	errs = make([]string, 0)
+	format = NormalizeFormat(format)
+
+	var (
+		parsed    interface{}
+		iniParsed *ini.File
+		err       error
+	)
+	switch format {
+	case FORMAT_JSON:
+		err = json.Unmarshal([]byte(payload), &parsed)
+	case FORMAT_YAML:
+		err = yaml.Unmarshal([]byte(payload), &parsed)
+	case FORMAT_TOML:
+		parsedMap := make(map[string]interface{})
+		err = toml.Unmarshal([]byte(payload), &parsedMap)
+		parsed = parsedMap
+	case FORMAT_INI:
+		iniParsed, err = ini.Load([]byte(payload))
+		if err == nil {
+			parsed = iniToMap(iniParsed)
+		}
+	default:
+		// unknown format, no syntax check possible
+		if schema != nil && (len(schema.JsonSchema) > 0 || len(schema.IniSchema) > 0) {
+			errs = append(errs, "payload format unknown, cannot perform schema validation")
+		}
+		return
+	}
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("%s syntax error: %s", strings.ToUpper(format), err.Error()))
+		return
+	}
+	if schema == nil {
+		return
+	}
+
+	if len(schema.JsonSchema) > 0 {
+		errs = append(errs, validateJsonSchema(parsed, schema.JsonSchema)...)
+	}
+	if len(schema.IniSchema) > 0 {
+		if iniParsed == nil {
+			errs = append(errs, fmt.Sprintf("INI key schema cannot be applied to %s payload", strings.ToUpper(format)))
+		} else {
+			errs = append(errs, validateIniSchema(iniParsed, schema.IniSchema)...)
+		}
+	}
+	return
+}
+
+func validateJsonSchema(document interface{}, jsonSchema json.RawMessage) (errs []string) {
+	// gojsonschema marshals Go values to JSON, so we must make sure no
+	// map[interface{}]interface{} slips through from YAML or TOML decoders
+	document = sanitizeForJson(document)
+
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(jsonSchema), gojsonschema.NewGoLoader(document))
+	if err != nil {
+		return []string{"schema validation error: " + err.Error()}
+	}
+	for _, resultErr := range result.Errors() {
+		errs = append(errs, resultErr.String())
+	}
+	return
+}
+
+func validateIniSchema(file *ini.File, iniSchema map[string]map[string]IniKeySchema) (errs []string) {
+	sectionPatterns := make([]string, 0, len(iniSchema))
+	for pattern := range iniSchema {
+		sectionPatterns = append(sectionPatterns, pattern)
+	}
+	sort.Strings(sectionPatterns)
+
+	for _, pattern := range sectionPatterns {
+		keys := iniSchema[pattern]
+		var sections []*ini.Section
+		for _, section := range file.Sections() {
+			if matched, _ := path.Match(pattern, section.Name()); matched {
+				sections = append(sections, section)
+			}
+		}
+		if len(sections) == 0 {
+			for key, keySchema := range keys {
+				if keySchema.Required {
+					errs = append(errs, fmt.Sprintf("missing INI section %s with required key %s", pattern, key))
+				}
+			}
+			continue
+		}
+
+		keyNames := make([]string, 0, len(keys))
+		for key := range keys {
+			keyNames = append(keyNames, key)
+		}
+		sort.Strings(keyNames)
+
+		for _, section := range sections {
+			for _, key := range keyNames {
+				keySchema := keys[key]
+				if !section.HasKey(key) {
+					if keySchema.Required {
+						errs = append(errs, fmt.Sprintf("missing required INI key %s in section %s", key, section.Name()))
+					}
+					continue
+				}
+				value := strings.TrimSpace(section.Key(key).String())
+				var err error
+				switch keySchema.Type {
+				case "int":
+					_, err = strconv.ParseInt(value, 0, 64)
+				case "float":
+					_, err = strconv.ParseFloat(value, 64)
+				case "bool":
+					_, err = strconv.ParseBool(value)
+				}
+				if err != nil {
+					errs = append(errs, fmt.Sprintf("INI key %s in section %s must be of type %s, got %s", key, section.Name(), keySchema.Type, value))
+				}
+			}
+		}
+	}
+	return
+}
+
+func iniToMap(file *ini.File) map[string]interface{} {
+	out := make(map[string]interface{})
+	for _, section := range file.Sections() {
+		keys := make(map[string]interface{})
+		for _, key := range section.Keys() {
+			keys[key.Name()] = key.String()
+		}
+		if section.Name() == ini.DefaultSection && len(keys) == 0 {
+			continue
+		}
+		out[section.Name()] = keys
+	}
+	return out
+}
+
+func sanitizeForJson(in interface{}) interface{} {
+	switch typed := in.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(typed))
+		for k, v := range typed {
+			out[fmt.Sprintf("%v", k)] = sanitizeForJson(v)
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(typed))
+		for k, v := range typed {
+			out[k] = sanitizeForJson(v)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(typed))
+		for i, v := range typed {
+			out[i] = sanitizeForJson(v)
+		}
+		return out
+	}
+	return in
+}
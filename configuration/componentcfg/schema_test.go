@@ -0,0 +1,112 @@
+package componentcfg
+
+import (
+	apricotpb "github.com/AliceO2Group/Control/apricot/protos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("schema", func() {
+	Describe("parsing a schema", func() {
+		When("the schema is valid", func() {
+			It("should normalize the format", func() {
+				schema, err := ParseSchema(`{"format": "YML", "entryPattern": "*/*/readout-*"}`)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(schema.Format).To(Equal(FORMAT_YAML))
+			})
+		})
+		When("the format is unknown", func() {
+			It("should produce an error", func() {
+				_, err := ParseSchema(`{"format": "xml"}`)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		When("the JSON schema is broken", func() {
+			It("should produce an error", func() {
+				_, err := ParseSchema(`{"jsonSchema": {"type": 42}}`)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		When("an INI key type is unknown", func() {
+			It("should produce an error", func() {
+				_, err := ParseSchema(`{"iniSchema": {"readout": {"rate": {"type": "decimal"}}}}`)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("matching a schema to a query", func() {
+		var schema *Schema
+		BeforeEach(func() {
+			schema = &Schema{EntryPattern: "PHYSICS/*/readout-*"}
+		})
+		It("should match entries covered by the pattern", func() {
+			Expect(schema.Matches(&Query{Component: "readout", RunType: apricotpb.RunType_PHYSICS, RoleName: "flp001", EntryKey: "readout-cru"})).To(BeTrue())
+		})
+		It("should not match entries outside the pattern", func() {
+			Expect(schema.Matches(&Query{Component: "readout", RunType: apricotpb.RunType_ANY, RoleName: "flp001", EntryKey: "readout-cru"})).To(BeFalse())
+			Expect(schema.Matches(&Query{Component: "readout", RunType: apricotpb.RunType_PHYSICS, RoleName: "flp001", EntryKey: "stfb"})).To(BeFalse())
+		})
+		It("should match everything with an empty pattern", func() {
+			schema.EntryPattern = ""
+			Expect(schema.Matches(&Query{Component: "readout", RunType: apricotpb.RunType_ANY, RoleName: "any", EntryKey: "stfb"})).To(BeTrue())
+		})
+	})
+
+	Describe("validating a payload", func() {
+		When("the format is unknown and there is no schema", func() {
+			It("should not report errors", func() {
+				Expect(ValidatePayload("{{{", "", nil)).To(BeEmpty())
+			})
+		})
+		When("the payload has syntax errors", func() {
+			It("should report them for JSON", func() {
+				Expect(ValidatePayload(`{"a": 1,}`, "json", nil)).To(HaveLen(1))
+			})
+			It("should report them for YAML", func() {
+				Expect(ValidatePayload("a: [1, 2", "yaml", nil)).To(HaveLen(1))
+			})
+			It("should report them for INI", func() {
+				Expect(ValidatePayload("[readout\nrate=1", "ini", nil)).To(HaveLen(1))
+			})
+		})
+		When("a JSON schema is provided", func() {
+			var schema *Schema
+			BeforeEach(func() {
+				var err error
+				schema, err = ParseSchema(`{"jsonSchema": {"type": "object", "required": ["rate"], "properties": {"rate": {"type": "number"}}}}`)
+				Expect(err).NotTo(HaveOccurred())
+			})
+			It("should accept a conforming JSON payload", func() {
+				Expect(ValidatePayload(`{"rate": 42}`, "json", schema)).To(BeEmpty())
+			})
+			It("should accept a conforming YAML payload", func() {
+				Expect(ValidatePayload("rate: 42\n", "yaml", schema)).To(BeEmpty())
+			})
+			It("should reject a non-conforming payload", func() {
+				Expect(ValidatePayload(`{"rate": "fast"}`, "json", schema)).To(HaveLen(1))
+				Expect(ValidatePayload(`{}`, "json", schema)).To(HaveLen(1))
+			})
+		})
+		When("an INI key schema is provided", func() {
+			var schema *Schema
+			BeforeEach(func() {
+				var err error
+				schema, err = ParseSchema(`{"iniSchema": {"readout": {"rate": {"type": "float", "required": true}}, "equipment-*": {"enabled": {"type": "bool"}}}}`)
+				Expect(err).NotTo(HaveOccurred())
+			})
+			It("should accept a conforming payload", func() {
+				Expect(ValidatePayload("[readout]\nrate=1.5\n[equipment-1]\nenabled=1\n", "ini", schema)).To(BeEmpty())
+			})
+			It("should report missing required keys", func() {
+				Expect(ValidatePayload("[readout]\nfoo=bar\n", "ini", schema)).To(HaveLen(1))
+			})
+			It("should report mistyped keys in all matching sections", func() {
+				Expect(ValidatePayload("[readout]\nrate=1\n[equipment-1]\nenabled=maybe\n[equipment-2]\nenabled=nope\n", "ini", schema)).To(HaveLen(2))
+			})
+			It("should refuse to apply to other formats", func() {
+				Expect(ValidatePayload(`{"readout": {"rate": 1}}`, "json", schema)).To(HaveLen(1))
+			})
+		})
+	})
+})
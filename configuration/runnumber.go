@@ -0,0 +1,56 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package configuration
+
+import "time"
+
+// RunNumberAllocation is an audit record of a single run number handed out
+// by NewRunNumber. Records outlive the environments they refer to.
+type RunNumberAllocation struct {
+	RunNumber     uint32    `json:"runNumber"`
+	EnvironmentId string    `json:"environmentId"`
+	Requester     string    `json:"requester"`
+	Timestamp     time.Time `json:"timestamp"`
+}
+
+// RunNumberAllocationsQuery filters the allocation audit trail. Zero values
+// match any allocation.
+type RunNumberAllocationsQuery struct {
+	RunNumber     uint32
+	EnvironmentId string
+}
+
+func (q *RunNumberAllocationsQuery) Matches(allocation *RunNumberAllocation) bool {
+	if q == nil || allocation == nil {
+		return allocation != nil
+	}
+	if q.RunNumber != 0 && q.RunNumber != allocation.RunNumber {
+		return false
+	}
+	if q.EnvironmentId != "" && q.EnvironmentId != allocation.EnvironmentId {
+		return false
+	}
+	return true
+}
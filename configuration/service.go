@@ -37,9 +37,21 @@ type RuntimeService interface {
 	ListRuntimeEntries(component string) ([]string, error)
 }
 
+// SecretsService gives access to the secrets namespace, which is kept apart
+// from the rest of the configuration tree and is not reachable through the
+// raw and runtime accessors.
+type SecretsService interface {
+	GetSecret(name string) (string, error)
+	SetSecret(name string, value string) error
+	DeleteSecret(name string) error
+	ListSecrets() ([]string, error)
+}
+
 type Service interface {
 	RuntimeService
-	NewRunNumber() (runNumber uint32, err error)
+	SecretsService
+	NewRunNumber(envId string, requester string) (runNumber uint32, err error)
+	GetRunNumberAllocations(query *RunNumberAllocationsQuery) (allocations []*RunNumberAllocation, err error)
 	GetDefaults() map[string]string
 	GetVars() map[string]string
 	InvalidateComponentTemplateCache()
@@ -55,7 +67,10 @@ type Service interface {
 	ListComponentEntries(query *componentcfg.EntriesQuery) (entries []string, err error)
 	ResolveComponentQuery(query *componentcfg.Query) (resolved *componentcfg.Query, err error)
 
-	ImportComponentConfiguration(query *componentcfg.Query, payload string, newComponent bool) (existingComponentUpdated bool, existingEntryUpdated bool, err error)
+	ImportComponentConfiguration(query *componentcfg.Query, payload string, newComponent bool, format string) (existingComponentUpdated bool, existingEntryUpdated bool, err error)
+	ValidateComponentConfiguration(query *componentcfg.Query, payload string, format string, varStack map[string]string) (result *componentcfg.ValidationResult, err error)
+	ListComponentSchemas(component string) (schemas map[string]string, err error)
+	ImportComponentSchema(component string, name string, payload string) error
 
 	GetDetectorForHost(hostname string) (string, error)
 	GetDetectorsForHosts(hosts []string) ([]string, error)
@@ -65,4 +80,5 @@ type Service interface {
 	GetAliasedLinkIDsForDetector(detector string, onlyEnabled bool) (aliasedLinkIds []string, err error)
 
 	RawGetRecursive(path string) (string, error)
+	RawPutRecursive(path string, payload string) error
 }
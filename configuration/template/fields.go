@@ -266,6 +266,8 @@ func (fields Fields) Execute(confSvc ConfigurationService, parentPath string, va
 	configAccessObj := MakeConfigAccessObject(confSvc, varStack)
 	copyMap(configAccessObj, environment)
 
+	environment["secret"] = MakeSecretFunc()
+
 	// We override the "+" operator so that in situations like
 	// "string" + <nil>
 	// <nil> + "string"
@@ -39,6 +39,7 @@ import (
 	"github.com/AliceO2Group/Control/core/integration"
 	"github.com/AliceO2Group/Control/core/repos"
 
+	"github.com/AliceO2Group/Control/common/secrets"
 	"github.com/AliceO2Group/Control/common/utils"
 	"github.com/AliceO2Group/Control/common/utils/uid"
 	"github.com/sirupsen/logrus"
@@ -194,6 +195,18 @@ func MakeToPtreeFunc(varStack map[string]string, propMap map[string]string) ToPt
 	}
 }
 
+// MakeSecretFunc returns the secret template function, which only yields a
+// placeholder: the value is filled in by the executor right before the task
+// is launched.
+func MakeSecretFunc() func(string) (string, error) {
+	return func(name string) (string, error) {
+		if err := secrets.ValidateName(name); err != nil {
+			return "", err
+		}
+		return secrets.Placeholder(name), nil
+	}
+}
+
 func MakeUtilFuncMap(varStack map[string]string) map[string]interface{} {
 	legacy := make(map[string]interface{})
 	stringsMap := map[string]interface{}{
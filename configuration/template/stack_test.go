@@ -171,6 +171,22 @@ var _ = Describe("Template function stack", func() {
 		})
 	})
 
+	Describe("secret function", func() {
+		It("should only render a reference to the secret", func() {
+			items := []string{`--token={{ secret("bkp-token") }}`, `plain`}
+			fields := template.WrapSliceItems(items)
+			err := fields.Execute(nil, "test", map[string]string{}, nil, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(items).To(Equal([]string{"--token=${secret:bkp-token}", "plain"}))
+		})
+		It("should reject invalid secret names", func() {
+			items := []string{`{{ secret("../bkp-token") }}`}
+			fields := template.WrapSliceItems(items)
+			err := fields.Execute(nil, "test", map[string]string{}, nil, nil, nil, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("inventory functions", func() {
 		var (
 			svc                *local.Service
@@ -38,9 +38,12 @@ import (
 	"github.com/AliceO2Group/Control/apricot"
 	apricotpb "github.com/AliceO2Group/Control/apricot/protos"
 	"github.com/AliceO2Group/Control/common/product"
+	"github.com/AliceO2Group/Control/common/secrets"
 	"github.com/AliceO2Group/Control/common/utils"
 	"github.com/AliceO2Group/Control/configuration/componentcfg"
 	"github.com/AliceO2Group/Control/core/task/schedutil"
+	"github.com/AliceO2Group/Control/executor/artifact"
+	"github.com/AliceO2Group/Control/executor/tasklog"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"golang.org/x/sys/unix"
@@ -56,6 +59,12 @@ func setDefaults() error {
 	viper.Set("component", "core")
 	viper.SetDefault("version", false)
 	viper.SetDefault("controlPort", 32102)
+	viper.SetDefault("httpListenPort", 32103)
+	viper.SetDefault("haEnabled", false)
+	viper.SetDefault("haConsulEndpoint", "")
+	viper.SetDefault("haLockKey", "o2/runtime/aliecs/core_leader")
+	viper.SetDefault("haAdvertiseEndpoint", "")
+	viper.SetDefault("haStandbyRefreshInterval", 5*time.Minute)
 	viper.SetDefault("coreConfigurationUri", "")
 	viper.SetDefault("consulBasePath", "o2/components/aliecs/ANY/any")
 	viper.SetDefault("coreWorkingDir", "/var/lib/o2/aliecs")
@@ -89,6 +98,13 @@ func setDefaults() error {
 	viper.SetDefault("mesosUrl", getenv("MESOS_MASTER_HTTP", "http://:5050/api/v1/scheduler"))
 	viper.SetDefault("mesosCredentials.username", "")
 	viper.SetDefault("mesosCredentials.passwordFile", "")
+	viper.SetDefault("taskLogDir", tasklog.DefaultConfig().Dir)
+	viper.SetDefault("taskLogMaxSize", tasklog.DefaultConfig().MaxSize)
+	viper.SetDefault("taskLogMaxFiles", tasklog.DefaultConfig().MaxFiles)
+	viper.SetDefault("taskLogRetention", tasklog.DefaultConfig().Retention)
+	viper.SetDefault("artifactDir", artifact.DefaultConfig().Dir)
+	viper.SetDefault("artifactRetention", artifact.DefaultConfig().Retention)
+	viper.SetDefault("artifactFetchTimeout", artifact.DefaultConfig().FetchTimeout)
 	viper.SetDefault("metrics.address", getenv("LIBPROCESS_IP", "127.0.0.1"))
 	viper.SetDefault("metrics.port", getenvInt("PORT0", "64009"))
 	viper.SetDefault("metrics.path", getenv("METRICS_API_PATH", "/metrics"))
@@ -117,7 +133,9 @@ func setDefaults() error {
 	viper.SetDefault("coreConfigEntry", "settings")
 	viper.SetDefault("fmqPlugin", "OCClite")
 	viper.SetDefault("fmqPluginSearchPath", "$CONTROL_OCCPLUGIN_ROOT/lib/")
-	viper.SetDefault("bookkeepingToken", "token")
+	viper.SetDefault("bookkeepingToken", "")
+	viper.SetDefault("secretsKeyFile", "")
+	viper.SetDefault("secretsTokenFile", "")
 	viper.SetDefault("kafkaEndpoint", "localhost:9092")
 	viper.SetDefault("concurrentWorkflowTemplateProcessing", true)
 	viper.SetDefault("concurrentWorkflowTemplateIteratorProcessing", true)
@@ -135,6 +153,12 @@ func setDefaults() error {
 func setFlags() error {
 	pflag.Bool("version", viper.GetBool("version"), "The current AliECS core version")
 	pflag.Int("controlPort", viper.GetInt("controlPort"), "Port of control server")
+	pflag.Int("httpListenPort", viper.GetInt("httpListenPort"), "Port of the HTTP/JSON gateway to the control server, 0 to disable")
+	pflag.Bool("haEnabled", viper.GetBool("haEnabled"), "Run in active/standby mode, with leader election among core instances through a Consul session lock")
+	pflag.String("haConsulEndpoint", viper.GetString("haConsulEndpoint"), "Consul server (`host:port`) used for leader election, defaults to the one in configServiceUri if it is a consul:// URI, required otherwise")
+	pflag.String("haLockKey", viper.GetString("haLockKey"), "Consul key of the leader lock, shared by all the core instances of a cluster")
+	pflag.String("haAdvertiseEndpoint", viper.GetString("haAdvertiseEndpoint"), "Control endpoint (`host:port`) of this core instance advertised to clients while it is the leader, defaults to hostname:controlPort")
+	pflag.Duration("haStandbyRefreshInterval", viper.GetDuration("haStandbyRefreshInterval"), "How often a standby core instance refreshes its repositories and task classes")
 	pflag.String("coreConfigurationUri", viper.GetString("coreConfigurationUri"), "Consul URI or filesystem path to JSON/YAML configuration payload to initialize core settings [EXPERT SETTING]")
 	pflag.String("coreWorkingDir", viper.GetString("coreWorkingDir"), "Path to a writable directory for runtime AliECS data")
 	pflag.String("executor", viper.GetString("executor"), "Full path to executor binary on Mesos agents")
@@ -155,6 +179,13 @@ func setFlags() error {
 	pflag.Bool("mesosGpuClusterCompat", viper.GetBool("mesosGpuClusterCompat"), "When true the framework will receive offers from agents w/ GPU resources.")
 	pflag.Duration("mesosJobRestartDelay", viper.GetDuration("mesosJobRestartDelay"), "Duration between job (internal service) restarts between failures")
 	pflag.Duration("mesosMaxRefuseSeconds", viper.GetDuration("mesosMaxRefuseSeconds"), "Max length of time to refuse future offers")
+	pflag.String("taskLogDir", viper.GetString("taskLogDir"), "Directory on the Mesos agents where the executor keeps the output of each task")
+	pflag.Int64("taskLogMaxSize", viper.GetInt64("taskLogMaxSize"), "Size in bytes after which a task log file is rotated, 0 to disable rotation")
+	pflag.Int("taskLogMaxFiles", viper.GetInt("taskLogMaxFiles"), "Number of rotated files kept per task besides the current log file")
+	pflag.Duration("taskLogRetention", viper.GetDuration("taskLogRetention"), "How long task log files are kept after the task is gone, 0 to keep them forever")
+	pflag.String("artifactDir", viper.GetString("artifactDir"), "Directory on the Mesos agents where the executor caches task artifacts and stages them in per-task sandboxes")
+	pflag.Duration("artifactRetention", viper.GetDuration("artifactRetention"), "How long a cached task artifact is kept after it was last used, 0 to keep them forever")
+	pflag.Duration("artifactFetchTimeout", viper.GetDuration("artifactFetchTimeout"), "Timeout for the download of a single task artifact by the executor")
 	pflag.String("mesosPrincipal", viper.GetString("mesosPrincipal"), "Framework principal with which to authenticate")
 	pflag.Int("mesosReviveBurst", viper.GetInt("mesosReviveBurst"), "Number of revive messages that may be sent in a burst within revive-wait period")
 	pflag.Duration("mesosReviveWait", viper.GetDuration("mesosReviveWait"), "Wait this long to fully recharge revive-burst quota")
@@ -190,6 +221,9 @@ func setFlags() error {
 	pflag.String("fmqPluginSearchPath", viper.GetString("fmqPluginSearchPath"), "Path to the directory where the FairMQ plugins are found on controlled nodes")
 	pflag.String("kafkaEndpoint", viper.GetString("kafkaEndpoint"), "Endpoint of the Kafka service (`host:port`)")
 	pflag.String("bookkeepingBaseUri", viper.GetString("bookkeepingBaseUri"), "URI of the O² Bookkeeping service (`protocol://host:port`)")
+	pflag.String("bookkeepingToken", viper.GetString("bookkeepingToken"), "Token for the O² Bookkeeping service, preferably as a `secret:name` reference to the secrets store")
+	pflag.String("secretsTokenFile", viper.GetString("secretsTokenFile"), "File with the token to present to Apricot for secrets access")
+	pflag.String("secretsKeyFile", viper.GetString("secretsKeyFile"), "File with the key secrets are encrypted with at rest, only used if configServiceUri points directly to Consul or a YAML file")
 	pflag.Bool("concurrentWorkflowTemplateProcessing", viper.GetBool("concurrentWorkflowTemplateProcessing"), "Process aggregators in workflow templates concurrently")
 	pflag.Bool("concurrentWorkflowTemplateIteratorProcessing", viper.GetBool("concurrentWorkflowTemplateIteratorProcessing"), "Process iterators in workflow templates concurrently")
 	pflag.Bool("concurrentIteratorRoleExpansion", viper.GetBool("concurrentIteratorRoleExpansion"), "Expand iterator roles concurrently during workflow template processing")
@@ -309,6 +343,61 @@ func bindEnvironmentVariables() {
 	viper.AutomaticEnv()
 }
 
+// getHaConsulEndpoint returns the Consul server used for leader election, by
+// default the configuration backend if it is Consul itself. Leader election
+// is only implemented with Consul session locks, so with any other backend
+// the Consul server must be given explicitly.
+func getHaConsulEndpoint() (string, error) {
+	if endpoint := viper.GetString("haConsulEndpoint"); len(endpoint) != 0 {
+		return endpoint, nil
+	}
+	uri := viper.GetString("configServiceUri")
+	if strings.HasPrefix(uri, "consul://") {
+		return strings.TrimPrefix(uri, "consul://"), nil
+	}
+	return "", fmt.Errorf("active/standby mode requires a Consul server for leader election, but configServiceUri %s is not a consul:// URI: set haConsulEndpoint", uri)
+}
+
+func getHaAdvertiseEndpoint() string {
+	if endpoint := viper.GetString("haAdvertiseEndpoint"); len(endpoint) != 0 {
+		return endpoint
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	return fmt.Sprintf("%s:%d", hostname, viper.GetInt("controlPort"))
+}
+
+// redactedSettings returns all the viper settings with the values of
+// credential-like keys replaced, so that they can be logged.
+// Paths to files holding credentials are kept.
+func redactedSettings() map[string]interface{} {
+	var redact func(settings map[string]interface{}) map[string]interface{}
+	redact = func(settings map[string]interface{}) map[string]interface{} {
+		redacted := make(map[string]interface{}, len(settings))
+		for k, v := range settings {
+			lowerKey := strings.ToLower(k)
+			switch {
+			case strings.HasSuffix(lowerKey, "file"):
+				redacted[k] = v
+			case strings.Contains(lowerKey, "token"),
+				strings.Contains(lowerKey, "password"),
+				strings.Contains(lowerKey, "secret"):
+				redacted[k] = secrets.REDACTED
+			default:
+				if nested, ok := v.(map[string]interface{}); ok {
+					redacted[k] = redact(nested)
+				} else {
+					redacted[k] = v
+				}
+			}
+		}
+		return redacted
+	}
+	return redact(viper.AllSettings())
+}
+
 // NewConfig is the constructor for a new config.
 func NewConfig() (err error) {
 	if err = setDefaults(); err != nil {
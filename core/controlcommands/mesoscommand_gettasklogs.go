@@ -0,0 +1,88 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2020 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package controlcommands
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/common/utils/uid"
+)
+
+const getTaskLogsResponseTimeout = 15 * time.Second
+
+type MesosCommand_GetTaskLogs struct {
+	MesosCommandBase
+
+	Tail   int   `json:"tail"`
+	Offset int64 `json:"offset"`
+	Follow bool  `json:"follow"`
+}
+
+func (m *MesosCommand_GetTaskLogs) MakeSingleTarget(target MesosCommandTarget) (cmd MesosCommand) {
+	if m == nil {
+		return
+	}
+	mc := m.MesosCommandBase.MakeSingleTarget(target)
+	mcb, ok := mc.(*MesosCommandBase)
+	if !ok {
+		return
+	}
+
+	cmd = &MesosCommand_GetTaskLogs{
+		MesosCommandBase: *mcb,
+		Tail:             m.Tail,
+		Offset:           m.Offset,
+		Follow:           m.Follow,
+	}
+	return
+}
+
+func NewMesosCommand_GetTaskLogs(envId uid.ID, receiver MesosCommandTarget, tail int, offset int64, follow bool) *MesosCommand_GetTaskLogs {
+	cmd := &MesosCommand_GetTaskLogs{
+		MesosCommandBase: *NewMesosCommand("MesosCommand_GetTaskLogs", envId, []MesosCommandTarget{receiver}, PropertyMapsMap{}),
+		Tail:             tail,
+		Offset:           offset,
+		Follow:           follow,
+	}
+	cmd.ResponseTimeout = getTaskLogsResponseTimeout
+	return cmd
+}
+
+type MesosCommandResponse_GetTaskLogs struct {
+	MesosCommandResponseBase
+
+	TaskId     string `json:"taskId"`
+	Content    string `json:"content"`
+	NextOffset int64  `json:"nextOffset"`
+}
+
+func NewMesosCommandResponse_GetTaskLogs(mesosCommand *MesosCommand_GetTaskLogs, err error, taskId string, content string, nextOffset int64) *MesosCommandResponse_GetTaskLogs {
+	return &MesosCommandResponse_GetTaskLogs{
+		MesosCommandResponseBase: *NewMesosCommandResponse(mesosCommand, err),
+		TaskId:                   taskId,
+		Content:                  content,
+		NextOffset:               nextOffset,
+	}
+}
@@ -104,7 +104,7 @@ func Run() error {
 	}
 
 	if viper.GetBool("veryVerbose") {
-		log.WithField("configuration", viper.AllSettings()).Debug("core starting up")
+		log.WithField("configuration", redactedSettings()).Debug("core starting up")
 	}
 	log.WithField("level", infologger.IL_Support).Infof("%s core (%s v%s build %s) starting up", product.PRETTY_FULLNAME, product.PRETTY_SHORTNAME, product.VERSION, product.BUILD)
 
@@ -134,7 +134,32 @@ func Run() error {
 	_ = the.RepoManager()
 
 	// We now build the Control server
-	s := NewServer(state)
+	rpcsvr := NewRpcServer(state)
+	s := NewServer(rpcsvr)
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", viper.GetInt("controlPort")))
+	if err != nil {
+		log.WithField("error", err).
+			WithField("port", viper.GetInt("controlPort")).
+			Fatal("net.Listener failed to listen")
+	}
+
+	serveErrCh := make(chan error, 1)
+	if state.elector != nil {
+		// On standby, the control server answers health checks and points
+		// clients to the leader. The Mesos framework is only started once
+		// this instance is elected.
+		go func() {
+			serveErrCh <- s.Serve(lis)
+		}()
+		if viper.GetInt("httpListenPort") > 0 {
+			httpsvr := NewHttpService(rpcsvr)
+			defer httpsvr.Close()
+		}
+		if err = campaign(ctx, state); err != nil {
+			return err
+		}
+	}
 
 	state.taskman.Start(ctx)
 
@@ -151,13 +176,16 @@ func Run() error {
 
 	log.WithField("level", infologger.IL_Devel).Infof("Everything initiated and listening on control port: %d", viper.GetInt("controlPort"))
 
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", viper.GetInt("controlPort")))
-	if err != nil {
-		log.WithField("error", err).
-			WithField("port", viper.GetInt("controlPort")).
-			Fatal("net.Listener failed to listen")
+	if state.elector != nil {
+		err = <-serveErrCh
+	} else {
+		if viper.GetInt("httpListenPort") > 0 {
+			httpsvr := NewHttpService(rpcsvr)
+			defer httpsvr.Close()
+		}
+		err = s.Serve(lis)
 	}
-	if err = s.Serve(lis); err != nil {
+	if err != nil {
 		log.WithField("error", err).Fatal("GRPC server failed to serve")
 	}
 
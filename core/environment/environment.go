@@ -202,7 +202,7 @@ func newEnvironment(userVars map[string]string, newId uid.ID) (env *Environment,
 				// before_START_ACTIVITY hooks. By setting it up here, we ensure the run number is available especially
 				// to plugin hooks.
 				if e.Event == "START_ACTIVITY" {
-					runNumber, rnErr := the.ConfSvc().NewRunNumber()
+					runNumber, rnErr := the.ConfSvc().NewRunNumber(envId.String(), env.GetLastRequestUser().GetName())
 					if rnErr != nil {
 						e.Cancel(rnErr)
 						return
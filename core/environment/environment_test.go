@@ -16,6 +16,8 @@ var _ = BeforeSuite(func() {
 	viper.Set("integrationPlugins", []string{"testplugin"})
 	viper.Set("testPluginEndpoint", "http://example.com")
 	viper.Set("config_endpoint", "mock://")
+	// the mock backend has no counter, so run numbers are allocated from files in here
+	viper.Set("coreWorkingDir", GinkgoT().TempDir())
 })
 
 func TestCoreEnvironment(t *testing.T) {
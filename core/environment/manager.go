@@ -123,6 +123,9 @@ func NewEnvManager(tm *task.Manager, incomingEventCh chan event.Event) *Manager
 							Debug("received agent failed event")
 					}
 
+				case *event.TaskRestartRequestedEvent:
+					go instance.handleTaskRestartRequest(typedEvent)
+
 				case *event.TasksReleasedEvent:
 					// If we got a TasksReleasedEvent, it must be matched with a pending
 					// environment teardown.
@@ -136,17 +139,20 @@ func NewEnvManager(tm *task.Manager, incomingEventCh chan event.Event) *Manager
 
 						instance.mu.Lock()
 						close(thisEnvCh)
-						delete(instance.pendingTeardownsCh, typedEvent.GetEnvironmentId())
+						// the teardown may already have registered its next pending channel
+						if instance.pendingTeardownsCh[typedEvent.GetEnvironmentId()] == thisEnvCh {
+							delete(instance.pendingTeardownsCh, typedEvent.GetEnvironmentId())
+						}
 						instance.mu.Unlock()
 
 					} else {
 						// If there is no pending environment teardown, it means that the released task stopped
 						// unexpectedly. In that case, the environment should get torn-down only if the task
-						// is critical.
+						// is critical and not tolerated by the quorum of one of its roles.
 						releaseCriticalTask := false
 						for _, v := range typedEvent.GetTaskIds() {
 							if tm.GetTask(v) != nil {
-								if tm.GetTask(v).GetTraits().Critical == true {
+								if tm.GetTask(v).GetTraits().Critical == true && !tm.GetTask(v).FailureTolerated(nil) {
 									//|| tm.GetTask(v).GetParent().GetTaskTraits().Critical == true
 									releaseCriticalTask = true
 								}
@@ -157,7 +163,9 @@ func NewEnvManager(tm *task.Manager, incomingEventCh chan event.Event) *Manager
 
 							instance.mu.Lock()
 							close(thisEnvCh)
-							delete(instance.pendingTeardownsCh, typedEvent.GetEnvironmentId())
+							if instance.pendingTeardownsCh[typedEvent.GetEnvironmentId()] == thisEnvCh {
+								delete(instance.pendingTeardownsCh, typedEvent.GetEnvironmentId())
+							}
 							instance.mu.Unlock()
 						}
 					}
@@ -881,13 +889,16 @@ func (envs *Manager) TeardownEnvironment(environmentId uid.ID, force bool) error
 		WithField("level", infologger.IL_Devel).
 		Debug("envman write lock")
 	envs.mu.Lock()
-	defer envs.mu.Unlock()
 	delete(envs.m, environmentId)
 	env.unsubscribeFromWfState()
+	envs.mu.Unlock()
 	log.WithField("method", "TeardownEnvironment").
 		WithField("level", infologger.IL_Devel).
 		Debug("envman write lock")
 
+	// written without holding the lock, as event hub filters run
+	// synchronously within WriteEvent
+
 	the.EventWriterWithTopic(topic.Environment).WriteEvent(&evpb.Ev_EnvironmentEvent{
 		EnvironmentId:        environmentId.String(),
 		State:                "DONE",
@@ -1174,6 +1185,45 @@ func (envs *Manager) handleDeviceEvent(evt event.DeviceEvent) {
 			}
 		}
 
+	case pb.DeviceEventType_DEVICE_STATE_CHANGED:
+		// a task has changed state without us asking, we align the task and
+		// its parent roles with what the executor observed
+		dsc, ok := evt.(*event.DeviceStateChanged)
+		if !ok {
+			return
+		}
+		// the executor labels the event with the environment of the task, we
+		// only align tasks which still belong to it
+		taskId := evt.GetOrigin().TaskId
+		env, err := envs.environment(envId)
+		if err != nil {
+			log.WithPrefix("scheduler").
+				WithField("partition", envId.String()).
+				WithField("taskId", taskId.Value).
+				WithError(err).
+				Debug("cannot find environment for DeviceEvent DEVICE_STATE_CHANGED")
+			return
+		}
+		var t *task.Task
+		if wf := env.Workflow(); wf != nil {
+			t = wf.GetTasks().GetByTaskId(taskId.Value)
+		}
+		if t == nil {
+			log.WithPrefix("scheduler").
+				WithField("partition", envId.String()).
+				WithField("taskId", taskId.Value).
+				Debug("cannot find task for DeviceEvent DEVICE_STATE_CHANGED")
+			return
+		}
+		log.WithPrefix("scheduler").
+			WithField("partition", envId.String()).
+			WithField("taskId", taskId.Value).
+			WithField("taskRole", t.GetParentRolePath()).
+			WithField("previousState", dsc.PreviousState).
+			WithField("state", dsc.State).
+			WithField(infologger.Level, infologger.IL_Support).
+			Warning("task changed state on its own")
+		envs.taskman.MessageChannel <- task.NewTaskStateMessage(taskId.Value, dsc.State)
 	}
 }
 
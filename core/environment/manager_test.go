@@ -0,0 +1,139 @@
+package environment
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/AliceO2Group/Control/core/workflow"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tearing down an environment", func() {
+	var (
+		envs    *Manager
+		env     *Environment
+		envId   uid.ID
+		taskman *task.Manager
+	)
+
+	BeforeEach(func() {
+		var err error
+		envId, err = uid.FromString("2oDvieFrVTi")
+		Expect(err).NotTo(HaveOccurred())
+
+		taskman = &task.Manager{MessageChannel: make(chan *task.TaskmanMessage)}
+		incomingEventCh := make(chan event.Event)
+		envs = NewEnvManager(taskman, incomingEventCh)
+
+		env, err = newEnvironment(nil, envId)
+		Expect(err).NotTo(HaveOccurred())
+		env.workflow = workflow.NewAggregatorRole("root", []workflow.Role{})
+		env.Sm.SetState("STANDBY")
+		envs.m[envId] = env
+
+		// every release request is acknowledged as if the tasks were gone
+		go func() {
+			for range taskman.MessageChannel {
+				incomingEventCh <- event.NewTasksReleasedEvent(envId, nil, nil)
+			}
+		}()
+	})
+
+	AfterEach(func() {
+		close(taskman.MessageChannel)
+	})
+
+	It("does not block event hub subscribers which look up the environment", func() {
+		sub := the.EventHub().Subscribe(100, func(e event.TopicEvent) bool {
+			if e.Event.GetEnvironmentId() != envId.String() {
+				return false
+			}
+			// the detector filter of event streams used to do this on publish
+			_, _ = envs.Environment(envId)
+			return true
+		})
+		defer sub.Unsubscribe()
+
+		done := make(chan error)
+		go func() {
+			done <- envs.TeardownEnvironment(envId, false)
+		}()
+
+		var err error
+		Eventually(done, 5*time.Second).Should(Receive(&err))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(env.CurrentState()).To(Equal("DONE"))
+		_, err = envs.Environment(envId)
+		Expect(err).To(HaveOccurred())
+
+		var received event.TopicEvent
+		Eventually(func() string {
+			select {
+			case received = <-sub.Events():
+				return received.Event.GetEnvironmentEvent().GetTransitionStatus().String()
+			default:
+				return ""
+			}
+		}).Should(Equal("DONE_OK"))
+	})
+})
+
+var _ = Describe("handling device events", func() {
+	var (
+		envs    *Manager
+		envId   uid.ID
+		taskman *task.Manager
+		taskId  string
+	)
+
+	stateChanged := func(envId uid.ID, taskId string, previousState string, state string) event.DeviceEvent {
+		origin := event.DeviceEventOrigin{TaskId: mesos.TaskID{Value: taskId}}
+		evt := event.NewDeviceEvent(origin, pb.DeviceEventType_DEVICE_STATE_CHANGED)
+		dsc := evt.(*event.DeviceStateChanged)
+		dsc.PreviousState = previousState
+		dsc.State = state
+		evt.SetLabels(map[string]string{"detector": "TST", "environmentId": envId.String()})
+		return evt
+	}
+
+	BeforeEach(func() {
+		var err error
+		envId, err = uid.FromString("2oDvieFrVTi")
+		Expect(err).NotTo(HaveOccurred())
+
+		taskman = &task.Manager{MessageChannel: make(chan *task.TaskmanMessage, 10)}
+		envs = NewEnvManager(taskman, make(chan event.Event))
+
+		env, err := newEnvironment(nil, envId)
+		Expect(err).NotTo(HaveOccurred())
+		env.workflow, err = workflow.LoadDPL([]*taskclass.Class{{Identifier: taskclass.Id{Name: "readout"}}}, "root", nil)
+		Expect(err).NotTo(HaveOccurred())
+		env.Sm.SetState("CONFIGURED")
+		envs.m[envId] = env
+		taskId = env.Workflow().GetTasks()[0].GetTaskId()
+	})
+
+	When("a task changed state on its own", func() {
+		It("updates the task to the state reported by the executor", func() {
+			envs.handleDeviceEvent(stateChanged(envId, taskId, "CONFIGURED", "ERROR"))
+			Expect(taskman.MessageChannel).To(Receive(Equal(task.NewTaskStateMessage(taskId, "ERROR"))))
+		})
+
+		It("ignores tasks which are not part of the environment", func() {
+			envs.handleDeviceEvent(stateChanged(envId, "2oDvieFrVTj", "CONFIGURED", "ERROR"))
+			Expect(taskman.MessageChannel).NotTo(Receive())
+
+			otherEnvId, err := uid.FromString("2oDvieFrVTk")
+			Expect(err).NotTo(HaveOccurred())
+			envs.handleDeviceEvent(stateChanged(otherEnvId, taskId, "CONFIGURED", "ERROR"))
+			Expect(taskman.MessageChannel).NotTo(Receive())
+		})
+	})
+})
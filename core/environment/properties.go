@@ -0,0 +1,257 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/gera"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/AliceO2Group/Control/core/repos"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/AliceO2Group/Control/core/workflow"
+	"github.com/gobwas/glob"
+)
+
+// PropertyValidationError is returned by SetProperties when a property key
+// or value is not acceptable, as opposed to the environment not being in a
+// state that allows setting properties.
+type PropertyValidationError struct {
+	Key string
+	err error
+}
+
+func (e PropertyValidationError) Error() string {
+	return fmt.Sprintf("invalid property %s: %s", e.Key, e.err.Error())
+}
+
+func (e PropertyValidationError) Unwrap() error {
+	return e.err
+}
+
+// SetProperties sets user variables on a DEPLOYED or CONFIGURED environment,
+// without redeploying it.
+// Keys of the form "path.to.role:key" are set on the matching workflow roles,
+// all other keys on the environment itself. Values of variables declared in the
+// workflow template are validated against their VarSpec.
+// If the environment is CONFIGURED, the property maps of its active tasks are
+// re-templated, and any values which change are pushed to the tasks with the
+// next START. Otherwise, the new values are picked up at the next CONFIGURE.
+func (env *Environment) SetProperties(properties map[string]string) (err error) {
+	if env == nil {
+		return fmt.Errorf("cannot set properties on nil environment")
+	}
+	if len(properties) == 0 {
+		return nil
+	}
+
+	if !env.transitionMutex.TryLock() {
+		return fmt.Errorf("cannot set properties while transition %s is in progress", env.CurrentTransition())
+	}
+	defer env.transitionMutex.Unlock()
+
+	state := env.CurrentState()
+	if state != "DEPLOYED" && state != "CONFIGURED" {
+		return fmt.Errorf("cannot set properties in state %s, allowed states: DEPLOYED, CONFIGURED", state)
+	}
+	if env.Workflow() == nil {
+		return fmt.Errorf("cannot set properties on environment %s without workflow", env.id.String())
+	}
+
+	varSpecs, err := env.getVarSpecMap()
+	if err != nil {
+		return fmt.Errorf("cannot fetch variable specification for workflow %s: %w", env.WorkflowPath, err)
+	}
+
+	// We validate everything before touching any variable, so a bad request
+	// leaves the environment as it was
+	for k, v := range properties {
+		path, key := "", k
+		if strings.ContainsRune(k, task.TARGET_SEPARATOR_RUNE) {
+			path, key, _ = strings.Cut(k, task.TARGET_SEPARATOR)
+		}
+		if len(strings.TrimSpace(key)) == 0 {
+			return PropertyValidationError{Key: k, err: fmt.Errorf("empty key")}
+		}
+		if spec, ok := varSpecs[key]; ok {
+			if err = spec.Validate(v); err != nil {
+				return PropertyValidationError{Key: k, err: err}
+			}
+		}
+		if len(path) == 0 {
+			continue
+		}
+		if len(env.QueryRoles(path)) == 0 {
+			return PropertyValidationError{Key: k, err: fmt.Errorf("no workflow role matches path %s", path)}
+		}
+	}
+
+	type previousValue struct {
+		userVars gera.Map[string, string]
+		key      string
+		value    string
+		existed  bool
+	}
+	previousValues := make([]previousValue, 0, len(properties))
+	for k, v := range properties {
+		key := k
+		targets := map[string]gera.Map[string, string]{"": env.UserVars}
+		if strings.ContainsRune(k, task.TARGET_SEPARATOR_RUNE) {
+			var path string
+			path, key, _ = strings.Cut(k, task.TARGET_SEPARATOR)
+			targets = make(map[string]gera.Map[string, string])
+			for _, role := range env.QueryRoles(path) {
+				targets[role.GetPath()] = role.GetUserVars()
+			}
+		}
+		for _, userVars := range targets {
+			value, existed := userVars.Get(key)
+			previousValues = append(previousValues, previousValue{userVars, key, value, existed})
+			userVars.Set(key, v)
+		}
+	}
+	restorePreviousValues := func() {
+		for i := len(previousValues) - 1; i >= 0; i-- {
+			pv := previousValues[i]
+			if pv.existed {
+				pv.userVars.Set(pv.key, pv.value)
+			} else {
+				pv.userVars.Del(pv.key)
+			}
+		}
+	}
+
+	if state == "CONFIGURED" {
+		activeTasks := workflow.GetActiveTasks(env.Workflow())
+		deltas := make(map[*task.Task]controlcommands.PropertyMap, len(activeTasks))
+		for _, t := range activeTasks {
+			var delta controlcommands.PropertyMap
+			delta, err = t.BuildPropertyMapDelta()
+			if err != nil {
+				restorePreviousValues()
+				return PropertyValidationError{Key: strings.Join(sortedKeys(properties), ", "),
+					err: fmt.Errorf("cannot re-template properties of task %s: %w", t.GetName(), err)}
+			}
+			deltas[t] = delta
+		}
+
+		affected := 0
+		for t, delta := range deltas {
+			t.SetPendingProperties(delta)
+			if len(delta) > 0 {
+				affected++
+			}
+		}
+		log.WithField("partition", env.id.String()).
+			WithField(infologger.Level, infologger.IL_Support).
+			Infof("%d tasks will receive updated properties at the next START", affected)
+	}
+
+	log.WithField("partition", env.id.String()).
+		WithField(infologger.Level, infologger.IL_Ops).
+		Infof("environment properties set: %s", strings.Join(sortedKeys(properties), ", "))
+	return nil
+}
+
+// GetProperties returns the entries of the consolidated variable stack of the
+// environment's root role whose keys match any of the given glob queries.
+// If excludeGlobals is true, the variables coming exclusively from the
+// configuration service defaults and vars are left out.
+func (env *Environment) GetProperties(queries []string, excludeGlobals bool) (properties map[string]string, err error) {
+	properties = make(map[string]string)
+	if env == nil {
+		return nil, fmt.Errorf("cannot get properties of nil environment")
+	}
+	if len(queries) == 0 {
+		return
+	}
+
+	globs := make([]glob.Glob, len(queries))
+	for i, query := range queries {
+		globs[i], err = glob.Compile(query)
+		if err != nil {
+			return nil, PropertyValidationError{Key: query, err: err}
+		}
+	}
+
+	root := env.Workflow()
+	if root == nil {
+		return nil, fmt.Errorf("cannot get properties of environment %s without workflow", env.id.String())
+	}
+
+	var varStack map[string]string
+	if excludeGlobals {
+		// The root role's Defaults and Vars wrap the global ones, so we only
+		// take their own entries, while UserVars never come from globals
+		var userVars map[string]string
+		userVars, err = root.GetUserVars().Flattened()
+		if err != nil {
+			return nil, err
+		}
+		varStack, err = gera.MakeMapWithMap(userVars).
+			WrappedAndFlattened(gera.MakeMapWithMap(root.GetVars().RawCopy()).
+				Wrap(gera.MakeMapWithMap(root.GetDefaults().RawCopy())))
+	} else {
+		varStack, err = root.ConsolidatedVarStack()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range varStack {
+		for _, g := range globs {
+			if g.Match(k) {
+				properties[k] = v
+				break
+			}
+		}
+	}
+	return
+}
+
+func (env *Environment) getVarSpecMap() (repos.VarSpecMap, error) {
+	if len(env.WorkflowPath) == 0 { // no template to validate against
+		return repos.VarSpecMap{}, nil
+	}
+	resolvedWorkflowPath, _, err := the.RepoManager().GetWorkflow(env.WorkflowPath)
+	if err != nil {
+		return nil, err
+	}
+	_, _, varSpecs, err := repos.ParseWorkflowPublicVariableInfo(resolvedWorkflowPath)
+	return varSpecs, err
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
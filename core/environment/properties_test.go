@@ -0,0 +1,110 @@
+package environment
+
+import (
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/workflow"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("setting and getting environment properties", func() {
+	var env *Environment
+	var root workflow.Role
+	BeforeEach(func() {
+		envId, err := uid.FromString("2oDvieFrVTi")
+		Expect(err).NotTo(HaveOccurred())
+
+		env, err = newEnvironment(map[string]string{"n_hbf_per_tf": "128"}, envId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(env).NotTo(BeNil())
+
+		root = workflow.NewAggregatorRole("root", []workflow.Role{
+			workflow.NewCallRole(
+				"call",
+				task.Traits{Trigger: "before_CONFIGURE", Timeout: "5s", Critical: true},
+				"testplugin.Test()",
+				"")})
+		root.GetDefaults().Wrap(env.GlobalDefaults)
+		root.GetVars().Wrap(env.GlobalVars)
+		root.GetUserVars().Wrap(env.UserVars)
+		workflow.LinkChildrenToParents(root)
+		env.workflow = root
+	})
+
+	When("the environment is DEPLOYED", func() {
+		BeforeEach(func() {
+			env.Sm.SetState("DEPLOYED")
+		})
+		It("sets environment user vars", func() {
+			Expect(env.SetProperties(map[string]string{"n_hbf_per_tf": "256"})).To(Succeed())
+			v, _ := env.UserVars.Get("n_hbf_per_tf")
+			Expect(v).To(Equal("256"))
+		})
+		It("sets role user vars for role-qualified keys", func() {
+			Expect(env.SetProperties(map[string]string{"root.call:qc_enabled": "true"})).To(Succeed())
+			v, ok := env.QueryRoles("root.call")[0].GetUserVars().Get("qc_enabled")
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal("true"))
+			_, ok = env.UserVars.Get("qc_enabled")
+			Expect(ok).To(BeFalse())
+		})
+		It("rejects keys for unknown roles and leaves all vars untouched", func() {
+			err := env.SetProperties(map[string]string{
+				"n_hbf_per_tf":        "256",
+				"root.nope:something": "1",
+			})
+			Expect(err).To(BeAssignableToTypeOf(PropertyValidationError{}))
+			v, _ := env.UserVars.Get("n_hbf_per_tf")
+			Expect(v).To(Equal("128"))
+		})
+	})
+
+	When("the environment is neither DEPLOYED nor CONFIGURED", func() {
+		It("refuses to set properties", func() {
+			for _, state := range []string{"STANDBY", "RUNNING", "ERROR"} {
+				env.Sm.SetState(state)
+				err := env.SetProperties(map[string]string{"n_hbf_per_tf": "256"})
+				Expect(err).To(HaveOccurred())
+				Expect(err).NotTo(BeAssignableToTypeOf(PropertyValidationError{}))
+			}
+			v, _ := env.UserVars.Get("n_hbf_per_tf")
+			Expect(v).To(Equal("128"))
+		})
+	})
+
+	When("querying properties", func() {
+		BeforeEach(func() {
+			env.GlobalVars.Set("global_only", "1")
+			root.GetVars().Set("qc_config_uri", "consul-json://somewhere")
+		})
+		It("returns nothing for no queries", func() {
+			props, err := env.GetProperties(nil, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(props).To(BeEmpty())
+		})
+		It("matches globs against the consolidated var stack", func() {
+			props, err := env.GetProperties([]string{"n_hbf_*", "qc_*"}, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(props).To(Equal(map[string]string{
+				"n_hbf_per_tf":  "128",
+				"qc_config_uri": "consul-json://somewhere",
+			}))
+		})
+		It("leaves out global vars if requested", func() {
+			props, err := env.GetProperties([]string{"*"}, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(props).To(HaveKey("global_only"))
+
+			props, err = env.GetProperties([]string{"*"}, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(props).NotTo(HaveKey("global_only"))
+			Expect(props).To(HaveKey("qc_config_uri"))
+			Expect(props).To(HaveKey("n_hbf_per_tf"))
+		})
+		It("rejects malformed queries", func() {
+			_, err := env.GetProperties([]string{"[unterminated"}, false)
+			Expect(err).To(BeAssignableToTypeOf(PropertyValidationError{}))
+		})
+	})
+})
@@ -0,0 +1,361 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/event/topic"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	pb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/AliceO2Group/Control/core/workflow"
+)
+
+// TaskOperationError is returned by the per-task operations of an environment
+// when the operation is refused because of the state of the environment or of
+// the task, as opposed to failing while it is carried out.
+type TaskOperationError struct {
+	TaskId string
+	err    error
+}
+
+func (e TaskOperationError) Error() string {
+	return fmt.Sprintf("cannot operate on task %s: %s", e.TaskId, e.err.Error())
+}
+
+func (e TaskOperationError) Unwrap() error {
+	return e.err
+}
+
+// taskOperator is the part of task.Manager which the per-task operations rely on.
+type taskOperator interface {
+	ConfigureTask(envId uid.ID, t *task.Task, peers task.Tasks) error
+	TransitionTask(envId uid.ID, t *task.Task, src string, event string, dest string, args controlcommands.PropertyMap) error
+	RestartTask(envId uid.ID, t *task.Task, descriptor *task.Descriptor, timeout time.Duration) (*task.Task, error)
+}
+
+// The environment states in which tasks can be operated individually, and the
+// state each task is expected to reach.
+var taskStateForEnvState = map[string]sm.State{
+	"DEPLOYED":   sm.STANDBY,
+	"CONFIGURED": sm.CONFIGURED,
+	"RUNNING":    sm.RUNNING,
+}
+
+// taskStateDistance returns how many transitions separate two task states,
+// with ERROR one step before STANDBY.
+func taskStateDistance(a, b sm.State) int {
+	rank := func(s sm.State) int {
+		switch s {
+		case sm.STANDBY:
+			return 1
+		case sm.CONFIGURED:
+			return 2
+		case sm.RUNNING:
+			return 3
+		}
+		return 0
+	}
+	d := rank(a) - rank(b)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// lockForTaskOperation acquires the transition mutex of the environment, and
+// checks that t is one of its tasks and that the environment is in a
+// state in which tasks can be operated individually. On success, it returns
+// the task state matching the environment state, and the caller must release
+// the mutex.
+func (env *Environment) lockForTaskOperation(t *task.Task) (targetState sm.State, err error) {
+	if !env.transitionMutex.TryLock() {
+		return sm.UNKNOWN, TaskOperationError{TaskId: t.GetTaskId(),
+			err: fmt.Errorf("transition %s is in progress in environment %s", env.CurrentTransition(), env.id.String())}
+	}
+
+	state := env.CurrentState()
+	targetState, ok := taskStateForEnvState[state]
+	if !ok {
+		env.transitionMutex.Unlock()
+		return sm.UNKNOWN, TaskOperationError{TaskId: t.GetTaskId(),
+			err: fmt.Errorf("environment %s is in state %s, allowed states: DEPLOYED, CONFIGURED, RUNNING", env.id.String(), state)}
+	}
+
+	if env.Workflow().GetTasks().GetByTaskId(t.GetTaskId()) == nil {
+		env.transitionMutex.Unlock()
+		return sm.UNKNOWN, TaskOperationError{TaskId: t.GetTaskId(),
+			err: fmt.Errorf("task does not belong to environment %s", env.id.String())}
+	}
+	return targetState, nil
+}
+
+// RestartTask kills one of the tasks of the environment and deploys a
+// replacement for it on the same host, which is then brought to the state of
+// the environment. The killed task is detached from its role first, so its
+// termination does not move the environment to ERROR.
+// In CONFIGURED and RUNNING, tasks with inbound channels cannot be restarted,
+// because their peers would not learn of the replacement's endpoints.
+func (env *Environment) RestartTask(taskman taskOperator, t *task.Task) (replacement *task.Task, err error) {
+	return env.restartTask(taskman, t, "restart requested")
+}
+
+func (env *Environment) restartTask(taskman taskOperator, t *task.Task, reason string) (replacement *task.Task, err error) {
+	targetState, err := env.lockForTaskOperation(t)
+	if err != nil {
+		return nil, err
+	}
+	defer env.transitionMutex.Unlock()
+
+	rolePath := t.GetParentRolePath()
+	env.sendTaskRestartEvent(rolePath, pb.OpStatus_STARTED, fmt.Sprintf("restarting task %s, %s", t.GetTaskId(), reason), nil)
+	defer func() {
+		if err != nil {
+			env.sendTaskRestartEvent(rolePath, pb.OpStatus_DONE_ERROR, fmt.Sprintf("could not restart task %s", t.GetTaskId()), err)
+		} else {
+			env.sendTaskRestartEvent(rolePath, pb.OpStatus_DONE_OK, fmt.Sprintf("task %s replaced by task %s", t.GetTaskId(), replacement.GetTaskId()), nil)
+		}
+	}()
+
+	if targetState != sm.STANDBY && len(t.GetLocalBindMap()) > 0 {
+		return nil, TaskOperationError{TaskId: t.GetTaskId(),
+			err: fmt.Errorf("task binds inbound channels, it can only be restarted while the environment is DEPLOYED")}
+	}
+
+	role, ok := t.GetParentRole().(workflow.Role)
+	parent := t.GetParent()
+	if !ok || parent == nil {
+		return nil, TaskOperationError{TaskId: t.GetTaskId(), err: fmt.Errorf("task has no workflow role")}
+	}
+
+	parent.SetTask(nil)
+	t.SetParent(nil)
+	descriptors := role.GenerateTaskDescriptors()
+	if len(descriptors) != 1 {
+		t.SetParent(parent)
+		parent.SetTask(t)
+		return nil, fmt.Errorf("cannot generate task descriptor for role %s", role.GetPath())
+	}
+
+	log.WithField("partition", env.id.String()).
+		WithField(infologger.Level, infologger.IL_Ops).
+		Infof("restarting task %s of role %s on %s, %s", t.GetTaskId(), role.GetPath(), t.GetHostname(), reason)
+
+	replacement, err = taskman.RestartTask(env.id, t, descriptors[0], acquireDeploymentTimeout(env.Workflow()))
+	if err != nil {
+		// The role is left without a task, which is what a dead task would look like
+		parent.UpdateState(sm.ERROR)
+		return nil, fmt.Errorf("cannot restart task %s of role %s: %w", t.GetTaskId(), role.GetPath(), err)
+	}
+
+	err = env.bringTaskToState(taskman, replacement, targetState)
+	if err != nil {
+		return replacement, fmt.Errorf("replacement task %s of role %s deployed, but could not reach %s: %w",
+			replacement.GetTaskId(), role.GetPath(), targetState.String(), err)
+	}
+
+	log.WithField("partition", env.id.String()).
+		WithField(infologger.Level, infologger.IL_Ops).
+		Infof("task %s of role %s replaced by task %s", t.GetTaskId(), role.GetPath(), replacement.GetTaskId())
+	return replacement, nil
+}
+
+// TransitionTask pushes a single transition to one of the tasks of the
+// environment. Only transitions which bring the task closer to the state of
+// the environment are allowed, e.g. to catch up a task which failed to follow
+// an environment transition.
+func (env *Environment) TransitionTask(taskman taskOperator, t *task.Task, eventName string) (err error) {
+	targetState, err := env.lockForTaskOperation(t)
+	if err != nil {
+		return err
+	}
+	defer env.transitionMutex.Unlock()
+
+	src := t.GetState()
+	tr, ok := sm.TransitionFor(sm.Event(strings.ToUpper(eventName)), src)
+	if !ok {
+		return TaskOperationError{TaskId: t.GetTaskId(),
+			err: fmt.Errorf("event %s is not valid for a task in state %s", eventName, src.String())}
+	}
+	if taskStateDistance(tr.Dst, targetState) >= taskStateDistance(src, targetState) {
+		return TaskOperationError{TaskId: t.GetTaskId(),
+			err: fmt.Errorf("event %s would not bring the task closer to state %s of environment %s", tr.Evt, targetState.String(), env.id.String())}
+	}
+
+	log.WithField("partition", env.id.String()).
+		WithField(infologger.Level, infologger.IL_Ops).
+		Infof("transitioning task %s of role %s with %s", t.GetTaskId(), t.GetParentRolePath(), tr.Evt)
+
+	return env.transitionTask(taskman, t, tr)
+}
+
+// SetTaskProperties sets property values on one of the tasks of a
+// DEPLOYED or CONFIGURED environment, overriding the templated ones for as
+// long as the task lives. They are pushed to the task with its next CONFIGURE,
+// or its next START if the task is already CONFIGURED.
+func (env *Environment) SetTaskProperties(t *task.Task, properties map[string]string) (err error) {
+	if len(properties) == 0 {
+		return nil
+	}
+
+	targetState, err := env.lockForTaskOperation(t)
+	if err != nil {
+		return err
+	}
+	defer env.transitionMutex.Unlock()
+
+	if targetState == sm.RUNNING {
+		return TaskOperationError{TaskId: t.GetTaskId(),
+			err: fmt.Errorf("properties cannot be pushed while environment %s is RUNNING", env.id.String())}
+	}
+	for k := range properties {
+		if len(strings.TrimSpace(k)) == 0 {
+			return PropertyValidationError{Key: k, err: fmt.Errorf("empty key")}
+		}
+	}
+
+	previous := t.SetPropertyOverrides(properties)
+	if t.GetState() == sm.CONFIGURED {
+		var delta controlcommands.PropertyMap
+		delta, err = t.BuildPropertyMapDelta()
+		if err != nil {
+			t.ResetPropertyOverrides(previous)
+			return PropertyValidationError{Key: strings.Join(sortedKeys(properties), ", "),
+				err: fmt.Errorf("cannot re-template properties of task %s: %w", t.GetName(), err)}
+		}
+		t.SetPendingProperties(delta)
+	}
+
+	log.WithField("partition", env.id.String()).
+		WithField(infologger.Level, infologger.IL_Ops).
+		Infof("properties set on task %s of role %s: %s", t.GetTaskId(), t.GetParentRolePath(), strings.Join(sortedKeys(properties), ", "))
+	return nil
+}
+
+// bringTaskToState pushes the transitions needed to bring a task of the
+// environment from its current state to targetState.
+func (env *Environment) bringTaskToState(taskman taskOperator, t *task.Task, targetState sm.State) error {
+	state := t.GetState()
+	for state != targetState {
+		var evt sm.Event
+		switch {
+		case state == sm.ERROR:
+			evt = sm.RECOVER
+		case state == sm.STANDBY:
+			evt = sm.CONFIGURE
+		case state == sm.CONFIGURED && targetState == sm.RUNNING:
+			evt = sm.START
+		case state == sm.CONFIGURED:
+			evt = sm.RESET
+		case state == sm.RUNNING:
+			evt = sm.STOP
+		default:
+			return fmt.Errorf("task %s is in state %s", t.GetTaskId(), state.String())
+		}
+		tr, _ := sm.TransitionFor(evt, state)
+		if err := env.transitionTask(taskman, t, tr); err != nil {
+			return err
+		}
+		state = tr.Dst
+	}
+	return nil
+}
+
+func (env *Environment) transitionTask(taskman taskOperator, t *task.Task, tr sm.Transition) error {
+	switch tr.Evt {
+	case sm.CONFIGURE:
+		return taskman.ConfigureTask(env.id, t, workflow.GetActiveTasks(env.Workflow()))
+	case sm.START:
+		return taskman.TransitionTask(env.id, t, tr.Src.String(), tr.Evt.String(), tr.Dst.String(),
+			env.buildStartActivityArgs(env.currentRunNumber))
+	default:
+		return taskman.TransitionTask(env.id, t, tr.Src.String(), tr.Evt.String(), tr.Dst.String(), nil)
+	}
+}
+
+func (env *Environment) sendTaskRestartEvent(rolePath string, status pb.OpStatus, message string, err error) {
+	ev := &pb.Ev_EnvironmentEvent{
+		EnvironmentId:    env.id.String(),
+		State:            env.Sm.Current(),
+		RunNumber:        env.GetCurrentRunNumber(),
+		Message:          message,
+		Transition:       "TASK_RESTART",
+		TransitionStep:   rolePath,
+		TransitionStatus: status,
+		LastRequestUser:  env.GetLastRequestUser(),
+	}
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	the.EventWriterWithTopic(topic.Environment).WriteEvent(ev)
+}
+
+// handleTaskRestartRequest relaunches a task which died unexpectedly, as
+// allowed by its restart policy. If the task cannot be restarted, for instance
+// because its environment is in the middle of a transition, the failure is
+// handled as it would be without a restart policy.
+func (envs *Manager) handleTaskRestartRequest(evt *event.TaskRestartRequestedEvent) {
+	t := envs.taskman.GetTask(evt.GetTaskId())
+	if t == nil {
+		return
+	}
+	fail := func(err error) {
+		log.WithField("partition", evt.GetEnvironmentId().String()).
+			WithField("taskId", evt.GetTaskId()).
+			WithField(infologger.Level, infologger.IL_Ops).
+			WithError(err).
+			Errorf("automatic restart of task of role %s failed", t.GetParentRolePath())
+		// The task is still attached to its role only if we did not get as far
+		// as killing it, otherwise restartTask already took care of the role
+		if t.GetParent() != nil {
+			envs.taskman.MessageChannel <- task.NewTaskStateMessage(evt.GetTaskId(), sm.ERROR.String())
+		}
+	}
+
+	env, err := envs.environment(evt.GetEnvironmentId())
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	log.WithField("partition", env.id.String()).
+		WithField("taskId", evt.GetTaskId()).
+		WithField(infologger.Level, infologger.IL_Ops).
+		Warnf("task of role %s failed, restarting in %s (attempt %d/%d)", t.GetParentRolePath(), evt.Backoff.String(), evt.Attempt, evt.MaxAttempts)
+	time.Sleep(evt.Backoff)
+
+	_, err = env.restartTask(envs.taskman, t, fmt.Sprintf("task failed, attempt %d/%d", evt.Attempt, evt.MaxAttempts))
+	if err != nil {
+		fail(err)
+	}
+}
@@ -0,0 +1,127 @@
+package environment
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
+	"github.com/AliceO2Group/Control/core/workflow"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeTaskOperator stands in for task.Manager, it records the operations
+// pushed to it and applies their outcome to the tasks directly.
+type fakeTaskOperator struct {
+	events    []string
+	restarted *task.Task
+}
+
+func (f *fakeTaskOperator) ConfigureTask(envId uid.ID, t *task.Task, peers task.Tasks) error {
+	f.events = append(f.events, string(sm.CONFIGURE))
+	t.SetState(sm.CONFIGURED)
+	return nil
+}
+
+func (f *fakeTaskOperator) TransitionTask(envId uid.ID, t *task.Task, src string, evt string, dest string, args controlcommands.PropertyMap) error {
+	f.events = append(f.events, evt)
+	t.SetState(sm.StateFromString(dest))
+	return nil
+}
+
+func (f *fakeTaskOperator) RestartTask(envId uid.ID, t *task.Task, descriptor *task.Descriptor, timeout time.Duration) (*task.Task, error) {
+	f.restarted = t
+	replacement := task.ClassToTask(t.GetTaskClass(), descriptor.TaskRole)
+	replacement.SetState(sm.STANDBY)
+	descriptor.TaskRole.SetTask(replacement)
+	return replacement, nil
+}
+
+var _ = Describe("operating on single tasks of an environment", func() {
+	var env *Environment
+	BeforeEach(func() {
+		envId, err := uid.FromString("2oDvieFrVTi")
+		Expect(err).NotTo(HaveOccurred())
+
+		env, err = newEnvironment(nil, envId)
+		Expect(err).NotTo(HaveOccurred())
+		env.workflow = workflow.NewAggregatorRole("root", []workflow.Role{})
+	})
+
+	It("refuses outside of DEPLOYED, CONFIGURED and RUNNING", func() {
+		for _, state := range []string{"STANDBY", "ERROR", "DONE"} {
+			env.Sm.SetState(state)
+			err := env.SetTaskProperties(&task.Task{}, map[string]string{"severity": "debug"})
+			Expect(err).To(BeAssignableToTypeOf(TaskOperationError{}))
+		}
+	})
+
+	It("refuses tasks which do not belong to the environment", func() {
+		env.Sm.SetState("CONFIGURED")
+		err := env.TransitionTask(nil, &task.Task{}, "START")
+		Expect(err).To(BeAssignableToTypeOf(TaskOperationError{}))
+		Expect(env.transitionMutex.TryLock()).To(BeTrue())
+	})
+
+	It("only allows transitions towards the environment state", func() {
+		Expect(taskStateDistance(sm.CONFIGURED, sm.RUNNING)).To(BeNumerically("<", taskStateDistance(sm.STANDBY, sm.RUNNING)))
+		Expect(taskStateDistance(sm.STANDBY, sm.STANDBY)).To(BeNumerically("<", taskStateDistance(sm.ERROR, sm.STANDBY)))
+		Expect(taskStateDistance(sm.STANDBY, sm.CONFIGURED)).To(Equal(taskStateDistance(sm.RUNNING, sm.CONFIGURED)))
+	})
+
+	Context("on a task of the environment", func() {
+		var (
+			t       *task.Task
+			taskman *fakeTaskOperator
+		)
+		BeforeEach(func() {
+			var err error
+			env.workflow, err = workflow.LoadDPL([]*taskclass.Class{{Identifier: taskclass.Id{Name: "readout"}}}, "root", nil)
+			Expect(err).NotTo(HaveOccurred())
+			t = env.Workflow().GetTasks()[0]
+			taskman = &fakeTaskOperator{}
+		})
+
+		It("sets properties on a task of a DEPLOYED environment", func() {
+			env.Sm.SetState("DEPLOYED")
+			t.SetState(sm.STANDBY)
+			Expect(env.SetTaskProperties(t, map[string]string{"severity": "debug"})).To(Succeed())
+			Expect(t.SetPropertyOverrides(nil)).To(HaveKeyWithValue("severity", "debug"))
+			Expect(env.transitionMutex.TryLock()).To(BeTrue())
+		})
+
+		It("configures a task which lags behind a CONFIGURED environment", func() {
+			env.Sm.SetState("CONFIGURED")
+			t.SetState(sm.STANDBY)
+			Expect(env.TransitionTask(taskman, t, "configure")).To(Succeed())
+			Expect(taskman.events).To(Equal([]string{"CONFIGURE"}))
+			Expect(t.GetState()).To(Equal(sm.CONFIGURED))
+			Expect(env.transitionMutex.TryLock()).To(BeTrue())
+		})
+
+		It("starts a task which lags behind a RUNNING environment", func() {
+			env.Sm.SetState("RUNNING")
+			t.SetState(sm.CONFIGURED)
+			Expect(env.TransitionTask(taskman, t, "START")).To(Succeed())
+			Expect(taskman.events).To(Equal([]string{"START"}))
+			Expect(t.GetState()).To(Equal(sm.RUNNING))
+		})
+
+		It("restarts a task and brings the replacement to the state of the environment", func() {
+			env.Sm.SetState("RUNNING")
+			t.SetState(sm.RUNNING)
+			replacement, err := env.RestartTask(taskman, t)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(taskman.restarted).To(BeIdenticalTo(t))
+			Expect(replacement).NotTo(BeIdenticalTo(t))
+			Expect(t.GetParent()).To(BeNil())
+			Expect(env.Workflow().GetTasks()).To(ConsistOf(replacement))
+			Expect(taskman.events).To(Equal([]string{"CONFIGURE", "START"}))
+			Expect(replacement.GetState()).To(Equal(sm.RUNNING))
+			Expect(env.transitionMutex.TryLock()).To(BeTrue())
+		})
+	})
+})
@@ -350,10 +350,30 @@ func (t DeployTransition) do(env *Environment) (err error) {
 		return
 	}
 
+	validateTopology(env)
+
 	env.sendEnvironmentEvent(&event.EnvironmentEvent{EnvironmentID: env.Id().String(), State: "DEPLOYED"})
 	return
 }
 
+// validateTopology reports problems in the channel topology of the deployed
+// tasks, which would otherwise only show up as devices stuck in READY after
+// CONFIGURE. Issues are logged, but do not fail the deployment.
+func validateTopology(env *Environment) {
+	topology := task.BuildTopology(env.Workflow().GetTasks())
+	for _, issue := range topology.Issues {
+		entry := log.WithField("partition", env.Id().String()).
+			WithField("level", infologger.IL_Support).
+			WithField("taskId", issue.TaskId).
+			WithField("channel", issue.Channel)
+		if issue.Severity == task.TOPOLOGY_ERROR {
+			entry.Errorf("channel topology error: %s", issue.Message)
+		} else {
+			entry.Warnf("channel topology warning: %s", issue.Message)
+		}
+	}
+}
+
 func acquireDeploymentTimeout(wf workflow.Role) time.Duration {
 	envId := wf.GetEnvironmentId()
 
@@ -26,7 +26,9 @@ package environment
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/AliceO2Group/Control/core/task/sm"
 	"github.com/AliceO2Group/Control/core/workflow"
@@ -58,11 +60,57 @@ func (t StartActivityTransition) do(env *Environment) (err error) {
 
 	runNumber := env.currentRunNumber
 
+	// A run cannot start while a critical task fails its readiness probe
+	notReady := workflow.GetActiveTasks(env.Workflow()).Filtered(func(t *task.Task) bool {
+		return t.GetTraits().Critical && !t.IsReady()
+	})
+	if len(notReady) > 0 {
+		rolePaths := make([]string, len(notReady))
+		for i, t := range notReady {
+			rolePaths[i] = t.GetParentRolePath()
+		}
+		return fmt.Errorf("critical tasks are not ready: %s", strings.Join(rolePaths, ", "))
+	}
+
 	log.WithField(infologger.Run, runNumber).
 		WithField("partition", env.Id().String()).
 		WithField(infologger.Level, infologger.IL_Support).
 		Info("starting new run")
 
+	args := env.buildStartActivityArgs(runNumber)
+
+	taskmanMessage := task.NewTransitionTaskMessage(
+		workflow.GetActiveTasks(env.Workflow()),
+		sm.CONFIGURED.String(),
+		sm.START.String(),
+		sm.RUNNING.String(),
+		args,
+		env.Id(),
+	)
+	t.taskman.MessageChannel <- taskmanMessage
+
+	incomingEv := <-env.stateChangedCh
+	// If some tasks failed to transition
+	if tasksStateErrors := incomingEv.GetTasksStateChangedError(); tasksStateErrors != nil {
+		return tasksStateErrors
+	}
+
+	log.WithField(infologger.Run, env.currentRunNumber).
+		WithField("partition", env.Id().String()).
+		WithField(infologger.Level, infologger.IL_Support).
+		Info("run started")
+	env.sendEnvironmentEvent(&event.EnvironmentEvent{
+		EnvironmentID: env.Id().String(),
+		State:         "RUNNING",
+		Run:           env.currentRunNumber,
+	})
+
+	return
+}
+
+// buildStartActivityArgs returns the arguments pushed to all tasks along with
+// the START transition.
+func (env *Environment) buildStartActivityArgs(runNumber uint32) controlcommands.PropertyMap {
 	cleanupCount := 0
 	cleanupCountS, ok := env.GlobalVars.Get("__fmq_cleanup_count")
 	if ok && len(cleanupCountS) > 0 {
@@ -104,32 +152,5 @@ func (t StartActivityTransition) do(env *Environment) (err error) {
 			}
 		}
 	}
-
-	taskmanMessage := task.NewTransitionTaskMessage(
-		workflow.GetActiveTasks(env.Workflow()),
-		sm.CONFIGURED.String(),
-		sm.START.String(),
-		sm.RUNNING.String(),
-		args,
-		env.Id(),
-	)
-	t.taskman.MessageChannel <- taskmanMessage
-
-	incomingEv := <-env.stateChangedCh
-	// If some tasks failed to transition
-	if tasksStateErrors := incomingEv.GetTasksStateChangedError(); tasksStateErrors != nil {
-		return tasksStateErrors
-	}
-
-	log.WithField(infologger.Run, env.currentRunNumber).
-		WithField("partition", env.Id().String()).
-		WithField(infologger.Level, infologger.IL_Support).
-		Info("run started")
-	env.sendEnvironmentEvent(&event.EnvironmentEvent{
-		EnvironmentID: env.Id().String(),
-		State:         "RUNNING",
-		Run:           env.currentRunNumber,
-	})
-
-	return
+	return args
 }
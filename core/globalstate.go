@@ -32,6 +32,8 @@ import (
 
 	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/core/environment"
+	"github.com/AliceO2Group/Control/core/ha"
+	"github.com/AliceO2Group/Control/core/sequencer"
 
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/the"
@@ -61,6 +63,19 @@ func newGlobalState(shutdown func()) (*globalState, error) {
 	state.taskman = taskman
 	//state.taskman.Start()
 	state.environments = environment.NewEnvManager(state.taskman, internalEventCh)
+	state.sequences = sequencer.NewManager(state.environments, state.taskman)
+
+	if viper.GetBool("haEnabled") {
+		var consulEndpoint string
+		consulEndpoint, err = getHaConsulEndpoint()
+		if err != nil {
+			return nil, err
+		}
+		state.elector, err = ha.NewElector(consulEndpoint, viper.GetString("haLockKey"), getHaAdvertiseEndpoint())
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return state, nil
 }
@@ -73,4 +88,8 @@ type globalState struct {
 	// uses locks, so thread safe
 	environments *environment.Manager
 	taskman      *task.Manager
+	sequences    *sequencer.Manager
+
+	// nil unless running in active/standby mode
+	elector *ha.Elector
 }
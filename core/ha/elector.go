@@ -0,0 +1,202 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package ha implements the leader election which allows several instances
+// of the AliECS core to run in active/standby mode: only the instance which
+// holds the leader lock in Consul controls the Mesos framework, the others
+// wait to take over.
+package ha
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/hashicorp/consul/api"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logger.New(logrus.StandardLogger(), "ha")
+
+const (
+	// LEADER_METADATA_KEY is the gRPC metadata key under which every core
+	// instance advertises the control endpoint of the current leader.
+	LEADER_METADATA_KEY = "aliecs-leader"
+	SESSION_NAME        = "aliecs-core"
+	// if the leader stops renewing its session, the lock is released
+	// after at most twice this TTL
+	SESSION_TTL = "15s"
+)
+
+// Elector competes for a Consul session lock on behalf of this core
+// instance. The lock value is the control endpoint of the instance which
+// holds it, so that standby instances can tell clients where to go.
+type Elector struct {
+	kv        *api.KV
+	lock      *api.Lock
+	key       string
+	advertise string
+
+	mu      sync.RWMutex
+	leader  bool
+	elected chan struct{}
+	lost    chan struct{}
+}
+
+func NewElector(consulEndpoint string, key string, advertise string) (*Elector, error) {
+	if len(key) == 0 {
+		return nil, errors.New("empty leader lock key")
+	}
+	if len(advertise) == 0 {
+		return nil, errors.New("empty advertised endpoint")
+	}
+
+	if scheme, _, found := strings.Cut(consulEndpoint, "://"); found && scheme != "consul" {
+		return nil, fmt.Errorf("leader election requires a Consul server, cannot use %s", consulEndpoint)
+	}
+	if len(consulEndpoint) == 0 {
+		return nil, errors.New("empty Consul endpoint for leader election")
+	}
+
+	cfg := api.DefaultConfig()
+	cfg.Address = strings.TrimPrefix(consulEndpoint, "consul://")
+	cli, err := api.NewClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create Consul client for leader election: %w", err)
+	}
+	lock, err := cli.LockOpts(&api.LockOptions{
+		Key:            key,
+		Value:          []byte(advertise),
+		SessionName:    SESSION_NAME,
+		SessionTTL:     SESSION_TTL,
+		MonitorRetries: 3,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create leader lock %s: %w", key, err)
+	}
+
+	return &Elector{
+		kv:        cli.KV(),
+		lock:      lock,
+		key:       key,
+		advertise: advertise,
+		elected:   make(chan struct{}),
+		lost:      make(chan struct{}),
+	}, nil
+}
+
+// Campaign blocks until this instance acquires the leader lock, or until
+// ctx is done. Once leader, the instance stays leader until Lost is closed.
+func (e *Elector) Campaign(ctx context.Context) error {
+	log.WithField("key", e.key).
+		WithField("endpoint", e.advertise).
+		WithField("level", infologger.IL_Support).
+		Info("core instance on standby, waiting for leadership")
+
+	lostCh, err := e.lock.Lock(ctx.Done())
+	if err != nil {
+		return fmt.Errorf("cannot acquire leader lock %s: %w", e.key, err)
+	}
+	if lostCh == nil { // ctx done before we got the lock
+		return ctx.Err()
+	}
+
+	e.mu.Lock()
+	e.leader = true
+	close(e.elected)
+	e.mu.Unlock()
+
+	log.WithField("key", e.key).
+		WithField("endpoint", e.advertise).
+		WithField("level", infologger.IL_Ops).
+		Info("core instance elected leader")
+
+	go func() {
+		<-lostCh
+		e.mu.Lock()
+		wasLeader := e.leader
+		e.leader = false
+		e.mu.Unlock()
+		if wasLeader {
+			log.WithField("key", e.key).
+				WithField("level", infologger.IL_Ops).
+				Error("core instance lost leadership")
+		}
+		close(e.lost)
+	}()
+	return nil
+}
+
+// Resign releases the leader lock, if held.
+func (e *Elector) Resign() error {
+	e.mu.Lock()
+	wasLeader := e.leader
+	e.leader = false
+	e.mu.Unlock()
+	if !wasLeader {
+		return nil
+	}
+	return e.lock.Unlock()
+}
+
+func (e *Elector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leader
+}
+
+// Elected is closed when this instance becomes leader.
+func (e *Elector) Elected() <-chan struct{} {
+	return e.elected
+}
+
+// Lost is closed when this instance, having been leader, loses the lock.
+func (e *Elector) Lost() <-chan struct{} {
+	return e.lost
+}
+
+// Advertise returns the control endpoint of this instance.
+func (e *Elector) Advertise() string {
+	return e.advertise
+}
+
+// Leader returns the control endpoint of the current leader, or an empty
+// string if no instance currently holds the lock.
+func (e *Elector) Leader() (string, error) {
+	if e.IsLeader() {
+		return e.advertise, nil
+	}
+	kvp, _, err := e.kv.Get(e.key, nil)
+	if err != nil {
+		return "", fmt.Errorf("cannot read leader lock %s: %w", e.key, err)
+	}
+	if kvp == nil || len(kvp.Session) == 0 {
+		return "", nil
+	}
+	return string(kvp.Value), nil
+}
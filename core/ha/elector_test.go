@@ -0,0 +1,303 @@
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeConsul implements the subset of the Consul session and KV HTTP API
+// used by session locks, including blocking queries.
+type fakeConsul struct {
+	mu       sync.Mutex
+	index    uint64
+	kv       map[string]*api.KVPair
+	sessions map[string]struct{}
+	nextId   int
+	// closed and replaced on every write, to wake up blocking queries
+	changed chan struct{}
+	closed  chan struct{}
+}
+
+func newFakeConsul() *fakeConsul {
+	return &fakeConsul{
+		index:    1,
+		kv:       make(map[string]*api.KVPair),
+		sessions: make(map[string]struct{}),
+		changed:  make(chan struct{}),
+		closed:   make(chan struct{}),
+	}
+}
+
+// touch records a write, with f.mu held.
+func (f *fakeConsul) touch() {
+	f.index++
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+// expireSession drops a session like Consul does when it is not renewed
+// in time, releasing the locks it holds.
+func (f *fakeConsul) expireSession(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.sessions, id)
+	for _, pair := range f.kv {
+		if pair.Session == id {
+			pair.Session = ""
+		}
+	}
+	f.touch()
+}
+
+func (f *fakeConsul) holder(key string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if pair, ok := f.kv[key]; ok {
+		return pair.Session
+	}
+	return ""
+}
+
+func (f *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/v1/session/create" && r.Method == http.MethodPut:
+		f.mu.Lock()
+		f.nextId++
+		id := fmt.Sprintf("session-%d", f.nextId)
+		f.sessions[id] = struct{}{}
+		f.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]string{"ID": id})
+	case strings.HasPrefix(r.URL.Path, "/v1/session/renew/") && r.Method == http.MethodPut:
+		id := strings.TrimPrefix(r.URL.Path, "/v1/session/renew/")
+		f.mu.Lock()
+		_, ok := f.sessions[id]
+		f.mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]*api.SessionEntry{{ID: id, TTL: SESSION_TTL}})
+	case strings.HasPrefix(r.URL.Path, "/v1/session/destroy/") && r.Method == http.MethodPut:
+		f.expireSession(strings.TrimPrefix(r.URL.Path, "/v1/session/destroy/"))
+		_, _ = io.WriteString(w, "true")
+	case strings.HasPrefix(r.URL.Path, "/v1/kv/") && r.Method == http.MethodGet:
+		f.serveGet(w, r)
+	case strings.HasPrefix(r.URL.Path, "/v1/kv/") && r.Method == http.MethodPut:
+		f.servePut(w, r)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func (f *fakeConsul) serveGet(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+	waitIndex, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+
+	f.mu.Lock()
+	// blocking queries return early so that the tests never wait long
+	timeout := time.After(200 * time.Millisecond)
+	for waiting := true; waiting && f.index <= waitIndex; {
+		changed := f.changed
+		f.mu.Unlock()
+		select {
+		case <-changed:
+		case <-timeout:
+			waiting = false
+		case <-f.closed:
+			waiting = false
+		case <-r.Context().Done():
+			waiting = false
+		}
+		f.mu.Lock()
+	}
+	pair, ok := f.kv[key]
+	var payload []byte
+	if ok {
+		copied := *pair
+		payload, _ = json.Marshal(api.KVPairs{&copied})
+	}
+	index := f.index
+	f.mu.Unlock()
+
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(index, 10))
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write(payload)
+}
+
+func (f *fakeConsul) servePut(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+	query := r.URL.Query()
+	value, _ := io.ReadAll(r.Body)
+	flags, _ := strconv.ParseUint(query.Get("flags"), 10, 64)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	pair, ok := f.kv[key]
+	switch {
+	case query.Has("acquire"):
+		session := query.Get("acquire")
+		if _, valid := f.sessions[session]; !valid || (ok && pair.Session != "" && pair.Session != session) {
+			_, _ = io.WriteString(w, "false")
+			return
+		}
+		f.kv[key] = &api.KVPair{Key: key, Value: value, Flags: flags, Session: session}
+	case query.Has("release"):
+		if !ok || pair.Session != query.Get("release") {
+			_, _ = io.WriteString(w, "false")
+			return
+		}
+		pair.Session = ""
+	default:
+		f.kv[key] = &api.KVPair{Key: key, Value: value, Flags: flags}
+	}
+	f.touch()
+	_, _ = io.WriteString(w, "true")
+}
+
+var _ = Describe("leader election", func() {
+	const lockKey = "o2/runtime/aliecs/core_leader"
+	var (
+		fake     *fakeConsul
+		server   *httptest.Server
+		endpoint string
+		elector  *Elector
+	)
+
+	newElector := func(advertise string) *Elector {
+		e, err := NewElector(endpoint, lockKey, advertise)
+		Expect(err).NotTo(HaveOccurred())
+		return e
+	}
+
+	// campaign runs Campaign in the background and returns its outcome
+	campaign := func(ctx context.Context, e *Elector) <-chan error {
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- e.Campaign(ctx)
+		}()
+		return errCh
+	}
+
+	BeforeEach(func() {
+		fake = newFakeConsul()
+		server = httptest.NewServer(fake)
+		endpoint = "consul://" + strings.TrimPrefix(server.URL, "http://")
+		elector = newElector("core-b:32102")
+	})
+	AfterEach(func() {
+		close(fake.closed)
+		server.Close()
+	})
+
+	It("should refuse an incomplete configuration", func() {
+		_, err := NewElector(endpoint, "", "core-b:32102")
+		Expect(err).To(HaveOccurred())
+		_, err = NewElector(endpoint, lockKey, "")
+		Expect(err).To(HaveOccurred())
+		_, err = NewElector("", lockKey, "core-b:32102")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should refuse configuration backends other than Consul", func() {
+		_, err := NewElector("etcd://127.0.0.1:2379", lockKey, "core-b:32102")
+		Expect(err).To(MatchError(ContainSubstring("requires a Consul server")))
+		_, err = NewElector("apricot://127.0.0.1:32101", lockKey, "core-b:32102")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should start on standby", func() {
+		Expect(elector.IsLeader()).To(BeFalse())
+		Expect(elector.Elected()).NotTo(BeClosed())
+		Expect(elector.Resign()).To(Succeed())
+	})
+
+	It("should report no leader if the lock is not held", func() {
+		Expect(elector.Leader()).To(BeEmpty())
+
+		fake.kv[lockKey] = &api.KVPair{Key: lockKey, Value: []byte("core-a:32102")}
+		Expect(elector.Leader()).To(BeEmpty())
+	})
+
+	It("should acquire a free lock and report itself as leader", func() {
+		Eventually(campaign(context.Background(), elector)).Should(Receive(BeNil()))
+		Expect(elector.IsLeader()).To(BeTrue())
+		Expect(elector.Elected()).To(BeClosed())
+		Expect(elector.Lost()).NotTo(BeClosed())
+		Expect(elector.Leader()).To(Equal("core-b:32102"))
+
+		standby := newElector("core-c:32102")
+		Expect(standby.Leader()).To(Equal("core-b:32102"))
+
+		Expect(elector.Resign()).To(Succeed())
+	})
+
+	It("should stay on standby while another instance is leader", func() {
+		leader := newElector("core-a:32102")
+		Eventually(campaign(context.Background(), leader)).Should(Receive(BeNil()))
+		defer func() { _ = leader.Resign() }()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := campaign(ctx, elector)
+		Consistently(errCh, 500*time.Millisecond).ShouldNot(Receive())
+		Expect(elector.IsLeader()).To(BeFalse())
+		Expect(elector.Leader()).To(Equal("core-a:32102"))
+
+		cancel()
+		Eventually(errCh).Should(Receive(MatchError(context.Canceled)))
+		Expect(elector.Elected()).NotTo(BeClosed())
+	})
+
+	When("the leader resigns", func() {
+		It("should hand over to a standby instance", func() {
+			leader := newElector("core-a:32102")
+			Eventually(campaign(context.Background(), leader)).Should(Receive(BeNil()))
+			errCh := campaign(context.Background(), elector)
+			Consistently(errCh, 300*time.Millisecond).ShouldNot(Receive())
+
+			Expect(leader.Resign()).To(Succeed())
+			Expect(leader.IsLeader()).To(BeFalse())
+			Eventually(leader.Lost()).Should(BeClosed())
+
+			Eventually(errCh, 5*time.Second).Should(Receive(BeNil()))
+			Expect(elector.IsLeader()).To(BeTrue())
+			Expect(leader.Leader()).To(Equal("core-b:32102"))
+			Expect(elector.Resign()).To(Succeed())
+		})
+	})
+
+	When("the session of the leader expires", func() {
+		It("should report the loss and fail over to a standby instance", func() {
+			leader := newElector("core-a:32102")
+			Eventually(campaign(context.Background(), leader)).Should(Receive(BeNil()))
+			session := fake.holder(lockKey)
+			Expect(session).NotTo(BeEmpty())
+
+			errCh := campaign(context.Background(), elector)
+			Consistently(errCh, 300*time.Millisecond).ShouldNot(Receive())
+
+			fake.expireSession(session)
+			Eventually(leader.Lost(), 5*time.Second).Should(BeClosed())
+			Expect(leader.IsLeader()).To(BeFalse())
+
+			Eventually(errCh, 5*time.Second).Should(Receive(BeNil()))
+			Expect(elector.IsLeader()).To(BeTrue())
+			Expect(fake.holder(lockKey)).NotTo(Equal(session))
+			Expect(elector.Resign()).To(Succeed())
+		})
+	})
+})
@@ -29,7 +29,9 @@ import (
 	"time"
 
 	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/common/secrets"
 	bkpb "github.com/AliceO2Group/Control/core/integration/bookkeeping/protos"
+	"github.com/AliceO2Group/Control/core/the"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
@@ -50,6 +52,17 @@ type RpcClient struct {
 	cancel context.CancelFunc
 }
 
+// bearerToken authenticates every call to Bookkeeping with the configured token.
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
 func NewClient(cxt context.Context, cancel context.CancelFunc, endpoint string) *RpcClient {
 	log.WithFields(logrus.Fields{
 		"endpoint": endpoint,
@@ -75,6 +88,20 @@ func NewClient(cxt context.Context, cancel context.CancelFunc, endpoint string)
 	if !viper.GetBool("bookkeepingUseSystemProxy") {
 		dialOptions = append(dialOptions, grpc.WithNoProxy())
 	}
+	if token := viper.GetString("bookkeepingToken"); len(token) != 0 {
+		if secretName, isSecret := secrets.ParseReference(token); isSecret {
+			var err error
+			token, err = the.ConfSvc().GetSecret(secretName)
+			if err != nil {
+				log.WithError(err).
+					WithField("secret", secretName).
+					Error("cannot fetch Bookkeeping token, calls will not be authenticated")
+			}
+		}
+		if len(token) != 0 {
+			dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(bearerToken(token)))
+		}
+	}
 	conn, err := grpc.DialContext(cxt,
 		endpoint,
 		dialOptions...,
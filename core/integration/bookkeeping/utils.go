@@ -40,8 +40,7 @@ func getJWTAPIToken() (jwtToken string) {
 	client := new(http.Client)
 	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
 		if token, ok := req.URL.Query()["token"]; ok {
-			log.WithField("JWT token", token[0]).
-				Debug("bookkeeping jwt token")
+			log.Debug("bookkeeping jwt token received")
 			jwtToken = token[0]
 		}
 		return nil
@@ -0,0 +1,178 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/core/ha"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const controlServicePrefix = "/o2control.Control/"
+
+// campaign keeps this core instance on standby until it is elected leader,
+// meanwhile refreshing the apricot inventory, the repositories and task
+// classes so that the takeover does not have to wait for them.
+func campaign(ctx context.Context, state *globalState) error {
+	campaignErrCh := make(chan error, 1)
+	go func() {
+		campaignErrCh <- state.elector.Campaign(ctx)
+	}()
+
+	warmCaches(state)
+	ticker := time.NewTicker(viper.GetDuration("haStandbyRefreshInterval"))
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-campaignErrCh:
+			if err != nil {
+				return err
+			}
+			// the previous leader might have registered the framework or
+			// cordoned hosts after this instance started
+			state.taskman.ReloadFrameworkID()
+			state.taskman.ReloadCordonedHosts()
+
+			go func() {
+				<-state.elector.Lost()
+				// another instance may already be taking over, so we must
+				// stop controlling the cluster right away and leave the
+				// cleanup of our tasks to the new leader, which kills them
+				// on reconciliation
+				log.WithField("level", infologger.IL_Ops).
+					Fatal("leadership lost, exiting so that another core instance can take over")
+			}()
+			return nil
+		case <-ticker.C:
+			warmCaches(state)
+		}
+	}
+}
+
+func warmCaches(state *globalState) {
+	// the first call also builds the apricot client and, if enabled, its
+	// cache of the detector of each host
+	if inventory, err := the.ConfSvc().GetDetectorsInventory(); err != nil {
+		log.WithError(err).
+			WithField("level", infologger.IL_Devel).
+			Warn("standby core could not load the detectors inventory")
+	} else {
+		hostsCount := 0
+		for _, hosts := range inventory {
+			hostsCount += len(hosts)
+		}
+		log.WithField("detectors", len(inventory)).
+			WithField("hosts", hostsCount).
+			WithField("level", infologger.IL_Devel).
+			Debug("standby core loaded the detectors inventory")
+	}
+	if err := the.RepoManager().RefreshRepos(); err != nil {
+		log.WithError(err).
+			WithField("level", infologger.IL_Devel).
+			Warn("standby core could not refresh repositories")
+	}
+	if err := state.taskman.PreloadClasses(); err != nil {
+		log.WithError(err).
+			WithField("level", infologger.IL_Devel).
+			Warn("standby core could not load task classes")
+	}
+}
+
+// watchLeadership reports this instance as not serving to health checks
+// for as long as it is on standby.
+func watchLeadership(elector *ha.Elector, healthsvr *health.Server) {
+	for _, service := range []string{"", "o2control.Control"} {
+		healthsvr.SetServingStatus(service, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	}
+	go func() {
+		<-elector.Elected()
+		for _, service := range []string{"", "o2control.Control"} {
+			healthsvr.SetServingStatus(service, grpc_health_v1.HealthCheckResponse_SERVING)
+		}
+		<-elector.Lost()
+		healthsvr.Shutdown()
+	}()
+}
+
+// leaderMetadata returns the gRPC metadata which tells clients where the
+// leader is, and an error if this instance is on standby and the call is
+// not one of those which are always served.
+func leaderMetadata(elector *ha.Elector, servedOnStandby bool) (metadata.MD, error) {
+	leader, err := elector.Leader()
+	if err != nil {
+		log.WithError(err).
+			WithField("level", infologger.IL_Devel).
+			Warn("cannot determine current leader")
+	}
+	md := metadata.Pairs(ha.LEADER_METADATA_KEY, leader)
+
+	if elector.IsLeader() || servedOnStandby {
+		return md, nil
+	}
+	if len(leader) == 0 {
+		return md, status.Error(codes.Unavailable, "this core instance is on standby and no leader is currently elected")
+	}
+	return md, status.Errorf(codes.Unavailable, "this core instance is on standby, the leader is at %s", leader)
+}
+
+// isServedOnStandby is true for the health and reflection services.
+func isServedOnStandby(fullMethod string) bool {
+	return !strings.HasPrefix(fullMethod, controlServicePrefix)
+}
+
+func leaderUnaryInterceptor(elector *ha.Elector) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, err := leaderMetadata(elector, isServedOnStandby(info.FullMethod))
+		_ = grpc.SetHeader(ctx, md)
+		if err != nil {
+			_ = grpc.SetTrailer(ctx, md)
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func leaderStreamInterceptor(elector *ha.Elector) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		md, err := leaderMetadata(elector, isServedOnStandby(info.FullMethod))
+		_ = ss.SetHeader(md)
+		if err != nil {
+			ss.SetTrailer(md)
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
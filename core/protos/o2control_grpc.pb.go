@@ -53,9 +53,23 @@ const (
 	Control_GetActiveDetectors_FullMethodName       = "/o2control.Control/GetActiveDetectors"
 	Control_GetAvailableDetectors_FullMethodName    = "/o2control.Control/GetAvailableDetectors"
 	Control_NewEnvironmentAsync_FullMethodName      = "/o2control.Control/NewEnvironmentAsync"
+	Control_SetEnvironmentProperties_FullMethodName = "/o2control.Control/SetEnvironmentProperties"
+	Control_GetEnvironmentProperties_FullMethodName = "/o2control.Control/GetEnvironmentProperties"
+	Control_GetEnvironmentTopology_FullMethodName   = "/o2control.Control/GetEnvironmentTopology"
 	Control_GetTasks_FullMethodName                 = "/o2control.Control/GetTasks"
 	Control_GetTask_FullMethodName                  = "/o2control.Control/GetTask"
 	Control_CleanupTasks_FullMethodName             = "/o2control.Control/CleanupTasks"
+	Control_RestartTask_FullMethodName              = "/o2control.Control/RestartTask"
+	Control_TransitionTask_FullMethodName           = "/o2control.Control/TransitionTask"
+	Control_SetTaskProperties_FullMethodName        = "/o2control.Control/SetTaskProperties"
+	Control_GetTaskLogs_FullMethodName              = "/o2control.Control/GetTaskLogs"
+	Control_CordonHost_FullMethodName               = "/o2control.Control/CordonHost"
+	Control_UncordonHost_FullMethodName             = "/o2control.Control/UncordonHost"
+	Control_DrainHost_FullMethodName                = "/o2control.Control/DrainHost"
+	Control_GetCordonedHosts_FullMethodName         = "/o2control.Control/GetCordonedHosts"
+	Control_RunSequence_FullMethodName              = "/o2control.Control/RunSequence"
+	Control_GetSequences_FullMethodName             = "/o2control.Control/GetSequences"
+	Control_ControlSequence_FullMethodName          = "/o2control.Control/ControlSequence"
 	Control_GetRoles_FullMethodName                 = "/o2control.Control/GetRoles"
 	Control_GetWorkflowTemplates_FullMethodName     = "/o2control.Control/GetWorkflowTemplates"
 	Control_ListRepos_FullMethodName                = "/o2control.Control/ListRepos"
@@ -92,9 +106,42 @@ type ControlClient interface {
 	// It returns once an environment ID is created and continues the creation asynchronously to the call.
 	// The environment will be listed in GetEnvironments() only once the workflow is loaded and deployment starts.
 	NewEnvironmentAsync(ctx context.Context, in *NewEnvironmentRequest, opts ...grpc.CallOption) (*NewEnvironmentReply, error)
+	// Sets user variables on a DEPLOYED or CONFIGURED environment. Values are validated against the VarSpec of
+	// the workflow template, and take effect at the next CONFIGURE or START transition.
+	SetEnvironmentProperties(ctx context.Context, in *SetEnvironmentPropertiesRequest, opts ...grpc.CallOption) (*SetEnvironmentPropertiesReply, error)
+	GetEnvironmentProperties(ctx context.Context, in *GetEnvironmentPropertiesRequest, opts ...grpc.CallOption) (*GetEnvironmentPropertiesReply, error)
+	// Returns the resolved FairMQ channel graph of a deployed environment, along with
+	// any unconnected channels and type or transport mismatches.
+	GetEnvironmentTopology(ctx context.Context, in *GetEnvironmentTopologyRequest, opts ...grpc.CallOption) (*GetEnvironmentTopologyReply, error)
 	GetTasks(ctx context.Context, in *GetTasksRequest, opts ...grpc.CallOption) (*GetTasksReply, error)
 	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskReply, error)
 	CleanupTasks(ctx context.Context, in *CleanupTasksRequest, opts ...grpc.CallOption) (*CleanupTasksReply, error)
+	// Kills a task of a DEPLOYED, CONFIGURED or RUNNING environment and deploys a replacement on the same host,
+	// which is then brought to the state of the environment.
+	RestartTask(ctx context.Context, in *RestartTaskRequest, opts ...grpc.CallOption) (*RestartTaskReply, error)
+	// Pushes a single state machine transition to a task of an environment, provided it brings the task closer
+	// to the state of the environment.
+	TransitionTask(ctx context.Context, in *TransitionTaskRequest, opts ...grpc.CallOption) (*TransitionTaskReply, error)
+	// Sets property values on a task of a DEPLOYED or CONFIGURED environment, which are pushed to the task
+	// with its next CONFIGURE or START transition.
+	SetTaskProperties(ctx context.Context, in *SetTaskPropertiesRequest, opts ...grpc.CallOption) (*SetTaskPropertiesReply, error)
+	// Retrieves the output of a task from the log files kept by its executor, also for tasks
+	// which are gone, for as long as the executor retains their logs.
+	GetTaskLogs(ctx context.Context, in *GetTaskLogsRequest, opts ...grpc.CallOption) (*GetTaskLogsReply, error)
+	// Cordoned hosts are excluded from scheduling: their offers are declined, while tasks
+	// already running there are left alone. Cordons are persisted across core restarts.
+	CordonHost(ctx context.Context, in *CordonHostRequest, opts ...grpc.CallOption) (*CordonHostReply, error)
+	UncordonHost(ctx context.Context, in *UncordonHostRequest, opts ...grpc.CallOption) (*UncordonHostReply, error)
+	// Cordons a host if needed and reports which environments still hold tasks there.
+	DrainHost(ctx context.Context, in *CordonHostRequest, opts ...grpc.CallOption) (*CordonHostReply, error)
+	GetCordonedHosts(ctx context.Context, in *GetCordonedHostsRequest, opts ...grpc.CallOption) (*GetCordonedHostsReply, error)
+	// Run sequences drive an environment through a scripted series of variable
+	// changes and transitions, e.g. for calibration scans.
+	// Only one sequence may run at a time on a given environment.
+	RunSequence(ctx context.Context, in *RunSequenceRequest, opts ...grpc.CallOption) (*RunSequenceReply, error)
+	GetSequences(ctx context.Context, in *GetSequencesRequest, opts ...grpc.CallOption) (*GetSequencesReply, error)
+	// Pausing and aborting take effect between steps and during waits.
+	ControlSequence(ctx context.Context, in *ControlSequenceRequest, opts ...grpc.CallOption) (*ControlSequenceReply, error)
 	GetRoles(ctx context.Context, in *GetRolesRequest, opts ...grpc.CallOption) (*GetRolesReply, error)
 	GetWorkflowTemplates(ctx context.Context, in *GetWorkflowTemplatesRequest, opts ...grpc.CallOption) (*GetWorkflowTemplatesReply, error)
 	ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposReply, error)
@@ -106,8 +153,10 @@ type ControlClient interface {
 	SetRepoDefaultRevision(ctx context.Context, in *SetRepoDefaultRevisionRequest, opts ...grpc.CallOption) (*SetRepoDefaultRevisionReply, error)
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Control_SubscribeClient, error)
 	GetIntegratedServices(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListIntegratedServicesReply, error)
-	// Reserved and not implemented:
+	// Stops all running environments, destroys all environments and kills any leftover tasks.
+	// It returns once the teardown is complete, with a summary of anything that could not be shut down cleanly.
 	Teardown(ctx context.Context, in *TeardownRequest, opts ...grpc.CallOption) (*TeardownReply, error)
+	// Reserved and not implemented:
 	ModifyEnvironment(ctx context.Context, in *ModifyEnvironmentRequest, opts ...grpc.CallOption) (*ModifyEnvironmentReply, error)
 }
 
@@ -209,6 +258,33 @@ func (c *controlClient) NewEnvironmentAsync(ctx context.Context, in *NewEnvironm
 	return out, nil
 }
 
+func (c *controlClient) SetEnvironmentProperties(ctx context.Context, in *SetEnvironmentPropertiesRequest, opts ...grpc.CallOption) (*SetEnvironmentPropertiesReply, error) {
+	out := new(SetEnvironmentPropertiesReply)
+	err := c.cc.Invoke(ctx, Control_SetEnvironmentProperties_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetEnvironmentProperties(ctx context.Context, in *GetEnvironmentPropertiesRequest, opts ...grpc.CallOption) (*GetEnvironmentPropertiesReply, error) {
+	out := new(GetEnvironmentPropertiesReply)
+	err := c.cc.Invoke(ctx, Control_GetEnvironmentProperties_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetEnvironmentTopology(ctx context.Context, in *GetEnvironmentTopologyRequest, opts ...grpc.CallOption) (*GetEnvironmentTopologyReply, error) {
+	out := new(GetEnvironmentTopologyReply)
+	err := c.cc.Invoke(ctx, Control_GetEnvironmentTopology_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) GetTasks(ctx context.Context, in *GetTasksRequest, opts ...grpc.CallOption) (*GetTasksReply, error) {
 	out := new(GetTasksReply)
 	err := c.cc.Invoke(ctx, Control_GetTasks_FullMethodName, in, out, opts...)
@@ -236,6 +312,105 @@ func (c *controlClient) CleanupTasks(ctx context.Context, in *CleanupTasksReques
 	return out, nil
 }
 
+func (c *controlClient) RestartTask(ctx context.Context, in *RestartTaskRequest, opts ...grpc.CallOption) (*RestartTaskReply, error) {
+	out := new(RestartTaskReply)
+	err := c.cc.Invoke(ctx, Control_RestartTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) TransitionTask(ctx context.Context, in *TransitionTaskRequest, opts ...grpc.CallOption) (*TransitionTaskReply, error) {
+	out := new(TransitionTaskReply)
+	err := c.cc.Invoke(ctx, Control_TransitionTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) SetTaskProperties(ctx context.Context, in *SetTaskPropertiesRequest, opts ...grpc.CallOption) (*SetTaskPropertiesReply, error) {
+	out := new(SetTaskPropertiesReply)
+	err := c.cc.Invoke(ctx, Control_SetTaskProperties_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetTaskLogs(ctx context.Context, in *GetTaskLogsRequest, opts ...grpc.CallOption) (*GetTaskLogsReply, error) {
+	out := new(GetTaskLogsReply)
+	err := c.cc.Invoke(ctx, Control_GetTaskLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CordonHost(ctx context.Context, in *CordonHostRequest, opts ...grpc.CallOption) (*CordonHostReply, error) {
+	out := new(CordonHostReply)
+	err := c.cc.Invoke(ctx, Control_CordonHost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) UncordonHost(ctx context.Context, in *UncordonHostRequest, opts ...grpc.CallOption) (*UncordonHostReply, error) {
+	out := new(UncordonHostReply)
+	err := c.cc.Invoke(ctx, Control_UncordonHost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DrainHost(ctx context.Context, in *CordonHostRequest, opts ...grpc.CallOption) (*CordonHostReply, error) {
+	out := new(CordonHostReply)
+	err := c.cc.Invoke(ctx, Control_DrainHost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetCordonedHosts(ctx context.Context, in *GetCordonedHostsRequest, opts ...grpc.CallOption) (*GetCordonedHostsReply, error) {
+	out := new(GetCordonedHostsReply)
+	err := c.cc.Invoke(ctx, Control_GetCordonedHosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) RunSequence(ctx context.Context, in *RunSequenceRequest, opts ...grpc.CallOption) (*RunSequenceReply, error) {
+	out := new(RunSequenceReply)
+	err := c.cc.Invoke(ctx, Control_RunSequence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetSequences(ctx context.Context, in *GetSequencesRequest, opts ...grpc.CallOption) (*GetSequencesReply, error) {
+	out := new(GetSequencesReply)
+	err := c.cc.Invoke(ctx, Control_GetSequences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ControlSequence(ctx context.Context, in *ControlSequenceRequest, opts ...grpc.CallOption) (*ControlSequenceReply, error) {
+	out := new(ControlSequenceReply)
+	err := c.cc.Invoke(ctx, Control_ControlSequence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) GetRoles(ctx context.Context, in *GetRolesRequest, opts ...grpc.CallOption) (*GetRolesReply, error) {
 	out := new(GetRolesReply)
 	err := c.cc.Invoke(ctx, Control_GetRoles_FullMethodName, in, out, opts...)
@@ -397,9 +572,42 @@ type ControlServer interface {
 	// It returns once an environment ID is created and continues the creation asynchronously to the call.
 	// The environment will be listed in GetEnvironments() only once the workflow is loaded and deployment starts.
 	NewEnvironmentAsync(context.Context, *NewEnvironmentRequest) (*NewEnvironmentReply, error)
+	// Sets user variables on a DEPLOYED or CONFIGURED environment. Values are validated against the VarSpec of
+	// the workflow template, and take effect at the next CONFIGURE or START transition.
+	SetEnvironmentProperties(context.Context, *SetEnvironmentPropertiesRequest) (*SetEnvironmentPropertiesReply, error)
+	GetEnvironmentProperties(context.Context, *GetEnvironmentPropertiesRequest) (*GetEnvironmentPropertiesReply, error)
+	// Returns the resolved FairMQ channel graph of a deployed environment, along with
+	// any unconnected channels and type or transport mismatches.
+	GetEnvironmentTopology(context.Context, *GetEnvironmentTopologyRequest) (*GetEnvironmentTopologyReply, error)
 	GetTasks(context.Context, *GetTasksRequest) (*GetTasksReply, error)
 	GetTask(context.Context, *GetTaskRequest) (*GetTaskReply, error)
 	CleanupTasks(context.Context, *CleanupTasksRequest) (*CleanupTasksReply, error)
+	// Kills a task of a DEPLOYED, CONFIGURED or RUNNING environment and deploys a replacement on the same host,
+	// which is then brought to the state of the environment.
+	RestartTask(context.Context, *RestartTaskRequest) (*RestartTaskReply, error)
+	// Pushes a single state machine transition to a task of an environment, provided it brings the task closer
+	// to the state of the environment.
+	TransitionTask(context.Context, *TransitionTaskRequest) (*TransitionTaskReply, error)
+	// Sets property values on a task of a DEPLOYED or CONFIGURED environment, which are pushed to the task
+	// with its next CONFIGURE or START transition.
+	SetTaskProperties(context.Context, *SetTaskPropertiesRequest) (*SetTaskPropertiesReply, error)
+	// Retrieves the output of a task from the log files kept by its executor, also for tasks
+	// which are gone, for as long as the executor retains their logs.
+	GetTaskLogs(context.Context, *GetTaskLogsRequest) (*GetTaskLogsReply, error)
+	// Cordoned hosts are excluded from scheduling: their offers are declined, while tasks
+	// already running there are left alone. Cordons are persisted across core restarts.
+	CordonHost(context.Context, *CordonHostRequest) (*CordonHostReply, error)
+	UncordonHost(context.Context, *UncordonHostRequest) (*UncordonHostReply, error)
+	// Cordons a host if needed and reports which environments still hold tasks there.
+	DrainHost(context.Context, *CordonHostRequest) (*CordonHostReply, error)
+	GetCordonedHosts(context.Context, *GetCordonedHostsRequest) (*GetCordonedHostsReply, error)
+	// Run sequences drive an environment through a scripted series of variable
+	// changes and transitions, e.g. for calibration scans.
+	// Only one sequence may run at a time on a given environment.
+	RunSequence(context.Context, *RunSequenceRequest) (*RunSequenceReply, error)
+	GetSequences(context.Context, *GetSequencesRequest) (*GetSequencesReply, error)
+	// Pausing and aborting take effect between steps and during waits.
+	ControlSequence(context.Context, *ControlSequenceRequest) (*ControlSequenceReply, error)
 	GetRoles(context.Context, *GetRolesRequest) (*GetRolesReply, error)
 	GetWorkflowTemplates(context.Context, *GetWorkflowTemplatesRequest) (*GetWorkflowTemplatesReply, error)
 	ListRepos(context.Context, *ListReposRequest) (*ListReposReply, error)
@@ -411,8 +619,10 @@ type ControlServer interface {
 	SetRepoDefaultRevision(context.Context, *SetRepoDefaultRevisionRequest) (*SetRepoDefaultRevisionReply, error)
 	Subscribe(*SubscribeRequest, Control_SubscribeServer) error
 	GetIntegratedServices(context.Context, *Empty) (*ListIntegratedServicesReply, error)
-	// Reserved and not implemented:
+	// Stops all running environments, destroys all environments and kills any leftover tasks.
+	// It returns once the teardown is complete, with a summary of anything that could not be shut down cleanly.
 	Teardown(context.Context, *TeardownRequest) (*TeardownReply, error)
+	// Reserved and not implemented:
 	ModifyEnvironment(context.Context, *ModifyEnvironmentRequest) (*ModifyEnvironmentReply, error)
 }
 
@@ -450,6 +660,15 @@ func (UnimplementedControlServer) GetAvailableDetectors(context.Context, *Empty)
 func (UnimplementedControlServer) NewEnvironmentAsync(context.Context, *NewEnvironmentRequest) (*NewEnvironmentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewEnvironmentAsync not implemented")
 }
+func (UnimplementedControlServer) SetEnvironmentProperties(context.Context, *SetEnvironmentPropertiesRequest) (*SetEnvironmentPropertiesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEnvironmentProperties not implemented")
+}
+func (UnimplementedControlServer) GetEnvironmentProperties(context.Context, *GetEnvironmentPropertiesRequest) (*GetEnvironmentPropertiesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnvironmentProperties not implemented")
+}
+func (UnimplementedControlServer) GetEnvironmentTopology(context.Context, *GetEnvironmentTopologyRequest) (*GetEnvironmentTopologyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnvironmentTopology not implemented")
+}
 func (UnimplementedControlServer) GetTasks(context.Context, *GetTasksRequest) (*GetTasksReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTasks not implemented")
 }
@@ -459,6 +678,39 @@ func (UnimplementedControlServer) GetTask(context.Context, *GetTaskRequest) (*Ge
 func (UnimplementedControlServer) CleanupTasks(context.Context, *CleanupTasksRequest) (*CleanupTasksReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CleanupTasks not implemented")
 }
+func (UnimplementedControlServer) RestartTask(context.Context, *RestartTaskRequest) (*RestartTaskReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartTask not implemented")
+}
+func (UnimplementedControlServer) TransitionTask(context.Context, *TransitionTaskRequest) (*TransitionTaskReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionTask not implemented")
+}
+func (UnimplementedControlServer) SetTaskProperties(context.Context, *SetTaskPropertiesRequest) (*SetTaskPropertiesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaskProperties not implemented")
+}
+func (UnimplementedControlServer) GetTaskLogs(context.Context, *GetTaskLogsRequest) (*GetTaskLogsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskLogs not implemented")
+}
+func (UnimplementedControlServer) CordonHost(context.Context, *CordonHostRequest) (*CordonHostReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonHost not implemented")
+}
+func (UnimplementedControlServer) UncordonHost(context.Context, *UncordonHostRequest) (*UncordonHostReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncordonHost not implemented")
+}
+func (UnimplementedControlServer) DrainHost(context.Context, *CordonHostRequest) (*CordonHostReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainHost not implemented")
+}
+func (UnimplementedControlServer) GetCordonedHosts(context.Context, *GetCordonedHostsRequest) (*GetCordonedHostsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCordonedHosts not implemented")
+}
+func (UnimplementedControlServer) RunSequence(context.Context, *RunSequenceRequest) (*RunSequenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunSequence not implemented")
+}
+func (UnimplementedControlServer) GetSequences(context.Context, *GetSequencesRequest) (*GetSequencesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSequences not implemented")
+}
+func (UnimplementedControlServer) ControlSequence(context.Context, *ControlSequenceRequest) (*ControlSequenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControlSequence not implemented")
+}
 func (UnimplementedControlServer) GetRoles(context.Context, *GetRolesRequest) (*GetRolesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoles not implemented")
 }
@@ -690,6 +942,60 @@ func _Control_NewEnvironmentAsync_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_SetEnvironmentProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEnvironmentPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).SetEnvironmentProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_SetEnvironmentProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetEnvironmentProperties(ctx, req.(*SetEnvironmentPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetEnvironmentProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvironmentPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetEnvironmentProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_GetEnvironmentProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetEnvironmentProperties(ctx, req.(*GetEnvironmentPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetEnvironmentTopology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvironmentTopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetEnvironmentTopology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_GetEnvironmentTopology_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetEnvironmentTopology(ctx, req.(*GetEnvironmentTopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_GetTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTasksRequest)
 	if err := dec(in); err != nil {
@@ -744,6 +1050,204 @@ func _Control_CleanupTasks_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_RestartTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).RestartTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_RestartTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).RestartTask(ctx, req.(*RestartTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_TransitionTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).TransitionTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_TransitionTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).TransitionTask(ctx, req.(*TransitionTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_SetTaskProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaskPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).SetTaskProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_SetTaskProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetTaskProperties(ctx, req.(*SetTaskPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetTaskLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetTaskLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_GetTaskLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetTaskLogs(ctx, req.(*GetTaskLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CordonHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CordonHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CordonHost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CordonHost(ctx, req.(*CordonHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_UncordonHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncordonHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).UncordonHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_UncordonHost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).UncordonHost(ctx, req.(*UncordonHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DrainHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DrainHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DrainHost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DrainHost(ctx, req.(*CordonHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetCordonedHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCordonedHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetCordonedHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_GetCordonedHosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetCordonedHosts(ctx, req.(*GetCordonedHostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_RunSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).RunSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_RunSequence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).RunSequence(ctx, req.(*RunSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetSequences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSequencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetSequences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_GetSequences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetSequences(ctx, req.(*GetSequencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ControlSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ControlSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ControlSequence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ControlSequence(ctx, req.(*ControlSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_GetRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRolesRequest)
 	if err := dec(in); err != nil {
@@ -1028,6 +1532,18 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NewEnvironmentAsync",
 			Handler:    _Control_NewEnvironmentAsync_Handler,
 		},
+		{
+			MethodName: "SetEnvironmentProperties",
+			Handler:    _Control_SetEnvironmentProperties_Handler,
+		},
+		{
+			MethodName: "GetEnvironmentProperties",
+			Handler:    _Control_GetEnvironmentProperties_Handler,
+		},
+		{
+			MethodName: "GetEnvironmentTopology",
+			Handler:    _Control_GetEnvironmentTopology_Handler,
+		},
 		{
 			MethodName: "GetTasks",
 			Handler:    _Control_GetTasks_Handler,
@@ -1040,6 +1556,50 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CleanupTasks",
 			Handler:    _Control_CleanupTasks_Handler,
 		},
+		{
+			MethodName: "RestartTask",
+			Handler:    _Control_RestartTask_Handler,
+		},
+		{
+			MethodName: "TransitionTask",
+			Handler:    _Control_TransitionTask_Handler,
+		},
+		{
+			MethodName: "SetTaskProperties",
+			Handler:    _Control_SetTaskProperties_Handler,
+		},
+		{
+			MethodName: "GetTaskLogs",
+			Handler:    _Control_GetTaskLogs_Handler,
+		},
+		{
+			MethodName: "CordonHost",
+			Handler:    _Control_CordonHost_Handler,
+		},
+		{
+			MethodName: "UncordonHost",
+			Handler:    _Control_UncordonHost_Handler,
+		},
+		{
+			MethodName: "DrainHost",
+			Handler:    _Control_DrainHost_Handler,
+		},
+		{
+			MethodName: "GetCordonedHosts",
+			Handler:    _Control_GetCordonedHosts_Handler,
+		},
+		{
+			MethodName: "RunSequence",
+			Handler:    _Control_RunSequence_Handler,
+		},
+		{
+			MethodName: "GetSequences",
+			Handler:    _Control_GetSequences_Handler,
+		},
+		{
+			MethodName: "ControlSequence",
+			Handler:    _Control_ControlSequence_Handler,
+		},
 		{
 			MethodName: "GetRoles",
 			Handler:    _Control_GetRoles_Handler,
@@ -26,8 +26,13 @@
 package repos
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/utils"
 
 	"github.com/AliceO2Group/Control/core/repos/varsource"
 	"gopkg.in/yaml.v3"
@@ -81,6 +86,59 @@ type VarSpec struct {
 	EnabledIf     string           `yaml:"enabledif"`
 }
 
+// Validate checks whether value is acceptable for a variable declared with this
+// VarSpec, i.e. whether it parses as the declared type and, if the VarSpec
+// restricts the allowed values, whether it is one of them.
+// For sliders, the allowed values are the bounds of the range, and for lists,
+// each element must be one of the allowed values.
+func (vs VarSpec) Validate(value string) error {
+	switch strings.ToLower(vs.VarType) {
+	case "number":
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return fmt.Errorf("value %s is not a number", value)
+		}
+		if strings.ToLower(vs.Widget) == "slider" && len(vs.AllowedValues) == 2 {
+			lower, errLower := strconv.ParseFloat(vs.AllowedValues[0], 64)
+			upper, errUpper := strconv.ParseFloat(vs.AllowedValues[1], 64)
+			if errLower == nil && errUpper == nil && (number < lower || number > upper) {
+				return fmt.Errorf("value %s is out of range [%s, %s]", value, vs.AllowedValues[0], vs.AllowedValues[1])
+			}
+			return nil
+		}
+	case "bool":
+		if _, err := strconv.ParseBool(strings.TrimSpace(value)); err != nil {
+			return fmt.Errorf("value %s is not a bool", value)
+		}
+	case "list":
+		var list []interface{}
+		if err := json.Unmarshal([]byte(value), &list); err != nil {
+			return fmt.Errorf("value %s is not a JSON list", value)
+		}
+		if len(vs.AllowedValues) == 0 {
+			return nil
+		}
+		for _, item := range list {
+			itemS := fmt.Sprintf("%v", item)
+			if !utils.StringSliceContains(vs.AllowedValues, itemS) {
+				return fmt.Errorf("list item %s is not one of the allowed values %s", itemS, strings.Join(vs.AllowedValues, ", "))
+			}
+		}
+		return nil
+	case "map":
+		var m map[string]interface{}
+		if err := json.Unmarshal([]byte(value), &m); err != nil {
+			return fmt.Errorf("value %s is not a JSON map", value)
+		}
+		return nil
+	}
+
+	if len(vs.AllowedValues) > 0 && !utils.StringSliceContains(vs.AllowedValues, value) {
+		return fmt.Errorf("value %s is not one of the allowed values %s", value, strings.Join(vs.AllowedValues, ", "))
+	}
+	return nil
+}
+
 // AuxNode Use an auxiliary node struct that also carries its parent Name
 type AuxNode struct {
 	parentName string
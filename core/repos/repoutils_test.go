@@ -0,0 +1,57 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package repos
+
+import "testing"
+
+func TestVarSpecValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		spec    VarSpec
+		value   string
+		wantErr bool
+	}{
+		{"string accepts anything", VarSpec{VarType: "string"}, "whatever", false},
+		{"string with allowed values", VarSpec{VarType: "string", AllowedValues: []string{"PHYSICS", "TECHNICAL"}}, "COSMICS", true},
+		{"number", VarSpec{VarType: "number"}, "128", false},
+		{"not a number", VarSpec{VarType: "number"}, "lots", true},
+		{"slider within range", VarSpec{VarType: "number", Widget: "slider", AllowedValues: []string{"1", "256"}}, "128", false},
+		{"slider out of range", VarSpec{VarType: "number", Widget: "slider", AllowedValues: []string{"1", "256"}}, "512", true},
+		{"bool", VarSpec{VarType: "bool"}, "true", false},
+		{"not a bool", VarSpec{VarType: "bool"}, "yes please", true},
+		{"list", VarSpec{VarType: "list"}, `["TPC","ITS"]`, false},
+		{"list with allowed values", VarSpec{VarType: "list", AllowedValues: []string{"TPC", "ITS"}}, `["TPC","MID"]`, true},
+		{"not a list", VarSpec{VarType: "list"}, "TPC,ITS", true},
+		{"map", VarSpec{VarType: "map"}, `{"a":"b"}`, false},
+		{"not a map", VarSpec{VarType: "map"}, `["a"]`, true},
+	}
+
+	for _, c := range cases {
+		err := c.spec.Validate(c.value)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: Validate(%q) returned %v, expected error: %t", c.name, c.value, err, c.wantErr)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package sequencer runs scripted series of variable changes and transitions,
+// such as calibration scans, against existing environments.
+package sequencer
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"gopkg.in/yaml.v3"
+)
+
+const MAX_SEQUENCE_STEPS = 10000
+
+// Sequence is the YAML description of a run sequence: a name, optional abort
+// conditions and a list of steps.
+type Sequence struct {
+	Name  string `yaml:"name"`
+	Abort Abort  `yaml:"abort,omitempty"`
+	Steps []Step `yaml:"steps"`
+}
+
+// Abort lists the conditions which end a sequence early, besides the failure
+// of one of its steps.
+type Abort struct {
+	// States of the environment which abort the sequence when reached, ERROR
+	// is always one of them
+	States []string `yaml:"states,omitempty"`
+	// Timeout is the maximum duration of the whole sequence
+	Timeout string `yaml:"timeout,omitempty"`
+}
+
+// Step is exactly one of: a set of variables, an environment transition, a
+// wait or a loop over further steps.
+type Step struct {
+	Set        map[string]string `yaml:"set,omitempty"`
+	Transition string            `yaml:"transition,omitempty"`
+	Wait       *Wait             `yaml:"wait,omitempty"`
+	Loop       *Loop             `yaml:"loop,omitempty"`
+}
+
+// Wait pauses the sequence either for a fixed duration, or until the
+// environment reaches a state, for instance after an automatic stop.
+type Wait struct {
+	Duration string `yaml:"duration,omitempty"`
+	State    string `yaml:"state,omitempty"`
+	Timeout  string `yaml:"timeout,omitempty"`
+}
+
+// Loop runs its steps once for each value of a variable, which is set on the
+// environment before each iteration. The values are either listed, or given
+// as an integer range with an inclusive end.
+type Loop struct {
+	Var    string   `yaml:"var"`
+	Values []string `yaml:"values,omitempty"`
+	Begin  *int     `yaml:"begin,omitempty"`
+	End    *int     `yaml:"end,omitempty"`
+	Step   int      `yaml:"step,omitempty"`
+	Steps  []Step   `yaml:"steps"`
+}
+
+// instruction is a single step of a sequence once loops are expanded.
+type instruction struct {
+	description  string
+	set          map[string]string
+	transition   pb.ControlEnvironmentRequest_Optype
+	waitDuration time.Duration
+	waitState    string
+	waitTimeout  time.Duration
+}
+
+// program is a compiled sequence, ready to be executed.
+type program struct {
+	name         string
+	abortStates  map[string]struct{}
+	timeout      time.Duration
+	instructions []instruction
+}
+
+// ParseSequence reads a YAML run sequence and checks that it can be
+// executed.
+func ParseSequence(data []byte) (seq *Sequence, err error) {
+	seq = &Sequence{}
+	err = yaml.Unmarshal(data, seq)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse sequence: %w", err)
+	}
+	_, err = seq.compile()
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func (s *Sequence) compile() (p *program, err error) {
+	if s == nil {
+		return nil, errors.New("empty sequence")
+	}
+	if len(strings.TrimSpace(s.Name)) == 0 {
+		return nil, errors.New("sequence must have a name")
+	}
+	if len(s.Steps) == 0 {
+		return nil, errors.New("sequence must have at least one step")
+	}
+
+	p = &program{
+		name:        s.Name,
+		abortStates: map[string]struct{}{"ERROR": {}},
+	}
+	for _, state := range s.Abort.States {
+		p.abortStates[strings.ToUpper(strings.TrimSpace(state))] = struct{}{}
+	}
+	if len(s.Abort.Timeout) > 0 {
+		p.timeout, err = time.ParseDuration(s.Abort.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid abort timeout: %w", err)
+		}
+	}
+
+	p.instructions, err = compileSteps(s.Steps, "")
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func compileSteps(steps []Step, prefix string) (instructions []instruction, err error) {
+	instructions = make([]instruction, 0, len(steps))
+	for i, step := range steps {
+		var compiled []instruction
+		compiled, err = step.compile(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("%sstep %d: %w", prefix, i+1, err)
+		}
+		instructions = append(instructions, compiled...)
+		if len(instructions) > MAX_SEQUENCE_STEPS {
+			return nil, fmt.Errorf("sequence too long, at most %d steps are allowed after loop expansion", MAX_SEQUENCE_STEPS)
+		}
+	}
+	return
+}
+
+func (step Step) compile(prefix string) (instructions []instruction, err error) {
+	kinds := 0
+	if step.Set != nil {
+		kinds++
+	}
+	if len(step.Transition) > 0 {
+		kinds++
+	}
+	if step.Wait != nil {
+		kinds++
+	}
+	if step.Loop != nil {
+		kinds++
+	}
+	if kinds != 1 {
+		return nil, errors.New("a step must have exactly one of set, transition, wait or loop")
+	}
+
+	switch {
+	case step.Set != nil:
+		if len(step.Set) == 0 {
+			return nil, errors.New("nothing to set")
+		}
+		return []instruction{{description: prefix + "set " + formatVars(step.Set), set: step.Set}}, nil
+
+	case len(step.Transition) > 0:
+		optype, ok := parseTransition(step.Transition)
+		if !ok {
+			return nil, fmt.Errorf("invalid transition %s, allowed: CONFIGURE, START_ACTIVITY, STOP_ACTIVITY, RESET", step.Transition)
+		}
+		return []instruction{{description: prefix + optype.String(), transition: optype}}, nil
+
+	case step.Wait != nil:
+		instr := instruction{}
+		switch {
+		case len(step.Wait.Duration) > 0 && len(step.Wait.State) == 0:
+			instr.waitDuration, err = time.ParseDuration(step.Wait.Duration)
+			if err != nil {
+				return nil, fmt.Errorf("invalid wait duration: %w", err)
+			}
+			instr.description = prefix + "wait " + instr.waitDuration.String()
+		case len(step.Wait.State) > 0 && len(step.Wait.Duration) == 0:
+			instr.waitState = strings.ToUpper(strings.TrimSpace(step.Wait.State))
+			if len(step.Wait.Timeout) > 0 {
+				instr.waitTimeout, err = time.ParseDuration(step.Wait.Timeout)
+				if err != nil {
+					return nil, fmt.Errorf("invalid wait timeout: %w", err)
+				}
+			}
+			instr.description = prefix + "wait for state " + instr.waitState
+		default:
+			return nil, errors.New("a wait must have exactly one of duration or state")
+		}
+		return []instruction{instr}, nil
+
+	default:
+		return step.Loop.compile(prefix)
+	}
+}
+
+func (l *Loop) compile(prefix string) (instructions []instruction, err error) {
+	if len(strings.TrimSpace(l.Var)) == 0 {
+		return nil, errors.New("a loop must have a var")
+	}
+	if len(l.Steps) == 0 {
+		return nil, errors.New("a loop must have at least one step")
+	}
+
+	values := l.Values
+	switch {
+	case len(l.Values) > 0 && l.Begin == nil && l.End == nil:
+	case len(l.Values) == 0 && l.Begin != nil && l.End != nil:
+		step := l.Step
+		if step == 0 {
+			step = 1
+		}
+		if (step > 0 && *l.Begin > *l.End) || (step < 0 && *l.Begin < *l.End) {
+			return nil, fmt.Errorf("loop range %d..%d never ends with step %d", *l.Begin, *l.End, step)
+		}
+		values = make([]string, 0)
+		for v := *l.Begin; (step > 0 && v <= *l.End) || (step < 0 && v >= *l.End); v += step {
+			values = append(values, strconv.Itoa(v))
+			if len(values) > MAX_SEQUENCE_STEPS {
+				return nil, fmt.Errorf("loop range too long, at most %d iterations are allowed", MAX_SEQUENCE_STEPS)
+			}
+		}
+	default:
+		return nil, errors.New("a loop must have either values, or begin and end")
+	}
+
+	instructions = make([]instruction, 0)
+	for i, value := range values {
+		iterationPrefix := fmt.Sprintf("%s[%s=%s %d/%d] ", prefix, l.Var, value, i+1, len(values))
+		set := map[string]string{l.Var: value}
+		instructions = append(instructions, instruction{description: iterationPrefix + "set " + formatVars(set), set: set})
+
+		var body []instruction
+		body, err = compileSteps(l.Steps, iterationPrefix)
+		if err != nil {
+			return nil, err
+		}
+		instructions = append(instructions, body...)
+		if len(instructions) > MAX_SEQUENCE_STEPS {
+			return nil, fmt.Errorf("sequence too long, at most %d steps are allowed after loop expansion", MAX_SEQUENCE_STEPS)
+		}
+	}
+	return
+}
+
+// parseTransition accepts the names of ControlEnvironment transitions which
+// make sense within a sequence, plus START and STOP as shorthands.
+func parseTransition(name string) (optype pb.ControlEnvironmentRequest_Optype, ok bool) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	switch name {
+	case "START":
+		name = "START_ACTIVITY"
+	case "STOP":
+		name = "STOP_ACTIVITY"
+	}
+	value, ok := pb.ControlEnvironmentRequest_Optype_value[name]
+	if !ok {
+		return pb.ControlEnvironmentRequest_NOOP, false
+	}
+	optype = pb.ControlEnvironmentRequest_Optype(value)
+	switch optype {
+	case pb.ControlEnvironmentRequest_CONFIGURE,
+		pb.ControlEnvironmentRequest_START_ACTIVITY,
+		pb.ControlEnvironmentRequest_STOP_ACTIVITY,
+		pb.ControlEnvironmentRequest_RESET:
+		return optype, true
+	}
+	return pb.ControlEnvironmentRequest_NOOP, false
+}
+
+func formatVars(vars map[string]string) string {
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + "=" + vars[k]
+	}
+	return strings.Join(pairs, ", ")
+}
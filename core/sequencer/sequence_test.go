@@ -0,0 +1,100 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package sequencer
+
+import (
+	"time"
+
+	pb "github.com/AliceO2Group/Control/core/protos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("run sequence", func() {
+	When("a sequence loops over a parameter", func() {
+		var prog *program
+		BeforeEach(func() {
+			seq, err := ParseSequence([]byte(`
+name: threshold-scan
+abort:
+  states: [STANDBY]
+  timeout: 2h
+steps:
+  - set:
+      pulser_enabled: "true"
+  - loop:
+      var: threshold
+      begin: 10
+      end: 30
+      step: 10
+      steps:
+        - transition: CONFIGURE
+        - transition: start
+        - wait:
+            duration: 5m
+        - transition: stop
+        - transition: RESET
+`))
+			Expect(err).NotTo(HaveOccurred())
+			prog, err = seq.compile()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should expand the loop", func() {
+			Expect(prog.instructions).To(HaveLen(1 + 3*6))
+			Expect(prog.instructions[1].set).To(Equal(map[string]string{"threshold": "10"}))
+			Expect(prog.instructions[7].set).To(Equal(map[string]string{"threshold": "20"}))
+			Expect(prog.instructions[13].set).To(Equal(map[string]string{"threshold": "30"}))
+			Expect(prog.instructions[14].description).To(Equal("[threshold=30 3/3] CONFIGURE"))
+		})
+		It("should resolve transitions and waits", func() {
+			Expect(prog.instructions[3].transition).To(Equal(pb.ControlEnvironmentRequest_START_ACTIVITY))
+			Expect(prog.instructions[4].waitDuration).To(Equal(5 * time.Minute))
+			Expect(prog.instructions[5].transition).To(Equal(pb.ControlEnvironmentRequest_STOP_ACTIVITY))
+		})
+		It("should always abort on ERROR", func() {
+			Expect(prog.abortStates).To(HaveKey("ERROR"))
+			Expect(prog.abortStates).To(HaveKey("STANDBY"))
+			Expect(prog.timeout).To(Equal(2 * time.Hour))
+		})
+	})
+
+	When("a sequence is invalid", func() {
+		It("should be rejected", func() {
+			for _, yamlSeq := range []string{
+				"name: empty\nsteps: []",
+				"steps:\n  - transition: CONFIGURE",
+				"name: deploy\nsteps:\n  - transition: DEPLOY",
+				"name: ambiguous\nsteps:\n  - transition: CONFIGURE\n    wait:\n      duration: 1s",
+				"name: wait\nsteps:\n  - wait:\n      duration: 1s\n      state: RUNNING",
+				"name: loop\nsteps:\n  - loop:\n      var: x\n      begin: 3\n      end: 1\n      steps:\n        - transition: CONFIGURE",
+				"name: huge\nsteps:\n  - loop:\n      var: x\n      begin: 1\n      end: 100000\n      steps:\n        - transition: CONFIGURE",
+			} {
+				_, err := ParseSequence([]byte(yamlSeq))
+				Expect(err).To(HaveOccurred(), yamlSeq)
+			}
+		})
+	})
+})
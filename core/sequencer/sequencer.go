@@ -0,0 +1,548 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package sequencer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event/topic"
+	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/environment"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logger.New(logrus.StandardLogger(), "sequencer")
+
+// Sequence progress is kept in the runtime KV store under this component, so
+// that it can still be queried once the sequence is over.
+const SEQUENCES_RUNTIME_COMPONENT = "aliecs_sequences"
+
+const WAIT_STATE_POLL_INTERVAL = 500 * time.Millisecond
+
+// Finished sequences are forgotten by the core after this long, but their
+// final status remains available from the runtime KV store.
+const SEQUENCE_RETENTION = 24 * time.Hour
+
+type State string
+
+const (
+	RUNNING State = "RUNNING"
+	PAUSED  State = "PAUSED"
+	DONE    State = "DONE"
+	FAILED  State = "FAILED"
+	ABORTED State = "ABORTED"
+)
+
+func (s State) IsFinal() bool {
+	return s == DONE || s == FAILED || s == ABORTED
+}
+
+// Status is the progress report of a sequence, as persisted and returned to
+// clients.
+type Status struct {
+	Id              string `json:"id"`
+	Name            string `json:"name"`
+	EnvironmentId   string `json:"environmentId"`
+	State           State  `json:"state"`
+	CurrentStep     int    `json:"currentStep"`
+	StepCount       int    `json:"stepCount"`
+	StepDescription string `json:"stepDescription"`
+	Error           string `json:"error,omitempty"`
+	CreatedWhen     int64  `json:"createdWhen"`
+	UpdatedWhen     int64  `json:"updatedWhen"`
+}
+
+// Manager keeps track of the sequences submitted to the core.
+type Manager struct {
+	mu         sync.RWMutex
+	executions map[string]*execution
+
+	envs    *environment.Manager
+	taskman *task.Manager
+}
+
+func NewManager(envs *environment.Manager, taskman *task.Manager) *Manager {
+	return &Manager{
+		executions: make(map[string]*execution),
+		envs:       envs,
+		taskman:    taskman,
+	}
+}
+
+// Run starts executing a sequence against an environment, and returns as soon
+// as it has started.
+func (m *Manager) Run(envId uid.ID, seq *Sequence, requestUser *evpb.User) (status Status, err error) {
+	prog, err := seq.compile()
+	if err != nil {
+		return
+	}
+	env, err := m.envs.Environment(envId)
+	if err != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, x := range m.executions {
+		xStatus := x.getStatus()
+		if !xStatus.State.IsFinal() {
+			if x.envId == envId {
+				return status, fmt.Errorf("sequence %s is already running on environment %s", x.id, envId.String())
+			}
+			continue
+		}
+		if time.Since(time.UnixMilli(xStatus.UpdatedWhen)) > SEQUENCE_RETENTION {
+			delete(m.executions, id)
+		}
+	}
+
+	x := newExecution(prog, environmentDriver{Environment: env, taskman: m.taskman}, requestUser)
+	m.executions[x.id] = x
+	go x.run()
+
+	return x.getStatus(), nil
+}
+
+// Get returns the status of a sequence, looking it up in the runtime KV store
+// if the core does not know it.
+func (m *Manager) Get(id string) (status Status, err error) {
+	m.mu.RLock()
+	x, ok := m.executions[id]
+	m.mu.RUnlock()
+	if ok {
+		return x.getStatus(), nil
+	}
+
+	payload, err := the.ConfSvc().GetRuntimeEntry(SEQUENCES_RUNTIME_COMPONENT, id)
+	if err != nil || len(payload) == 0 {
+		return status, fmt.Errorf("sequence %s not found", id)
+	}
+	err = json.Unmarshal([]byte(payload), &status)
+	return
+}
+
+// List returns the status of the sequences known to the core, optionally
+// only those of one environment, most recent first.
+func (m *Manager) List(envId uid.ID) (statuses []Status) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	statuses = make([]Status, 0, len(m.executions))
+	for _, x := range m.executions {
+		if !envId.IsNil() && x.envId != envId {
+			continue
+		}
+		statuses = append(statuses, x.getStatus())
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].CreatedWhen > statuses[j].CreatedWhen
+	})
+	return
+}
+
+func (m *Manager) Pause(id string) (Status, error) {
+	return m.control(id, (*execution).pause)
+}
+
+func (m *Manager) Resume(id string) (Status, error) {
+	return m.control(id, (*execution).resume)
+}
+
+func (m *Manager) Abort(id string) (Status, error) {
+	return m.control(id, (*execution).abort)
+}
+
+func (m *Manager) control(id string, op func(*execution) error) (status Status, err error) {
+	m.mu.RLock()
+	x, ok := m.executions[id]
+	m.mu.RUnlock()
+	if !ok {
+		return status, fmt.Errorf("sequence %s not found", id)
+	}
+	err = op(x)
+	return x.getStatus(), err
+}
+
+// sequencedEnvironment is what an execution needs from the environment it
+// drives.
+type sequencedEnvironment interface {
+	Id() uid.ID
+	CurrentState() string
+	GetCurrentRunNumber() uint32
+	SetProperties(properties map[string]string) error
+	SetLastRequestUser(lastRequestUser *evpb.User)
+	RunTransition(optype pb.ControlEnvironmentRequest_Optype) error
+}
+
+// environmentDriver is the sequencedEnvironment of an actual environment.
+type environmentDriver struct {
+	*environment.Environment
+	taskman *task.Manager
+}
+
+// RunTransition behaves like a ControlEnvironment request: if the transition
+// fails, the environment is moved to ERROR, unless it was torn down meanwhile.
+func (d environmentDriver) RunTransition(optype pb.ControlEnvironmentRequest_Optype) (err error) {
+	trans := environment.MakeTransition(d.taskman, optype)
+	if trans == nil {
+		return fmt.Errorf("cannot prepare invalid transition %s", optype.String())
+	}
+
+	err = d.TryTransition(trans)
+	if err == nil || d.CurrentState() == "DONE" {
+		return
+	}
+	log.WithField("partition", d.Id().String()).
+		WithField("level", infologger.IL_Ops).
+		WithError(err).
+		Errorf("transition '%s' failed, transitioning into ERROR.", optype.String())
+	errGoError := d.TryTransition(environment.NewGoErrorTransition(d.taskman))
+	if errGoError != nil && d.CurrentState() != "DONE" {
+		log.WithField("partition", d.Id().String()).
+			Warnf("could not complete requested GO_ERROR transition, forcing move to ERROR: %s", errGoError.Error())
+		d.Sm.SetState("ERROR")
+	}
+	return
+}
+
+type execution struct {
+	mu     sync.Mutex
+	status Status
+
+	id          string
+	envId       uid.ID
+	prog        *program
+	env         sequencedEnvironment
+	requestUser *evpb.User
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	resumeCh chan struct{}
+	pauseCh  chan struct{}
+}
+
+func newExecution(prog *program, env sequencedEnvironment, requestUser *evpb.User) *execution {
+	id := uid.New().String()
+	now := time.Now().UnixMilli()
+	x := &execution{
+		status: Status{
+			Id:            id,
+			Name:          prog.name,
+			EnvironmentId: env.Id().String(),
+			State:         RUNNING,
+			StepCount:     len(prog.instructions),
+			CreatedWhen:   now,
+			UpdatedWhen:   now,
+		},
+		id:          id,
+		envId:       env.Id(),
+		prog:        prog,
+		env:         env,
+		requestUser: requestUser,
+		pauseCh:     make(chan struct{}),
+	}
+	if prog.timeout > 0 {
+		x.ctx, x.cancel = context.WithTimeout(context.Background(), prog.timeout)
+	} else {
+		x.ctx, x.cancel = context.WithCancel(context.Background())
+	}
+	return x
+}
+
+func (x *execution) getStatus() Status {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.status
+}
+
+func (x *execution) pause() error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.status.State != RUNNING {
+		return fmt.Errorf("cannot pause sequence in state %s", x.status.State)
+	}
+	x.status.State = PAUSED
+	x.resumeCh = make(chan struct{})
+	close(x.pauseCh)
+	x.touch()
+	return nil
+}
+
+func (x *execution) resume() error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.status.State != PAUSED {
+		return fmt.Errorf("cannot resume sequence in state %s", x.status.State)
+	}
+	x.status.State = RUNNING
+	close(x.resumeCh)
+	x.resumeCh = nil
+	x.pauseCh = make(chan struct{})
+	x.touch()
+	return nil
+}
+
+func (x *execution) abort() error {
+	if x.getStatus().State.IsFinal() {
+		return fmt.Errorf("sequence %s is already over", x.id)
+	}
+	x.cancel()
+	return nil
+}
+
+// touch updates the timestamp and persists the status, with x.mu held.
+func (x *execution) touch() {
+	x.status.UpdatedWhen = time.Now().UnixMilli()
+	payload, err := json.Marshal(x.status)
+	if err != nil {
+		return
+	}
+	err = the.ConfSvc().SetRuntimeEntry(SEQUENCES_RUNTIME_COMPONENT, x.id, string(payload))
+	if err != nil {
+		log.WithField("partition", x.envId.String()).
+			WithError(err).
+			Debugf("cannot persist progress of sequence %s", x.id)
+	}
+}
+
+func (x *execution) run() {
+	defer x.cancel()
+
+	log.WithField("partition", x.envId.String()).
+		WithField("level", infologger.IL_Ops).
+		Infof("starting sequence %s (%s), %d steps", x.prog.name, x.id, len(x.prog.instructions))
+	x.mu.Lock()
+	x.touch()
+	x.mu.Unlock()
+	x.sendEvent(evpb.OpStatus_STARTED, "", nil)
+
+	for i, instr := range x.prog.instructions {
+		err := x.waitWhilePaused()
+		if err == nil {
+			err = x.checkAbortStates()
+		}
+		if err == nil {
+			x.mu.Lock()
+			x.status.CurrentStep = i
+			x.status.StepDescription = instr.description
+			x.touch()
+			x.mu.Unlock()
+			x.sendEvent(evpb.OpStatus_ONGOING, instr.description, nil)
+
+			err = x.execute(instr)
+		}
+		if err != nil {
+			x.finish(err)
+			return
+		}
+	}
+
+	x.mu.Lock()
+	x.status.CurrentStep = len(x.prog.instructions)
+	x.mu.Unlock()
+	x.finish(nil)
+}
+
+func (x *execution) finish(err error) {
+	x.mu.Lock()
+	switch {
+	case err == nil:
+		x.status.State = DONE
+	case errors.Is(err, context.Canceled):
+		x.status.State = ABORTED
+		x.status.Error = "aborted by request"
+	case errors.Is(err, context.DeadlineExceeded):
+		x.status.State = ABORTED
+		x.status.Error = "sequence timeout expired"
+	case errors.Is(err, errEnvironmentDone):
+		x.status.State = ABORTED
+		x.status.Error = err.Error()
+	default:
+		x.status.State = FAILED
+		x.status.Error = err.Error()
+	}
+	if x.resumeCh != nil {
+		close(x.resumeCh)
+		x.resumeCh = nil
+	}
+	x.touch()
+	status := x.status
+	x.mu.Unlock()
+
+	logWithId := log.WithField("partition", x.envId.String()).
+		WithField("level", infologger.IL_Ops)
+	if status.State == DONE {
+		logWithId.Infof("sequence %s (%s) done", status.Name, status.Id)
+		x.sendEvent(evpb.OpStatus_DONE_OK, "", nil)
+	} else {
+		logWithId.Errorf("sequence %s (%s) %s at step %d/%d (%s): %s", status.Name, status.Id, status.State, status.CurrentStep+1, status.StepCount, status.StepDescription, status.Error)
+		x.sendEvent(evpb.OpStatus_DONE_ERROR, status.StepDescription, errors.New(status.Error))
+	}
+}
+
+func (x *execution) waitWhilePaused() error {
+	x.mu.Lock()
+	resumeCh := x.resumeCh
+	x.mu.Unlock()
+	if resumeCh == nil {
+		return x.ctx.Err()
+	}
+	select {
+	case <-resumeCh:
+		return x.ctx.Err()
+	case <-x.ctx.Done():
+		return x.ctx.Err()
+	}
+}
+
+// pausedCh returns a channel which is closed once the sequence is paused.
+func (x *execution) pausedCh() <-chan struct{} {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.pauseCh
+}
+
+// errEnvironmentDone aborts a sequence whose environment was torn down,
+// regardless of its abort states.
+var errEnvironmentDone = errors.New("environment was torn down")
+
+func (x *execution) checkAbortStates() error {
+	state := x.env.CurrentState()
+	if state == "DONE" {
+		return errEnvironmentDone
+	}
+	if _, abort := x.prog.abortStates[state]; abort {
+		return fmt.Errorf("abort condition met, environment in state %s", state)
+	}
+	return nil
+}
+
+func (x *execution) execute(instr instruction) (err error) {
+	switch {
+	case instr.set != nil:
+		return x.env.SetProperties(instr.set)
+
+	case instr.waitDuration > 0:
+		_, err = x.wait(instr.waitDuration, nil)
+		return
+
+	case len(instr.waitState) > 0:
+		var expired bool
+		expired, err = x.wait(instr.waitTimeout, func() bool {
+			return x.env.CurrentState() == instr.waitState
+		})
+		if expired {
+			return fmt.Errorf("environment did not reach state %s within %s", instr.waitState, instr.waitTimeout.String())
+		}
+		return
+
+	default:
+		return x.transition(instr)
+	}
+}
+
+// wait blocks until reached returns true, or until timeout expires if it is
+// positive, checking the abort states every WAIT_STATE_POLL_INTERVAL. While
+// the sequence is paused the timeout is suspended and reached is not checked,
+// and an abort ends the wait right away.
+func (x *execution) wait(timeout time.Duration, reached func() bool) (expired bool, err error) {
+	var timer *time.Timer
+	var timeoutCh <-chan time.Time
+	startedWhen := time.Now()
+	if timeout > 0 {
+		timer = time.NewTimer(timeout)
+		defer func() { timer.Stop() }()
+		timeoutCh = timer.C
+	}
+	ticker := time.NewTicker(WAIT_STATE_POLL_INTERVAL)
+	defer ticker.Stop()
+	for {
+		if reached != nil && reached() {
+			return false, nil
+		}
+		if err = x.checkAbortStates(); err != nil {
+			return false, err
+		}
+		select {
+		case <-ticker.C:
+		case <-timeoutCh:
+			return true, nil
+		case <-x.pausedCh():
+			if timer != nil {
+				timer.Stop()
+				timeout -= time.Since(startedWhen)
+			}
+			if err = x.waitWhilePaused(); err != nil {
+				return false, err
+			}
+			if timer != nil {
+				startedWhen = time.Now()
+				timer = time.NewTimer(timeout)
+				timeoutCh = timer.C
+			}
+		case <-x.ctx.Done():
+			return false, x.ctx.Err()
+		}
+	}
+}
+
+func (x *execution) transition(instr instruction) (err error) {
+	x.env.SetLastRequestUser(x.requestUser)
+	err = x.env.RunTransition(instr.transition)
+	if err != nil && x.env.CurrentState() == "DONE" {
+		// the transition most likely failed because of the teardown
+		return fmt.Errorf("%w: %s", errEnvironmentDone, err.Error())
+	}
+	return
+}
+
+func (x *execution) sendEvent(status evpb.OpStatus, step string, err error) {
+	ev := &evpb.Ev_EnvironmentEvent{
+		EnvironmentId:    x.envId.String(),
+		State:            x.env.CurrentState(),
+		RunNumber:        x.env.GetCurrentRunNumber(),
+		Message:          fmt.Sprintf("sequence %s (%s)", x.prog.name, x.id),
+		Transition:       "SEQUENCE",
+		TransitionStep:   step,
+		TransitionStatus: status,
+		LastRequestUser:  x.requestUser,
+	}
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	the.EventWriterWithTopic(topic.Environment).WriteEvent(ev)
+}
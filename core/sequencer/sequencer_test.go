@@ -0,0 +1,357 @@
+package sequencer
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeEnvironment records the steps a sequence performs on it, and moves
+// between states like an environment whose transitions always succeed,
+// unless onTransition says otherwise.
+type fakeEnvironment struct {
+	mu           sync.Mutex
+	id           uid.ID
+	state        string
+	steps        []string
+	onTransition func(optype pb.ControlEnvironmentRequest_Optype) error
+}
+
+func (f *fakeEnvironment) Id() uid.ID {
+	return f.id
+}
+
+func (f *fakeEnvironment) CurrentState() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.state
+}
+
+func (f *fakeEnvironment) setState(state string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.state = state
+}
+
+func (f *fakeEnvironment) GetCurrentRunNumber() uint32 {
+	return 0
+}
+
+func (f *fakeEnvironment) SetProperties(properties map[string]string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	keys := make([]string, 0, len(properties))
+	for k, v := range properties {
+		keys = append(keys, k+"="+v)
+	}
+	sort.Strings(keys)
+	f.steps = append(f.steps, "set "+strings.Join(keys, ","))
+	return nil
+}
+
+func (f *fakeEnvironment) SetLastRequestUser(*evpb.User) {}
+
+func (f *fakeEnvironment) RunTransition(optype pb.ControlEnvironmentRequest_Optype) error {
+	f.mu.Lock()
+	f.steps = append(f.steps, optype.String())
+	f.mu.Unlock()
+	if f.onTransition != nil {
+		if err := f.onTransition(optype); err != nil {
+			return err
+		}
+	}
+	switch optype {
+	case pb.ControlEnvironmentRequest_CONFIGURE, pb.ControlEnvironmentRequest_STOP_ACTIVITY:
+		f.setState("CONFIGURED")
+	case pb.ControlEnvironmentRequest_START_ACTIVITY:
+		f.setState("RUNNING")
+	case pb.ControlEnvironmentRequest_RESET:
+		f.setState("STANDBY")
+	}
+	return nil
+}
+
+func (f *fakeEnvironment) getSteps() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string{}, f.steps...)
+}
+
+var _ = Describe("executing a run sequence", func() {
+	var env *fakeEnvironment
+
+	BeforeEach(func() {
+		env = &fakeEnvironment{id: uid.New(), state: "STANDBY"}
+	})
+
+	newTestExecution := func(yamlSeq string) *execution {
+		seq, err := ParseSequence([]byte(yamlSeq))
+		Expect(err).NotTo(HaveOccurred())
+		prog, err := seq.compile()
+		Expect(err).NotTo(HaveOccurred())
+		return newExecution(prog, env, nil)
+	}
+
+	// run executes the sequence to completion, or fails the test if it
+	// does not end in time
+	run := func(x *execution) Status {
+		done := make(chan struct{})
+		go func() {
+			x.run()
+			close(done)
+		}()
+		Eventually(done, 5*time.Second).Should(BeClosed())
+		return x.getStatus()
+	}
+
+	It("runs the steps in order", func() {
+		x := newTestExecution(`
+name: scan
+steps:
+  - set:
+      pulser: "on"
+  - loop:
+      var: threshold
+      values: ["10", "20"]
+      steps:
+        - transition: CONFIGURE
+        - transition: START_ACTIVITY
+        - transition: STOP_ACTIVITY
+        - transition: RESET
+`)
+		status := run(x)
+		Expect(status.State).To(Equal(DONE))
+		Expect(status.Error).To(BeEmpty())
+		Expect(status.CurrentStep).To(Equal(status.StepCount))
+		Expect(env.getSteps()).To(Equal([]string{
+			"set pulser=on",
+			"set threshold=10", "CONFIGURE", "START_ACTIVITY", "STOP_ACTIVITY", "RESET",
+			"set threshold=20", "CONFIGURE", "START_ACTIVITY", "STOP_ACTIVITY", "RESET",
+		}))
+	})
+
+	It("fails at the first step which fails", func() {
+		env.onTransition = func(optype pb.ControlEnvironmentRequest_Optype) error {
+			if optype == pb.ControlEnvironmentRequest_START_ACTIVITY {
+				env.setState("ERROR")
+				return errors.New("readout refused to start")
+			}
+			return nil
+		}
+		x := newTestExecution(`
+name: start
+steps:
+  - transition: CONFIGURE
+  - transition: START_ACTIVITY
+  - transition: STOP_ACTIVITY
+`)
+		status := run(x)
+		Expect(status.State).To(Equal(FAILED))
+		Expect(status.CurrentStep).To(Equal(1))
+		Expect(status.Error).To(ContainSubstring("readout refused to start"))
+		Expect(env.getSteps()).To(Equal([]string{"CONFIGURE", "START_ACTIVITY"}))
+	})
+
+	It("stops once the environment reaches an abort state", func() {
+		env.setState("DEPLOYED")
+		x := newTestExecution(`
+name: until-standby
+abort:
+  states: [STANDBY]
+steps:
+  - transition: CONFIGURE
+  - wait:
+      duration: 1h
+  - transition: START_ACTIVITY
+`)
+		go func() {
+			defer GinkgoRecover()
+			Eventually(env.getSteps).Should(ContainElement("CONFIGURE"))
+			env.setState("STANDBY")
+		}()
+		status := run(x)
+		Expect(status.State).To(Equal(FAILED))
+		Expect(status.Error).To(ContainSubstring("abort condition met, environment in state STANDBY"))
+		Expect(env.getSteps()).To(Equal([]string{"CONFIGURE"}))
+	})
+
+	It("does not start in an abort state", func() {
+		env.setState("ERROR")
+		x := newTestExecution(`
+name: configure
+steps:
+  - transition: CONFIGURE
+`)
+		status := run(x)
+		Expect(status.State).To(Equal(FAILED))
+		Expect(env.getSteps()).To(BeEmpty())
+	})
+
+	It("is aborted when the sequence timeout expires", func() {
+		x := newTestExecution(`
+name: slow
+abort:
+  timeout: 100ms
+steps:
+  - wait:
+      duration: 1h
+  - transition: CONFIGURE
+`)
+		status := run(x)
+		Expect(status.State).To(Equal(ABORTED))
+		Expect(status.Error).To(Equal("sequence timeout expired"))
+		Expect(env.getSteps()).To(BeEmpty())
+	})
+
+	It("fails when a state is not reached in time", func() {
+		x := newTestExecution(`
+name: wait-for-running
+steps:
+  - transition: CONFIGURE
+  - wait:
+      state: RUNNING
+      timeout: 100ms
+`)
+		status := run(x)
+		Expect(status.State).To(Equal(FAILED))
+		Expect(status.Error).To(ContainSubstring("did not reach state RUNNING"))
+	})
+
+	It("is aborted by request", func() {
+		x := newTestExecution(`
+name: long
+steps:
+  - wait:
+      duration: 1h
+`)
+		go func() {
+			defer GinkgoRecover()
+			Eventually(func() int64 { return x.getStatus().UpdatedWhen }).ShouldNot(BeZero())
+			Expect(x.abort()).To(Succeed())
+		}()
+		status := run(x)
+		Expect(status.State).To(Equal(ABORTED))
+		Expect(status.Error).To(Equal("aborted by request"))
+		Expect(x.abort()).NotTo(Succeed())
+	})
+
+	When("paused during a wait step", func() {
+		// pauseInWait pauses x once it is waiting at the given step
+		pauseInWait := func(x *execution, step int) {
+			Eventually(func() int { return x.getStatus().CurrentStep }).Should(Equal(step))
+			Expect(x.pause()).To(Succeed())
+		}
+
+		It("suspends the wait until resumed", func() {
+			x := newTestExecution(`
+name: paused-wait
+steps:
+  - transition: CONFIGURE
+  - wait:
+      duration: 200ms
+  - transition: START_ACTIVITY
+`)
+			go func() {
+				defer GinkgoRecover()
+				pauseInWait(x, 1)
+				Consistently(env.getSteps, 500*time.Millisecond).Should(Equal([]string{"CONFIGURE"}))
+				Expect(x.getStatus().State).To(Equal(PAUSED))
+				Expect(x.resume()).To(Succeed())
+			}()
+			status := run(x)
+			Expect(status.State).To(Equal(DONE))
+			Expect(env.getSteps()).To(Equal([]string{"CONFIGURE", "START_ACTIVITY"}))
+		})
+
+		It("does not count the pause against the timeout of a state wait", func() {
+			x := newTestExecution(`
+name: paused-wait-for-running
+steps:
+  - transition: CONFIGURE
+  - wait:
+      state: RUNNING
+      timeout: 200ms
+`)
+			go func() {
+				defer GinkgoRecover()
+				pauseInWait(x, 1)
+				Consistently(func() State { return x.getStatus().State }, 500*time.Millisecond).Should(Equal(PAUSED))
+				env.setState("RUNNING")
+				Expect(x.resume()).To(Succeed())
+			}()
+			status := run(x)
+			Expect(status.State).To(Equal(DONE))
+		})
+
+		It("is aborted by request", func() {
+			x := newTestExecution(`
+name: paused-long
+steps:
+  - transition: CONFIGURE
+  - wait:
+      duration: 1h
+`)
+			go func() {
+				defer GinkgoRecover()
+				pauseInWait(x, 1)
+				Expect(x.abort()).To(Succeed())
+			}()
+			status := run(x)
+			Expect(status.State).To(Equal(ABORTED))
+			Expect(status.Error).To(Equal("aborted by request"))
+		})
+	})
+
+	When("the environment is torn down", func() {
+		It("is aborted while waiting, without running further steps", func() {
+			x := newTestExecution(`
+name: torn-down
+steps:
+  - transition: CONFIGURE
+  - wait:
+      duration: 1h
+  - transition: RESET
+`)
+			go func() {
+				defer GinkgoRecover()
+				Eventually(env.getSteps).Should(ContainElement("CONFIGURE"))
+				env.setState("DONE")
+			}()
+			status := run(x)
+			Expect(status.State).To(Equal(ABORTED))
+			Expect(status.Error).To(Equal(errEnvironmentDone.Error()))
+			Expect(env.getSteps()).To(Equal([]string{"CONFIGURE"}))
+		})
+
+		It("is aborted if a transition fails because of it", func() {
+			env.onTransition = func(optype pb.ControlEnvironmentRequest_Optype) error {
+				if optype == pb.ControlEnvironmentRequest_START_ACTIVITY {
+					env.setState("DONE")
+					return fmt.Errorf("transition START_ACTIVITY impossible in state DONE")
+				}
+				return nil
+			}
+			x := newTestExecution(`
+name: torn-down
+steps:
+  - transition: CONFIGURE
+  - transition: START_ACTIVITY
+  - transition: STOP_ACTIVITY
+`)
+			status := run(x)
+			Expect(status.State).To(Equal(ABORTED))
+			Expect(status.Error).To(HavePrefix(errEnvironmentDone.Error()))
+			Expect(env.getSteps()).To(Equal([]string{"CONFIGURE", "START_ACTIVITY"}))
+			Expect(env.CurrentState()).To(Equal("DONE"))
+		})
+	})
+})
@@ -28,12 +28,17 @@ package core
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"maps"
 	"runtime"
 	"sort"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/common/event/topic"
 	"github.com/AliceO2Group/Control/common/logger/infologger"
 	evpb "github.com/AliceO2Group/Control/common/protos"
@@ -43,6 +48,7 @@ import (
 	"github.com/AliceO2Group/Control/core/integration"
 	"github.com/AliceO2Group/Control/core/repos"
 	"github.com/AliceO2Group/Control/core/repos/varsource"
+	"github.com/AliceO2Group/Control/core/sequencer"
 	"github.com/AliceO2Group/Control/core/workflow"
 	"github.com/jinzhu/copier"
 	"github.com/spf13/viper"
@@ -64,14 +70,30 @@ import (
 )
 
 const MAX_ERROR_LENGTH = 6000 // gRPC seems to impose this limit on the status message
+const EVENT_STREAM_BUFFER_SIZE = 4096
 
-func NewServer(state *globalState) *grpc.Server {
-	s := grpc.NewServer()
-	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
-	pb.RegisterControlServer(s, &RpcServer{
+func NewRpcServer(state *globalState) *RpcServer {
+	return &RpcServer{
 		state:      state,
 		envStreams: newSafeStreamsMap(),
-	})
+	}
+}
+
+func NewServer(rpcsvr *RpcServer) *grpc.Server {
+	var opts []grpc.ServerOption
+	if elector := rpcsvr.state.elector; elector != nil {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(leaderUnaryInterceptor(elector)),
+			grpc.ChainStreamInterceptor(leaderStreamInterceptor(elector)),
+		)
+	}
+	s := grpc.NewServer(opts...)
+	healthsvr := health.NewServer()
+	if rpcsvr.state.elector != nil {
+		watchLeadership(rpcsvr.state.elector, healthsvr)
+	}
+	grpc_health_v1.RegisterHealthServer(s, healthsvr)
+	pb.RegisterControlServer(s, rpcsvr)
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
 	return s
@@ -117,6 +139,8 @@ func (m *RpcServer) logMethodHandled() {
 type RpcServer struct {
 	state      *globalState
 	envStreams SafeStreamsMap
+
+	teardownInProgress atomic.Bool
 }
 
 // We are setting up timestamp field of proto files in the moment of function return,
@@ -203,14 +227,183 @@ func (m *RpcServer) GetFrameworkInfo(context.Context, *pb.GetFrameworkInfoReques
 		AvailableDetectors:    availableDetectors,
 		Timestamp:             currentUnixMilli(),
 	}
+	for _, hc := range m.state.taskman.GetCordonedHosts() {
+		r.CordonedHosts = append(r.CordonedHosts, hc.Hostname)
+	}
 	return r, nil
 }
 
-func (*RpcServer) Teardown(context.Context, *pb.TeardownRequest) (*pb.TeardownReply, error) {
+func (m *RpcServer) Teardown(cxt context.Context, req *pb.TeardownRequest) (*pb.TeardownReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+	reason := strings.TrimSpace(req.GetReason())
+	if len(reason) == 0 {
+		return nil, status.New(codes.InvalidArgument, "a reason is required for teardown").Err()
+	}
+
+	if !m.teardownInProgress.CompareAndSwap(false, true) {
+		return nil, status.New(codes.FailedPrecondition, "teardown already in progress").Err()
+	}
+	defer m.teardownInProgress.Store(false)
+
 	log.WithPrefix("rpcserver").
-		WithField("method", "Teardown").
-		Debug("implement me")
-	return nil, status.New(codes.Unimplemented, "not implemented").Err()
+		WithField("level", infologger.IL_Ops).
+		Infof("TEARDOWN starting, reason: %s", reason)
+	the.EventWriterWithTopic(topic.Core).WriteEvent(&evpb.Ev_MetaEvent_FrameworkEvent{
+		FrameworkId: m.state.taskman.GetFrameworkID(),
+		Message:     fmt.Sprintf("teardown starting, reason: %s", reason),
+	})
+
+	reply := &pb.TeardownReply{
+		Environments: make([]*pb.TeardownEnvironmentResult, 0),
+		Clean:        true,
+	}
+	defer setCurrentUnixMilli(&reply.Timestamp)
+
+	// Environments are shut down one at a time, so that EOR hooks
+	// don't all hit the integrated services at once
+	envIds := m.state.environments.Ids()
+	sort.Slice(envIds, func(i, j int) bool { return envIds[i].String() < envIds[j].String() })
+	for _, envId := range envIds {
+		env, err := m.state.environments.Environment(envId)
+		if err != nil { // destroyed in the meantime
+			continue
+		}
+		result := m.doTeardownEnvironment(env, reason, req.GetRequestUser())
+		if result.Forced || len(result.Errors) > 0 {
+			reply.Clean = false
+		}
+		reply.Environments = append(reply.Environments, result)
+	}
+
+	killed, running, err := m.doCleanupTasks(nil)
+	reply.CleanupTasksReply = &pb.CleanupTasksReply{KilledTasks: killed, RunningTasks: running}
+	if err != nil {
+		log.WithPrefix("rpcserver").
+			WithError(err).
+			Error("TEARDOWN task cleanup error")
+		reply.Clean = false
+	}
+	if len(running) > 0 {
+		reply.Clean = false
+	}
+
+	summary := fmt.Sprintf("teardown done, %d environments shut down, %d tasks killed", len(reply.Environments), len(killed))
+	if !reply.Clean {
+		problems := make([]string, 0)
+		for _, result := range reply.Environments {
+			if result.Forced {
+				problems = append(problems, fmt.Sprintf("environment %s forced", result.EnvironmentId))
+			}
+			for _, envErr := range result.Errors {
+				problems = append(problems, fmt.Sprintf("environment %s: %s", result.EnvironmentId, envErr))
+			}
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("task cleanup: %s", err.Error()))
+		}
+		if len(running) > 0 {
+			problems = append(problems, fmt.Sprintf("%d tasks still running", len(running)))
+		}
+		summary = fmt.Sprintf("%s, not clean: %s", summary, strings.Join(problems, "; "))
+	}
+	log.WithPrefix("rpcserver").
+		WithField("level", infologger.IL_Ops).
+		Info(strings.ToUpper(summary[:1]) + summary[1:])
+	the.EventWriterWithTopic(topic.Core).WriteEvent(&evpb.Ev_MetaEvent_FrameworkEvent{
+		FrameworkId: m.state.taskman.GetFrameworkID(),
+		Message:     summary,
+	})
+
+	return reply, nil
+}
+
+// doTeardownEnvironment brings an environment down as gracefully as its state
+// allows: a RUNNING environment is stopped so that EOR hooks run, then the
+// environment is reset, destroyed and its tasks killed. If any of these steps
+// fails, the environment is torn down by force.
+func (m *RpcServer) doTeardownEnvironment(env *environment.Environment, reason string, requestUser *evpb.User) *pb.TeardownEnvironmentResult {
+	result := &pb.TeardownEnvironmentResult{
+		EnvironmentId: env.Id().String(),
+		InitialState:  env.CurrentState(),
+		Errors:        make([]string, 0),
+	}
+	if requestUser != nil {
+		env.SetLastRequestUser(requestUser)
+	}
+
+	writeProgress := func(step string, opStatus evpb.OpStatus, message string, err error) {
+		ev := &evpb.Ev_EnvironmentEvent{
+			EnvironmentId:    env.Id().String(),
+			State:            env.CurrentState(),
+			RunNumber:        env.GetCurrentRunNumber(),
+			Message:          fmt.Sprintf("%s, reason: %s", message, reason),
+			Transition:       "TEARDOWN",
+			TransitionStep:   step,
+			TransitionStatus: opStatus,
+			LastRequestUser:  env.GetLastRequestUser(),
+		}
+		if err != nil {
+			ev.Error = err.Error()
+		}
+		the.EventWriterWithTopic(topic.Environment).WriteEvent(ev)
+	}
+	tryTransition := func(transitionType pb.ControlEnvironmentRequest_Optype) bool {
+		step := transitionType.String()
+		writeProgress(step, evpb.OpStatus_STARTED, fmt.Sprintf("teardown performing %s", step), nil)
+		err := env.TryTransition(environment.MakeTransition(m.state.taskman, transitionType))
+		if err != nil {
+			log.WithField("partition", env.Id().String()).
+				WithField("level", infologger.IL_Ops).
+				WithError(err).
+				Warnf("TEARDOWN could not perform %s, forcing", step)
+			writeProgress(step, evpb.OpStatus_DONE_ERROR, fmt.Sprintf("teardown could not perform %s", step), err)
+			result.Errors = append(result.Errors, fmt.Sprintf("%s failed: %s", step, err.Error()))
+			return false
+		}
+		writeProgress(step, evpb.OpStatus_DONE_OK, fmt.Sprintf("teardown performed %s", step), nil)
+		return true
+	}
+
+	log.WithField("partition", env.Id().String()).
+		WithField("level", infologger.IL_Ops).
+		Infof("TEARDOWN shutting down environment in state %s", result.InitialState)
+	writeProgress("", evpb.OpStatus_STARTED, "teardown starting", nil)
+
+	if env.CurrentState() == "RUNNING" {
+		result.RunNumber = env.GetCurrentRunNumber()
+		result.Forced = !tryTransition(pb.ControlEnvironmentRequest_STOP_ACTIVITY)
+	}
+	if !result.Forced && env.CurrentState() == "CONFIGURED" {
+		result.Forced = !tryTransition(pb.ControlEnvironmentRequest_RESET)
+	}
+	if !result.Forced && env.CurrentState() != "DEPLOYED" && env.CurrentState() != "STANDBY" {
+		log.WithField("partition", env.Id().String()).
+			WithField("level", infologger.IL_Ops).
+			Warnf("TEARDOWN cannot destroy environment in state %s, forcing", env.CurrentState())
+		result.Forced = true
+	}
+
+	destroyReply, forced, err := m.doTeardownAndCleanup(env, result.Forced, false)
+	if forced && !result.Forced {
+		result.Forced = true
+		result.Errors = append(result.Errors, "graceful destroy failed, environment torn down by force")
+	}
+	if destroyReply != nil && destroyReply.GetCleanupTasksReply() != nil && len(destroyReply.GetCleanupTasksReply().GetRunningTasks()) > 0 {
+		result.Errors = append(result.Errors, fmt.Sprintf("%d tasks could not be killed", len(destroyReply.GetCleanupTasksReply().GetRunningTasks())))
+	}
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("destroy failed: %s", status.Convert(err).Message()))
+		writeProgress("", evpb.OpStatus_DONE_ERROR, "teardown failed", err)
+	} else {
+		writeProgress("", evpb.OpStatus_DONE_OK, "teardown done", nil)
+	}
+	return result
 }
 
 func (m *RpcServer) GetEnvironments(cxt context.Context, request *pb.GetEnvironmentsRequest) (*pb.GetEnvironmentsReply, error) {
@@ -361,6 +554,10 @@ func (m *RpcServer) NewEnvironmentAsync(cxt context.Context, request *pb.NewEnvi
 	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
 	m.logMethod()
 
+	if m.teardownInProgress.Load() {
+		return nil, status.New(codes.Unavailable, "cannot create environment while teardown is in progress").Err()
+	}
+
 	// Create new Environment instance with some roles, we get back a UUID
 	id := uid.New()
 
@@ -391,6 +588,10 @@ func (m *RpcServer) NewEnvironment(cxt context.Context, request *pb.NewEnvironme
 	m.logMethod()
 	defer m.logMethodHandled()
 
+	if m.teardownInProgress.Load() {
+		return nil, status.New(codes.Unavailable, "cannot create environment while teardown is in progress").Err()
+	}
+
 	// NEW_ENVIRONMENT transition
 	// The following should
 	// 1) Create a new value of type Environment struct
@@ -663,9 +864,102 @@ func (m *RpcServer) ControlEnvironment(cxt context.Context, req *pb.ControlEnvir
 	}
 	defer setCurrentUnixMilli(&reply.Timestamp)
 	if err != nil {
-		return reply, status.Newf(codes.Aborted, err.Error()).Err()
+		return reply, status.New(codes.Aborted, err.Error()).Err()
+	}
+
+	return reply, nil
+}
+
+func (m *RpcServer) SetEnvironmentProperties(cxt context.Context, req *pb.SetEnvironmentPropertiesRequest) (*pb.SetEnvironmentPropertiesReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.Id) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	envId, err := uid.FromString(req.Id)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "received bad environment id").Err()
+	}
+
+	env, err := m.state.environments.Environment(envId)
+	if err != nil {
+		return nil, status.Newf(codes.NotFound, "environment not found: %s", err.Error()).Err()
+	}
+
+	err = env.SetProperties(req.GetProperties())
+	if err != nil {
+		var validationErr environment.PropertyValidationError
+		if errors.As(err, &validationErr) {
+			return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+		}
+		return nil, status.New(codes.FailedPrecondition, err.Error()).Err()
+	}
+
+	return &pb.SetEnvironmentPropertiesReply{}, nil
+}
+
+func (m *RpcServer) GetEnvironmentProperties(cxt context.Context, req *pb.GetEnvironmentPropertiesRequest) (*pb.GetEnvironmentPropertiesReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.Id) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	envId, err := uid.FromString(req.Id)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "received bad environment id").Err()
+	}
+
+	env, err := m.state.environments.Environment(envId)
+	if err != nil {
+		return nil, status.Newf(codes.NotFound, "environment not found: %s", err.Error()).Err()
+	}
+
+	properties, err := env.GetProperties(req.GetQueries(), req.GetExcludeGlobals())
+	if err != nil {
+		var validationErr environment.PropertyValidationError
+		if errors.As(err, &validationErr) {
+			return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+		}
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+
+	return &pb.GetEnvironmentPropertiesReply{Properties: properties}, nil
+}
+
+func (m *RpcServer) GetEnvironmentTopology(cxt context.Context, req *pb.GetEnvironmentTopologyRequest) (*pb.GetEnvironmentTopologyReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.Id) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	envId, err := uid.FromString(req.Id)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "received bad environment id").Err()
+	}
+
+	env, err := m.state.environments.Environment(envId)
+	if err != nil {
+		return nil, status.Newf(codes.NotFound, "environment not found: %s", err.Error()).Err()
 	}
 
+	topology := task.BuildTopology(env.Workflow().GetTasks())
+	reply := topologyToGetEnvironmentTopologyReply(topology)
+	if len(req.Format) != 0 {
+		reply.Document, err = topology.Export(req.Format)
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+		}
+	}
+	reply.Timestamp = currentUnixMilli()
 	return reply, nil
 }
 
@@ -709,7 +1003,7 @@ func (m *RpcServer) DestroyEnvironment(cxt context.Context, req *pb.DestroyEnvir
 
 	// if Force immediately disband the environment (unlocking all tasks) and run the cleanup.
 	if req.Force {
-		reply, err = m.doTeardownAndCleanup(env, req.Force, req.KeepTasks)
+		reply, _, err = m.doTeardownAndCleanup(env, req.Force, req.KeepTasks)
 		return
 	}
 
@@ -718,7 +1012,7 @@ func (m *RpcServer) DestroyEnvironment(cxt context.Context, req *pb.DestroyEnvir
 		if err != nil {
 			log.WithField("partition", env.Id().String()).
 				Warn("could not perform STOP transition for environment teardown, forcing")
-			reply, err = m.doTeardownAndCleanup(env, true /*force*/, false /*keepTasks*/)
+			reply, _, err = m.doTeardownAndCleanup(env, true /*force*/, false /*keepTasks*/)
 			return
 		}
 	}
@@ -736,7 +1030,7 @@ func (m *RpcServer) DestroyEnvironment(cxt context.Context, req *pb.DestroyEnvir
 	if !canDestroy {
 		log.WithField("partition", env.Id().String()).
 			Warnf("cannot teardown environment in state %s, forcing", env.CurrentState())
-		reply, err = m.doTeardownAndCleanup(env, true /*force*/, false /*keepTasks*/)
+		reply, _, err = m.doTeardownAndCleanup(env, true /*force*/, false /*keepTasks*/)
 		return
 	}
 
@@ -746,32 +1040,30 @@ func (m *RpcServer) DestroyEnvironment(cxt context.Context, req *pb.DestroyEnvir
 		if err != nil {
 			log.WithField("partition", env.Id().String()).
 				Warnf("cannot teardown environment in state %s, forcing", env.CurrentState())
-			reply, err = m.doTeardownAndCleanup(env, true /*force*/, false /*keepTasks*/)
+			reply, _, err = m.doTeardownAndCleanup(env, true /*force*/, false /*keepTasks*/)
 			return
 		}
 	}
 
-	reply, err = m.doTeardownAndCleanup(env, req.Force, req.KeepTasks)
+	reply, _, err = m.doTeardownAndCleanup(env, req.Force, req.KeepTasks)
 	return
 }
 
-func (m *RpcServer) doTeardownAndCleanup(env *environment.Environment, force bool, keepTasks bool) (*pb.DestroyEnvironmentReply, error) {
+// doTeardownAndCleanup destroys the environment and kills its tasks, unless keepTasks is set.
+// forced is true if the environment was torn down by force, either because force was requested
+// or because a graceful teardown failed.
+func (m *RpcServer) doTeardownAndCleanup(env *environment.Environment, force bool, keepTasks bool) (reply *pb.DestroyEnvironmentReply, forced bool, err error) {
 	log.WithField("partition", env.Id().String()).
 		WithField("level", infologger.IL_Ops).
 		Info("DESTROY starting")
 
-	err := m.state.environments.TeardownEnvironment(env.Id(), force)
+	forced, err = teardownWithFallback(env.Id(), force, m.state.environments.TeardownEnvironment)
 	if err != nil {
-		if !force {
-			// if the teardown failed, but we haven't tried a Force teardown yet, we retry
-			// a second time but with force
-			return m.doTeardownAndCleanup(env, true, keepTasks)
-		}
-		return &pb.DestroyEnvironmentReply{}, status.New(codes.Internal, err.Error()).Err()
+		return &pb.DestroyEnvironmentReply{}, forced, status.New(codes.Internal, err.Error()).Err()
 	}
 
 	if keepTasks { // Tasks should stay running, so we're done
-		return &pb.DestroyEnvironmentReply{}, nil
+		return &pb.DestroyEnvironmentReply{}, forced, nil
 	}
 
 	// cleanup tasks
@@ -782,9 +1074,23 @@ func (m *RpcServer) doTeardownAndCleanup(env *environment.Environment, force boo
 		log.WithError(err).
 			WithField("partition", env.Id().String()).
 			Error("task cleanup error")
-		return &pb.DestroyEnvironmentReply{CleanupTasksReply: ctr}, status.New(codes.Internal, err.Error()).Err()
+		return &pb.DestroyEnvironmentReply{CleanupTasksReply: ctr}, forced, status.New(codes.Internal, err.Error()).Err()
+	}
+	return &pb.DestroyEnvironmentReply{CleanupTasksReply: ctr}, forced, nil
+}
+
+// teardownWithFallback calls teardown for the given environment, and if a graceful teardown fails,
+// it retries a second time but with force. It returns whether force was used.
+func teardownWithFallback(envId uid.ID, force bool, teardown func(uid.ID, bool) error) (forced bool, err error) {
+	err = teardown(envId, force)
+	if err == nil || force {
+		return force, err
 	}
-	return &pb.DestroyEnvironmentReply{CleanupTasksReply: ctr}, nil
+	log.WithField("partition", envId.String()).
+		WithField("level", infologger.IL_Ops).
+		WithError(err).
+		Warn("DESTROY failed, forcing")
+	return true, teardown(envId, true)
 }
 
 func (m *RpcServer) GetActiveDetectors(_ context.Context, _ *pb.Empty) (*pb.GetActiveDetectorsReply, error) {
@@ -935,6 +1241,315 @@ func (m *RpcServer) doCleanupTasks(taskIds []string) (killedTaskInfos []*pb.Shor
 	return
 }
 
+func (m *RpcServer) RestartTask(cxt context.Context, req *pb.RestartTaskRequest) (*pb.RestartTaskReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.TaskId) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	t, env, err := m.getTaskEnvironment(req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	env.SetLastRequestUser(req.RequestUser)
+
+	replacement, err := env.RestartTask(m.state.taskman, t)
+	reply := &pb.RestartTaskReply{
+		Task:           taskToShortTaskInfo(replacement, m.state.taskman),
+		PreviousTaskId: req.TaskId,
+		Timestamp:      currentUnixMilli(),
+	}
+	if err != nil {
+		return reply, taskOperationStatus(err).Err()
+	}
+	return reply, nil
+}
+
+func (m *RpcServer) TransitionTask(cxt context.Context, req *pb.TransitionTaskRequest) (*pb.TransitionTaskReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.TaskId) == 0 || len(req.Event) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	t, env, err := m.getTaskEnvironment(req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	env.SetLastRequestUser(req.RequestUser)
+
+	err = env.TransitionTask(m.state.taskman, t, req.Event)
+	reply := &pb.TransitionTaskReply{
+		Task:      taskToShortTaskInfo(t, m.state.taskman),
+		Timestamp: currentUnixMilli(),
+	}
+	if err != nil {
+		return reply, taskOperationStatus(err).Err()
+	}
+	return reply, nil
+}
+
+func (m *RpcServer) SetTaskProperties(cxt context.Context, req *pb.SetTaskPropertiesRequest) (*pb.SetTaskPropertiesReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.TaskId) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	t, env, err := m.getTaskEnvironment(req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	env.SetLastRequestUser(req.RequestUser)
+
+	err = env.SetTaskProperties(t, req.GetProperties())
+	if err != nil {
+		return nil, taskOperationStatus(err).Err()
+	}
+	return &pb.SetTaskPropertiesReply{Timestamp: currentUnixMilli()}, nil
+}
+
+func (m *RpcServer) GetTaskLogs(cxt context.Context, req *pb.GetTaskLogsRequest) (*pb.GetTaskLogsReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.TaskId) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+	if req.Tail < 0 || req.Offset < 0 {
+		return nil, status.New(codes.InvalidArgument, "tail and offset cannot be negative").Err()
+	}
+
+	logs, err := m.state.taskman.GetTaskLogs(req.TaskId, int(req.Tail), req.Offset, req.Follow)
+	if err != nil {
+		var notFoundErr task.TaskNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, status.New(codes.NotFound, err.Error()).Err()
+		}
+		return nil, status.New(codes.Unavailable, err.Error()).Err()
+	}
+	return &pb.GetTaskLogsReply{
+		TaskId:     logs.TaskId,
+		Hostname:   logs.Hostname,
+		Content:    logs.Content,
+		NextOffset: logs.NextOffset,
+		Timestamp:  currentUnixMilli(),
+	}, nil
+}
+
+// getTaskEnvironment returns a task along with the environment it belongs to,
+// or a status error fit for returning from a per-task RPC.
+func (m *RpcServer) getTaskEnvironment(taskId string) (*task.Task, *environment.Environment, error) {
+	t := m.state.taskman.GetTask(taskId)
+	if t == nil {
+		return nil, nil, status.Newf(codes.NotFound, "task not found: %s", taskId).Err()
+	}
+	if !t.IsLocked() {
+		return nil, nil, status.Newf(codes.FailedPrecondition, "task %s does not belong to any environment", taskId).Err()
+	}
+	env, err := m.state.environments.Environment(t.GetEnvironmentId())
+	if err != nil {
+		return nil, nil, status.Newf(codes.NotFound, "environment not found: %s", err.Error()).Err()
+	}
+	return t, env, nil
+}
+
+func taskOperationStatus(err error) *status.Status {
+	var validationErr environment.PropertyValidationError
+	var operationErr environment.TaskOperationError
+	switch {
+	case errors.As(err, &validationErr):
+		return status.New(codes.InvalidArgument, err.Error())
+	case errors.As(err, &operationErr):
+		return status.New(codes.FailedPrecondition, err.Error())
+	}
+	return status.New(codes.Internal, err.Error())
+}
+
+func (m *RpcServer) CordonHost(cxt context.Context, req *pb.CordonHostRequest) (*pb.CordonHostReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.Hostname) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	hc, err := m.state.taskman.CordonHost(req.Hostname, req.Reason, req.GetRequestUser().GetName())
+	if err != nil {
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+	return &pb.CordonHostReply{
+		Host:      hostCordonToCordonedHostInfo(hc),
+		Timestamp: currentUnixMilli(),
+	}, nil
+}
+
+func (m *RpcServer) UncordonHost(cxt context.Context, req *pb.UncordonHostRequest) (*pb.UncordonHostReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.Hostname) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	if !m.state.taskman.IsHostCordoned(req.Hostname) {
+		return nil, status.Newf(codes.NotFound, "host %s is not cordoned", req.Hostname).Err()
+	}
+	err := m.state.taskman.UncordonHost(req.Hostname)
+	if err != nil {
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+	return &pb.UncordonHostReply{Timestamp: currentUnixMilli()}, nil
+}
+
+func (m *RpcServer) DrainHost(cxt context.Context, req *pb.CordonHostRequest) (*pb.CordonHostReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.Hostname) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	drainStatus, err := m.state.taskman.DrainHost(req.Hostname, req.Reason, req.GetRequestUser().GetName())
+	if err != nil {
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+	return &pb.CordonHostReply{
+		Host:      hostDrainStatusToCordonedHostInfo(drainStatus),
+		Timestamp: currentUnixMilli(),
+	}, nil
+}
+
+func (m *RpcServer) GetCordonedHosts(cxt context.Context, req *pb.GetCordonedHostsRequest) (*pb.GetCordonedHostsReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	drainStatuses := m.state.taskman.GetCordonedHosts()
+	hosts := make([]*pb.CordonedHostInfo, len(drainStatuses))
+	for i, drainStatus := range drainStatuses {
+		hosts[i] = hostDrainStatusToCordonedHostInfo(drainStatus)
+	}
+	return &pb.GetCordonedHostsReply{
+		Hosts:     hosts,
+		Timestamp: currentUnixMilli(),
+	}, nil
+}
+
+func (m *RpcServer) RunSequence(cxt context.Context, req *pb.RunSequenceRequest) (*pb.RunSequenceReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.EnvironmentId) == 0 || len(req.Sequence) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	envId, err := uid.FromString(req.EnvironmentId)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "received bad environment id").Err()
+	}
+	if _, err = m.state.environments.Environment(envId); err != nil {
+		return nil, status.Newf(codes.NotFound, "environment not found: %s", err.Error()).Err()
+	}
+
+	seq, err := sequencer.ParseSequence([]byte(req.Sequence))
+	if err != nil {
+		return nil, status.Newf(codes.InvalidArgument, "invalid sequence: %s", err.Error()).Err()
+	}
+
+	seqStatus, err := m.state.sequences.Run(envId, seq, req.RequestUser)
+	if err != nil {
+		return nil, status.New(codes.FailedPrecondition, err.Error()).Err()
+	}
+	return &pb.RunSequenceReply{
+		Sequence:  sequenceStatusToSequenceInfo(seqStatus),
+		Timestamp: currentUnixMilli(),
+	}, nil
+}
+
+func (m *RpcServer) GetSequences(cxt context.Context, req *pb.GetSequencesRequest) (*pb.GetSequencesReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	reply := &pb.GetSequencesReply{Sequences: make([]*pb.SequenceInfo, 0)}
+	defer setCurrentUnixMilli(&reply.Timestamp)
+
+	if len(req.SequenceId) > 0 {
+		seqStatus, err := m.state.sequences.Get(req.SequenceId)
+		if err != nil {
+			return nil, status.New(codes.NotFound, err.Error()).Err()
+		}
+		reply.Sequences = append(reply.Sequences, sequenceStatusToSequenceInfo(seqStatus))
+		return reply, nil
+	}
+
+	envId := uid.NilID()
+	if len(req.EnvironmentId) > 0 {
+		var err error
+		envId, err = uid.FromString(req.EnvironmentId)
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, "received bad environment id").Err()
+		}
+	}
+	for _, seqStatus := range m.state.sequences.List(envId) {
+		reply.Sequences = append(reply.Sequences, sequenceStatusToSequenceInfo(seqStatus))
+	}
+	return reply, nil
+}
+
+func (m *RpcServer) ControlSequence(cxt context.Context, req *pb.ControlSequenceRequest) (*pb.ControlSequenceReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.SequenceId) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	var (
+		seqStatus sequencer.Status
+		err       error
+	)
+	switch req.Type {
+	case pb.ControlSequenceRequest_PAUSE:
+		seqStatus, err = m.state.sequences.Pause(req.SequenceId)
+	case pb.ControlSequenceRequest_RESUME:
+		seqStatus, err = m.state.sequences.Resume(req.SequenceId)
+	case pb.ControlSequenceRequest_ABORT:
+		seqStatus, err = m.state.sequences.Abort(req.SequenceId)
+	default:
+		return nil, status.Newf(codes.InvalidArgument, "invalid sequence operation %s", req.Type.String()).Err()
+	}
+	if err != nil {
+		return nil, status.New(codes.FailedPrecondition, err.Error()).Err()
+	}
+
+	log.WithField("level", infologger.IL_Ops).
+		Infof("sequence %s: %s requested by %s", req.SequenceId, req.Type.String(), req.GetRequestUser().GetName())
+
+	return &pb.ControlSequenceReply{
+		Sequence:  sequenceStatusToSequenceInfo(seqStatus),
+		Timestamp: currentUnixMilli(),
+	}, nil
+}
+
 func (m *RpcServer) GetRoles(cxt context.Context, req *pb.GetRolesRequest) (*pb.GetRolesReply, error) {
 	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
 	m.logMethod()
@@ -1185,6 +1800,10 @@ func (m *RpcServer) Subscribe(req *pb.SubscribeRequest, srv pb.Control_Subscribe
 	m.logMethod()
 	defer m.logMethodHandled()
 
+	if len(req.GetId()) == 0 {
+		return m.streamEvents(req, srv)
+	}
+
 	for {
 		ch, chOk := m.envStreams.GetChannel(req.GetId())
 		if !chOk {
@@ -1206,11 +1825,152 @@ func (m *RpcServer) Subscribe(req *pb.SubscribeRequest, srv pb.Control_Subscribe
 	}
 }
 
+// streamEvents sends every event written by the core which passes the
+// request's filters, until the client goes away.
+func (m *RpcServer) streamEvents(req *pb.SubscribeRequest, srv pb.Control_SubscribeServer) error {
+	filter, err := newEventStreamFilter(req, func(id uid.ID) (system.IDMap, error) {
+		env, err := m.state.environments.Environment(id)
+		if err != nil {
+			return nil, err
+		}
+		return env.GetActiveDetectors(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sub := the.EventHub().Subscribe(EVENT_STREAM_BUFFER_SIZE, filter.matchesPublished)
+	defer sub.Unsubscribe()
+
+	var reportedDropped uint64
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case e, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+			if !filter.matchesDequeued(e) {
+				continue
+			}
+			if err := srv.Send(e.Event); err != nil {
+				return err
+			}
+			if dropped := sub.Dropped(); dropped > reportedDropped {
+				log.WithField("dropped", dropped-reportedDropped).
+					WithField("level", infologger.IL_Devel).
+					Warn("event stream client too slow, events dropped")
+				reportedDropped = dropped
+			}
+		}
+	}
+}
+
+// eventStreamFilter selects the events sent on an event stream.
+// Filtering by detector requires looking up environments, which must never
+// happen while an event is being published, because the publisher may hold
+// the environment manager lock (e.g. on teardown). Therefore only the cheap
+// checks run on publish, and the detector check runs in the stream goroutine
+// once the event is dequeued.
+type eventStreamFilter struct {
+	topics          []string
+	environmentId   string
+	detectors       system.IDMap
+	activeDetectors func(uid.ID) (system.IDMap, error)
+
+	// environments are only looked up the first time one of their events
+	// is seen, and only cached once they have their detectors set, so that
+	// their events still match after they are gone from the manager
+	envsDetectors map[string]bool
+}
+
+func newEventStreamFilter(req *pb.SubscribeRequest, activeDetectors func(uid.ID) (system.IDMap, error)) (*eventStreamFilter, error) {
+	if len(req.GetEnvironmentId()) > 0 {
+		if _, err := uid.FromString(req.GetEnvironmentId()); err != nil {
+			return nil, status.Newf(codes.InvalidArgument, "invalid environment id: %s", err.Error()).Err()
+		}
+	}
+	detectors := make(system.IDMap)
+	for _, det := range req.GetDetectors() {
+		sid, err := system.IDString(strings.ToUpper(det))
+		if err != nil {
+			return nil, status.Newf(codes.InvalidArgument, "invalid detector: %s", det).Err()
+		}
+		detectors[sid] = struct{}{}
+	}
+	return &eventStreamFilter{
+		topics:          req.GetTopics(),
+		environmentId:   req.GetEnvironmentId(),
+		detectors:       detectors,
+		activeDetectors: activeDetectors,
+		envsDetectors:   make(map[string]bool),
+	}, nil
+}
+
+// matchesPublished is run by the Hub for every published event, and must
+// not block or take any lock.
+func (f *eventStreamFilter) matchesPublished(e event.TopicEvent) bool {
+	if len(f.topics) > 0 {
+		topicMatches := false
+		for _, t := range f.topics {
+			if e.MatchesTopic(t) {
+				topicMatches = true
+				break
+			}
+		}
+		if !topicMatches {
+			return false
+		}
+	}
+	envId := e.Event.GetEnvironmentId()
+	if len(f.environmentId) > 0 && envId != f.environmentId {
+		return false
+	}
+	if len(f.detectors) > 0 && len(envId) == 0 {
+		return false
+	}
+	return true
+}
+
+// matchesDequeued is run by the stream goroutine for every event which
+// passed matchesPublished.
+func (f *eventStreamFilter) matchesDequeued(e event.TopicEvent) bool {
+	if len(f.detectors) == 0 {
+		return true
+	}
+	envId := e.Event.GetEnvironmentId()
+	if matches, ok := f.envsDetectors[envId]; ok {
+		return matches
+	}
+	id, err := uid.FromString(envId)
+	if err != nil {
+		return false
+	}
+	activeDetectors, err := f.activeDetectors(id)
+	if err != nil || len(activeDetectors) == 0 {
+		return false
+	}
+	matches := false
+	for det := range activeDetectors {
+		if _, ok := f.detectors[det]; ok {
+			matches = true
+			break
+		}
+	}
+	f.envsDetectors[envId] = matches
+	return matches
+}
+
 func (m *RpcServer) NewAutoEnvironment(cxt context.Context, request *pb.NewAutoEnvironmentRequest) (*pb.NewAutoEnvironmentReply, error) {
 	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
 	m.logMethod()
 	defer m.logMethodHandled()
 
+	if m.teardownInProgress.Load() {
+		return nil, status.New(codes.Unavailable, "cannot create environment while teardown is in progress").Err()
+	}
+
 	ch := make(chan *pb.Event)
 	m.envStreams.add(request.GetId(), ch)
 	sub := environment.SubscribeToStream(ch)
@@ -0,0 +1,178 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/event/topic"
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/system"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("tearing down an environment", func() {
+	var (
+		envId uid.ID
+		calls []bool
+	)
+
+	BeforeEach(func() {
+		envId = uid.New()
+		calls = nil
+	})
+
+	It("should not force a graceful teardown which succeeds", func() {
+		forced, err := teardownWithFallback(envId, false, func(id uid.ID, force bool) error {
+			Expect(id).To(Equal(envId))
+			calls = append(calls, force)
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(forced).To(BeFalse())
+		Expect(calls).To(Equal([]bool{false}))
+	})
+
+	It("should report a forced teardown if the graceful one fails", func() {
+		forced, err := teardownWithFallback(envId, false, func(_ uid.ID, force bool) error {
+			calls = append(calls, force)
+			if !force {
+				return errors.New("cannot teardown environment in state ERROR")
+			}
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(forced).To(BeTrue())
+		Expect(calls).To(Equal([]bool{false, true}))
+	})
+
+	It("should not retry a forced teardown", func() {
+		forced, err := teardownWithFallback(envId, true, func(_ uid.ID, force bool) error {
+			calls = append(calls, force)
+			return errors.New("already in DONE")
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(forced).To(BeTrue())
+		Expect(calls).To(Equal([]bool{true}))
+	})
+})
+
+var _ = Describe("filtering an event stream by detector", func() {
+	var (
+		hub     *event.Hub
+		envId   uid.ID
+		envsMu  sync.RWMutex
+		envs    map[uid.ID]system.IDMap
+		filter  *eventStreamFilter
+		sub     *event.HubSubscription
+		publish func(state string, status evpb.OpStatus)
+	)
+
+	BeforeEach(func() {
+		hub = event.NewHub()
+		envId = uid.New()
+		envs = map[uid.ID]system.IDMap{
+			envId: {system.TPC: struct{}{}},
+		}
+
+		var err error
+		filter, err = newEventStreamFilter(&pb.SubscribeRequest{Detectors: []string{"tpc"}}, func(id uid.ID) (system.IDMap, error) {
+			envsMu.RLock()
+			defer envsMu.RUnlock()
+			detectors, ok := envs[id]
+			if !ok {
+				return nil, errors.New("no such environment")
+			}
+			return detectors, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		sub = hub.Subscribe(10, filter.matchesPublished)
+
+		publish = func(state string, status evpb.OpStatus) {
+			hub.Publish(topic.Environment, evpb.WrapEvent(&evpb.Event_EnvironmentEvent{EnvironmentEvent: &evpb.Ev_EnvironmentEvent{
+				EnvironmentId:    envId.String(),
+				State:            state,
+				TransitionStatus: status,
+			}}))
+		}
+	})
+
+	AfterEach(func() {
+		sub.Unsubscribe()
+	})
+
+	// next returns the next event which passes the whole filter, as the stream goroutine does
+	next := func() *evpb.Event {
+		for e := range sub.Events() {
+			if filter.matchesDequeued(e) {
+				return e.Event
+			}
+		}
+		return nil
+	}
+
+	It("rejects invalid detectors", func() {
+		_, err := newEventStreamFilter(&pb.SubscribeRequest{Detectors: []string{"nope"}}, nil)
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("does not look up environments while publishing", func() {
+		publish("STANDBY", evpb.OpStatus_STARTED)
+		Expect(next().GetEnvironmentEvent().GetState()).To(Equal("STANDBY"))
+
+		// like the environment manager on teardown, remove the environment
+		// and write the final event while holding the write lock
+		published := make(chan struct{})
+		go func() {
+			envsMu.Lock()
+			defer envsMu.Unlock()
+			delete(envs, envId)
+			publish("DONE", evpb.OpStatus_DONE_OK)
+			close(published)
+		}()
+		Eventually(published).Should(BeClosed())
+
+		// the environment is gone, but it was already known to match
+		Expect(next().GetEnvironmentEvent().GetTransitionStatus()).To(Equal(evpb.OpStatus_DONE_OK))
+	})
+
+	It("drops events of environments without the requested detectors", func() {
+		otherId := uid.New()
+		envs[otherId] = system.IDMap{system.ITS: struct{}{}}
+		hub.Publish(topic.Environment, evpb.WrapEvent(&evpb.Event_EnvironmentEvent{EnvironmentEvent: &evpb.Ev_EnvironmentEvent{
+			EnvironmentId: otherId.String(),
+		}}))
+		hub.Publish(topic.Core, evpb.WrapEvent(&evpb.Event_CoreStartEvent{CoreStartEvent: &evpb.Ev_MetaEvent_CoreStart{}}))
+		publish("CONFIGURED", evpb.OpStatus_DONE_OK)
+
+		Expect(next().GetEnvironmentEvent().GetEnvironmentId()).To(Equal(envId.String()))
+	})
+})
@@ -26,11 +26,13 @@ package core
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/AliceO2Group/Control/core/repos"
 
 	"github.com/AliceO2Group/Control/common"
 	pb "github.com/AliceO2Group/Control/core/protos"
+	"github.com/AliceO2Group/Control/core/sequencer"
 	"github.com/AliceO2Group/Control/core/task/channel"
 
 	"github.com/AliceO2Group/Control/core/task"
@@ -128,6 +130,7 @@ func taskToShortTaskInfo(t *task.Task, taskman *task.Manager) (sti *pb.ShortTask
 			AgentId:    t.GetAgentId(),
 			OfferId:    t.GetOfferId(),
 			ExecutorId: t.GetExecutorId(),
+			Affinity:   affinityToTaskAffinityInfo(t.GetEffectiveAffinity()),
 		},
 		Pid:           t.GetTaskPID(),
 		SandboxStdout: sandboxStdoutUri,
@@ -237,3 +240,99 @@ func convertVarTypeStringToEnum(varType string) pb.VarSpecMessage_Type {
 	}
 	return pb.VarSpecMessage_Type(msgType)
 }
+
+func sequenceStatusToSequenceInfo(seqStatus sequencer.Status) *pb.SequenceInfo {
+	return &pb.SequenceInfo{
+		Id:              seqStatus.Id,
+		Name:            seqStatus.Name,
+		EnvironmentId:   seqStatus.EnvironmentId,
+		State:           string(seqStatus.State),
+		CurrentStep:     int32(seqStatus.CurrentStep),
+		StepCount:       int32(seqStatus.StepCount),
+		StepDescription: seqStatus.StepDescription,
+		Error:           seqStatus.Error,
+		CreatedWhen:     seqStatus.CreatedWhen,
+		UpdatedWhen:     seqStatus.UpdatedWhen,
+	}
+}
+
+func hostCordonToCordonedHostInfo(hc task.HostCordon) *pb.CordonedHostInfo {
+	return &pb.CordonedHostInfo{
+		Hostname:     hc.Hostname,
+		Reason:       hc.Reason,
+		CordonedBy:   hc.RequestUser,
+		CordonedWhen: hc.Since.UnixMilli(),
+	}
+}
+
+func hostDrainStatusToCordonedHostInfo(drainStatus task.HostDrainStatus) *pb.CordonedHostInfo {
+	chi := hostCordonToCordonedHostInfo(drainStatus.HostCordon)
+	chi.Environments = make([]*pb.HostDrainEnvironment, 0, len(drainStatus.Environments))
+	for envId, taskIds := range drainStatus.Environments {
+		chi.Environments = append(chi.Environments, &pb.HostDrainEnvironment{
+			EnvironmentId: envId.String(),
+			TaskIds:       taskIds,
+		})
+	}
+	sort.Slice(chi.Environments, func(i, j int) bool {
+		return chi.Environments[i].EnvironmentId < chi.Environments[j].EnvironmentId
+	})
+	chi.UnlockedTaskIds = drainStatus.UnlockedTasks
+	chi.Drained = drainStatus.IsDrained()
+	return chi
+}
+
+func topologyChannelEndToPb(end task.TopologyChannelEnd) *pb.TopologyChannelEnd {
+	return &pb.TopologyChannelEnd{
+		TaskId:     end.TaskId,
+		Channel:    end.Channel,
+		Type:       end.Type.String(),
+		Transport:  end.Transport.String(),
+		SndBufSize: int32(end.SndBufSize),
+		RcvBufSize: int32(end.RcvBufSize),
+	}
+}
+
+func topologyToGetEnvironmentTopologyReply(topology *task.Topology) *pb.GetEnvironmentTopologyReply {
+	reply := &pb.GetEnvironmentTopologyReply{
+		Nodes:  make([]*pb.TopologyNode, len(topology.Nodes)),
+		Edges:  make([]*pb.TopologyEdge, len(topology.Edges)),
+		Issues: make([]*pb.TopologyIssue, len(topology.Issues)),
+	}
+	for i, node := range topology.Nodes {
+		reply.Nodes[i] = &pb.TopologyNode{
+			TaskId:    node.TaskId,
+			Name:      node.Name,
+			ClassName: node.ClassName,
+			Hostname:  node.Hostname,
+			RolePath:  node.RolePath,
+		}
+	}
+	for i, edge := range topology.Edges {
+		reply.Edges[i] = &pb.TopologyEdge{
+			Connect:   topologyChannelEndToPb(edge.Connect),
+			Bind:      topologyChannelEndToPb(edge.Bind),
+			Address:   edge.Address,
+			Transport: edge.Transport.String(),
+		}
+	}
+	for i, issue := range topology.Issues {
+		reply.Issues[i] = &pb.TopologyIssue{
+			Severity: string(issue.Severity),
+			TaskId:   issue.TaskId,
+			Channel:  issue.Channel,
+			Message:  issue.Message,
+		}
+	}
+	return reply
+}
+
+func affinityToTaskAffinityInfo(affinity *common.TaskAffinity) *pb.TaskAffinityInfo {
+	if affinity == nil || affinity.IsEmpty() {
+		return nil
+	}
+	return &pb.TaskAffinityInfo{
+		Cpus:        affinity.Cpus,
+		MemoryNodes: affinity.MemoryNodes,
+	}
+}
@@ -0,0 +1,976 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	_ "github.com/AliceO2Group/Control/core/docs"
+	"github.com/AliceO2Group/Control/core/ha"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
+	httpSwagger "github.com/swaggo/http-swagger/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// HttpService exposes the Control gRPC API as HTTP/JSON, for scripts and web
+// tools for which generated gRPC stubs are impractical. Every call is served
+// by the same RpcServer instance which serves gRPC clients.
+type HttpService struct {
+	rpcsvr *RpcServer
+}
+
+// httpError is the JSON payload of all non-2xx responses.
+type httpError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+//	@title			AliECS core REST API
+//	@version		1.0
+//	@description	HTTP/JSON gateway to the control API of the ALICE O² AliECS core. Requests and replies are the JSON mapping of the messages of the Control gRPC service (o2control.proto); path and query parameters set the request fields of the same name.
+
+//	@contact.name	O² FLP support
+//	@contact.url	https://alice-flp.docs.cern.ch/
+//	@contact.email	alice-o2-flp-support@cern.ch
+
+// @externalDocs.description	AliECS handbook
+// @externalDocs.url			https://alice-flp.docs.cern.ch/aliecs/handbook/
+func newHandlerForHttpService(httpsvc *HttpService) *mux.Router {
+	router := mux.NewRouter()
+	// documentation endpoint, the core binary also embeds the apricot docs
+	// under the default instance name
+	_ = router.PathPrefix("/docs/").Handler(httpSwagger.Handler(httpSwagger.InstanceName("core")))
+
+	router.HandleFunc("/info", httpsvc.ApiGetFrameworkInfo).Methods(http.MethodGet)
+	router.HandleFunc("/integrations", httpsvc.ApiGetIntegratedServices).Methods(http.MethodGet)
+	router.HandleFunc("/detectors/active", httpsvc.ApiGetActiveDetectors).Methods(http.MethodGet)
+	router.HandleFunc("/detectors/available", httpsvc.ApiGetAvailableDetectors).Methods(http.MethodGet)
+
+	// environments API
+	router.HandleFunc("/environments", httpsvc.ApiGetEnvironments).Methods(http.MethodGet)
+	router.HandleFunc("/environments", httpsvc.ApiNewEnvironment).Methods(http.MethodPost)
+	router.HandleFunc("/environments/{id}", httpsvc.ApiGetEnvironment).Methods(http.MethodGet)
+	router.HandleFunc("/environments/{id}", httpsvc.ApiDestroyEnvironment).Methods(http.MethodDelete)
+	router.HandleFunc("/environments/{id}/transition", httpsvc.ApiControlEnvironment).Methods(http.MethodPost)
+	router.HandleFunc("/environments/{id}/properties", httpsvc.ApiGetEnvironmentProperties).Methods(http.MethodGet)
+	router.HandleFunc("/environments/{id}/properties", httpsvc.ApiSetEnvironmentProperties).Methods(http.MethodPut)
+	router.HandleFunc("/environments/{envId}/roles", httpsvc.ApiGetRoles).Methods(http.MethodGet)
+	router.HandleFunc("/environments/{id}/topology", httpsvc.ApiGetEnvironmentTopology).Methods(http.MethodGet)
+	router.HandleFunc("/environments/{environmentId}/sequences", httpsvc.ApiGetEnvironmentSequences).Methods(http.MethodGet)
+	router.HandleFunc("/environments/{environmentId}/sequences", httpsvc.ApiRunSequence).Methods(http.MethodPost)
+
+	// run sequences API
+	router.HandleFunc("/sequences", httpsvc.ApiGetSequences).Methods(http.MethodGet)
+	router.HandleFunc("/sequences/{sequenceId}", httpsvc.ApiGetSequences).Methods(http.MethodGet)
+	router.HandleFunc("/sequences/{sequenceId}/{type:pause|resume|abort}", httpsvc.ApiControlSequence).Methods(http.MethodPost)
+
+	// tasks API
+	router.HandleFunc("/tasks", httpsvc.ApiGetTasks).Methods(http.MethodGet)
+	router.HandleFunc("/tasks/_cleanup", httpsvc.ApiCleanupTasks).Methods(http.MethodPost)
+	router.HandleFunc("/tasks/{taskId}", httpsvc.ApiGetTask).Methods(http.MethodGet)
+	router.HandleFunc("/tasks/{taskId}/restart", httpsvc.ApiRestartTask).Methods(http.MethodPost)
+	router.HandleFunc("/tasks/{taskId}/transition", httpsvc.ApiTransitionTask).Methods(http.MethodPost)
+	router.HandleFunc("/tasks/{taskId}/properties", httpsvc.ApiSetTaskProperties).Methods(http.MethodPut)
+	router.HandleFunc("/tasks/{taskId}/logs", httpsvc.ApiGetTaskLogs).Methods(http.MethodGet)
+
+	// hosts API
+	router.HandleFunc("/hosts/cordoned", httpsvc.ApiGetCordonedHosts).Methods(http.MethodGet)
+	router.HandleFunc("/hosts/{hostname}/cordon", httpsvc.ApiCordonHost).Methods(http.MethodPost)
+	router.HandleFunc("/hosts/{hostname}/cordon", httpsvc.ApiUncordonHost).Methods(http.MethodDelete)
+	router.HandleFunc("/hosts/{hostname}/drain", httpsvc.ApiDrainHost).Methods(http.MethodPost)
+
+	// workflow templates and repositories API
+	router.HandleFunc("/templates", httpsvc.ApiGetWorkflowTemplates).Methods(http.MethodGet)
+	router.HandleFunc("/repos", httpsvc.ApiListRepos).Methods(http.MethodGet)
+	router.HandleFunc("/repos", httpsvc.ApiAddRepo).Methods(http.MethodPost)
+	router.HandleFunc("/repos/_refresh", httpsvc.ApiRefreshRepos).Methods(http.MethodPost)
+	router.HandleFunc("/repos/_revision", httpsvc.ApiSetGlobalDefaultRevision).Methods(http.MethodPut)
+	router.HandleFunc("/repos/{index}", httpsvc.ApiRemoveRepo).Methods(http.MethodDelete)
+	router.HandleFunc("/repos/{index}/_default", httpsvc.ApiSetDefaultRepo).Methods(http.MethodPut)
+	router.HandleFunc("/repos/{index}/_revision", httpsvc.ApiSetRepoDefaultRevision).Methods(http.MethodPut)
+
+	// event stream
+	router.HandleFunc("/events", httpsvc.ApiSubscribe).Methods(http.MethodGet)
+
+	if elector := httpsvc.rpcsvr.state.elector; elector != nil {
+		router.Use(leaderMiddleware(elector))
+	}
+	return router
+}
+
+// leaderMiddleware refuses API calls on a standby core instance, pointing
+// the client to the leader like the gRPC server does.
+func leaderMiddleware(elector *ha.Elector) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			md, err := leaderMetadata(elector, strings.HasPrefix(r.URL.Path, "/docs/"))
+			if leaders := md.Get(ha.LEADER_METADATA_KEY); len(leaders) != 0 {
+				w.Header().Set("X-Aliecs-Leader", leaders[0])
+			}
+			if err != nil {
+				writeHttpError(w, err)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func NewHttpService(rpcsvr *RpcServer) (svr *http.Server) {
+	httpsvc := &HttpService{
+		rpcsvr: rpcsvr,
+	}
+	handler := newHandlerForHttpService(httpsvc)
+
+	// no write timeout: transitions can take minutes and the event stream
+	// stays open for as long as the client wants it
+	httpsvr := &http.Server{
+		Handler:     handler,
+		Addr:        ":" + strconv.Itoa(viper.GetInt("httpListenPort")),
+		ReadTimeout: 15 * time.Second,
+	}
+
+	// async-start of http Service and capture error
+	go func() {
+		log.WithField("port", viper.GetInt("httpListenPort")).
+			WithField("level", infologger.IL_Support).
+			Info("HTTP service started")
+
+		err := httpsvr.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Error("HTTP service returned error")
+		}
+	}()
+	return httpsvr
+}
+
+// ApiGetFrameworkInfo returns information on this core instance
+//
+//	@Summary	Get information on the AliECS core instance
+//	@Tags		core
+//	@Produce	json
+//	@Success	200	{object}	pb.GetFrameworkInfoReply
+//	@Failure	500	{object}	httpError
+//	@Router		/info [get]
+func (httpsvc *HttpService) ApiGetFrameworkInfo(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetFrameworkInfoRequest{}, httpsvc.rpcsvr.GetFrameworkInfo)
+}
+
+// ApiGetIntegratedServices lists the integrated services
+//
+//	@Summary	List the integrated services and their connection state
+//	@Tags		core
+//	@Produce	json
+//	@Success	200	{object}	pb.ListIntegratedServicesReply
+//	@Failure	500	{object}	httpError
+//	@Router		/integrations [get]
+func (httpsvc *HttpService) ApiGetIntegratedServices(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.Empty{}, httpsvc.rpcsvr.GetIntegratedServices)
+}
+
+// ApiGetActiveDetectors lists the detectors in use by environments
+//
+//	@Summary	List the detectors in use by environments
+//	@Tags		core
+//	@Produce	json
+//	@Success	200	{object}	pb.GetActiveDetectorsReply
+//	@Failure	500	{object}	httpError
+//	@Router		/detectors/active [get]
+func (httpsvc *HttpService) ApiGetActiveDetectors(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.Empty{}, httpsvc.rpcsvr.GetActiveDetectors)
+}
+
+// ApiGetAvailableDetectors lists the detectors not in use by any environment
+//
+//	@Summary	List the detectors not in use by any environment
+//	@Tags		core
+//	@Produce	json
+//	@Success	200	{object}	pb.GetAvailableDetectorsReply
+//	@Failure	500	{object}	httpError
+//	@Router		/detectors/available [get]
+func (httpsvc *HttpService) ApiGetAvailableDetectors(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.Empty{}, httpsvc.rpcsvr.GetAvailableDetectors)
+}
+
+// ApiGetEnvironments lists environments
+//
+//	@Summary	List environments
+//	@Tags		environments
+//	@Produce	json
+//	@Param		showAll							query		boolean	false	"Include the task infos and integrated services details"
+//	@Param		showTaskInfos					query		boolean	false	"Include the task infos"
+//	@Param		showDetailedIntegratedServices	query		boolean	false	"Include the integrated services details"
+//	@Success	200								{object}	pb.GetEnvironmentsReply
+//	@Failure	400								{object}	httpError
+//	@Failure	500								{object}	httpError
+//	@Router		/environments [get]
+func (httpsvc *HttpService) ApiGetEnvironments(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetEnvironmentsRequest{}, httpsvc.rpcsvr.GetEnvironments)
+}
+
+// ApiNewEnvironment creates an environment
+//
+//	@Summary		Create an environment
+//	@Description	Creates an environment from a workflow template. The call returns when the environment is deployed, unless async is true, in which case it returns immediately and the environment creation can be followed on the event stream.
+//	@Tags			environments
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		pb.NewEnvironmentRequest	true	"Environment creation request"
+//	@Param			async	query		boolean						false	"Return before the environment is deployed"
+//	@Success		200		{object}	pb.NewEnvironmentReply
+//	@Failure		400		{object}	httpError
+//	@Failure		500		{object}	httpError
+//	@Router			/environments [post]
+func (httpsvc *HttpService) ApiNewEnvironment(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	async, _ := strconv.ParseBool(query.Get("async"))
+	query.Del("async")
+	r.URL.RawQuery = query.Encode()
+	if async {
+		serveRpc(w, r, &pb.NewEnvironmentRequest{}, httpsvc.rpcsvr.NewEnvironmentAsync)
+		return
+	}
+	serveRpc(w, r, &pb.NewEnvironmentRequest{}, httpsvc.rpcsvr.NewEnvironment)
+}
+
+// ApiGetEnvironment returns the details of an environment
+//
+//	@Summary	Get an environment
+//	@Tags		environments
+//	@Produce	json
+//	@Param		id					path		string	true	"Environment ID"
+//	@Param		showWorkflowTree	query		boolean	false	"Include the workflow tree"
+//	@Success	200					{object}	pb.GetEnvironmentReply
+//	@Failure	404					{object}	httpError
+//	@Failure	500					{object}	httpError
+//	@Router		/environments/{id} [get]
+func (httpsvc *HttpService) ApiGetEnvironment(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetEnvironmentRequest{}, httpsvc.rpcsvr.GetEnvironment)
+}
+
+// ApiDestroyEnvironment tears down an environment
+//
+//	@Summary	Destroy an environment
+//	@Tags		environments
+//	@Produce	json
+//	@Param		id					path		string	true	"Environment ID"
+//	@Param		keepTasks			query		boolean	false	"Release the tasks instead of killing them"
+//	@Param		allowInRunningState	query		boolean	false	"Stop the run first if the environment is RUNNING"
+//	@Param		force				query		boolean	false	"Destroy the environment even if its state machine does not allow it"
+//	@Success	200					{object}	pb.DestroyEnvironmentReply
+//	@Failure	404					{object}	httpError
+//	@Failure	500					{object}	httpError
+//	@Router		/environments/{id} [delete]
+func (httpsvc *HttpService) ApiDestroyEnvironment(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.DestroyEnvironmentRequest{}, httpsvc.rpcsvr.DestroyEnvironment)
+}
+
+// ApiControlEnvironment transitions an environment
+//
+//	@Summary	Push a state machine transition to an environment
+//	@Tags		environments
+//	@Produce	json
+//	@Param		id		path		string	true	"Environment ID"
+//	@Param		type	query		string	true	"Transition"	Enums(CONFIGURE, START_ACTIVITY, STOP_ACTIVITY, RESET, GO_ERROR, DEPLOY)
+//	@Success	200		{object}	pb.ControlEnvironmentReply
+//	@Failure	400		{object}	httpError
+//	@Failure	404		{object}	httpError
+//	@Failure	500		{object}	httpError
+//	@Router		/environments/{id}/transition [post]
+func (httpsvc *HttpService) ApiControlEnvironment(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.ControlEnvironmentRequest{}, httpsvc.rpcsvr.ControlEnvironment)
+}
+
+// ApiGetEnvironmentProperties returns the variables of an environment
+//
+//	@Summary	Get the variables of an environment
+//	@Tags		environments
+//	@Produce	json
+//	@Param		id				path		string		true	"Environment ID"
+//	@Param		queries			query		[]string	false	"Glob patterns of the keys to return, * for all"	collectionFormat(multi)
+//	@Param		excludeGlobals	query		boolean		false	"Exclude the variables which only come from the global defaults and vars"
+//	@Success	200				{object}	pb.GetEnvironmentPropertiesReply
+//	@Failure	404				{object}	httpError
+//	@Failure	500				{object}	httpError
+//	@Router		/environments/{id}/properties [get]
+func (httpsvc *HttpService) ApiGetEnvironmentProperties(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetEnvironmentPropertiesRequest{}, httpsvc.rpcsvr.GetEnvironmentProperties)
+}
+
+// ApiSetEnvironmentProperties sets variables on an environment
+//
+//	@Summary	Set variables on an environment
+//	@Tags		environments
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string								true	"Environment ID"
+//	@Param		request	body		pb.SetEnvironmentPropertiesRequest	true	"Properties to set"
+//	@Success	200		{object}	pb.SetEnvironmentPropertiesReply
+//	@Failure	400		{object}	httpError
+//	@Failure	404		{object}	httpError
+//	@Failure	500		{object}	httpError
+//	@Router		/environments/{id}/properties [put]
+func (httpsvc *HttpService) ApiSetEnvironmentProperties(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.SetEnvironmentPropertiesRequest{}, httpsvc.rpcsvr.SetEnvironmentProperties)
+}
+
+// ApiGetRoles queries the roles of an environment
+//
+//	@Summary	Query the workflow roles of an environment
+//	@Tags		environments
+//	@Produce	json
+//	@Param		envId		path		string	true	"Environment ID"
+//	@Param		pathSpec	query		string	false	"Role path, may include wildcards"
+//	@Success	200			{object}	pb.GetRolesReply
+//	@Failure	404			{object}	httpError
+//	@Failure	500			{object}	httpError
+//	@Router		/environments/{envId}/roles [get]
+func (httpsvc *HttpService) ApiGetRoles(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetRolesRequest{}, httpsvc.rpcsvr.GetRoles)
+}
+
+// ApiGetEnvironmentTopology returns the channel topology of an environment
+//
+//	@Summary	Get the resolved channel topology of an environment
+//	@Tags		environments
+//	@Produce	json
+//	@Param		id		path		string	true	"Environment ID"
+//	@Param		format	query		string	false	"Also render the topology as json, dot or graphml"
+//	@Success	200		{object}	pb.GetEnvironmentTopologyReply
+//	@Failure	400		{object}	httpError
+//	@Failure	404		{object}	httpError
+//	@Failure	500		{object}	httpError
+//	@Router		/environments/{id}/topology [get]
+func (httpsvc *HttpService) ApiGetEnvironmentTopology(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetEnvironmentTopologyRequest{}, httpsvc.rpcsvr.GetEnvironmentTopology)
+}
+
+// ApiRunSequence starts a run sequence on an environment
+//
+//	@Summary	Run a sequence on an environment
+//	@Tags		run sequences
+//	@Accept		json
+//	@Produce	json
+//	@Param		environmentId	path		string					true	"Environment ID"
+//	@Param		request			body		pb.RunSequenceRequest	true	"Sequence as a YAML document"
+//	@Success	200				{object}	pb.RunSequenceReply
+//	@Failure	400				{object}	httpError
+//	@Failure	404				{object}	httpError
+//	@Failure	500				{object}	httpError
+//	@Router		/environments/{environmentId}/sequences [post]
+func (httpsvc *HttpService) ApiRunSequence(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.RunSequenceRequest{}, httpsvc.rpcsvr.RunSequence)
+}
+
+// ApiGetEnvironmentSequences lists the run sequences of an environment
+//
+//	@Summary	List the run sequences of an environment
+//	@Tags		run sequences
+//	@Produce	json
+//	@Param		environmentId	path		string	true	"Environment ID"
+//	@Success	200				{object}	pb.GetSequencesReply
+//	@Failure	404				{object}	httpError
+//	@Failure	500				{object}	httpError
+//	@Router		/environments/{environmentId}/sequences [get]
+func (httpsvc *HttpService) ApiGetEnvironmentSequences(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetSequencesRequest{}, httpsvc.rpcsvr.GetSequences)
+}
+
+// ApiGetSequences lists run sequences
+//
+//	@Summary		List run sequences
+//	@Description	Returns the given run sequence, or all known run sequences if no sequence ID is provided.
+//	@Tags			run sequences
+//	@Produce		json
+//	@Param			sequenceId	path		string	false	"Sequence ID"
+//	@Success		200			{object}	pb.GetSequencesReply
+//	@Failure		404			{object}	httpError
+//	@Failure		500			{object}	httpError
+//	@Router			/sequences [get]
+//	@Router			/sequences/{sequenceId} [get]
+func (httpsvc *HttpService) ApiGetSequences(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetSequencesRequest{}, httpsvc.rpcsvr.GetSequences)
+}
+
+// ApiControlSequence pauses, resumes or aborts a run sequence
+//
+//	@Summary	Pause, resume or abort a run sequence
+//	@Tags		run sequences
+//	@Produce	json
+//	@Param		sequenceId	path		string	true	"Sequence ID"
+//	@Param		type		path		string	true	"Operation"	Enums(pause, resume, abort)
+//	@Success	200			{object}	pb.ControlSequenceReply
+//	@Failure	404			{object}	httpError
+//	@Failure	500			{object}	httpError
+//	@Router		/sequences/{sequenceId}/{type} [post]
+func (httpsvc *HttpService) ApiControlSequence(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.ControlSequenceRequest{}, httpsvc.rpcsvr.ControlSequence)
+}
+
+// ApiGetTasks lists tasks
+//
+//	@Summary	List all tasks known to the core
+//	@Tags		tasks
+//	@Produce	json
+//	@Success	200	{object}	pb.GetTasksReply
+//	@Failure	500	{object}	httpError
+//	@Router		/tasks [get]
+func (httpsvc *HttpService) ApiGetTasks(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetTasksRequest{}, httpsvc.rpcsvr.GetTasks)
+}
+
+// ApiCleanupTasks kills tasks
+//
+//	@Summary		Kill tasks
+//	@Description	Kills the given tasks, or all the tasks which do not belong to an environment if no task IDs are given.
+//	@Tags			tasks
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		pb.CleanupTasksRequest	false	"Tasks to kill"
+//	@Success		200		{object}	pb.CleanupTasksReply
+//	@Failure		400		{object}	httpError
+//	@Failure		500		{object}	httpError
+//	@Router			/tasks/_cleanup [post]
+func (httpsvc *HttpService) ApiCleanupTasks(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.CleanupTasksRequest{}, httpsvc.rpcsvr.CleanupTasks)
+}
+
+// ApiGetTask returns the details of a task
+//
+//	@Summary	Get a task
+//	@Tags		tasks
+//	@Produce	json
+//	@Param		taskId	path		string	true	"Task ID"
+//	@Success	200		{object}	pb.GetTaskReply
+//	@Failure	404		{object}	httpError
+//	@Failure	500		{object}	httpError
+//	@Router		/tasks/{taskId} [get]
+func (httpsvc *HttpService) ApiGetTask(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetTaskRequest{}, httpsvc.rpcsvr.GetTask)
+}
+
+// ApiRestartTask replaces a task of an environment
+//
+//	@Summary	Restart a task of an environment
+//	@Tags		tasks
+//	@Produce	json
+//	@Param		taskId	path		string	true	"Task ID"
+//	@Success	200		{object}	pb.RestartTaskReply
+//	@Failure	404		{object}	httpError
+//	@Failure	500		{object}	httpError
+//	@Router		/tasks/{taskId}/restart [post]
+func (httpsvc *HttpService) ApiRestartTask(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.RestartTaskRequest{}, httpsvc.rpcsvr.RestartTask)
+}
+
+// ApiTransitionTask transitions a single task of an environment
+//
+//	@Summary	Push a state machine transition to a task of an environment
+//	@Tags		tasks
+//	@Produce	json
+//	@Param		taskId	path		string	true	"Task ID"
+//	@Param		event	query		string	true	"Event"	Enums(CONFIGURE, RESET, START, STOP, RECOVER)
+//	@Success	200		{object}	pb.TransitionTaskReply
+//	@Failure	400		{object}	httpError
+//	@Failure	404		{object}	httpError
+//	@Failure	500		{object}	httpError
+//	@Router		/tasks/{taskId}/transition [post]
+func (httpsvc *HttpService) ApiTransitionTask(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.TransitionTaskRequest{}, httpsvc.rpcsvr.TransitionTask)
+}
+
+// ApiSetTaskProperties sets properties on a task of an environment
+//
+//	@Summary	Set properties on a task of an environment
+//	@Tags		tasks
+//	@Accept		json
+//	@Produce	json
+//	@Param		taskId	path		string						true	"Task ID"
+//	@Param		request	body		pb.SetTaskPropertiesRequest	true	"Properties to set"
+//	@Success	200		{object}	pb.SetTaskPropertiesReply
+//	@Failure	400		{object}	httpError
+//	@Failure	404		{object}	httpError
+//	@Failure	500		{object}	httpError
+//	@Router		/tasks/{taskId}/properties [put]
+func (httpsvc *HttpService) ApiSetTaskProperties(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.SetTaskPropertiesRequest{}, httpsvc.rpcsvr.SetTaskProperties)
+}
+
+// ApiGetTaskLogs returns the output of a task
+//
+//	@Summary	Get the output of a task, as kept in the log files of its executor
+//	@Tags		tasks
+//	@Produce	json
+//	@Param		taskId	path		string	true	"Task ID"
+//	@Param		tail	query		int		false	"Number of most recent lines to return, 0 for the whole log"
+//	@Param		offset	query		int		false	"Byte offset where the previous read ended"
+//	@Param		follow	query		bool	false	"Read on from offset, including any new output"
+//	@Success	200		{object}	pb.GetTaskLogsReply
+//	@Failure	400		{object}	httpError
+//	@Failure	404		{object}	httpError
+//	@Failure	500		{object}	httpError
+//	@Router		/tasks/{taskId}/logs [get]
+func (httpsvc *HttpService) ApiGetTaskLogs(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetTaskLogsRequest{}, httpsvc.rpcsvr.GetTaskLogs)
+}
+
+// ApiGetCordonedHosts lists cordoned hosts
+//
+//	@Summary	List the hosts excluded from scheduling, along with the tasks still running there
+//	@Tags		hosts
+//	@Produce	json
+//	@Success	200	{object}	pb.GetCordonedHostsReply
+//	@Failure	500	{object}	httpError
+//	@Router		/hosts/cordoned [get]
+func (httpsvc *HttpService) ApiGetCordonedHosts(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetCordonedHostsRequest{}, httpsvc.rpcsvr.GetCordonedHosts)
+}
+
+// ApiCordonHost excludes a host from scheduling
+//
+//	@Summary	Exclude a host from scheduling
+//	@Tags		hosts
+//	@Produce	json
+//	@Param		hostname	path		string	true	"Hostname"
+//	@Param		reason		query		string	false	"Reason for cordoning the host"
+//	@Success	200			{object}	pb.CordonHostReply
+//	@Failure	400			{object}	httpError
+//	@Failure	500			{object}	httpError
+//	@Router		/hosts/{hostname}/cordon [post]
+func (httpsvc *HttpService) ApiCordonHost(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.CordonHostRequest{}, httpsvc.rpcsvr.CordonHost)
+}
+
+// ApiUncordonHost makes a cordoned host available for scheduling again
+//
+//	@Summary	Make a cordoned host available for scheduling again
+//	@Tags		hosts
+//	@Produce	json
+//	@Param		hostname	path		string	true	"Hostname"
+//	@Success	200			{object}	pb.UncordonHostReply
+//	@Failure	404			{object}	httpError
+//	@Failure	500			{object}	httpError
+//	@Router		/hosts/{hostname}/cordon [delete]
+func (httpsvc *HttpService) ApiUncordonHost(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.UncordonHostRequest{}, httpsvc.rpcsvr.UncordonHost)
+}
+
+// ApiDrainHost cordons a host and reports what still runs there
+//
+//	@Summary	Cordon a host and report which environments still hold tasks there
+//	@Tags		hosts
+//	@Produce	json
+//	@Param		hostname	path		string	true	"Hostname"
+//	@Param		reason		query		string	false	"Reason for draining the host"
+//	@Success	200			{object}	pb.CordonHostReply
+//	@Failure	400			{object}	httpError
+//	@Failure	500			{object}	httpError
+//	@Router		/hosts/{hostname}/drain [post]
+func (httpsvc *HttpService) ApiDrainHost(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.CordonHostRequest{}, httpsvc.rpcsvr.DrainHost)
+}
+
+// ApiGetWorkflowTemplates lists workflow templates
+//
+//	@Summary	List the workflow templates available in the configuration repositories
+//	@Tags		workflow templates
+//	@Produce	json
+//	@Param		repoPattern		query		string	false	"Glob pattern of the repositories to list"
+//	@Param		revisionPattern	query		string	false	"Glob pattern of the revisions to list"
+//	@Param		allBranches		query		boolean	false	"List templates on all branches"
+//	@Param		allTags			query		boolean	false	"List templates on all tags"
+//	@Param		allWorkflows	query		boolean	false	"Include templates which are not public"
+//	@Success	200				{object}	pb.GetWorkflowTemplatesReply
+//	@Failure	400				{object}	httpError
+//	@Failure	500				{object}	httpError
+//	@Router		/templates [get]
+func (httpsvc *HttpService) ApiGetWorkflowTemplates(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.GetWorkflowTemplatesRequest{}, httpsvc.rpcsvr.GetWorkflowTemplates)
+}
+
+// ApiListRepos lists the configuration repositories
+//
+//	@Summary	List the configuration repositories
+//	@Tags		repositories
+//	@Produce	json
+//	@Param		getRevisions	query		boolean	false	"Include the available revisions"
+//	@Success	200				{object}	pb.ListReposReply
+//	@Failure	500				{object}	httpError
+//	@Router		/repos [get]
+func (httpsvc *HttpService) ApiListRepos(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.ListReposRequest{}, httpsvc.rpcsvr.ListRepos)
+}
+
+// ApiAddRepo adds a configuration repository
+//
+//	@Summary	Add a configuration repository
+//	@Tags		repositories
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		pb.AddRepoRequest	true	"Repository to add"
+//	@Success	200		{object}	pb.AddRepoReply
+//	@Failure	400		{object}	httpError
+//	@Failure	500		{object}	httpError
+//	@Router		/repos [post]
+func (httpsvc *HttpService) ApiAddRepo(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.AddRepoRequest{}, httpsvc.rpcsvr.AddRepo)
+}
+
+// ApiRemoveRepo removes a configuration repository
+//
+//	@Summary	Remove a configuration repository
+//	@Tags		repositories
+//	@Produce	json
+//	@Param		index	path		integer	true	"Repository index"
+//	@Success	200		{object}	pb.RemoveRepoReply
+//	@Failure	400		{object}	httpError
+//	@Failure	500		{object}	httpError
+//	@Router		/repos/{index} [delete]
+func (httpsvc *HttpService) ApiRemoveRepo(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.RemoveRepoRequest{}, httpsvc.rpcsvr.RemoveRepo)
+}
+
+// ApiRefreshRepos refreshes the configuration repositories
+//
+//	@Summary	Refresh one or all configuration repositories
+//	@Tags		repositories
+//	@Produce	json
+//	@Param		index	query		integer	false	"Repository index, -1 for all"	Default(-1)
+//	@Success	200		{object}	pb.Empty
+//	@Failure	400		{object}	httpError
+//	@Failure	500		{object}	httpError
+//	@Router		/repos/_refresh [post]
+func (httpsvc *HttpService) ApiRefreshRepos(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.RefreshReposRequest{Index: -1}, httpsvc.rpcsvr.RefreshRepos)
+}
+
+// ApiSetDefaultRepo sets the default configuration repository
+//
+//	@Summary	Set the default configuration repository
+//	@Tags		repositories
+//	@Produce	json
+//	@Param		index	path		integer	true	"Repository index"
+//	@Success	200		{object}	pb.Empty
+//	@Failure	400		{object}	httpError
+//	@Failure	500		{object}	httpError
+//	@Router		/repos/{index}/_default [put]
+func (httpsvc *HttpService) ApiSetDefaultRepo(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.SetDefaultRepoRequest{}, httpsvc.rpcsvr.SetDefaultRepo)
+}
+
+// ApiSetGlobalDefaultRevision sets the global default revision
+//
+//	@Summary	Set the global default revision of configuration repositories
+//	@Tags		repositories
+//	@Produce	json
+//	@Param		revision	query		string	true	"Revision"
+//	@Success	200			{object}	pb.Empty
+//	@Failure	400			{object}	httpError
+//	@Failure	500			{object}	httpError
+//	@Router		/repos/_revision [put]
+func (httpsvc *HttpService) ApiSetGlobalDefaultRevision(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.SetGlobalDefaultRevisionRequest{}, httpsvc.rpcsvr.SetGlobalDefaultRevision)
+}
+
+// ApiSetRepoDefaultRevision sets the default revision of a repository
+//
+//	@Summary	Set the default revision of a configuration repository
+//	@Tags		repositories
+//	@Produce	json
+//	@Param		index		path		integer	true	"Repository index"
+//	@Param		revision	query		string	true	"Revision"
+//	@Success	200			{object}	pb.SetRepoDefaultRevisionReply
+//	@Failure	400			{object}	httpError
+//	@Failure	500			{object}	httpError
+//	@Router		/repos/{index}/_revision [put]
+func (httpsvc *HttpService) ApiSetRepoDefaultRevision(w http.ResponseWriter, r *http.Request) {
+	serveRpc(w, r, &pb.SetRepoDefaultRevisionRequest{}, httpsvc.rpcsvr.SetRepoDefaultRevision)
+}
+
+// ApiSubscribe streams the events written by the core as server-sent events
+//
+//	@Summary		Stream core events
+//	@Description	Streams the events written by the core as server-sent events, one JSON-encoded event per message, for as long as the client keeps the connection open.
+//	@Tags			events
+//	@Produce		text/event-stream
+//	@Param			environmentId	query		string		false	"Only stream events of this environment"
+//	@Param			topics			query		[]string	false	"Only stream events written to these topics or their subtopics"				collectionFormat(multi)
+//	@Param			detectors		query		[]string	false	"Only stream events of environments which include any of these detectors"	collectionFormat(multi)
+//	@Success		200				{object}	evpb.Event
+//	@Failure		400				{object}	httpError
+//	@Router			/events [get]
+func (httpsvc *HttpService) ApiSubscribe(w http.ResponseWriter, r *http.Request) {
+	req := &pb.SubscribeRequest{}
+	if err := readHttpRequest(r, req); err != nil {
+		writeHttpError(w, status.New(codes.InvalidArgument, err.Error()).Err())
+		return
+	}
+	if len(req.GetId()) > 0 {
+		writeHttpError(w, status.New(codes.InvalidArgument, "streaming the events of an auto environment is not supported").Err())
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeHttpError(w, status.New(codes.Unimplemented, "streaming not supported").Err())
+		return
+	}
+
+	stream := &sseStream{
+		ctx:     r.Context(),
+		w:       w,
+		flusher: flusher,
+	}
+	err := httpsvc.rpcsvr.Subscribe(req, stream)
+	if err != nil && !stream.started {
+		writeHttpError(w, err)
+	}
+}
+
+// sseStream adapts an HTTP response to the Control_SubscribeServer interface,
+// so that Subscribe can write its events as server-sent events.
+type sseStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	w       http.ResponseWriter
+	flusher http.Flusher
+	started bool
+}
+
+func (s *sseStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sseStream) Send(ev *evpb.Event) error {
+	if !s.started {
+		s.w.Header().Set("Content-Type", "text/event-stream")
+		s.w.Header().Set("Cache-Control", "no-cache")
+		s.w.WriteHeader(http.StatusOK)
+		s.started = true
+	}
+	payload, err := protojson.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintf(s.w, "data: %s\n\n", payload); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// serveRpc builds the request of a Control RPC from the HTTP request, calls it
+// on the RpcServer and writes the reply as JSON.
+func serveRpc[Req proto.Message, Rep proto.Message](w http.ResponseWriter, r *http.Request, req Req, call func(context.Context, Req) (Rep, error)) {
+	if err := readHttpRequest(r, req); err != nil {
+		writeHttpError(w, status.New(codes.InvalidArgument, err.Error()).Err())
+		return
+	}
+
+	reply, err := call(r.Context(), req)
+	if err != nil {
+		writeHttpError(w, err)
+		return
+	}
+
+	out, err := protojson.MarshalOptions{Multiline: true, Indent: "\t", EmitUnpopulated: true}.Marshal(reply)
+	if err != nil {
+		writeHttpError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = fmt.Fprintln(w, string(out))
+}
+
+// readHttpRequest fills a request message from the JSON body, if any, then
+// from the query parameters and finally from the path variables, each of
+// which sets the message field with the same JSON name.
+func readHttpRequest(r *http.Request, req proto.Message) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("cannot read request body: %w", err)
+	}
+	if len(strings.TrimSpace(string(body))) > 0 {
+		if err = protojson.Unmarshal(body, req); err != nil {
+			return fmt.Errorf("invalid request body: %w", err)
+		}
+	}
+
+	msg := req.ProtoReflect()
+	for key, values := range r.URL.Query() {
+		if err = setRequestField(msg, key, values); err != nil {
+			return err
+		}
+	}
+	for key, value := range mux.Vars(r) {
+		if err = setRequestField(msg, key, []string{value}); err != nil {
+			return err
+		}
+	}
+
+	// requests which carry a user are attributed to the HTTP client
+	// unless the body says otherwise
+	if fd := msg.Descriptor().Fields().ByJSONName("requestUser"); fd != nil && !msg.Has(fd) {
+		host, _, _ := net.SplitHostPort(r.RemoteAddr)
+		user := &evpb.User{Name: "http@" + host}
+		msg.Set(fd, protoreflect.ValueOfMessage(user.ProtoReflect()))
+	}
+	return nil
+}
+
+func setRequestField(msg protoreflect.Message, key string, values []string) error {
+	fd := msg.Descriptor().Fields().ByJSONName(key)
+	if fd == nil {
+		return fmt.Errorf("unknown parameter %s", key)
+	}
+	if fd.IsMap() || fd.Kind() == protoreflect.MessageKind {
+		return fmt.Errorf("parameter %s must be passed in the request body", key)
+	}
+
+	if fd.IsList() {
+		list := msg.Mutable(fd).List()
+		for _, value := range values {
+			for _, item := range strings.Split(value, ",") {
+				v, err := parseFieldValue(fd, item)
+				if err != nil {
+					return err
+				}
+				list.Append(v)
+			}
+		}
+		return nil
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+	v, err := parseFieldValue(fd, values[len(values)-1])
+	if err != nil {
+		return err
+	}
+	msg.Set(fd, v)
+	return nil
+}
+
+func parseFieldValue(fd protoreflect.FieldDescriptor, value string) (v protoreflect.Value, err error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BoolKind:
+		// a bare ?flag means true
+		if len(value) == 0 {
+			return protoreflect.ValueOfBool(true), nil
+		}
+		var b bool
+		if b, err = strconv.ParseBool(value); err == nil {
+			return protoreflect.ValueOfBool(b), nil
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		var i int64
+		if i, err = strconv.ParseInt(value, 10, 32); err == nil {
+			return protoreflect.ValueOfInt32(int32(i)), nil
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		var i int64
+		if i, err = strconv.ParseInt(value, 10, 64); err == nil {
+			return protoreflect.ValueOfInt64(i), nil
+		}
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByName(protoreflect.Name(strings.ToUpper(value))); enumValue != nil {
+			return protoreflect.ValueOfEnum(enumValue.Number()), nil
+		}
+		err = fmt.Errorf("not one of %s", enumValueNames(fd.Enum()))
+	default:
+		err = fmt.Errorf("unsupported type %s", fd.Kind().String())
+	}
+	return v, fmt.Errorf("invalid value %q for parameter %s: %w", value, fd.JSONName(), err)
+}
+
+func enumValueNames(ed protoreflect.EnumDescriptor) string {
+	names := make([]string, 0, ed.Values().Len())
+	for i := 0; i < ed.Values().Len(); i++ {
+		names = append(names, string(ed.Values().Get(i).Name()))
+	}
+	return strings.Join(names, ", ")
+}
+
+func writeHttpError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	out, _ := json.MarshalIndent(httpError{
+		Code:    st.Code().String(),
+		Message: st.Message(),
+	}, "", "\t")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatusFromCode(st.Code()))
+	_, _ = fmt.Fprintln(w, string(out))
+}
+
+// httpStatusFromCode maps gRPC status codes to HTTP status codes following
+// https://github.com/googleapis/googleapis/blob/master/google/rpc/code.proto
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
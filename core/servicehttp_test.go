@@ -0,0 +1,263 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/event/topic"
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"github.com/AliceO2Group/Control/core/sequencer"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("HTTP gateway", func() {
+	Describe("reading requests", func() {
+		// read builds req from a request to the given route, as the router
+		// would, with path variables from the route template
+		read := func(method, route, target, body string, req proto.Message) error {
+			var err error
+			router := mux.NewRouter()
+			router.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+				err = readHttpRequest(r, req)
+			})
+			httpReq := httptest.NewRequest(method, target, strings.NewReader(body))
+			router.ServeHTTP(httptest.NewRecorder(), httpReq)
+			return err
+		}
+
+		DescribeTable("valid requests",
+			func(method, route, target, body string, req proto.Message, expected proto.Message) {
+				Expect(read(method, route, target, body, req)).To(Succeed())
+				Expect(proto.Equal(req, expected)).To(BeTrue(), "got %v", req)
+			},
+			Entry("path variables and bare booleans",
+				http.MethodGet, "/environments/{id}/properties", "/environments/2oDvieFrVTi/properties?excludeGlobals", "",
+				&pb.GetEnvironmentPropertiesRequest{},
+				&pb.GetEnvironmentPropertiesRequest{Id: "2oDvieFrVTi", ExcludeGlobals: true}),
+			Entry("repeated fields, repeated and comma separated",
+				http.MethodGet, "/environments/{id}/properties", "/environments/2oDvieFrVTi/properties?queries=a*,b&queries=c", "",
+				&pb.GetEnvironmentPropertiesRequest{},
+				&pb.GetEnvironmentPropertiesRequest{Id: "2oDvieFrVTi", Queries: []string{"a*", "b", "c"}}),
+			Entry("integers, explicit booleans and the last of several values",
+				http.MethodGet, "/tasks/{taskId}/logs", "/tasks/2oDvmzJRWJ1/logs?tail=10&tail=20&offset=8589934592&follow=false", "",
+				&pb.GetTaskLogsRequest{},
+				&pb.GetTaskLogsRequest{TaskId: "2oDvmzJRWJ1", Tail: 20, Offset: 8589934592}),
+			Entry("enums, case insensitive, with a nested field from the body",
+				http.MethodPost, "/environments/{id}/transition", "/environments/2oDvieFrVTi/transition?type=start_activity",
+				`{"requestUser": {"name": "shifter", "externalId": 42}}`,
+				&pb.ControlEnvironmentRequest{},
+				&pb.ControlEnvironmentRequest{
+					Id:          "2oDvieFrVTi",
+					Type:        pb.ControlEnvironmentRequest_START_ACTIVITY,
+					RequestUser: &evpb.User{Name: "shifter", ExternalId: proto.Int32(42)},
+				}),
+			Entry("query parameters override the body",
+				http.MethodPost, "/tasks/_cleanup", "/tasks/_cleanup?taskIds=2oDvmzJRWJ1", `{"taskIds": ["2oDvmzJRWJ2"]}`,
+				&pb.CleanupTasksRequest{},
+				&pb.CleanupTasksRequest{TaskIds: []string{"2oDvmzJRWJ2", "2oDvmzJRWJ1"}}),
+		)
+
+		It("attributes requests without a user to the HTTP client", func() {
+			req := &pb.ControlEnvironmentRequest{}
+			Expect(read(http.MethodPost, "/environments/{id}/transition", "/environments/2oDvieFrVTi/transition?type=CONFIGURE", "", req)).To(Succeed())
+			Expect(req.GetRequestUser().GetName()).To(Equal("http@192.0.2.1"))
+		})
+
+		DescribeTable("invalid requests",
+			func(method, route, target, body string, req proto.Message, errSubstring string) {
+				err := read(method, route, target, body, req)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(errSubstring))
+			},
+			Entry("unknown parameter",
+				http.MethodGet, "/tasks/{taskId}/logs", "/tasks/2oDvmzJRWJ1/logs?lines=10", "",
+				&pb.GetTaskLogsRequest{}, "unknown parameter lines"),
+			Entry("malformed integer",
+				http.MethodGet, "/tasks/{taskId}/logs", "/tasks/2oDvmzJRWJ1/logs?tail=ten", "",
+				&pb.GetTaskLogsRequest{}, `invalid value "ten" for parameter tail`),
+			Entry("integer out of range",
+				http.MethodGet, "/tasks/{taskId}/logs", "/tasks/2oDvmzJRWJ1/logs?tail=8589934592", "",
+				&pb.GetTaskLogsRequest{}, "parameter tail"),
+			Entry("malformed boolean",
+				http.MethodGet, "/tasks/{taskId}/logs", "/tasks/2oDvmzJRWJ1/logs?follow=maybe", "",
+				&pb.GetTaskLogsRequest{}, "parameter follow"),
+			Entry("unknown enum value",
+				http.MethodPost, "/environments/{id}/transition", "/environments/2oDvieFrVTi/transition?type=LAUNCH", "",
+				&pb.ControlEnvironmentRequest{}, "not one of NOOP, START_ACTIVITY"),
+			Entry("message field in the query",
+				http.MethodPost, "/environments/{id}/transition", "/environments/2oDvieFrVTi/transition?requestUser=shifter", "",
+				&pb.ControlEnvironmentRequest{}, "must be passed in the request body"),
+			Entry("map field in the query",
+				http.MethodPost, "/environments", "/environments?vars=a", "",
+				&pb.NewEnvironmentRequest{}, "must be passed in the request body"),
+			Entry("malformed body",
+				http.MethodPost, "/environments", "/environments", `{"workflowTemplate": `,
+				&pb.NewEnvironmentRequest{}, "invalid request body"),
+			Entry("unknown field in the body",
+				http.MethodPost, "/environments", "/environments", `{"template": "readout-dataflow"}`,
+				&pb.NewEnvironmentRequest{}, "invalid request body"),
+		)
+	})
+
+	DescribeTable("mapping gRPC status codes",
+		func(code codes.Code, httpStatus int) {
+			Expect(httpStatusFromCode(code)).To(Equal(httpStatus))
+		},
+		Entry(nil, codes.OK, http.StatusOK),
+		Entry(nil, codes.Canceled, 499),
+		Entry(nil, codes.InvalidArgument, http.StatusBadRequest),
+		Entry(nil, codes.FailedPrecondition, http.StatusBadRequest),
+		Entry(nil, codes.OutOfRange, http.StatusBadRequest),
+		Entry(nil, codes.DeadlineExceeded, http.StatusGatewayTimeout),
+		Entry(nil, codes.NotFound, http.StatusNotFound),
+		Entry(nil, codes.AlreadyExists, http.StatusConflict),
+		Entry(nil, codes.Aborted, http.StatusConflict),
+		Entry(nil, codes.PermissionDenied, http.StatusForbidden),
+		Entry(nil, codes.Unauthenticated, http.StatusUnauthorized),
+		Entry(nil, codes.ResourceExhausted, http.StatusTooManyRequests),
+		Entry(nil, codes.Unimplemented, http.StatusNotImplemented),
+		Entry(nil, codes.Unavailable, http.StatusServiceUnavailable),
+		Entry(nil, codes.Internal, http.StatusInternalServerError),
+		Entry(nil, codes.Unknown, http.StatusInternalServerError),
+	)
+
+	Describe("serving the API", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			rpcsvr := &RpcServer{state: &globalState{sequences: sequencer.NewManager(nil, nil)}}
+			server = httptest.NewServer(newHandlerForHttpService(&HttpService{rpcsvr: rpcsvr}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("replies to unary calls with the JSON mapping of the reply", func() {
+			resp, err := http.Get(server.URL + "/environments/2oDvieFrVTi/sequences")
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Type")).To(Equal("application/json"))
+
+			reply := &pb.GetSequencesReply{}
+			decoder := json.NewDecoder(resp.Body)
+			var raw json.RawMessage
+			Expect(decoder.Decode(&raw)).To(Succeed())
+			Expect(protojson.Unmarshal(raw, reply)).To(Succeed())
+			Expect(reply.GetSequences()).To(BeEmpty())
+			Expect(reply.GetTimestamp()).NotTo(BeZero())
+		})
+
+		It("replies to failed calls with the status code and message", func() {
+			resp, err := http.Get(server.URL + "/environments/not-an-id/sequences")
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+			var httpErr httpError
+			Expect(json.NewDecoder(resp.Body).Decode(&httpErr)).To(Succeed())
+			Expect(httpErr.Code).To(Equal(codes.InvalidArgument.String()))
+			Expect(httpErr.Message).To(Equal("received bad environment id"))
+		})
+
+		It("replies to bad parameters before calling the server", func() {
+			resp, err := http.Get(server.URL + "/environments/2oDvieFrVTi/sequences?sequenceId=a&bogus=1")
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("streams events as server-sent events", func() {
+			// the response only starts with the first event, so the request
+			// runs on its own and events are written until one is received
+			type sseResult struct {
+				resp    *http.Response
+				payload string
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			received := make(chan sseResult, 1)
+			go func() {
+				defer GinkgoRecover()
+				httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/events?topics=environment&environmentId=2oDvieFrVTi", nil)
+				Expect(err).NotTo(HaveOccurred())
+				resp, err := http.DefaultClient.Do(httpReq)
+				if err != nil {
+					return
+				}
+				defer resp.Body.Close()
+				reader := bufio.NewReader(resp.Body)
+				for {
+					line, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if strings.HasPrefix(line, "data: ") {
+						received <- sseResult{resp: resp, payload: strings.TrimPrefix(strings.TrimSpace(line), "data: ")}
+						return
+					}
+				}
+			}()
+
+			var result sseResult
+			Eventually(func() bool {
+				the.EventWriterWithTopic(topic.Task).WriteEvent(&evpb.Ev_TaskEvent{EnvironmentId: "2oDvieFrVTi"})
+				the.EventWriterWithTopic(topic.Environment).WriteEvent(&evpb.Ev_EnvironmentEvent{EnvironmentId: "2oDvieFrVTi", State: "CONFIGURED"})
+				select {
+				case result = <-received:
+					return true
+				default:
+					return false
+				}
+			}).Should(BeTrue())
+
+			Expect(result.resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(result.resp.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+			ev := &evpb.Event{}
+			Expect(protojson.Unmarshal([]byte(result.payload), ev)).To(Succeed())
+			Expect(ev.GetEnvironmentEvent().GetState()).To(Equal("CONFIGURED"))
+		})
+
+		It("refuses event streams with bad filters", func() {
+			resp, err := http.Get(server.URL + "/events?detectors=NOPE")
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -47,7 +47,10 @@ func signals(state *globalState) {
 	// Goroutine executes a blocking receive for signals
 	go func() {
 		s := <-signal_chan
-		manageKillSignals(state)
+		// a standby instance has nothing to clean up, the tasks belong to the leader
+		if state.elector == nil || state.elector.IsLeader() {
+			manageKillSignals(state)
+		}
 
 		// Mesos calls are async.Sleep for 2s to mark tasks as completed.
 		time.Sleep(2 * time.Second)
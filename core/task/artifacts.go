@@ -0,0 +1,73 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/configuration/componentcfg"
+	"github.com/AliceO2Group/Control/configuration/template"
+	"github.com/AliceO2Group/Control/core/the"
+)
+
+// wrapArtifactFields returns the artifact fields which may contain template
+// expressions, i.e. URIs, checksums and destinations.
+func wrapArtifactFields(artifacts common.TaskArtifacts) (fields template.Fields) {
+	for i := range artifacts {
+		fields = append(fields,
+			template.WrapPointer(&artifacts[i].Uri),
+			template.WrapPointer(&artifacts[i].Checksum),
+			template.WrapPointer(&artifacts[i].Dest),
+		)
+	}
+	return
+}
+
+// fetchTaskArtifacts returns the artifacts of a task command for inclusion
+// in the launch payload for the executor, with the content of apricot
+// entries filled in, as the executor has no access to the configuration
+// service. Other artifacts are fetched by the executor itself.
+func fetchTaskArtifacts(cmd *common.TaskCommandInfo) (common.TaskArtifacts, error) {
+	if len(cmd.Fetch) == 0 {
+		return nil, nil
+	}
+	artifacts := cmd.Fetch.Copy()
+	for i := range artifacts {
+		if artifacts[i].Scheme() != common.ARTIFACT_SCHEME_APRICOT {
+			continue
+		}
+		query, err := componentcfg.NewQuery(artifacts[i].ApricotPath())
+		if err != nil {
+			return nil, fmt.Errorf("cannot fetch artifact %s: %w", artifacts[i].Uri, err)
+		}
+		content, err := the.ConfSvc().GetComponentConfiguration(query)
+		if err != nil {
+			return nil, fmt.Errorf("cannot fetch artifact %s: %w", artifacts[i].Uri, err)
+		}
+		artifacts[i].Content = &content
+	}
+	return artifacts, nil
+}
@@ -0,0 +1,100 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+)
+
+var _ = Describe("task artifacts", func() {
+	It("are read from task templates", func() {
+		class := new(taskclass.Class)
+		err := yaml.Unmarshal([]byte(`
+name: tof-calib
+fetch:
+  - uri: "https://alice-files.cern.ch/tof/calib-{{ version }}.tar.gz"
+    checksum: "sha256:{{ calib_checksum }}"
+    dest: calib
+    extract: true
+  - uri: apricot://tof/ANY/any/calib-params
+    dest: params.json
+command:
+  value: ./tof-calib.sh
+`), class)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(class.Fetch).To(HaveLen(2))
+		Expect(class.Fetch[0].Extract).To(BeTrue())
+		Expect(class.Fetch[0].Dest).To(Equal("calib"))
+		Expect(class.Fetch[1].Scheme()).To(Equal(common.ARTIFACT_SCHEME_APRICOT))
+		Expect(class.Fetch[1].ApricotPath()).To(Equal("tof/ANY/any/calib-params"))
+
+		out, err := yaml.Marshal(class)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("fetch:"))
+	})
+
+	It("are templated along with the task command", func() {
+		artifacts := common.TaskArtifacts{{Uri: "file:///data/{{ name }}", Dest: "{{ name }}"}}
+		fields := wrapArtifactFields(artifacts)
+		Expect(fields).To(HaveLen(3))
+	})
+
+	It("never keep apricot content in copies", func() {
+		content := "secret-ish"
+		artifacts := common.TaskArtifacts{{Uri: "apricot://tof/ANY/any/calib-params", Content: &content}}
+		Expect(artifacts.Copy()[0].Content).To(BeNil())
+	})
+
+	checksum := "sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	DescribeTable("are validated",
+		func(artifacts common.TaskArtifacts, errSubstring string) {
+			err := artifacts.Validate()
+			if len(errSubstring) == 0 {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(errSubstring)))
+			}
+		},
+		Entry("http with checksum", common.TaskArtifacts{{Uri: "https://host/a.sh", Checksum: checksum, Executable: true}}, ""),
+		Entry("local archive", common.TaskArtifacts{{Uri: "file:///opt/a.tgz", Extract: true}}, ""),
+		Entry("http without checksum", common.TaskArtifacts{{Uri: "https://host/a.sh"}}, "checksum is required"),
+		Entry("malformed checksum", common.TaskArtifacts{{Uri: "https://host/a.sh", Checksum: "md5:abc"}}, "sha256"),
+		Entry("relative file URI", common.TaskArtifacts{{Uri: "file://opt/a.sh"}}, "absolute path"),
+		Entry("unknown scheme", common.TaskArtifacts{{Uri: "ftp://host/a.sh"}}, "unsupported scheme"),
+		Entry("extracted apricot entry", common.TaskArtifacts{{Uri: "apricot://tof/ANY/any/params", Extract: true}}, "cannot be extracted"),
+		Entry("extracted non-archive", common.TaskArtifacts{{Uri: "file:///opt/a.sh", Extract: true}}, "supported archives"),
+		Entry("executable archive", common.TaskArtifacts{{Uri: "file:///opt/a.tgz", Extract: true, Executable: true}}, "cannot be executable"),
+		Entry("destination outside the sandbox", common.TaskArtifacts{{Uri: "file:///opt/a.sh", Dest: "../a.sh"}}, "relative to the sandbox"),
+		Entry("same destination", common.TaskArtifacts{
+			{Uri: "file:///opt/a/run.sh"},
+			{Uri: "file:///opt/b/run.sh"},
+		}, "same destination"),
+	)
+})
@@ -30,6 +30,7 @@ import (
 
 	"github.com/AliceO2Group/Control/core/task/channel"
 	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
 )
 
 /*
@@ -54,6 +55,10 @@ type Descriptor struct {
 	RoleConstraints constraint.Constraints
 	RoleConnect     []channel.Outbound
 	RoleBind        []channel.Inbound
+	RoleAffinity    *taskclass.Affinity
+	// The machine_id of the host the task should be deployed on if it is
+	// offering, otherwise any host satisfying the constraints will do
+	PreferredMachineId string
 	//CmdExtraEnv       []string
 	//CmdExtraArguments []string
 }
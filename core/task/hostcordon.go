@@ -0,0 +1,197 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+)
+
+const CORDONED_HOSTS_RUNTIME_KEY = "cordoned_hosts"
+
+// HostCordon describes a host which was taken out of scheduling for
+// maintenance. Offers from cordoned hosts are declined, while tasks already
+// running there are left alone until their environments release them.
+type HostCordon struct {
+	Hostname    string    `json:"hostname"`
+	Reason      string    `json:"reason,omitempty"`
+	RequestUser string    `json:"requestUser,omitempty"`
+	Since       time.Time `json:"since"`
+}
+
+// HostDrainStatus reports, for a cordoned host, which environments still
+// hold tasks there (keyed by environment ID), and which tasks on the host
+// are not claimed by any environment.
+type HostDrainStatus struct {
+	HostCordon
+	Environments  map[uid.ID][]string
+	UnlockedTasks []string
+}
+
+func (s HostDrainStatus) IsDrained() bool {
+	return len(s.Environments) == 0 && len(s.UnlockedTasks) == 0
+}
+
+type hostCordons struct {
+	mu    sync.RWMutex
+	hosts map[string]HostCordon
+}
+
+func newHostCordons() *hostCordons {
+	return &hostCordons{
+		hosts: make(map[string]HostCordon),
+	}
+}
+
+func (c *hostCordons) cordon(hostname string, reason string, requestUser string) (hc HostCordon, changed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if existing, ok := c.hosts[hostname]; ok {
+		return existing, false
+	}
+	hc = HostCordon{
+		Hostname:    hostname,
+		Reason:      reason,
+		RequestUser: requestUser,
+		Since:       time.Now(),
+	}
+	c.hosts[hostname] = hc
+	return hc, true
+}
+
+func (c *hostCordons) uncordon(hostname string) (changed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.hosts[hostname]; !ok {
+		return false
+	}
+	delete(c.hosts, hostname)
+	return true
+}
+
+func (c *hostCordons) get(hostname string) (hc HostCordon, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	hc, ok = c.hosts[hostname]
+	return
+}
+
+func (c *hostCordons) isCordoned(hostname string) bool {
+	_, ok := c.get(hostname)
+	return ok
+}
+
+// list returns the cordoned hosts sorted by hostname.
+func (c *hostCordons) list() []HostCordon {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	out := make([]HostCordon, 0, len(c.hosts))
+	for _, hc := range c.hosts {
+		out = append(out, hc)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Hostname < out[j].Hostname
+	})
+	return out
+}
+
+// schedulable returns the subset of offers which do not come from a
+// cordoned host.
+func (c *hostCordons) schedulable(offers []mesos.Offer) (schedulable []mesos.Offer) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if len(c.hosts) == 0 {
+		return offers
+	}
+	schedulable = make([]mesos.Offer, 0, len(offers))
+	for _, offer := range offers {
+		if _, ok := c.hosts[offer.Hostname]; ok {
+			continue
+		}
+		schedulable = append(schedulable, offer)
+	}
+	return
+}
+
+func (c *hostCordons) marshal() (string, error) {
+	payload, err := json.Marshal(c.list())
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+func (c *hostCordons) unmarshal(payload string) error {
+	var hosts []HostCordon
+	if len(payload) > 0 {
+		if err := json.Unmarshal([]byte(payload), &hosts); err != nil {
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.hosts = make(map[string]HostCordon, len(hosts))
+	for _, hc := range hosts {
+		if len(hc.Hostname) == 0 {
+			continue
+		}
+		c.hosts[hc.Hostname] = hc
+	}
+	return nil
+}
+
+// drainStatus groups the tasks running on hostname by the environment
+// which holds them.
+func drainStatus(hc HostCordon, tasks Tasks) (status HostDrainStatus) {
+	status = HostDrainStatus{
+		HostCordon:    hc,
+		Environments:  make(map[uid.ID][]string),
+		UnlockedTasks: make([]string, 0),
+	}
+	for _, t := range tasks {
+		if t == nil || t.GetHostname() != hc.Hostname {
+			continue
+		}
+		envId := t.GetEnvironmentId()
+		if !t.IsLocked() || envId.IsNil() {
+			status.UnlockedTasks = append(status.UnlockedTasks, t.GetTaskId())
+			continue
+		}
+		status.Environments[envId] = append(status.Environments[envId], t.GetTaskId())
+	}
+	return
+}
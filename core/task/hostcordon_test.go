@@ -0,0 +1,133 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type cordonTestParent struct {
+	parentRole
+	envId uid.ID
+}
+
+func (p cordonTestParent) GetEnvironmentId() uid.ID {
+	return p.envId
+}
+
+func cordonTestTask(id string, hostname string, envId uid.ID) *Task {
+	t := &Task{
+		taskId:     id,
+		hostname:   hostname,
+		agentId:    "agent-" + hostname,
+		offerId:    "offer-" + id,
+		executorId: "executor-" + hostname,
+	}
+	if !envId.IsNil() {
+		t.parent = cordonTestParent{envId: envId}
+	}
+	return t
+}
+
+var _ = Describe("host cordons", func() {
+	var cordons *hostCordons
+	BeforeEach(func() {
+		cordons = newHostCordons()
+	})
+
+	It("cordons and uncordons hosts only once", func() {
+		hc, changed := cordons.cordon("flp001", "disk replacement", "jdoe")
+		Expect(changed).To(BeTrue())
+		Expect(hc.Reason).To(Equal("disk replacement"))
+
+		again, changed := cordons.cordon("flp001", "another reason", "someone")
+		Expect(changed).To(BeFalse())
+		Expect(again).To(Equal(hc))
+
+		Expect(cordons.isCordoned("flp001")).To(BeTrue())
+		Expect(cordons.uncordon("flp001")).To(BeTrue())
+		Expect(cordons.uncordon("flp001")).To(BeFalse())
+		Expect(cordons.isCordoned("flp001")).To(BeFalse())
+	})
+
+	It("filters out offers from cordoned hosts", func() {
+		offers := []mesos.Offer{
+			{ID: mesos.OfferID{Value: "o1"}, Hostname: "flp001"},
+			{ID: mesos.OfferID{Value: "o2"}, Hostname: "flp002"},
+			{ID: mesos.OfferID{Value: "o3"}, Hostname: "flp001"},
+		}
+		Expect(cordons.schedulable(offers)).To(HaveLen(3))
+
+		cordons.cordon("flp001", "", "")
+		schedulable := cordons.schedulable(offers)
+		Expect(schedulable).To(HaveLen(1))
+		Expect(schedulable[0].Hostname).To(Equal("flp002"))
+	})
+
+	It("survives a round trip through its persisted form", func() {
+		cordons.cordon("flp002", "", "jdoe")
+		cordons.cordon("flp001", "network", "jdoe")
+		payload, err := cordons.marshal()
+		Expect(err).NotTo(HaveOccurred())
+
+		restored := newHostCordons()
+		Expect(restored.unmarshal(payload)).To(Succeed())
+		list := restored.list()
+		Expect(list).To(HaveLen(2))
+		Expect(list[0].Hostname).To(Equal("flp001"))
+		Expect(list[0].Reason).To(Equal("network"))
+		Expect(list[1].Hostname).To(Equal("flp002"))
+
+		Expect(restored.unmarshal("")).To(Succeed())
+		Expect(restored.list()).To(BeEmpty())
+		Expect(restored.unmarshal("{")).NotTo(Succeed())
+	})
+
+	It("reports which environments still hold tasks on a host", func() {
+		envA := uid.New()
+		envB := uid.New()
+		tasks := Tasks{
+			cordonTestTask("t1", "flp001", envA),
+			cordonTestTask("t2", "flp001", envA),
+			cordonTestTask("t3", "flp001", envB),
+			cordonTestTask("t4", "flp001", uid.NilID()),
+			cordonTestTask("t5", "flp002", envB),
+		}
+		hc, _ := cordons.cordon("flp001", "", "")
+
+		status := drainStatus(hc, tasks)
+		Expect(status.IsDrained()).To(BeFalse())
+		Expect(status.Environments).To(HaveLen(2))
+		Expect(status.Environments[envA]).To(ConsistOf("t1", "t2"))
+		Expect(status.Environments[envB]).To(ConsistOf("t3"))
+		Expect(status.UnlockedTasks).To(ConsistOf("t4"))
+
+		hc, _ = cordons.cordon("flp003", "", "")
+		Expect(drainStatus(hc, tasks).IsDrained()).To(BeTrue())
+	})
+})
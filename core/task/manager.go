@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -90,6 +91,11 @@ type Manager struct {
 
 	classes *taskclass.Classes
 	roster  *roster
+	cordons *hostCordons
+
+	departed *departedTasks
+
+	pendingNumaClaims *pendingNumaClaims
 
 	tasksToDeploy chan<- *ResourceOffersDeploymentRequest
 
@@ -131,10 +137,14 @@ func NewManager(shutdown func(), internalEventCh chan<- event.Event) (taskman *M
 	}
 
 	taskman = &Manager{
-		classes:         taskclass.NewClasses(),
-		roster:          newRoster(),
-		internalEventCh: internalEventCh,
+		classes:           taskclass.NewClasses(),
+		roster:            newRoster(),
+		cordons:           newHostCordons(),
+		departed:          newDepartedTasks(DEPARTED_TASKS_CACHE_SIZE),
+		pendingNumaClaims: newPendingNumaClaims(),
+		internalEventCh:   internalEventCh,
 	}
+	taskman.ReloadCordonedHosts()
 	schedState, err := NewScheduler(taskman, fidStore, shutdown)
 	if err != nil {
 		return nil, err
@@ -250,6 +260,103 @@ func (m *Manager) GetFrameworkID() string {
 	return m.schedulerState.GetFrameworkID()
 }
 
+// ReloadFrameworkID reads the Mesos framework ID again from the runtime
+// configuration, where another core instance might have stored it after
+// this one started.
+func (m *Manager) ReloadFrameworkID() {
+	if fidValue, err := the.ConfSvc().GetRuntimeEntry("aliecs", "mesos_fid"); err == nil && len(fidValue) > 0 {
+		store.SetOrPanic(m.schedulerState.fidStore)(fidValue)
+	}
+}
+
+// ReloadCordonedHosts reads the set of cordoned hosts again from the runtime
+// configuration.
+func (m *Manager) ReloadCordonedHosts() {
+	payload, err := the.ConfSvc().GetRuntimeEntry("aliecs", CORDONED_HOSTS_RUNTIME_KEY)
+	if err != nil {
+		return
+	}
+	if err = m.cordons.unmarshal(payload); err != nil {
+		log.WithError(err).
+			WithField("level", infologger.IL_Devel).
+			Warn("cannot parse cordoned hosts from configuration, assuming none")
+	}
+}
+
+func (m *Manager) storeCordonedHosts() error {
+	payload, err := m.cordons.marshal()
+	if err != nil {
+		return err
+	}
+	return the.ConfSvc().SetRuntimeEntry("aliecs", CORDONED_HOSTS_RUNTIME_KEY, payload)
+}
+
+// CordonHost excludes a host from scheduling: from now on, offers coming from
+// it are declined. Tasks already running on the host are not affected.
+func (m *Manager) CordonHost(hostname string, reason string, requestUser string) (hc HostCordon, err error) {
+	if len(hostname) == 0 {
+		return hc, errors.New("hostname cannot be empty")
+	}
+	hc, changed := m.cordons.cordon(hostname, reason, requestUser)
+	if !changed {
+		return
+	}
+	if err = m.storeCordonedHosts(); err != nil {
+		m.cordons.uncordon(hostname)
+		return hc, fmt.Errorf("cannot store cordoned hosts: %w", err)
+	}
+	log.WithField("hostname", hostname).
+		WithField("reason", reason).
+		WithField("level", infologger.IL_Ops).
+		Infof("host %s cordoned by %s", hostname, requestUser)
+	return
+}
+
+// UncordonHost makes a cordoned host available for scheduling again.
+func (m *Manager) UncordonHost(hostname string) (err error) {
+	hc, ok := m.cordons.get(hostname)
+	if !ok {
+		return fmt.Errorf("host %s is not cordoned", hostname)
+	}
+	m.cordons.uncordon(hostname)
+	if err = m.storeCordonedHosts(); err != nil {
+		m.cordons.cordon(hc.Hostname, hc.Reason, hc.RequestUser)
+		return fmt.Errorf("cannot store cordoned hosts: %w", err)
+	}
+	log.WithField("hostname", hostname).
+		WithField("level", infologger.IL_Ops).
+		Infof("host %s uncordoned", hostname)
+
+	return
+}
+
+// DrainHost cordons a host (if not cordoned already) and reports which
+// environments still hold tasks there. The host is drained once all those
+// environments release their tasks.
+func (m *Manager) DrainHost(hostname string, reason string, requestUser string) (status HostDrainStatus, err error) {
+	hc, err := m.CordonHost(hostname, reason, requestUser)
+	if err != nil {
+		return
+	}
+	return drainStatus(hc, m.roster.getTasks()), nil
+}
+
+// GetCordonedHosts returns the drain status of all cordoned hosts, sorted by
+// hostname.
+func (m *Manager) GetCordonedHosts() (statuses []HostDrainStatus) {
+	tasks := m.roster.getTasks()
+	cordoned := m.cordons.list()
+	statuses = make([]HostDrainStatus, len(cordoned))
+	for i, hc := range cordoned {
+		statuses[i] = drainStatus(hc, tasks)
+	}
+	return
+}
+
+func (m *Manager) IsHostCordoned(hostname string) bool {
+	return m.cordons.isCordoned(hostname)
+}
+
 func (m *Manager) removeInactiveClasses() {
 	_ = m.classes.Do(func(classMap *map[string]*taskclass.Class) error {
 		keys := make([]string, 0)
@@ -328,6 +435,23 @@ func (m *Manager) RefreshClasses(taskClassesRequired []string) (err error) {
 	return
 }
 
+// PreloadClasses loads the task classes of all the task templates found in the
+// currently checked out revision of each configuration repository.
+func (m *Manager) PreloadClasses() error {
+	taskClassesRequired := make([]string, 0)
+	for _, repo := range the.RepoManager().GetAllRepos() {
+		taskTemplates, err := filepath.Glob(filepath.Join(repo.GetCloneDir(), "tasks", "*.yaml"))
+		if err != nil {
+			return err
+		}
+		for _, taskTemplate := range taskTemplates {
+			taskClassName := strings.TrimSuffix(filepath.Base(taskTemplate), ".yaml")
+			taskClassesRequired = append(taskClassesRequired, repo.ResolveTaskClassIdentifier(taskClassName))
+		}
+	}
+	return m.RefreshClasses(taskClassesRequired)
+}
+
 // prefix will be prepended before name of descriptor when printing name of each descriptor
 func logDescriptors(prefix string, logFunc func(format string, args ...interface{}), descriptors Descriptors) {
 	for _, desc := range descriptors {
@@ -574,8 +698,18 @@ func (m *Manager) acquireTasks(envId uid.ID, taskDescriptors Descriptors) (err e
 				logWithId.WithField("level", infologger.IL_Devel).
 					Errorf("environment deployment failure: %d tasks requested for deployment, but %d deployed", len(tasksToRunThisAttempt), len(deployedThisAttempt))
 
+				// Critical roles whose failure is absorbed by a quorum are treated
+				// as non-critical, and marked UNDEPLOYABLE further down.
+				failedRolePaths := make([]string, 0, len(undeployedDescriptors)+len(undeployableDescriptors))
+				for _, desc := range undeployedDescriptors {
+					failedRolePaths = append(failedRolePaths, desc.TaskRole.GetPath())
+				}
+				for _, desc := range undeployableDescriptors {
+					failedRolePaths = append(failedRolePaths, desc.TaskRole.GetPath())
+				}
+
 				for _, desc := range undeployedDescriptors {
-					if desc.TaskRole.GetTaskTraits().Critical == true {
+					if desc.TaskRole.GetTaskTraits().Critical == true && !quorumTolerates(desc.TaskRole, failedRolePaths) {
 						deploymentSuccess = false
 						undeployedCriticalDescriptors = append(undeployedCriticalDescriptors, desc)
 					} else {
@@ -584,7 +718,7 @@ func (m *Manager) acquireTasks(envId uid.ID, taskDescriptors Descriptors) (err e
 				}
 
 				for _, desc := range undeployableDescriptors {
-					if desc.TaskRole.GetTaskTraits().Critical == true {
+					if desc.TaskRole.GetTaskTraits().Critical == true && !quorumTolerates(desc.TaskRole, failedRolePaths) {
 						deploymentSuccess = false
 						undeployableCriticalDescriptors = append(undeployableCriticalDescriptors, desc)
 					} else {
@@ -634,6 +768,17 @@ func (m *Manager) acquireTasks(envId uid.ID, taskDescriptors Descriptors) (err e
 			desc.TaskRole.UpdateStatus(UNDEPLOYABLE)
 		}
 	}
+	// Critical tasks tolerated by a quorum must be marked as failed too, or
+	// the workflow would wait for them to become active.
+	if deploymentSuccess {
+		for _, desc := range undeployedNonCriticalDescriptors {
+			if desc.TaskRole.GetTaskTraits().Critical == true {
+				logWithId.WithField("level", infologger.IL_Ops).
+					Warnf("critical task deployment failure tolerated by quorum: %s", desc.TaskRole.GetPath())
+				desc.TaskRole.UpdateStatus(UNDEPLOYABLE)
+			}
+		}
+	}
 
 	m.deployMu.Unlock()
 
@@ -666,6 +811,18 @@ func (m *Manager) acquireTasks(envId uid.ID, taskDescriptors Descriptors) (err e
 	return
 }
 
+// failedRolePaths returns the role paths of the known tasks which reported an
+// error, so that quorums can be evaluated against all the failures at once.
+func (m *Manager) failedRolePaths(errs map[controlcommands.MesosCommandTarget]error) (paths []string) {
+	paths = make([]string, 0, len(errs))
+	for k := range errs {
+		if t := m.GetTask(k.TaskId.Value); t != nil && t.GetParent() != nil {
+			paths = append(paths, t.GetParent().GetPath())
+		}
+	}
+	return
+}
+
 func (m *Manager) releaseTasks(envId uid.ID, tasks Tasks) {
 	taskReleaseErrors := make(map[string]error)
 	taskIdsReleased := make([]string, 0)
@@ -700,7 +857,9 @@ func (m *Manager) releaseTask(envId uid.ID, task *Task) error {
 	return nil
 }
 
-func (m *Manager) configureTasks(envId uid.ID, tasks Tasks) error {
+// configureTasks pushes CONFIGURE to tasks, with a bindMap built from the
+// inbound channels of peers, which must include tasks themselves.
+func (m *Manager) configureTasks(envId uid.ID, tasks Tasks, peers Tasks) error {
 	notify := make(chan controlcommands.MesosCommandResponse)
 	receivers, err := tasks.GetMesosCommandTargets()
 	if err != nil {
@@ -714,7 +873,7 @@ func (m *Manager) configureTasks(envId uid.ID, tasks Tasks) error {
 	// We fetch each task's local bindMap to generate a global bindMap for the whole Tasks slice,
 	// i.e. a map of the paths of registered inbound channels and their ports.
 	bindMap := make(channel.BindMap)
-	for _, task := range tasks {
+	for _, task := range peers {
 		if task.GetParent() == nil { // Crash reported here by Roberto 6/2022
 			return fmt.Errorf("task %s on %s has nil parent, this should never happen", task.GetClassName(), task.GetHostname())
 		}
@@ -751,6 +910,9 @@ func (m *Manager) configureTasks(envId uid.ID, tasks Tasks) error {
 	if err != nil {
 		return err
 	}
+	for _, task := range tasks {
+		task.setConfiguredPropertyMap(bindMap, args[task.GetMesosCommandTarget()])
+	}
 	log.WithField("map", pp.Sprint(args)).
 		WithField("partition", envId.String()).
 		Debug("pushing configuration to tasks")
@@ -770,6 +932,7 @@ func (m *Manager) configureTasks(envId uid.ID, tasks Tasks) error {
 		taskCriticalErrors := make([]string, 0)
 		taskNonCriticalErrors := make([]string, 0)
 		i := 0
+		failedRolePaths := m.failedRolePaths(response.Errors())
 		for k, v := range response.Errors() {
 			task := m.GetTask(k.TaskId.Value)
 			var taskDescription string
@@ -778,7 +941,11 @@ func (m *Manager) configureTasks(envId uid.ID, tasks Tasks) error {
 			} else {
 				taskDescription = fmt.Sprintf("unknown task (id %s) failed with error: %s", k.TaskId.Value, v.Error())
 			}
-			if task != nil && task.GetTraits().Critical {
+			if task != nil && task.FailureTolerated(failedRolePaths) {
+				// a failed task whose role's quorum is still met is set aside in ERROR
+				taskNonCriticalErrors = append(taskNonCriticalErrors, taskDescription+" (tolerated by quorum)")
+				go m.updateTaskState(task.GetTaskId(), "ERROR")
+			} else if task != nil && task.GetTraits().Critical {
 				taskCriticalErrors = append(taskCriticalErrors, taskDescription)
 			} else if task != nil && task.parent != nil && task.parent.GetTaskTraits().Critical {
 				taskCriticalErrors = append(taskCriticalErrors, taskDescription)
@@ -829,6 +996,40 @@ func (m *Manager) transitionTasks(envId uid.ID, tasks Tasks, src string, event s
 		}
 	}
 
+	// Property values changed since CONFIGURE (see Task.SetPendingProperties)
+	// are pushed along with START, and only stop being pending once the task
+	// accepted them
+	pushed := make(map[*Task]controlcommands.PropertyMap)
+	commitPushed := func(failed map[controlcommands.MesosCommandTarget]error) {
+		failedTaskIds := make(map[string]struct{}, len(failed))
+		for target := range failed {
+			failedTaskIds[target.TaskId.Value] = struct{}{}
+		}
+		for task, pending := range pushed {
+			if _, hasFailed := failedTaskIds[task.GetTaskId()]; !hasFailed {
+				task.commitPendingProperties(pending)
+			}
+		}
+	}
+	if event == "START" {
+		for _, task := range tasks {
+			pending := task.getPendingProperties()
+			if len(pending) == 0 {
+				continue
+			}
+			pushed[task] = pending
+			rec := task.GetMesosCommandTarget()
+			if _, ok := args[rec]; !ok {
+				args[rec] = make(controlcommands.PropertyMap)
+			}
+			for k, v := range pending {
+				if _, isCommonArg := commonArgs[k]; !isCommonArg {
+					args[rec][k] = v
+				}
+			}
+		}
+	}
+
 	cmd := controlcommands.NewMesosCommand_Transition(envId, receivers, src, event, dest, args)
 	_ = m.cq.Enqueue(cmd, notify)
 
@@ -840,9 +1041,11 @@ func (m *Manager) transitionTasks(envId uid.ID, tasks Tasks, src string, event s
 	}
 
 	if response.IsMultiResponse() {
+		commitPushed(response.Errors())
 		taskCriticalErrors := make([]string, 0)
 		taskNonCriticalErrors := make([]string, 0)
 		i := 0
+		failedRolePaths := m.failedRolePaths(response.Errors())
 		for k, v := range response.Errors() {
 			task := m.GetTask(k.TaskId.Value)
 			var taskDescription string
@@ -858,7 +1061,11 @@ func (m *Manager) transitionTasks(envId uid.ID, tasks Tasks, src string, event s
 			} else {
 				taskDescription = fmt.Sprintf("unknown task (id %s) failed with error: %s", k.TaskId.Value, v.Error())
 			}
-			if task != nil && task.GetTraits().Critical {
+			if task != nil && task.FailureTolerated(failedRolePaths) {
+				// a failed task whose role's quorum is still met is set aside in ERROR
+				taskNonCriticalErrors = append(taskNonCriticalErrors, taskDescription+" (tolerated by quorum)")
+				go m.updateTaskState(task.GetTaskId(), "ERROR")
+			} else if task != nil && task.GetTraits().Critical {
 				taskCriticalErrors = append(taskCriticalErrors, taskDescription)
 			} else if task != nil && task.parent != nil && task.parent.GetTaskTraits().Critical {
 				taskCriticalErrors = append(taskCriticalErrors, taskDescription)
@@ -886,10 +1093,83 @@ func (m *Manager) transitionTasks(envId uid.ID, tasks Tasks, src string, event s
 			// FIXME: improve error handling ↑
 		}
 	}
+	commitPushed(nil)
 
 	return nil
 }
 
+// ConfigureTask pushes CONFIGURE to a single task of an environment, with the
+// channel endpoints of its peers, which must include the task itself.
+// It returns once the task has replied or the transition has timed out.
+func (m *Manager) ConfigureTask(envId uid.ID, task *Task, peers Tasks) error {
+	return m.configureTasks(envId, Tasks{task}, peers)
+}
+
+// TransitionTask pushes a transition to a single task of an environment.
+// It returns once the task has replied or the transition has timed out.
+func (m *Manager) TransitionTask(envId uid.ID, task *Task, src string, event string, dest string, args controlcommands.PropertyMap) error {
+	return m.transitionTasks(envId, Tasks{task}, src, event, dest, args)
+}
+
+// RestartTask kills a task of an environment and deploys a replacement for it
+// from descriptor, on the same host if it is still offering resources, or on
+// any host satisfying the constraints of the role. The task must already be detached from
+// its role, so that neither its termination nor its absence are propagated to
+// the environment, and descriptor must be generated by the now empty role.
+// It returns the replacement once it is ACTIVE and in STANDBY, or an error if
+// this does not happen within timeout.
+func (m *Manager) RestartTask(envId uid.ID, task *Task, descriptor *Descriptor, timeout time.Duration) (replacement *Task, err error) {
+	if task == nil || descriptor == nil {
+		return nil, errors.New("cannot restart nil task")
+	}
+	if task.IsLocked() {
+		return nil, TaskLockedError{taskErrorBase: taskErrorBase{taskId: task.GetTaskId()}, envId: envId}
+	}
+
+	// The replacement goes to the same machine if we know which one it is, and
+	// it is still around
+	if aci := m.AgentCache.Get(mesos.AgentID{Value: task.GetAgentId()}); aci != nil {
+		if machineId, ok := aci.Attributes.Get("machine_id"); ok {
+			descriptor.PreferredMachineId = machineId
+		}
+	}
+
+	// If the task is not ACTIVE, it is only removed from the roster
+	_, _, err = m.KillTasks([]string{task.GetTaskId()})
+	if err != nil {
+		return nil, fmt.Errorf("cannot kill task %s: %w", task.GetTaskId(), err)
+	}
+
+	err = m.acquireTasks(envId, Descriptors{descriptor})
+	if err != nil {
+		return nil, err
+	}
+
+	task.mu.RLock()
+	restartAttempts := task.restartAttempts
+	task.mu.RUnlock()
+
+	deadline := time.Now().Add(timeout)
+	for {
+		replacements := m.roster.filtered(func(t *Task) bool {
+			return t.GetParent() == descriptor.TaskRole &&
+				t.GetStatus() == ACTIVE &&
+				t.GetState() == sm.STANDBY
+		})
+		if len(replacements) > 0 {
+			replacement = replacements[0]
+			replacement.mu.Lock()
+			replacement.restartAttempts = restartAttempts
+			replacement.mu.Unlock()
+			return replacement, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("replacement for task %s did not become active within %s", task.GetTaskId(), timeout.String())
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
 func (m *Manager) TriggerHooks(envId uid.ID, tasks Tasks) error {
 	if len(tasks) == 0 {
 		return nil
@@ -1170,6 +1450,10 @@ func (m *Manager) doKillTasks(tasks Tasks) (killed Tasks, running Tasks, err err
 
 	running = m.roster.getTasks()
 
+	// Their executors keep the logs for a while, so we remember where they ran
+	m.rememberDepartedTasks(inactiveTasks)
+	m.rememberDepartedTasks(killed)
+
 	return
 }
 
@@ -1218,7 +1502,7 @@ func (m *Manager) handleMessage(tm *TaskmanMessage) error {
 		}()
 	case taskop.ConfigureTasks:
 		go func() {
-			err := m.configureTasks(tm.GetEnvironmentId(), tm.GetTasks())
+			err := m.configureTasks(tm.GetEnvironmentId(), tm.GetTasks(), tm.GetTasks())
 			m.internalEventCh <- event.NewTasksStateChangedEvent(tm.GetEnvironmentId(), tm.GetTasks().GetTaskIds(), err)
 		}()
 	case taskop.TransitionTasks:
@@ -1259,7 +1543,7 @@ func (m *Manager) handleMessage(tm *TaskmanMessage) error {
 				Error("task inactive exception")
 			taskIDValue := mesosStatus.GetTaskID().Value
 			t := m.GetTask(taskIDValue)
-			if t != nil && t.IsLocked() {
+			if t != nil && t.IsLocked() && !m.requestTaskRestart(t) {
 				go m.updateTaskState(taskIDValue, "ERROR")
 			}
 		}
@@ -1267,6 +1551,8 @@ func (m *Manager) handleMessage(tm *TaskmanMessage) error {
 		// This will check if the task update is from a reconciliation, as well as whether the task
 		// is in a state in which a mesos Kill call is possible.
 		// Reconcilation tasks are not part of the taskman.roster
+		// After a failover this kills all the tasks of the previous leader,
+		// since its environments are not carried over.
 		if mesosStatus.GetReason().String() == "REASON_RECONCILIATION" &&
 			(mesosState == mesos.TASK_STAGING ||
 				mesosState == mesos.TASK_STARTING ||
@@ -1313,6 +1599,31 @@ func (m *Manager) handleMessage(tm *TaskmanMessage) error {
 	return nil
 }
 
+// requestTaskRestart asks the environment manager to relaunch a task which
+// died unexpectedly, if its restart policy allows it. If it returns false, the
+// task failure must be handled as usual.
+func (m *Manager) requestTaskRestart(t *Task) bool {
+	policy := t.GetRestartPolicy()
+	if policy.Mode != taskclass.RestartOnFailure {
+		return false
+	}
+
+	attempt := t.IncrementRestartAttempts()
+	if attempt > policy.MaxAttempts {
+		log.WithField("partition", t.GetEnvironmentId().String()).
+			WithField("taskId", t.GetTaskId()).
+			WithField(infologger.Level, infologger.IL_Ops).
+			Errorf("task of role %s failed, but its %d restart attempts are exhausted", t.GetParentRolePath(), policy.MaxAttempts)
+		return false
+	}
+
+	restartEvent := event.NewTaskRestartRequestedEvent(t.GetEnvironmentId(), t.GetTaskId(), attempt, policy.MaxAttempts, policy.BackoffForAttempt(attempt))
+	go func() {
+		m.internalEventCh <- restartEvent
+	}()
+	return true
+}
+
 func (m *Manager) HandleExecutorFailed(e *event.ExecutorFailedEvent) map[uid.ID]struct{} {
 	// returns the set of environment ids affected by the failed executor
 	if len(e.ExecutorId.Value) == 0 {
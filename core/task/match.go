@@ -40,6 +40,10 @@ type Wants struct {
 	Memory          float64
 	StaticPorts     port.Ranges
 	InboundChannels []channel.Inbound
+	// NUMA node the task must run on, if any
+	NumaNode *int
+	// CPUs the task must run on, if any
+	Cpus []int
 }
 
 type Limits struct {
@@ -96,6 +100,18 @@ func (m *Manager) GetWantsForDescriptor(descriptor *Descriptor, envId uid.ID) (r
 				Tracef("missing ports resource requirement for requested task class %s", descriptor.TaskClassName)
 		}
 		r.InboundChannels = channel.MergeInbound(descriptor.RoleBind, taskClass.Bind)
+
+		// the affinity set on the task role takes precedence over the task class
+		affinity := descriptor.RoleAffinity.Merge(&wants.Affinity)
+		var numaNode int
+		numaNode, r.Cpus, err = affinity.Parse()
+		if err != nil {
+			err = fmt.Errorf("invalid affinity for task class %s: %w", descriptor.TaskClassName, err)
+			return nil, err
+		}
+		if numaNode >= 0 {
+			r.NumaNode = &numaNode
+		}
 	} else {
 		err = fmt.Errorf("task class %s not found in manager", descriptor.TaskClassName)
 	}
@@ -0,0 +1,298 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+)
+
+// NUMA topology is reported by the Mesos agents as attributes, one pair per
+// node: numa<N>_cpus holds the CPU list of node N (e.g. 0-15,32-47) and
+// numa<N>_memory its memory in MB.
+var numaAttributeRegexp = regexp.MustCompile(`^numa(\d+)_(cpus|memory)$`)
+
+// NUMA claims of launched tasks are counted for at most this long before the
+// tasks show up in the roster, for when a deployment fails midway.
+const NUMA_PENDING_CLAIM_TIMEOUT = 5 * time.Minute
+
+type numaNode struct {
+	cpus []int
+	// memory in MB, 0 if unknown
+	memory float64
+}
+
+type numaTopology map[int]numaNode
+
+func numaTopologyFromAttributes(attributes []mesos.Attribute) numaTopology {
+	topology := make(numaTopology)
+	for _, attr := range attributes {
+		match := numaAttributeRegexp.FindStringSubmatch(attr.GetName())
+		if match == nil {
+			continue
+		}
+		id, err := strconv.Atoi(match[1])
+		if err != nil {
+			continue
+		}
+		node := topology[id]
+		value := attributeValueString(attr)
+		switch match[2] {
+		case "cpus":
+			cpus, err := common.ParseCpuList(value)
+			if err != nil {
+				log.WithPrefix("scheduler").
+					WithField("attribute", attr.GetName()).
+					WithError(err).
+					Warn("invalid NUMA CPU list in agent attributes")
+				continue
+			}
+			node.cpus = cpus
+		case "memory":
+			memory, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				log.WithPrefix("scheduler").
+					WithField("attribute", attr.GetName()).
+					WithError(err).
+					Warn("invalid NUMA memory size in agent attributes")
+				continue
+			}
+			node.memory = memory
+		}
+		topology[id] = node
+	}
+
+	// a node is only usable if we know its CPUs
+	for id, node := range topology {
+		if len(node.cpus) == 0 {
+			delete(topology, id)
+		}
+	}
+	return topology
+}
+
+func (topology numaTopology) hasCpus(cpus []int) bool {
+	for _, cpu := range cpus {
+		found := false
+		for _, node := range topology {
+			if containsCpu(node.cpus, cpu) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func attributeValueString(attr mesos.Attribute) string {
+	switch attr.GetType() {
+	case mesos.SCALAR:
+		return strconv.FormatFloat(attr.GetScalar().GetValue(), 'f', -1, 64)
+	case mesos.RANGES:
+		items := make([]string, 0)
+		for _, r := range attr.GetRanges().GetRange() {
+			items = append(items, fmt.Sprintf("%d-%d", r.Begin, r.End))
+		}
+		return strings.Join(items, ",")
+	default:
+		return attr.GetText().GetValue()
+	}
+}
+
+func containsCpu(cpus []int, cpu int) bool {
+	i := sort.SearchInts(cpus, cpu)
+	return i < len(cpus) && cpus[i] == cpu
+}
+
+// numaClaim is the share of a NUMA node claimed by a task pinned to it.
+type numaClaim struct {
+	hostname string
+	node     int
+	cpu      float64
+	memory   float64
+}
+
+type numaLoad struct {
+	cpu    float64
+	memory float64
+}
+
+// pendingNumaClaims holds the claims of tasks which were launched but are
+// not in the roster yet.
+type pendingNumaClaims struct {
+	mu     sync.Mutex
+	claims map[string]pendingNumaClaim
+}
+
+type pendingNumaClaim struct {
+	numaClaim
+	since time.Time
+}
+
+func newPendingNumaClaims() *pendingNumaClaims {
+	return &pendingNumaClaims{
+		claims: make(map[string]pendingNumaClaim),
+	}
+}
+
+func (p *pendingNumaClaims) add(taskId string, claim numaClaim) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.claims[taskId] = pendingNumaClaim{numaClaim: claim, since: time.Now()}
+}
+
+// numaUsage is the load on the NUMA nodes of each host, as claimed by the
+// tasks pinned to a node. It is built for each round of offers.
+type numaUsage struct {
+	mu      sync.Mutex
+	load    map[string]map[int]numaLoad
+	pending *pendingNumaClaims
+}
+
+func newNumaUsage(tasks Tasks, pending *pendingNumaClaims) *numaUsage {
+	u := &numaUsage{
+		load:    make(map[string]map[int]numaLoad),
+		pending: pending,
+	}
+	inRoster := make(map[string]struct{}, len(tasks))
+	for _, t := range tasks {
+		if claim := t.getNumaClaim(); claim != nil {
+			u.addLoad(*claim)
+		}
+		inRoster[t.GetTaskId()] = struct{}{}
+	}
+
+	if pending != nil {
+		pending.mu.Lock()
+		defer pending.mu.Unlock()
+		for taskId, claim := range pending.claims {
+			if _, ok := inRoster[taskId]; ok || time.Since(claim.since) > NUMA_PENDING_CLAIM_TIMEOUT {
+				delete(pending.claims, taskId)
+				continue
+			}
+			u.addLoad(claim.numaClaim)
+		}
+	}
+	return u
+}
+
+func (u *numaUsage) addLoad(claim numaClaim) {
+	hostLoad, ok := u.load[claim.hostname]
+	if !ok {
+		hostLoad = make(map[int]numaLoad)
+		u.load[claim.hostname] = hostLoad
+	}
+	nodeLoad := hostLoad[claim.node]
+	nodeLoad.cpu += claim.cpu
+	nodeLoad.memory += claim.memory
+	hostLoad[claim.node] = nodeLoad
+}
+
+// place checks the NUMA node and cpuset wanted by a task against the
+// topology of the offering host, and returns the affinity to apply to it
+// along with the resulting claim, if any. Tasks with no affinity wants get a
+// nil affinity.
+func (u *numaUsage) place(offer *mesos.Offer, wants *Wants) (affinity *common.TaskAffinity, claim *numaClaim, err error) {
+	if wants.NumaNode == nil && len(wants.Cpus) == 0 {
+		return
+	}
+	topology := numaTopologyFromAttributes(offer.Attributes)
+
+	if wants.NumaNode == nil {
+		// without NUMA attributes we trust the cpuset, otherwise we check it
+		if len(topology) > 0 && !topology.hasCpus(wants.Cpus) {
+			err = fmt.Errorf("cpuset %s not available on host %s", common.FormatCpuList(wants.Cpus), offer.Hostname)
+			return
+		}
+		affinity = &common.TaskAffinity{Cpus: common.FormatCpuList(wants.Cpus)}
+		return
+	}
+
+	nodeId := *wants.NumaNode
+	node, ok := topology[nodeId]
+	if !ok {
+		err = fmt.Errorf("host %s does not report NUMA node %d", offer.Hostname, nodeId)
+		return
+	}
+	cpus := node.cpus
+	if len(wants.Cpus) > 0 {
+		if !(numaTopology{nodeId: node}).hasCpus(wants.Cpus) {
+			err = fmt.Errorf("cpuset %s not within NUMA node %d of host %s", common.FormatCpuList(wants.Cpus), nodeId, offer.Hostname)
+			return
+		}
+		cpus = wants.Cpus
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	load := u.load[offer.Hostname][nodeId]
+	if load.cpu+wants.Cpu > float64(len(node.cpus)) {
+		err = fmt.Errorf("not enough CPU left on NUMA node %d of host %s: %g of %d claimed, %g wanted",
+			nodeId, offer.Hostname, load.cpu, len(node.cpus), wants.Cpu)
+		return
+	}
+	if node.memory > 0 && load.memory+wants.Memory > node.memory {
+		err = fmt.Errorf("not enough memory left on NUMA node %d of host %s: %g of %g MB claimed, %g MB wanted",
+			nodeId, offer.Hostname, load.memory, node.memory, wants.Memory)
+		return
+	}
+
+	affinity = &common.TaskAffinity{
+		Cpus:        common.FormatCpuList(cpus),
+		MemoryNodes: strconv.Itoa(nodeId),
+	}
+	claim = &numaClaim{
+		hostname: offer.Hostname,
+		node:     nodeId,
+		cpu:      wants.Cpu,
+		memory:   wants.Memory,
+	}
+	return
+}
+
+// claim records the NUMA resources of a task which is being launched, so
+// that the following placements in this and later rounds of offers see them.
+func (u *numaUsage) claim(taskId string, claim *numaClaim) {
+	if claim == nil {
+		return
+	}
+	u.mu.Lock()
+	u.addLoad(*claim)
+	u.mu.Unlock()
+	if u.pending != nil {
+		u.pending.add(taskId, *claim)
+	}
+}
@@ -0,0 +1,167 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func numaTestOffer(hostname string) *mesos.Offer {
+	text := func(name string, value string) mesos.Attribute {
+		return mesos.Attribute{
+			Name: name,
+			Type: mesos.TEXT,
+			Text: &mesos.Value_Text{Value: value},
+		}
+	}
+	return &mesos.Offer{
+		Hostname: hostname,
+		Attributes: []mesos.Attribute{
+			text("numa0_cpus", "0-3"),
+			text("numa0_memory", "1000"),
+			{
+				Name:   "numa1_cpus",
+				Type:   mesos.RANGES,
+				Ranges: &mesos.Value_Ranges{Range: []mesos.Value_Range{{Begin: 4, End: 7}}},
+			},
+			text("numa2_memory", "1000"),
+			text("rack", "r1"),
+		},
+	}
+}
+
+func numaTestWants(node int, cpu float64, memory float64, cpus ...int) *Wants {
+	return &Wants{Cpu: cpu, Memory: memory, NumaNode: &node, Cpus: cpus}
+}
+
+var _ = Describe("NUMA placement", func() {
+	It("parses the CPU list format", func() {
+		cpus, err := common.ParseCpuList("8,0-2, 4-5,1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cpus).To(Equal([]int{0, 1, 2, 4, 5, 8}))
+		Expect(common.FormatCpuList(cpus)).To(Equal("0-2,4-5,8"))
+
+		_, err = common.ParseCpuList("3-1")
+		Expect(err).To(HaveOccurred())
+		_, err = common.ParseCpuList("a")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("reads the topology from agent attributes", func() {
+		topology := numaTopologyFromAttributes(numaTestOffer("flp001").Attributes)
+		Expect(topology).To(HaveLen(2))
+		Expect(topology[0].cpus).To(Equal([]int{0, 1, 2, 3}))
+		Expect(topology[0].memory).To(Equal(1000.0))
+		Expect(topology[1].cpus).To(Equal([]int{4, 5, 6, 7}))
+		Expect(topology[1].memory).To(BeZero())
+	})
+
+	It("leaves tasks without affinity wants alone", func() {
+		u := newNumaUsage(Tasks{}, nil)
+		affinity, claim, err := u.place(numaTestOffer("flp001"), &Wants{Cpu: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(affinity).To(BeNil())
+		Expect(claim).To(BeNil())
+	})
+
+	It("binds tasks to the CPUs and memory of their node until it is full", func() {
+		u := newNumaUsage(Tasks{}, nil)
+		offer := numaTestOffer("flp001")
+
+		affinity, claim, err := u.place(offer, numaTestWants(0, 3, 600))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*affinity).To(Equal(common.TaskAffinity{Cpus: "0-3", MemoryNodes: "0"}))
+		u.claim("t1", claim)
+
+		_, _, err = u.place(offer, numaTestWants(0, 1, 600))
+		Expect(err).To(MatchError(ContainSubstring("not enough memory")))
+		_, _, err = u.place(offer, numaTestWants(0, 2, 100))
+		Expect(err).To(MatchError(ContainSubstring("not enough CPU")))
+
+		// other hosts and nodes are not affected
+		_, _, err = u.place(numaTestOffer("flp002"), numaTestWants(0, 2, 100))
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = u.place(offer, numaTestWants(1, 2, 100000))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("checks cpusets against the topology", func() {
+		u := newNumaUsage(Tasks{}, nil)
+		offer := numaTestOffer("flp001")
+
+		affinity, _, err := u.place(offer, numaTestWants(1, 1, 0, 5, 6))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*affinity).To(Equal(common.TaskAffinity{Cpus: "5-6", MemoryNodes: "1"}))
+
+		_, _, err = u.place(offer, numaTestWants(0, 1, 0, 5))
+		Expect(err).To(HaveOccurred())
+		_, _, err = u.place(offer, numaTestWants(2, 1, 0))
+		Expect(err).To(HaveOccurred())
+
+		affinity, claim, err := u.place(offer, &Wants{Cpu: 1, Cpus: []int{3, 4}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(claim).To(BeNil())
+		Expect(*affinity).To(Equal(common.TaskAffinity{Cpus: "3-4"}))
+		_, _, err = u.place(offer, &Wants{Cpu: 1, Cpus: []int{8}})
+		Expect(err).To(HaveOccurred())
+
+		// hosts which do not report their topology are trusted with cpusets
+		_, _, err = u.place(&mesos.Offer{Hostname: "flp003"}, &Wants{Cpu: 1, Cpus: []int{8}})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("counts pending claims until the task is in the roster or the claim expires", func() {
+		pending := newPendingNumaClaims()
+		u := newNumaUsage(Tasks{}, pending)
+		_, claim, err := u.place(numaTestOffer("flp001"), numaTestWants(0, 4, 0))
+		Expect(err).NotTo(HaveOccurred())
+		u.claim("t1", claim)
+
+		u = newNumaUsage(Tasks{}, pending)
+		_, _, err = u.place(numaTestOffer("flp001"), numaTestWants(0, 1, 0))
+		Expect(err).To(HaveOccurred())
+
+		t := &Task{taskId: "t1", hostname: "flp001", numaClaim: claim}
+		u = newNumaUsage(Tasks{t}, pending)
+		Expect(pending.claims).To(BeEmpty())
+		_, _, err = u.place(numaTestOffer("flp001"), numaTestWants(0, 1, 0))
+		Expect(err).To(HaveOccurred())
+
+		pending.add("t2", numaClaim{hostname: "flp002", node: 0, cpu: 4})
+		pending.claims["t2"] = pendingNumaClaim{
+			numaClaim: pending.claims["t2"].numaClaim,
+			since:     time.Now().Add(-2 * NUMA_PENDING_CLAIM_TIMEOUT),
+		}
+		u = newNumaUsage(Tasks{}, pending)
+		Expect(pending.claims).To(BeEmpty())
+		_, _, err = u.place(numaTestOffer("flp002"), numaTestWants(0, 4, 0))
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -0,0 +1,136 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/configuration/template"
+)
+
+// wrapProbeFields returns the probe fields which may contain template
+// expressions, i.e. commands, hosts, ports and paths.
+func wrapProbeFields(probes *common.TaskProbes) (fields template.Fields) {
+	if probes == nil {
+		return
+	}
+	for _, probe := range []*common.TaskProbe{probes.Liveness, probes.Readiness} {
+		if probe == nil {
+			continue
+		}
+		switch {
+		case probe.Exec != nil:
+			fields = append(fields, template.WrapSliceItems(probe.Exec.Command)...)
+		case probe.HttpGet != nil:
+			fields = append(fields,
+				template.WrapPointer(&probe.HttpGet.Host),
+				template.WrapPointer(&probe.HttpGet.Port),
+				template.WrapPointer(&probe.HttpGet.Path),
+			)
+		case probe.TcpSocket != nil:
+			fields = append(fields,
+				template.WrapPointer(&probe.TcpSocket.Host),
+				template.WrapPointer(&probe.TcpSocket.Port),
+			)
+		}
+	}
+	return
+}
+
+// IsReady returns false while the readiness probe of the task is failing.
+func (t *Task) IsReady() bool {
+	if t == nil {
+		return false
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return !t.unready
+}
+
+// updateTaskProbe handles a probe report from the executor. A failed
+// liveness probe is handled like the death of the task, so the task is
+// restarted if its restart policy allows it and goes to ERROR otherwise.
+// A failed readiness probe marks the task as not ready until the probe
+// succeeds again, the task status is left alone because the task is still
+// running and can still be transitioned.
+func (m *Manager) updateTaskProbe(e *event.TaskProbeEvent) {
+	t := m.GetTask(e.GetTaskId())
+	if t == nil {
+		return
+	}
+
+	taskEvent := &event.TaskEvent{
+		Name:      t.GetName(),
+		TaskID:    t.GetTaskId(),
+		Hostname:  t.GetHostname(),
+		ClassName: t.GetClassName(),
+		Probe:     e.GetProbe(),
+	}
+	if !e.IsHealthy() {
+		taskEvent.ProbeError = e.GetMessage()
+		if len(taskEvent.ProbeError) == 0 {
+			taskEvent.ProbeError = "probe failed"
+		}
+	}
+
+	switch e.GetProbe() {
+	case common.LIVENESS_PROBE:
+		if e.IsHealthy() {
+			return
+		}
+		log.WithField("partition", t.GetEnvironmentId().String()).
+			WithField("taskId", t.GetTaskId()).
+			WithField(infologger.Level, infologger.IL_Ops).
+			Errorf("liveness probe of task of role %s on %s failed: %s", t.GetParentRolePath(), t.GetHostname(), taskEvent.ProbeError)
+		t.SendEvent(taskEvent)
+
+		if t.IsLocked() && !m.requestTaskRestart(t) {
+			m.updateTaskState(t.GetTaskId(), "ERROR")
+		}
+
+	case common.READINESS_PROBE:
+		t.mu.Lock()
+		if e.IsHealthy() == !t.unready {
+			t.mu.Unlock()
+			return
+		}
+		t.unready = !e.IsHealthy()
+		t.mu.Unlock()
+
+		if e.IsHealthy() {
+			log.WithField("partition", t.GetEnvironmentId().String()).
+				WithField("taskId", t.GetTaskId()).
+				WithField(infologger.Level, infologger.IL_Ops).
+				Infof("task of role %s on %s is ready again", t.GetParentRolePath(), t.GetHostname())
+		} else {
+			log.WithField("partition", t.GetEnvironmentId().String()).
+				WithField("taskId", t.GetTaskId()).
+				WithField(infologger.Level, infologger.IL_Ops).
+				Warnf("readiness probe of task of role %s on %s failed: %s", t.GetParentRolePath(), t.GetHostname(), taskEvent.ProbeError)
+		}
+		t.SendEvent(taskEvent)
+	}
+}
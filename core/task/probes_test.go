@@ -0,0 +1,122 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+)
+
+var _ = Describe("task probes", func() {
+	It("are read from task templates with defaults", func() {
+		class := new(taskclass.Class)
+		err := yaml.Unmarshal([]byte(`
+name: readout
+control:
+  mode: direct
+wants:
+  cpu: 1
+  memory: 128
+probes:
+  liveness:
+    occ:
+      states: [STANDBY, CONFIGURED, RUNNING]
+    period: 5s
+    failureThreshold: 2
+  readiness:
+    httpGet:
+      port: "{{ monitoring_port }}"
+      path: /ready
+    initialDelay: 30s
+`), class)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(class.Probes).NotTo(BeNil())
+
+		liveness := class.Probes.Liveness
+		Expect(liveness.Kind()).To(Equal("occ"))
+		Expect(liveness.Period).To(Equal(5 * time.Second))
+		Expect(liveness.Timeout).To(Equal(common.DEFAULT_PROBE_TIMEOUT))
+		Expect(liveness.FailureThreshold).To(Equal(2))
+
+		readiness := class.Probes.Readiness
+		Expect(readiness.Kind()).To(Equal("httpGet"))
+		Expect(readiness.InitialDelay).To(Equal(30 * time.Second))
+		Expect(readiness.Period).To(Equal(common.DEFAULT_PROBE_PERIOD))
+		Expect(readiness.FailureThreshold).To(Equal(common.DEFAULT_PROBE_FAILURE_THRESHOLD))
+
+		// the port is only checked once templated
+		Expect(class.Probes.Validate()).To(HaveOccurred())
+		Expect(wrapProbeFields(class.Probes)).To(HaveLen(3))
+
+		probes := class.Probes.Copy()
+		probes.Readiness.HttpGet.Port = "8080"
+		Expect(probes.Validate()).To(Succeed())
+		Expect(class.Probes.Readiness.HttpGet.Port).To(Equal("{{ monitoring_port }}"))
+	})
+
+	It("reject probes without exactly one check", func() {
+		probe := new(common.TaskProbe)
+		Expect(yaml.Unmarshal([]byte(`period: 5s`), probe)).NotTo(Succeed())
+		Expect(yaml.Unmarshal([]byte(`
+tcpSocket:
+  port: "22"
+exec:
+  command: [/bin/true]
+`), probe)).NotTo(Succeed())
+	})
+
+	It("reject invalid timings", func() {
+		probe := new(common.TaskProbe)
+		Expect(yaml.Unmarshal([]byte(`
+tcpSocket:
+  port: "22"
+period: 0s
+`), probe)).NotTo(Succeed())
+		Expect(yaml.Unmarshal([]byte(`
+tcpSocket:
+  port: "22"
+failureThreshold: 0
+`), probe)).NotTo(Succeed())
+		Expect(yaml.Unmarshal([]byte(`
+tcpSocket:
+  port: "22"
+timeout: 2s
+`), probe)).To(Succeed())
+		Expect(probe.Timeout).To(Equal(2 * time.Second))
+		Expect(probe.Validate()).To(Succeed())
+	})
+
+	It("are validated once templated", func() {
+		Expect((&common.TaskProbe{Exec: &common.ExecProbe{}}).Validate()).To(HaveOccurred())
+		Expect((&common.TaskProbe{Occ: &common.OccProbe{}}).Validate()).To(HaveOccurred())
+		Expect((&common.TaskProbe{TcpSocket: &common.TcpSocketProbe{Port: "70000"}}).Validate()).To(HaveOccurred())
+		Expect((&common.TaskProbe{Exec: &common.ExecProbe{Command: []string{"/bin/true"}}}).Validate()).To(Succeed())
+	})
+})
@@ -0,0 +1,130 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"math"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+)
+
+var _ = Describe("task process settings", func() {
+	It("are read from task templates", func() {
+		class := new(taskclass.Class)
+		err := yaml.Unmarshal([]byte(`
+name: readout
+control:
+  mode: direct
+command:
+  value: o2-readout-exe
+  workingDir: /var/lib/o2/readout
+  umask: "0027"
+  rlimits:
+    memlock: unlimited
+    nofile:
+      soft: 65536
+      hard: 131072
+    core:
+      hard: "{{ core_limit }}"
+`), class)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*class.Command.WorkingDir).To(Equal("/var/lib/o2/readout"))
+		Expect(*class.Command.Umask).To(Equal("0027"))
+		Expect(class.Command.Rlimits).To(Equal(common.Rlimits{
+			"memlock": {Soft: "unlimited", Hard: "unlimited"},
+			"nofile":  {Soft: "65536", Hard: "131072"},
+			"core":    {Soft: "{{ core_limit }}", Hard: "{{ core_limit }}"},
+		}))
+
+		cmd := class.Command.Copy()
+		cmd.Rlimits["nofile"] = common.Rlimit{Soft: "1024", Hard: "1024"}
+		*cmd.Umask = "0022"
+		Expect(class.Command.Rlimits["nofile"].Soft).To(Equal("65536"))
+		Expect(*class.Command.Umask).To(Equal("0027"))
+	})
+
+	It("template the soft and hard limits", func() {
+		rlimits := common.Rlimits{"core": {Soft: "{{ core_limit }}", Hard: "unlimited"}}
+		fields := wrapRlimitFields(rlimits)
+		Expect(fields).To(HaveLen(2))
+		for _, field := range fields {
+			if field.Get() == "{{ core_limit }}" {
+				field.Set("0")
+			}
+		}
+		Expect(rlimits["core"]).To(Equal(common.Rlimit{Soft: "0", Hard: "unlimited"}))
+
+		soft, hard, err := rlimits["core"].Values()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(soft).To(BeZero())
+		Expect(hard).To(Equal(uint64(math.MaxUint64)))
+	})
+
+	strPtr := func(s string) *string { return &s }
+
+	DescribeTable("are validated",
+		func(cmd common.TaskCommandInfo, errSubstring string) {
+			err := cmd.ValidateProcessSettings()
+			if len(errSubstring) == 0 {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(errSubstring)))
+			}
+		},
+		Entry("nothing set", common.TaskCommandInfo{}, ""),
+		Entry("all set", common.TaskCommandInfo{CommandInfo: common.CommandInfo{
+			WorkingDir: strPtr("/tmp"),
+			Umask:      strPtr("022"),
+			Rlimits:    common.Rlimits{"memlock": {Soft: "65536", Hard: "infinity"}},
+		}}, ""),
+		Entry("relative working directory in the sandbox", common.TaskCommandInfo{
+			CommandInfo: common.CommandInfo{WorkingDir: strPtr("calib")},
+			Fetch:       common.TaskArtifacts{{Uri: "file:///opt/calib.tgz", Extract: true}},
+		}, ""),
+		Entry("relative working directory without sandbox", common.TaskCommandInfo{
+			CommandInfo: common.CommandInfo{WorkingDir: strPtr("calib")},
+		}, "requires task artifacts"),
+		Entry("relative working directory outside the sandbox", common.TaskCommandInfo{
+			CommandInfo: common.CommandInfo{WorkingDir: strPtr("../calib")},
+			Fetch:       common.TaskArtifacts{{Uri: "file:///opt/calib.tgz", Extract: true}},
+		}, "within the sandbox"),
+		Entry("unknown limit", common.TaskCommandInfo{CommandInfo: common.CommandInfo{
+			Rlimits: common.Rlimits{"files": {Soft: "1", Hard: "1"}},
+		}}, "unknown resource limit files"),
+		Entry("bad limit value", common.TaskCommandInfo{CommandInfo: common.CommandInfo{
+			Rlimits: common.Rlimits{"nofile": {Soft: "lots", Hard: "lots"}},
+		}}, "bad limit value"),
+		Entry("soft above hard", common.TaskCommandInfo{CommandInfo: common.CommandInfo{
+			Rlimits: common.Rlimits{"nofile": {Soft: "unlimited", Hard: "1024"}},
+		}}, "above hard limit"),
+		Entry("bad umask", common.TaskCommandInfo{CommandInfo: common.CommandInfo{
+			Umask: strPtr("0999"),
+		}}, "bad umask"),
+	)
+})
@@ -0,0 +1,63 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("task pending properties", func() {
+	var t *Task
+
+	BeforeEach(func() {
+		t = &Task{}
+		t.setConfiguredPropertyMap(nil, controlcommands.PropertyMap{"a": "1", "b": "2"})
+		t.SetPendingProperties(controlcommands.PropertyMap{"a": "10"})
+	})
+
+	It("stay pending until the task accepted them", func() {
+		pushed := t.getPendingProperties()
+		Expect(pushed).To(Equal(controlcommands.PropertyMap{"a": "10"}))
+
+		// START failed, nothing is committed
+		Expect(t.getPendingProperties()).To(Equal(pushed))
+		Expect(t.configuredProperties).To(HaveKeyWithValue("a", "1"))
+
+		t.commitPendingProperties(pushed)
+		Expect(t.getPendingProperties()).To(BeEmpty())
+		Expect(t.configuredProperties).To(Equal(controlcommands.PropertyMap{"a": "10", "b": "2"}))
+	})
+
+	It("keep the values changed while they were pushed", func() {
+		pushed := t.getPendingProperties()
+		t.SetPendingProperties(controlcommands.PropertyMap{"a": "20", "b": "30"})
+
+		t.commitPendingProperties(pushed)
+		Expect(t.getPendingProperties()).To(Equal(controlcommands.PropertyMap{"a": "20", "b": "30"}))
+		Expect(t.configuredProperties).To(HaveKeyWithValue("a", "10"))
+	})
+})
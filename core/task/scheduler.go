@@ -387,6 +387,30 @@ func (state *schedulerState) incomingMessageHandler() events.HandlerFunc {
 					TaskId:     mesos.TaskID{Value: res.TaskId},
 				}
 
+				go func() {
+					state.servent.ProcessResponse(&res, sender)
+				}()
+				return
+			case "MesosCommand_GetTaskLogs":
+				var res controlcommands.MesosCommandResponse_GetTaskLogs
+				err = json.Unmarshal(data, &res)
+				if err != nil {
+					log.WithPrefix("scheduler").WithFields(logrus.Fields{
+						"commandName": incomingCommand.CommandName,
+						"agentId":     agentId.GetValue(),
+						"executorId":  executorId.GetValue(),
+						"message":     string(data[:]),
+						"error":       err.Error(),
+					}).
+						Error("cannot unmarshal incoming MESSAGE")
+					return
+				}
+				sender := controlcommands.MesosCommandTarget{
+					AgentId:    agentId,
+					ExecutorId: executorId,
+					TaskId:     mesos.TaskID{Value: res.TaskId},
+				}
+
 				go func() {
 					state.servent.ProcessResponse(&res, sender)
 				}()
@@ -434,6 +458,26 @@ func (state *schedulerState) incomingMessageHandler() events.HandlerFunc {
 			if t != nil {
 				t.setTaskPID(taskMessage.GetTaskPID())
 			}
+		case "AnnounceTaskAffinityEvent":
+			var taskMessage event.AnnounceTaskAffinityEvent
+			err = json.Unmarshal(data, &taskMessage)
+			if err != nil {
+				return
+			}
+
+			t := state.taskman.GetTask(taskMessage.GetTaskId())
+			if t != nil {
+				affinity := taskMessage.GetAffinity()
+				t.setEffectiveAffinity(&affinity)
+			}
+		case "TaskProbeEvent":
+			var taskMessage event.TaskProbeEvent
+			err = json.Unmarshal(data, &taskMessage)
+			if err != nil {
+				return
+			}
+
+			go state.taskman.updateTaskProbe(&taskMessage)
 		}
 		return
 	}
@@ -512,12 +556,19 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 
 		machinesUsed := make(map[string]struct{})
 
+		// NUMA nodes are not Mesos resources, so we account for them ourselves
+		numaUsage := newNumaUsage(state.taskman.roster.getTasks(), state.taskman.pendingNumaClaims)
+
 		// by default we get ready to decline all offers
 		offerIDsToDecline := make(map[mesos.OfferID]struct{}, len(offers))
 		for i := range offers {
 			offerIDsToDecline[offers[i].ID] = struct{}{}
 		}
 
+		// offers from cordoned hosts stay in the decline list and are never
+		// considered for deployment
+		offers = state.taskman.cordons.schedulable(offers)
+
 		tasksDeployed := make(DeploymentMap)
 		tasksDeployedMutex := sync.Mutex{}
 
@@ -582,6 +633,23 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 						break
 					}
 				}
+				if requiredMachineId == "" && descriptor.PreferredMachineId != "" {
+					// A preferred machine which is offering is as good as required, if it isn't
+					// the descriptor goes to any machine which satisfies its constraints.
+					if _, found := offersByMachineId[descriptor.PreferredMachineId]; found {
+						requiredMachineId = descriptor.PreferredMachineId
+						descriptorConstraints[descriptor] = constraint.Constraints{{
+							Attribute: "machine_id",
+							Value:     requiredMachineId,
+							Operator:  constraint.Equals,
+						}}.MergeParent(descriptorConstraints[descriptor])
+					} else {
+						log.WithField("partition", envId.String()).
+							WithField("level", infologger.IL_Devel).
+							WithField("descriptor", descriptor.TaskClassName).
+							Infof("no resource offer for preferred host %s, any matching host will do", descriptor.PreferredMachineId)
+					}
+				}
 				if requiredMachineId != "" {
 					// We have a constraint on the machine_id, so we need to find an offer that matches it.
 					// If we don't find any, we can bail out early.
@@ -782,6 +850,26 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 
 								break FOR_PREMATCH_DESCRIPTORS
 							}
+							affinity, claim, placeErr := numaUsage.place(&offer, wants)
+							if placeErr != nil {
+								log.WithPrefix("scheduler").
+									WithError(placeErr).
+									WithField("partition", envId.String()).
+									WithField("detector", descriptorDetector).
+									WithFields(logrus.Fields{
+										"taskClass": descriptor.TaskClassName,
+										"offerId":   offer.ID.Value,
+										"level":     infologger.IL_Devel,
+										"offerHost": offer.Hostname,
+									}).
+									Warn("descriptor affinity not satisfied by pre-matched offer host")
+
+								// we know this descriptor will never be satisfiable, no point in continuing
+								descriptorsPrematchToDeploy = append(descriptorsPrematchToDeploy[:i], descriptorsPrematchToDeploy[i+1:]...)
+								descriptorsUndeployable = append(descriptorsUndeployable, descriptor)
+
+								break FOR_PREMATCH_DESCRIPTORS
+							}
 
 							var limits *Limits
 							limits = state.taskman.GetLimitsForDescriptor(descriptor, envId)
@@ -793,6 +881,8 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 								descriptor,
 								wants,
 								limits,
+								affinity,
+								claim,
 								remainingResourcesInOffer,
 								machinesUsed,
 								targetExecutorId,
@@ -803,6 +893,7 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 							if taskPtr == nil || mesosTaskInfo == nil {
 								break FOR_PREMATCH_DESCRIPTORS
 							}
+							numaUsage.claim(taskPtr.GetTaskId(), claim)
 
 							log.WithPrefix("scheduler").
 								WithField("partition", envId.String()).
@@ -895,6 +986,23 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 							}
 							continue FOR_DESCRIPTORS // next descriptor
 						}
+						affinity, claim, placeErr := numaUsage.place(&offer, wants)
+						if placeErr != nil {
+							if viper.GetBool("veryVerbose") {
+								log.WithPrefix("scheduler").
+									WithError(placeErr).
+									WithField("partition", envId.String()).
+									WithField("detector", descriptorDetector).
+									WithFields(logrus.Fields{
+										"taskClass": descriptor.TaskClassName,
+										"offerId":   offer.ID.Value,
+										"level":     infologger.IL_Devel,
+										"offerHost": offer.Hostname,
+									}).
+									Warn("descriptor affinity not satisfied by offer host")
+							}
+							continue FOR_DESCRIPTORS // next descriptor
+						}
 
 						var limits *Limits
 						limits = state.taskman.GetLimitsForDescriptor(descriptor, envId)
@@ -906,6 +1014,8 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 							descriptor,
 							wants,
 							limits,
+							affinity,
+							claim,
 							remainingResourcesInOffer,
 							machinesUsed,
 							targetExecutorId,
@@ -916,6 +1026,7 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 						if taskPtr == nil || mesosTaskInfo == nil {
 							continue FOR_DESCRIPTORS // next descriptor
 						}
+						numaUsage.claim(taskPtr.GetTaskId(), claim)
 
 						log.WithPrefix("scheduler").
 							WithField("partition", envId.String()).
@@ -1335,6 +1446,8 @@ func makeTaskForMesosResources(
 	descriptor *Descriptor,
 	wants *Wants,
 	limits *Limits,
+	affinity *common.TaskAffinity,
+	claim *numaClaim,
 	remainingResourcesInOffer mesos.Resources,
 	machinesUsed map[string]struct{},
 	targetExecutorId mesos.ExecutorID,
@@ -1436,6 +1549,12 @@ func makeTaskForMesosResources(
 		cmd.Arguments = append(cmd.Arguments, "--control-port", strconv.FormatUint(controlPort, 10))
 	}
 
+	// The executor applies the placement to the whole process group of the task
+	cmd.Affinity = affinity
+	taskPtr.mu.Lock()
+	taskPtr.numaClaim = claim
+	taskPtr.mu.Unlock()
+
 	// Convenience function that scans through cmd.Env and appends the
 	// given key-value pair if the given variable isn't already
 	// provided by the user.
@@ -1463,6 +1582,31 @@ func makeTaskForMesosResources(
 
 	runCommand := *cmd
 
+	// Secrets are resolved by the executor, so their values only travel in
+	// the launch payload and never end up in the task state
+	runCommand.Secrets, err = fetchTaskSecrets(cmd)
+	if err != nil {
+		log.WithPrefix("scheduler").
+			WithField("partition", envId.String()).
+			WithField("detector", descriptorDetector).
+			WithField("taskRole", taskPtr.GetParent().GetPath()).
+			WithError(err).
+			Error("cannot fetch secrets for task")
+		return nil, nil
+	}
+
+	// Likewise, the content of apricot artifacts only travels in the launch payload
+	runCommand.Fetch, err = fetchTaskArtifacts(cmd)
+	if err != nil {
+		log.WithPrefix("scheduler").
+			WithField("partition", envId.String()).
+			WithField("detector", descriptorDetector).
+			WithField("taskRole", taskPtr.GetParent().GetPath()).
+			WithError(err).
+			Error("cannot fetch artifacts for task")
+		return nil, nil
+	}
+
 	// Serialize the actual command to be passed to the executor
 	var jsonCommand []byte
 	jsonCommand, err = json.Marshal(&runCommand)
@@ -1570,7 +1714,10 @@ func makeTaskForMesosResources(
 	// We must run the executor with a special LD_LIBRARY_PATH because
 	// its InfoLogger binding is built with GCC-Toolchain
 	ldLibPath, ok := agentForCache.Attributes.Get("executor_env_LD_LIBRARY_PATH")
-	mesosTaskInfo.Executor.Command.Environment = &mesos.Environment{}
+	// The executor environment may already carry settings from the core
+	if mesosTaskInfo.Executor.Command.Environment == nil {
+		mesosTaskInfo.Executor.Command.Environment = &mesos.Environment{}
+	}
 	if ok {
 		mesosTaskInfo.Executor.Command.Environment.Variables = append(mesosTaskInfo.Executor.Command.Environment.Variables,
 			mesos.Environment_Variable{
@@ -36,6 +36,9 @@ import (
 	"github.com/AliceO2Group/Control/common/logger/infologger"
 	"github.com/AliceO2Group/Control/core/controlcommands"
 	"github.com/AliceO2Group/Control/core/task/schedutil"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/AliceO2Group/Control/executor/artifact"
+	"github.com/AliceO2Group/Control/executor/tasklog"
 	"github.com/gogo/protobuf/proto"
 	"github.com/looplab/fsm"
 	mesos "github.com/mesos/mesos-go/api/v1/lib"
@@ -95,12 +98,14 @@ func NewScheduler(taskman *Manager, fidStore store.Singleton, shutdown func()) (
 		schedutil.BuildWantsExecutorResources(viper.GetFloat64("executorCPU"),
 			viper.GetFloat64("executorMemory")),
 		viper.GetDuration("mesosJobRestartDelay"),
+		executorEnvironment(),
 	)
 	if err != nil {
 		return nil, err
 	}
 	creds, err := schedutil.LoadCredentials(viper.GetString("mesosCredentials.username"),
-		viper.GetString("mesosCredentials.password"))
+		viper.GetString("mesosCredentials.passwordFile"),
+		the.ConfSvc().GetSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -226,6 +231,25 @@ func (state *schedulerState) Start(ctx context.Context) {
 	}()
 }
 
+// executorEnvironment returns the settings passed to the executor through its
+// environment.
+func executorEnvironment() map[string]string {
+	env := tasklog.Config{
+		Dir:       viper.GetString("taskLogDir"),
+		MaxSize:   viper.GetInt64("taskLogMaxSize"),
+		MaxFiles:  viper.GetInt("taskLogMaxFiles"),
+		Retention: viper.GetDuration("taskLogRetention"),
+	}.Env()
+	for k, v := range (artifact.Config{
+		Dir:          viper.GetString("artifactDir"),
+		Retention:    viper.GetDuration("artifactRetention"),
+		FetchTimeout: viper.GetDuration("artifactFetchTimeout"),
+	}).Env() {
+		env[k] = v
+	}
+	return env
+}
+
 func (state *schedulerState) CopyExecutorInfo() *mesos.ExecutorInfo {
 	return proto.Clone(state.executor).(*mesos.ExecutorInfo)
 }
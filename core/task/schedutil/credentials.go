@@ -30,10 +30,19 @@ package schedutil
 import (
 	"io/ioutil"
 	"os"
+
+	"github.com/AliceO2Group/Control/common/secrets"
 )
 
-func LoadCredentials(username string, password string) (result credentials, err error) {
+// LoadCredentials builds the Mesos credentials from a username and the path
+// to a file which contains the password. Instead of a path, password may also
+// be a secret:name reference, which is then fetched with getSecret.
+func LoadCredentials(username string, password string, getSecret func(string) (string, error)) (result credentials, err error) {
 	result = credentials{username, password}
+	if secretName, isSecret := secrets.ParseReference(password); isSecret {
+		result.password, err = getSecret(secretName)
+		return
+	}
 	if result.password != "" {
 		// this is the path to a file containing the password
 		_, err = os.Stat(result.password)
@@ -35,6 +35,7 @@ import (
 	"net/http"
 	"net/url"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/AliceO2Group/Control/common/logger"
@@ -58,7 +59,24 @@ func PrepareExecutorInfo(
 	execBinary, execImage string,
 	wantsResources mesos.Resources,
 	jobRestartDelay time.Duration,
+	environment map[string]string,
 ) (*mesos.ExecutorInfo, error) {
+	var executorEnv *mesos.Environment
+	if len(environment) > 0 {
+		keys := make([]string, 0, len(environment))
+		for k := range environment {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		executorEnv = &mesos.Environment{}
+		for _, k := range keys {
+			executorEnv.Variables = append(executorEnv.Variables, mesos.Environment_Variable{
+				Name:  k,
+				Value: proto.String(environment[k]),
+			})
+		}
+	}
+
 	if execImage != "" {
 		log.Trace("executor container image specified, will run")
 		// Create mesos custom executor
@@ -67,7 +85,8 @@ func PrepareExecutorInfo(
 			ExecutorID: mesos.ExecutorID{Value: product.NAME + "-container-executor"},
 			Name:       proto.String("AliECS container executor"),
 			Command: &mesos.CommandInfo{
-				Shell: func() *bool { x := false; return &x }(),
+				Shell:       func() *bool { x := false; return &x }(),
+				Environment: executorEnv,
 			},
 			Container: &mesos.ContainerInfo{
 				Type: mesos.ContainerInfo_DOCKER.Enum(),
@@ -97,8 +116,9 @@ func PrepareExecutorInfo(
 			ExecutorID: mesos.ExecutorID{Value: product.NAME + "-executor"},
 			Name:       proto.String("AliECS executor"),
 			Command: &mesos.CommandInfo{
-				Value: proto.String(executorCommand),
-				URIs:  executorUris,
+				Value:       proto.String(executorCommand),
+				URIs:        executorUris,
+				Environment: executorEnv,
 			},
 			Resources: wantsResources,
 		}, nil
@@ -0,0 +1,52 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/core/the"
+)
+
+// fetchTaskSecrets retrieves the values of all the secrets referenced by a
+// task command, for inclusion in the launch payload for the executor.
+// The values are never stored in the Task, so they cannot surface in
+// GetTask, events or logs.
+func fetchTaskSecrets(cmd *common.TaskCommandInfo) (map[string]string, error) {
+	names := cmd.ReferencedSecrets()
+	if len(names) == 0 {
+		return nil, nil
+	}
+	values := make(map[string]string, len(names))
+	for _, name := range names {
+		value, err := the.ConfSvc().GetSecret(name)
+		if err != nil {
+			return nil, fmt.Errorf("cannot fetch secret %s: %w", name, err)
+		}
+		values[name] = value
+	}
+	return values, nil
+}
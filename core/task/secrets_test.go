@@ -0,0 +1,53 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/secrets"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("task secrets", func() {
+	It("should collect the secrets referenced by the command, arguments and environment", func() {
+		value := "dcs-bridge --password=" + secrets.Placeholder("dcs.pass")
+		cmd := &common.TaskCommandInfo{
+			CommandInfo: common.CommandInfo{
+				Value:     &value,
+				Arguments: []string{"--token=" + secrets.Placeholder("bkp-token")},
+				Env:       []string{"TOKEN=secret:bkp-token", "KAFKA=secret:kafka", "PLAIN=secret"},
+			},
+		}
+		Expect(cmd.ReferencedSecrets()).To(Equal([]string{"bkp-token", "dcs.pass", "kafka"}))
+	})
+
+	It("should find no secrets in a command without references", func() {
+		value := "readout.exe"
+		cmd := &common.TaskCommandInfo{CommandInfo: common.CommandInfo{Value: &value}}
+		Expect(cmd.ReferencedSecrets()).To(BeEmpty())
+		Expect(fetchTaskSecrets(cmd)).To(BeNil())
+	})
+})
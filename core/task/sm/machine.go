@@ -50,3 +50,23 @@ func (e Event) String() string {
 }
 
 type EventArgs map[string]string
+
+var transitions = []Transition{
+	{Evt: CONFIGURE, Src: STANDBY, Dst: CONFIGURED},
+	{Evt: RESET, Src: CONFIGURED, Dst: STANDBY},
+	{Evt: START, Src: CONFIGURED, Dst: RUNNING},
+	{Evt: STOP, Src: RUNNING, Dst: CONFIGURED},
+	{Evt: RECOVER, Src: ERROR, Dst: STANDBY},
+}
+
+// TransitionFor returns the transition of the task state machine which the
+// given event triggers from the given source state, if any.
+// GO_ERROR and EXIT are not included, as they are not requested by the core.
+func TransitionFor(evt Event, src State) (t Transition, ok bool) {
+	for _, t = range transitions {
+		if t.Evt == evt && t.Src == src {
+			return t, true
+		}
+	}
+	return Transition{}, false
+}
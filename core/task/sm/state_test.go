@@ -80,6 +80,28 @@ var _ = Describe("task state", func() {
 			})
 		})
 	})
+
+	Describe("transition lookup", func() {
+		When("the event is valid from the source state", func() {
+			It("returns the destination state", func() {
+				t, ok := TransitionFor(START, CONFIGURED)
+				Expect(ok).To(BeTrue())
+				Expect(t.Dst).To(Equal(RUNNING))
+
+				t, ok = TransitionFor(RECOVER, ERROR)
+				Expect(ok).To(BeTrue())
+				Expect(t.Dst).To(Equal(STANDBY))
+			})
+		})
+		When("the event is not valid from the source state", func() {
+			It("returns no transition", func() {
+				_, ok := TransitionFor(START, STANDBY)
+				Expect(ok).To(BeFalse())
+				_, ok = TransitionFor(GO_ERROR, RUNNING)
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
 })
 
 func TestState(t *testing.T) {
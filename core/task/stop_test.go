@@ -0,0 +1,103 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+)
+
+var _ = Describe("task stop policy", func() {
+	It("is read from task templates with defaults", func() {
+		class := new(taskclass.Class)
+		err := yaml.Unmarshal([]byte(`
+name: readout
+control:
+  mode: direct
+stop:
+  preStop:
+    command: ["/opt/o2/bin/readout-flush", "--pid-file", "{{ pid_file }}"]
+  signals:
+    - signal: term
+      grace: 30s
+    - signal: SIGINT
+`), class)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(class.Stop).To(Equal(&common.TaskStopPolicy{
+			Transition:        true,
+			TransitionTimeout: common.DEFAULT_STOP_TRANSITION_TIMEOUT,
+			PreStop: &common.TaskStopHook{
+				Command: []string{"/opt/o2/bin/readout-flush", "--pid-file", "{{ pid_file }}"},
+				Timeout: common.DEFAULT_PRE_STOP_TIMEOUT,
+			},
+			Signals: []common.TaskStopSignal{
+				{Signal: "SIGTERM", Grace: 30 * time.Second},
+				{Signal: "SIGINT", Grace: common.DEFAULT_STOP_SIGNAL_GRACE},
+			},
+		}))
+
+		out, err := yaml.Marshal(class)
+		Expect(err).NotTo(HaveOccurred())
+		reread := new(taskclass.Class)
+		Expect(yaml.Unmarshal(out, reread)).To(Succeed())
+		Expect(reread.Stop).To(Equal(class.Stop))
+	})
+
+	It("tells apart default and empty signal sequences", func() {
+		policy := new(common.TaskStopPolicy)
+		Expect(yaml.Unmarshal([]byte(`transition: false`), policy)).To(Succeed())
+		Expect(policy.Transition).To(BeFalse())
+		Expect(policy.Signals).To(BeNil())
+		Expect(policy.Copy().Signals).To(BeNil())
+
+		Expect(yaml.Unmarshal([]byte(`signals: []`), policy)).To(Succeed())
+		Expect(policy.Signals).NotTo(BeNil())
+		Expect(policy.Signals).To(BeEmpty())
+		Expect(policy.Copy().Signals).NotTo(BeNil())
+	})
+
+	DescribeTable("rejects invalid settings",
+		func(doc string, errSubstring string) {
+			policy := new(common.TaskStopPolicy)
+			Expect(yaml.Unmarshal([]byte(doc), policy)).To(MatchError(ContainSubstring(errSubstring)))
+		},
+		Entry("unknown signal", `signals: [{signal: SIGSEGV}]`, "unsupported stop signal"),
+		Entry("bad grace", `signals: [{signal: TERM, grace: soon}]`, "invalid stop signal grace"),
+		Entry("negative grace", `signals: [{signal: TERM, grace: -1s}]`, "must not be negative"),
+		Entry("zero transition timeout", `transitionTimeout: 0s`, "must be positive"),
+	)
+
+	It("requires a pre-stop command once templated", func() {
+		policy := &common.TaskStopPolicy{PreStop: &common.TaskStopHook{Command: []string{""}}}
+		Expect(policy.Validate()).To(MatchError(ContainSubstring("no command")))
+		policy.PreStop.Command = []string{"/bin/true"}
+		Expect(policy.Validate()).To(Succeed())
+	})
+})
@@ -45,6 +45,7 @@ import (
 	"github.com/AliceO2Group/Control/common/gera"
 	"github.com/AliceO2Group/Control/common/logger"
 	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/secrets"
 	"github.com/AliceO2Group/Control/common/utils"
 	"github.com/AliceO2Group/Control/common/utils/uid"
 	"github.com/AliceO2Group/Control/configuration/template"
@@ -128,11 +129,30 @@ type Task struct {
 
 	properties gera.Map[string, string]
 
+	// The bindMap and property map pushed with the last CONFIGURE, and any
+	// property values changed since then, which are pushed with the next START
+	configuredBindMap    channel.BindMap
+	configuredProperties controlcommands.PropertyMap
+	pendingProperties    controlcommands.PropertyMap
+	// Property values set directly on this task, which override the
+	// templated ones for as long as the task lives
+	propertyOverrides controlcommands.PropertyMap
+
+	// Automatic restarts of the task's role so far, see GetRestartPolicy
+	restartAttempts int
+
 	GetTaskClass func() *taskclass.Class
 	// ↑ to be filled in by NewTaskForMesosOffer in Manager
 
 	commandInfo *common.TaskCommandInfo
 	pid         string
+
+	// The share of a NUMA node claimed by this task, if pinned to one
+	numaClaim *numaClaim
+	// The placement reported by the executor once the task is running
+	effectiveAffinity *common.TaskAffinity
+	// Set while the readiness probe of the task is failing
+	unready bool
 }
 
 func (t *Task) IsSafeToStop() bool {
@@ -170,6 +190,28 @@ func (t *Task) GetParentRolePath() string {
 	return t.getParentRolePath()
 }
 
+func (t *Task) GetStatus() Status {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.status
+}
+
+func (t *Task) GetState() sm.State {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.state
+}
+
+// SetState sets the state of the task as known to the core, without pushing
+// any transition to it. The state of a deployed task is normally only updated
+// from the status updates of its executor, so this is meant for tasks which
+// are not managed through one, such as in tests.
+func (t *Task) SetState(state sm.State) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.state = state
+}
+
 func (t *Task) IsLocked() bool {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
@@ -259,6 +301,59 @@ func (t *Task) GetTraits() Traits {
 	return Traits{}
 }
 
+// GetRestartPolicy returns the restart policy of this task's role if it has
+// one, else the one of its task class. Tasks without either, and hooks, are
+// never restarted.
+func (t *Task) GetRestartPolicy() taskclass.RestartPolicy {
+	never := taskclass.RestartPolicy{Mode: taskclass.RestartNever}
+	if t.GetControlMode() == controlmode.HOOK {
+		return never
+	}
+	type restartPolicyRole interface {
+		GetRestartPolicy() *taskclass.RestartPolicy
+	}
+	if role, ok := t.GetParent().(restartPolicyRole); ok {
+		if policy := role.GetRestartPolicy(); policy != nil {
+			return *policy
+		}
+	}
+	if class := t.GetTaskClass(); class != nil && class.Restart != nil {
+		return *class.Restart
+	}
+	return never
+}
+
+// FailureTolerated returns true if the failure of this task, together with
+// the failures of the roles at failedRolePaths, is absorbed by the quorum of
+// one of its ancestor roles, and should not be treated as critical.
+func (t *Task) FailureTolerated(failedRolePaths []string) bool {
+	if t == nil {
+		return false
+	}
+	return quorumTolerates(t.GetParent(), failedRolePaths)
+}
+
+func quorumTolerates(role parentRole, failedRolePaths []string) bool {
+	type quorumRole interface {
+		QuorumTolerates(failedPaths []string) bool
+	}
+	if role, ok := role.(quorumRole); ok {
+		return role.QuorumTolerates(failedRolePaths)
+	}
+	return false
+}
+
+// IncrementRestartAttempts records one more automatic restart of this task
+// and returns the number of attempts so far, which is carried over to its
+// replacement.
+func (t *Task) IncrementRestartAttempts() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.restartAttempts++
+	return t.restartAttempts
+}
+
 // Returns a consolidated CommandInfo for this Task, based on Roles tree and
 // Class.
 func (t *Task) BuildTaskCommand(role parentRole) (err error) {
@@ -374,6 +469,21 @@ func (t *Task) BuildTaskCommand(role parentRole) (err error) {
 			if cmd.Stderr != nil { // we only template it if it's defined
 				fields = append(fields, template.WrapPointer(cmd.Stderr))
 			}
+			if cmd.WorkingDir != nil {
+				fields = append(fields, template.WrapPointer(cmd.WorkingDir))
+			}
+			if cmd.Umask != nil {
+				fields = append(fields, template.WrapPointer(cmd.Umask))
+			}
+			fields = append(fields, wrapRlimitFields(cmd.Rlimits)...)
+			cmd.Probes = class.Probes.Copy()
+			fields = append(fields, wrapProbeFields(cmd.Probes)...)
+			cmd.Fetch = class.Fetch.Copy()
+			fields = append(fields, wrapArtifactFields(cmd.Fetch)...)
+			cmd.Stop = class.Stop.Copy()
+			if cmd.Stop != nil && cmd.Stop.PreStop != nil {
+				fields = append(fields, template.WrapSliceItems(cmd.Stop.PreStop.Command)...)
+			}
 			err = fields.Execute(the.ConfSvc(), t.name, varStack, nil, nil, make(map[string]texttemplate.Template), nil)
 			if err != nil {
 				t.commandInfo = &common.TaskCommandInfo{}
@@ -432,6 +542,40 @@ func (t *Task) BuildTaskCommand(role parentRole) (err error) {
 			cmd.Stderr = &none
 		}
 
+		if cmd.ControlMode == controlmode.HOOK {
+			// hooks run to completion within a transition, there is nothing to probe
+			cmd.Probes = nil
+		} else if !cmd.Probes.IsEmpty() {
+			if probeErr := cmd.Probes.Validate(); probeErr != nil {
+				t.commandInfo = &common.TaskCommandInfo{}
+				return probeErr
+			}
+			if cmd.ControlMode == controlmode.BASIC &&
+				((cmd.Probes.Liveness != nil && cmd.Probes.Liveness.Occ != nil) ||
+					(cmd.Probes.Readiness != nil && cmd.Probes.Readiness.Occ != nil)) {
+				t.commandInfo = &common.TaskCommandInfo{}
+				return errors.New("occ probes only apply to controllable tasks")
+			}
+		}
+
+		if cmd.ControlMode == controlmode.HOOK {
+			// hooks run to completion within a transition, their timeout applies instead
+			cmd.Stop = nil
+		} else if stopErr := cmd.Stop.Validate(); stopErr != nil {
+			t.commandInfo = &common.TaskCommandInfo{}
+			return stopErr
+		}
+
+		if fetchErr := cmd.Fetch.Validate(); fetchErr != nil {
+			t.commandInfo = &common.TaskCommandInfo{}
+			return fetchErr
+		}
+
+		if processErr := cmd.ValidateProcessSettings(); processErr != nil {
+			t.commandInfo = &common.TaskCommandInfo{}
+			return processErr
+		}
+
 		t.commandInfo = cmd
 	} else {
 		t.commandInfo = &common.TaskCommandInfo{}
@@ -440,6 +584,31 @@ func (t *Task) BuildTaskCommand(role parentRole) (err error) {
 	return
 }
 
+// wrapRlimitFields returns the soft and hard values of the resource limits,
+// which may contain template expressions.
+func wrapRlimitFields(rlimits common.Rlimits) (fields template.Fields) {
+	for _, name := range rlimits.Names() {
+		name := name // we need a local copy for the Getter/Setter closures
+		fields = append(fields,
+			template.WrapGeneric(
+				func() string { return rlimits[name].Soft },
+				func(value string) {
+					limit := rlimits[name]
+					limit.Soft = value
+					rlimits[name] = limit
+				}),
+			template.WrapGeneric(
+				func() string { return rlimits[name].Hard },
+				func(value string) {
+					limit := rlimits[name]
+					limit.Hard = value
+					rlimits[name] = limit
+				}),
+		)
+	}
+	return
+}
+
 func (t *Task) GetWantsCPU() float64 {
 	if t != nil {
 		if tt := t.GetTaskClass(); tt != nil {
@@ -551,6 +720,8 @@ func (t *Task) SendEvent(ev event.Event) {
 		if len(taskEvent.Status) != 0 {
 			outgoingEvent.Status = taskEvent.Status
 		}
+		outgoingEvent.Probe = taskEvent.Probe
+		outgoingEvent.ProbeError = taskEvent.ProbeError
 	}
 	the.EventWriterWithTopic(topic.Task).WriteEvent(outgoingEvent)
 
@@ -691,11 +862,138 @@ func (t *Task) BuildPropertyMap(bindMap channel.BindMap) (propMap controlcommand
 			for _, k := range keysToDelete {
 				delete(propMap, k)
 			}
+
+			// secret values only reach the executor at launch time, so the
+			// configuration pushed with a transition cannot use them
+			for k, v := range propMap {
+				if secrets.ContainsPlaceholder(v) {
+					return nil, fmt.Errorf("task %s property %s references a secret, secrets can only be used in the command, arguments and environment of a task", t.GetName(), k)
+				}
+			}
+
+			t.mu.RLock()
+			for k, v := range t.propertyOverrides {
+				propMap[k] = v
+			}
+			t.mu.RUnlock()
 		}
 	}
 	return propMap, err
 }
 
+func (t *Task) setConfiguredPropertyMap(bindMap channel.BindMap, propMap controlcommands.PropertyMap) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.configuredBindMap = bindMap
+	t.configuredProperties = propMap
+	t.pendingProperties = nil
+}
+
+// BuildPropertyMapDelta re-templates the property map of a configured task
+// against the current variable stack, and returns the properties whose values
+// differ from the ones pushed with the last CONFIGURE.
+func (t *Task) BuildPropertyMapDelta() (delta controlcommands.PropertyMap, err error) {
+	t.mu.RLock()
+	bindMap, configuredProperties := t.configuredBindMap, t.configuredProperties
+	t.mu.RUnlock()
+
+	if configuredProperties == nil {
+		return nil, fmt.Errorf("task %s (id %s) was never configured", t.name, t.taskId)
+	}
+
+	var propMap controlcommands.PropertyMap
+	propMap, err = t.BuildPropertyMap(bindMap)
+	if err != nil {
+		return nil, err
+	}
+
+	delta = make(controlcommands.PropertyMap)
+	for k, v := range propMap {
+		if configuredValue, ok := configuredProperties[k]; !ok || configuredValue != v {
+			delta[k] = v
+		}
+	}
+	return delta, nil
+}
+
+// SetPendingProperties replaces the property values to push to the task
+// along with the next START transition.
+func (t *Task) SetPendingProperties(delta controlcommands.PropertyMap) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(delta) == 0 {
+		t.pendingProperties = nil
+		return
+	}
+	t.pendingProperties = delta
+}
+
+// SetPropertyOverrides sets property values on this task which take
+// precedence over the templated ones in any property map built afterwards.
+// It returns the overrides previously in place, so they can be restored.
+func (t *Task) SetPropertyOverrides(properties controlcommands.PropertyMap) (previous controlcommands.PropertyMap) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	previous = make(controlcommands.PropertyMap, len(t.propertyOverrides))
+	for k, v := range t.propertyOverrides {
+		previous[k] = v
+	}
+	if t.propertyOverrides == nil {
+		t.propertyOverrides = make(controlcommands.PropertyMap)
+	}
+	for k, v := range properties {
+		t.propertyOverrides[k] = v
+	}
+	return
+}
+
+// ResetPropertyOverrides replaces the property overrides of this task.
+func (t *Task) ResetPropertyOverrides(properties controlcommands.PropertyMap) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.propertyOverrides = properties
+}
+
+// getPendingProperties returns a copy of the property values to push to the
+// task along with the next START transition.
+func (t *Task) getPendingProperties() (delta controlcommands.PropertyMap) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if len(t.pendingProperties) == 0 {
+		return nil
+	}
+	delta = make(controlcommands.PropertyMap, len(t.pendingProperties))
+	for k, v := range t.pendingProperties {
+		delta[k] = v
+	}
+	return
+}
+
+// commitPendingProperties records the property values of delta, which the
+// task accepted with START, as configured. They are no longer pending unless
+// they were changed again in the meantime.
+func (t *Task) commitPendingProperties(delta controlcommands.PropertyMap) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for k, v := range delta {
+		if t.configuredProperties != nil {
+			t.configuredProperties[k] = v
+		}
+		if pending, ok := t.pendingProperties[k]; ok && pending == v {
+			delete(t.pendingProperties, k)
+		}
+	}
+	if len(t.pendingProperties) == 0 {
+		t.pendingProperties = nil
+	}
+}
+
 func (t *Task) GetMesosCommandTarget() controlcommands.MesosCommandTarget {
 	return controlcommands.MesosCommandTarget{
 		AgentId: mesos.AgentID{
@@ -735,6 +1033,35 @@ func (t *Task) setTaskPID(pid int) {
 	t.pid = strconv.Itoa(pid)
 }
 
+func (t *Task) getNumaClaim() *numaClaim {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.numaClaim
+}
+
+func (t *Task) setEffectiveAffinity(affinity *common.TaskAffinity) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.effectiveAffinity = affinity
+}
+
+// GetEffectiveAffinity returns the CPU and NUMA placement applied to the task
+// by the executor, or nil if the task is not pinned or has not reported it.
+func (t *Task) GetEffectiveAffinity() *common.TaskAffinity {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.effectiveAffinity
+}
+
 func (t *Task) GetTaskPID() string {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
@@ -0,0 +1,83 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package taskclass
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common"
+)
+
+// Affinity pins a task to a NUMA node, and optionally to a subset of CPUs.
+// The values are strings because on task roles they can be templated.
+type Affinity struct {
+	Numa   string `yaml:"numa,omitempty"`
+	Cpuset string `yaml:"cpuset,omitempty"`
+}
+
+func (a *Affinity) IsEmpty() bool {
+	return a == nil || (len(strings.TrimSpace(a.Numa)) == 0 && len(strings.TrimSpace(a.Cpuset)) == 0)
+}
+
+// Merge returns the affinity with any unset field taken from defaults.
+func (a *Affinity) Merge(defaults *Affinity) *Affinity {
+	if a == nil {
+		return defaults
+	}
+	merged := *a
+	if defaults != nil {
+		if len(strings.TrimSpace(merged.Numa)) == 0 {
+			merged.Numa = defaults.Numa
+		}
+		if len(strings.TrimSpace(merged.Cpuset)) == 0 {
+			merged.Cpuset = defaults.Cpuset
+		}
+	}
+	return &merged
+}
+
+// Parse returns the NUMA node, or -1 if none is set, and the CPU IDs, or nil
+// if no cpuset is set.
+func (a *Affinity) Parse() (numaNode int, cpus []int, err error) {
+	numaNode = -1
+	if a == nil {
+		return
+	}
+	if numa := strings.TrimSpace(a.Numa); len(numa) > 0 {
+		numaNode, err = strconv.Atoi(numa)
+		if err != nil || numaNode < 0 {
+			return -1, nil, fmt.Errorf("invalid NUMA node %q, must be a non-negative integer", a.Numa)
+		}
+	}
+	if cpuset := strings.TrimSpace(a.Cpuset); len(cpuset) > 0 {
+		cpus, err = common.ParseCpuList(cpuset)
+		if err != nil {
+			return -1, nil, fmt.Errorf("invalid cpuset: %w", err)
+		}
+	}
+	return
+}
@@ -72,6 +72,10 @@ type Class struct {
 	Properties       gera.Map[string, string] `yaml:"properties"`
 	Constraints      []constraint.Constraint  `yaml:"constraints"`
 	Connect          []channel.Outbound       `yaml:"connect"`
+	Restart          *RestartPolicy           `yaml:"restart"`
+	Probes           *common.TaskProbes       `yaml:"probes"`
+	Fetch            common.TaskArtifacts     `yaml:"fetch"`
+	Stop             *common.TaskStopPolicy   `yaml:"stop"`
 	UpdatedTimestamp time.Time                `yaml:"-"`
 }
 
@@ -92,6 +96,10 @@ func (c *Class) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
 		Properties  map[string]string       `yaml:"properties"`
 		Constraints []constraint.Constraint `yaml:"constraints"`
 		Connect     []channel.Outbound      `yaml:"connect"`
+		Restart     *RestartPolicy          `yaml:"restart"`
+		Probes      *common.TaskProbes      `yaml:"probes"`
+		Fetch       common.TaskArtifacts    `yaml:"fetch"`
+		Stop        *common.TaskStopPolicy  `yaml:"stop"`
 	}
 	aux := _class{
 		Defaults:   make(map[string]string),
@@ -119,6 +127,10 @@ func (c *Class) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
 			Properties:       gera.MakeMapWithMap(aux.Properties),
 			Constraints:      aux.Constraints,
 			Connect:          aux.Connect,
+			Restart:          aux.Restart,
+			Probes:           aux.Probes,
+			Fetch:            aux.Fetch,
+			Stop:             aux.Stop,
 			UpdatedTimestamp: time.Now(),
 		}
 	}
@@ -140,6 +152,10 @@ func (c *Class) MarshalYAML() (interface{}, error) {
 		Properties  map[string]string       `yaml:"properties,omitempty"`
 		Constraints []constraint.Constraint `yaml:"constraints,omitempty"`
 		Command     *common.CommandInfo     `yaml:"command"`
+		Restart     *RestartPolicy          `yaml:"restart,omitempty"`
+		Probes      *common.TaskProbes      `yaml:"probes,omitempty"`
+		Fetch       common.TaskArtifacts    `yaml:"fetch,omitempty"`
+		Stop        *common.TaskStopPolicy  `yaml:"stop,omitempty"`
 	}
 
 	aux := _class{
@@ -152,6 +168,10 @@ func (c *Class) MarshalYAML() (interface{}, error) {
 		Bind:        c.Bind,
 		Constraints: c.Constraints,
 		Command:     c.Command,
+		Restart:     c.Restart,
+		Probes:      c.Probes,
+		Fetch:       c.Fetch,
+		Stop:        c.Stop,
 	}
 
 	if c.Control.Mode == controlmode.FAIRMQ {
@@ -172,6 +192,7 @@ func (c *Class) Equals(other *Class) (response bool) {
 	response = c.Command.Equals(other.Command) &&
 		*c.Wants.Cpu == *other.Wants.Cpu &&
 		*c.Wants.Memory == *other.Wants.Memory &&
-		c.Wants.Ports.Equals(other.Wants.Ports)
+		c.Wants.Ports.Equals(other.Wants.Ports) &&
+		c.Wants.Affinity == other.Wants.Affinity
 	return
 }
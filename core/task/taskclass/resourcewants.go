@@ -34,6 +34,8 @@ type ResourceWants struct {
 	Cpu    *float64    `yaml:"cpu"`
 	Memory *float64    `yaml:"memory"`
 	Ports  port.Ranges `yaml:"ports,omitempty"`
+	// Affinity places the task on a NUMA node and/or a set of CPUs
+	Affinity Affinity `yaml:",inline"`
 }
 
 func (rw *ResourceWants) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
@@ -41,6 +43,8 @@ func (rw *ResourceWants) UnmarshalYAML(unmarshal func(interface{}) error) (err e
 		Cpu    *string `yaml:"cpu"`
 		Memory *string `yaml:"memory"`
 		Ports  *string `yaml:"ports"`
+		Numa   *string `yaml:"numa"`
+		Cpuset *string `yaml:"cpuset"`
 	}
 	aux := _resourceWants{}
 	err = unmarshal(&aux)
@@ -72,5 +76,13 @@ func (rw *ResourceWants) UnmarshalYAML(unmarshal func(interface{}) error) (err e
 		}
 		rw.Ports = ranges
 	}
+	if aux.Numa != nil {
+		rw.Affinity.Numa = *aux.Numa
+	}
+	if aux.Cpuset != nil {
+		rw.Affinity.Cpuset = *aux.Cpuset
+	}
+	// task template wants are not templated, so we can validate right away
+	_, _, err = rw.Affinity.Parse()
 	return
 }
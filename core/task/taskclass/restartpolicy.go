@@ -0,0 +1,118 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package taskclass
+
+import (
+	"fmt"
+	"time"
+)
+
+type RestartMode string
+
+const (
+	RestartNever     RestartMode = "never"
+	RestartOnFailure RestartMode = "on-failure"
+)
+
+const (
+	DEFAULT_RESTART_MAX_ATTEMPTS = 3
+	DEFAULT_RESTART_BACKOFF      = 5 * time.Second
+)
+
+// RestartPolicy tells the core whether a task which dies unexpectedly while
+// part of an environment should be relaunched, instead of going to ERROR.
+// The wait before each attempt is Backoff, doubled for every previous attempt.
+type RestartPolicy struct {
+	Mode        RestartMode   `yaml:"policy"`
+	MaxAttempts int           `yaml:"maxAttempts"`
+	Backoff     time.Duration `yaml:"backoff"`
+}
+
+func (rp *RestartPolicy) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _restartPolicy struct {
+		Mode        string `yaml:"policy"`
+		MaxAttempts *int   `yaml:"maxAttempts"`
+		Backoff     string `yaml:"backoff"`
+	}
+	aux := _restartPolicy{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+
+	policy := RestartPolicy{
+		Mode:        RestartMode(aux.Mode),
+		MaxAttempts: DEFAULT_RESTART_MAX_ATTEMPTS,
+		Backoff:     DEFAULT_RESTART_BACKOFF,
+	}
+	switch policy.Mode {
+	case "":
+		policy.Mode = RestartNever
+	case RestartNever, RestartOnFailure:
+	default:
+		return fmt.Errorf("invalid restart policy %s, allowed values: %s, %s", aux.Mode, RestartNever, RestartOnFailure)
+	}
+	if aux.MaxAttempts != nil {
+		if *aux.MaxAttempts < 1 {
+			return fmt.Errorf("invalid restart maxAttempts %d, must be at least 1", *aux.MaxAttempts)
+		}
+		policy.MaxAttempts = *aux.MaxAttempts
+	}
+	if len(aux.Backoff) > 0 {
+		policy.Backoff, err = time.ParseDuration(aux.Backoff)
+		if err != nil {
+			return fmt.Errorf("invalid restart backoff %s: %w", aux.Backoff, err)
+		}
+	}
+
+	*rp = policy
+	return
+}
+
+func (rp *RestartPolicy) MarshalYAML() (interface{}, error) {
+	type _restartPolicy struct {
+		Mode        string `yaml:"policy"`
+		MaxAttempts int    `yaml:"maxAttempts,omitempty"`
+		Backoff     string `yaml:"backoff,omitempty"`
+	}
+	aux := _restartPolicy{Mode: string(rp.Mode)}
+	if rp.Mode == RestartOnFailure {
+		aux.MaxAttempts = rp.MaxAttempts
+		aux.Backoff = rp.Backoff.String()
+	}
+	return aux, nil
+}
+
+// BackoffForAttempt returns the wait before the given restart attempt,
+// counting from 1. The doubling stops after the 10th attempt.
+func (rp *RestartPolicy) BackoffForAttempt(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	if attempt > 10 {
+		attempt = 10
+	}
+	return rp.Backoff << (attempt - 1)
+}
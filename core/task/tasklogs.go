@@ -0,0 +1,150 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/controlcommands"
+)
+
+// DEPARTED_TASKS_CACHE_SIZE bounds how many released tasks we remember the
+// location of, so that their logs can still be retrieved from the executor.
+const DEPARTED_TASKS_CACHE_SIZE = 4096
+
+// TaskLogs is a chunk of the output of a task, as kept by its executor.
+// NextOffset should be passed back to continue reading where this chunk ends.
+type TaskLogs struct {
+	TaskId     string
+	Hostname   string
+	Content    string
+	NextOffset int64
+}
+
+type taskLocation struct {
+	hostname string
+	envId    uid.ID
+	target   controlcommands.MesosCommandTarget
+}
+
+// departedTasks is a bounded FIFO cache of the locations of tasks which are no
+// longer in the roster.
+type departedTasks struct {
+	mu        sync.RWMutex
+	locations map[string]taskLocation
+	order     []string
+	capacity  int
+}
+
+func newDepartedTasks(capacity int) *departedTasks {
+	return &departedTasks{
+		locations: make(map[string]taskLocation),
+		order:     make([]string, 0),
+		capacity:  capacity,
+	}
+}
+
+func (d *departedTasks) add(taskId string, loc taskLocation) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.locations[taskId]; !ok {
+		d.order = append(d.order, taskId)
+	}
+	d.locations[taskId] = loc
+
+	for len(d.order) > d.capacity {
+		delete(d.locations, d.order[0])
+		d.order = d.order[1:]
+	}
+}
+
+func (d *departedTasks) get(taskId string) (loc taskLocation, ok bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	loc, ok = d.locations[taskId]
+	return
+}
+
+func (m *Manager) rememberDepartedTasks(tasks Tasks) {
+	for _, t := range tasks {
+		if t == nil || len(t.GetAgentId()) == 0 || len(t.GetExecutorId()) == 0 {
+			continue
+		}
+		m.departed.add(t.GetTaskId(), taskLocation{
+			hostname: t.GetHostname(),
+			envId:    t.GetEnvironmentId(),
+			target:   t.GetMesosCommandTarget(),
+		})
+	}
+}
+
+// GetTaskLogs fetches the output of a task from its executor, whether the task
+// is still running or was released recently enough for its location to be
+// known. With tail > 0 and offset 0, only the last tail lines are returned.
+// With follow, offset is where the previous read ended.
+func (m *Manager) GetTaskLogs(taskId string, tail int, offset int64, follow bool) (*TaskLogs, error) {
+	var loc taskLocation
+	if t := m.GetTask(taskId); t != nil {
+		if len(t.GetAgentId()) == 0 || len(t.GetExecutorId()) == 0 {
+			return nil, fmt.Errorf("task %s has not been deployed yet", taskId)
+		}
+		loc = taskLocation{
+			hostname: t.GetHostname(),
+			envId:    t.GetEnvironmentId(),
+			target:   t.GetMesosCommandTarget(),
+		}
+	} else if departed, ok := m.departed.get(taskId); ok {
+		loc = departed
+	} else {
+		return nil, TaskNotFoundError{taskId: taskId}
+	}
+
+	// We bypass the command queue, reading logs is side-effect free and must
+	// not wait behind environment transitions.
+	cmd := controlcommands.NewMesosCommand_GetTaskLogs(loc.envId, loc.target, tail, offset, follow)
+	response, err := m.schedulerState.servent.RunCommand(cmd, loc.target)
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve logs for task %s on %s: %w", taskId, loc.hostname, err)
+	}
+	if respErr := response.Err(); respErr != nil {
+		return nil, fmt.Errorf("cannot retrieve logs for task %s on %s: %s", taskId, loc.hostname, respErr.Error())
+	}
+
+	res, ok := response.(*controlcommands.MesosCommandResponse_GetTaskLogs)
+	if !ok {
+		return nil, errors.New("unexpected response type for task logs command")
+	}
+	return &TaskLogs{
+		TaskId:     taskId,
+		Hostname:   loc.hostname,
+		Content:    res.Content,
+		NextOffset: res.NextOffset,
+	}, nil
+}
@@ -0,0 +1,69 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("departed task locations", func() {
+	It("remembers where released tasks ran", func() {
+		m := &Manager{departed: newDepartedTasks(8)}
+		envId := uid.New()
+		m.rememberDepartedTasks(Tasks{
+			cordonTestTask("t1", "flp001", envId),
+			{taskId: "t2"}, // never deployed, nothing to remember
+		})
+
+		loc, ok := m.departed.get("t1")
+		Expect(ok).To(BeTrue())
+		Expect(loc.hostname).To(Equal("flp001"))
+		Expect(loc.envId).To(Equal(envId))
+		Expect(loc.target.AgentId.Value).To(Equal("agent-flp001"))
+		Expect(loc.target.ExecutorId.Value).To(Equal("executor-flp001"))
+		Expect(loc.target.TaskId.Value).To(Equal("t1"))
+
+		_, ok = m.departed.get("t2")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("forgets the oldest tasks first", func() {
+		d := newDepartedTasks(2)
+		d.add("t1", taskLocation{hostname: "flp001"})
+		d.add("t2", taskLocation{hostname: "flp002"})
+		d.add("t1", taskLocation{hostname: "flp003"})
+		d.add("t3", taskLocation{hostname: "flp004"})
+
+		_, ok := d.get("t1")
+		Expect(ok).To(BeFalse())
+		loc, ok := d.get("t2")
+		Expect(ok).To(BeTrue())
+		Expect(loc.hostname).To(Equal("flp002"))
+		_, ok = d.get("t3")
+		Expect(ok).To(BeTrue())
+	})
+})
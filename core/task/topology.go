@@ -0,0 +1,285 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/core/task/channel"
+)
+
+type TopologyIssueSeverity string
+
+const (
+	TOPOLOGY_WARNING = TopologyIssueSeverity("WARNING")
+	TOPOLOGY_ERROR   = TopologyIssueSeverity("ERROR")
+)
+
+// TopologyNode is a task which binds or connects FairMQ channels.
+type TopologyNode struct {
+	TaskId    string `json:"taskId"`
+	Name      string `json:"name"`
+	ClassName string `json:"className"`
+	Hostname  string `json:"hostname"`
+	RolePath  string `json:"rolePath"`
+}
+
+// TopologyChannelEnd is one side of a channel as seen by the task which
+// declares it.
+type TopologyChannelEnd struct {
+	TaskId     string                `json:"taskId"`
+	Channel    string                `json:"channel"`
+	Type       channel.ChannelType   `json:"type"`
+	Transport  channel.TransportType `json:"transport"`
+	SndBufSize int                   `json:"sndBufSize"`
+	RcvBufSize int                   `json:"rcvBufSize"`
+}
+
+// TopologyEdge is a resolved channel, from the task which connects to the
+// task which binds.
+type TopologyEdge struct {
+	Connect   TopologyChannelEnd    `json:"connect"`
+	Bind      TopologyChannelEnd    `json:"bind"`
+	Address   string                `json:"address"`
+	Transport channel.TransportType `json:"transport"`
+}
+
+type TopologyIssue struct {
+	Severity TopologyIssueSeverity `json:"severity"`
+	TaskId   string                `json:"taskId"`
+	Channel  string                `json:"channel"`
+	Message  string                `json:"message"`
+}
+
+func (i TopologyIssue) String() string {
+	return fmt.Sprintf("%s: task %s channel %s: %s", i.Severity, i.TaskId, i.Channel, i.Message)
+}
+
+// Topology is the channel graph of a set of deployed tasks, resolved the same
+// way as when building their FairMQ property maps.
+type Topology struct {
+	Nodes  []TopologyNode  `json:"nodes"`
+	Edges  []TopologyEdge  `json:"edges"`
+	Issues []TopologyIssue `json:"issues"`
+}
+
+func (t *Topology) HasErrors() bool {
+	for _, issue := range t.Issues {
+		if issue.Severity == TOPOLOGY_ERROR {
+			return true
+		}
+	}
+	return false
+}
+
+type taskChannels struct {
+	node         TopologyNode
+	inbound      []channel.Inbound
+	outbound     []channel.Outbound
+	localBindMap channel.BindMap
+}
+
+type boundChannel struct {
+	end     TopologyChannelEnd
+	address string
+	used    bool
+}
+
+// BuildTopology resolves the channels of the given tasks into a graph and
+// validates it. Tasks which are not FairMQ or direct control tasks, or which
+// are not deployed yet, are skipped.
+func BuildTopology(tasks Tasks) *Topology {
+	specs := make([]taskChannels, 0, len(tasks))
+	for _, t := range tasks {
+		if t == nil || t.GetParent() == nil {
+			continue
+		}
+		class := t.GetTaskClass()
+		if class == nil ||
+			(class.Control.Mode != controlmode.FAIRMQ && class.Control.Mode != controlmode.DIRECT) {
+			continue
+		}
+		specs = append(specs, taskChannels{
+			node: TopologyNode{
+				TaskId:    t.GetTaskId(),
+				Name:      t.GetName(),
+				ClassName: t.GetClassName(),
+				Hostname:  t.GetHostname(),
+				RolePath:  t.GetParentRolePath(),
+			},
+			inbound:      channel.MergeInbound(t.GetParent().CollectInboundChannels(), class.Bind),
+			outbound:     channel.MergeOutbound(t.GetParent().CollectOutboundChannels(), class.Connect),
+			localBindMap: t.GetLocalBindMap(),
+		})
+	}
+	return buildTopology(specs)
+}
+
+func buildTopology(specs []taskChannels) *Topology {
+	topology := &Topology{
+		Nodes:  make([]TopologyNode, 0, len(specs)),
+		Edges:  make([]TopologyEdge, 0),
+		Issues: make([]TopologyIssue, 0),
+	}
+	issue := func(severity TopologyIssueSeverity, taskId string, channelName string, format string, a ...interface{}) {
+		topology.Issues = append(topology.Issues, TopologyIssue{
+			Severity: severity,
+			TaskId:   taskId,
+			Channel:  channelName,
+			Message:  fmt.Sprintf(format, a...),
+		})
+	}
+
+	// First we index all inbound channels by the targets outbound channels
+	// may use to reach them: role path, global alias and explicit address.
+	bound := make([]*boundChannel, 0)
+	byTarget := make(map[string]*boundChannel)
+	byAddress := make(map[string]*boundChannel)
+	for _, spec := range specs {
+		topology.Nodes = append(topology.Nodes, spec.node)
+		for _, inbound := range spec.inbound {
+			bc := &boundChannel{
+				end: TopologyChannelEnd{
+					TaskId:     spec.node.TaskId,
+					Channel:    inbound.Name,
+					Type:       inbound.Type,
+					Transport:  inbound.Transport,
+					SndBufSize: inbound.SndBufSize,
+					RcvBufSize: inbound.RcvBufSize,
+				},
+			}
+			if len(inbound.Target) != 0 {
+				bc.address = inbound.Target
+			} else if endpoint, ok := spec.localBindMap[inbound.Name]; ok {
+				endpoint = endpoint.ToTargetEndpoint(spec.node.Hostname)
+				bc.address = endpoint.GetAddress()
+				bc.end.Transport = endpoint.GetTransport()
+			} else {
+				issue(TOPOLOGY_ERROR, spec.node.TaskId, inbound.Name, "inbound channel has no bound endpoint")
+			}
+			bound = append(bound, bc)
+
+			byTarget[spec.node.RolePath+TARGET_SEPARATOR+inbound.Name] = bc
+			if len(inbound.Global) != 0 {
+				byTarget["::"+inbound.Global] = bc
+			}
+			if len(bc.address) != 0 {
+				byAddress[bc.address] = bc
+			}
+		}
+	}
+
+	for _, spec := range specs {
+		for _, outbound := range spec.outbound {
+			var bc *boundChannel
+			if strings.HasPrefix(outbound.Target, "tcp://") ||
+				strings.HasPrefix(outbound.Target, "ipc://") {
+				bc = byAddress[outbound.Target]
+				if bc == nil {
+					// bound by something outside of this set of tasks
+					continue
+				}
+			} else {
+				bc = byTarget[outbound.Target]
+				if bc == nil {
+					issue(TOPOLOGY_ERROR, spec.node.TaskId, outbound.Name, "cannot resolve outbound channel target %s", outbound.Target)
+					continue
+				}
+			}
+			bc.used = true
+
+			edge := TopologyEdge{
+				Connect: TopologyChannelEnd{
+					TaskId:     spec.node.TaskId,
+					Channel:    outbound.Name,
+					Type:       outbound.Type,
+					Transport:  outbound.Transport,
+					SndBufSize: outbound.SndBufSize,
+					RcvBufSize: outbound.RcvBufSize,
+				},
+				Bind:      bc.end,
+				Address:   bc.address,
+				Transport: bc.end.Transport,
+			}
+			topology.Edges = append(topology.Edges, edge)
+
+			if !channelTypesMatch(edge.Connect.Type, edge.Bind.Type) {
+				issue(TOPOLOGY_ERROR, spec.node.TaskId, outbound.Name, "channel type %s does not match type %s of inbound channel %s of task %s",
+					edge.Connect.Type, edge.Bind.Type, edge.Bind.Channel, edge.Bind.TaskId)
+			}
+			// same condition as the warning when building the FairMQ property map
+			if edge.Connect.Transport != channel.DEFAULT && edge.Connect.Transport != edge.Bind.Transport {
+				issue(TOPOLOGY_ERROR, spec.node.TaskId, outbound.Name, "channel transport %s does not match transport %s of inbound channel %s of task %s",
+					edge.Connect.Transport, edge.Bind.Transport, edge.Bind.Channel, edge.Bind.TaskId)
+			}
+		}
+	}
+
+	for _, bc := range bound {
+		if !bc.used {
+			issue(TOPOLOGY_WARNING, bc.end.TaskId, bc.end.Channel, "no outbound channel connects to this inbound channel")
+		}
+	}
+
+	sort.SliceStable(topology.Nodes, func(i, j int) bool {
+		return topology.Nodes[i].RolePath < topology.Nodes[j].RolePath
+	})
+	return topology
+}
+
+// channelTypesMatch returns true if the channel types can talk to each other,
+// regardless of which side binds.
+func channelTypesMatch(a channel.ChannelType, b channel.ChannelType) bool {
+	switch a {
+	case channel.PUSH:
+		return b == channel.PULL
+	case channel.PULL:
+		return b == channel.PUSH
+	case channel.PUB:
+		return b == channel.SUB
+	case channel.SUB:
+		return b == channel.PUB
+	}
+	return false
+}
+
+// sender returns the channel end which sends data through the edge.
+func (e TopologyEdge) sender() TopologyChannelEnd {
+	if e.Bind.Type == channel.PUSH || e.Bind.Type == channel.PUB {
+		return e.Bind
+	}
+	return e.Connect
+}
+
+// receiver returns the channel end which receives data through the edge.
+func (e TopologyEdge) receiver() TopologyChannelEnd {
+	if e.Bind.Type == channel.PUSH || e.Bind.Type == channel.PUB {
+		return e.Connect
+	}
+	return e.Bind
+}
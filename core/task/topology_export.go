@@ -0,0 +1,206 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	TOPOLOGY_FORMAT_JSON    = "json"
+	TOPOLOGY_FORMAT_DOT     = "dot"
+	TOPOLOGY_FORMAT_GRAPHML = "graphml"
+)
+
+// Export renders the topology in one of the supported formats: JSON,
+// Graphviz DOT or GraphML.
+func (t *Topology) Export(format string) (string, error) {
+	switch strings.ToLower(format) {
+	case TOPOLOGY_FORMAT_JSON:
+		payload, err := json.MarshalIndent(t, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(payload), nil
+	case TOPOLOGY_FORMAT_DOT:
+		return t.toDOT(), nil
+	case TOPOLOGY_FORMAT_GRAPHML:
+		return t.toGraphML()
+	}
+	return "", fmt.Errorf("unsupported topology format %s", format)
+}
+
+// toDOT renders the topology as a Graphviz digraph, with tasks grouped by
+// host and edges pointing in the direction of the data flow.
+func (t *Topology) toDOT() string {
+	var b strings.Builder
+	b.WriteString("digraph topology {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box];\n")
+
+	nodesByHost := make(map[string][]TopologyNode)
+	hosts := make([]string, 0)
+	for _, node := range t.Nodes {
+		if _, ok := nodesByHost[node.Hostname]; !ok {
+			hosts = append(hosts, node.Hostname)
+		}
+		nodesByHost[node.Hostname] = append(nodesByHost[node.Hostname], node)
+	}
+	for i, host := range hosts {
+		fmt.Fprintf(&b, "\tsubgraph cluster_%d {\n", i)
+		fmt.Fprintf(&b, "\t\tlabel=%s;\n", dotQuote(host))
+		for _, node := range nodesByHost[host] {
+			fmt.Fprintf(&b, "\t\t%s [label=%s];\n", dotQuote(node.TaskId), dotQuote(node.RolePath+"\n"+node.ClassName))
+		}
+		b.WriteString("\t}\n")
+	}
+
+	for _, edge := range t.Edges {
+		sender, receiver := edge.sender(), edge.receiver()
+		label := fmt.Sprintf("%s -> %s\n%s/%s %s\n%s", sender.Channel, receiver.Channel, sender.Type, receiver.Type, edge.Transport, edge.Address)
+		fmt.Fprintf(&b, "\t%s -> %s [label=%s];\n", dotQuote(sender.TaskId), dotQuote(receiver.TaskId), dotQuote(label))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	Id       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	Id          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	Id   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+var graphMLKeys = []graphMLKey{
+	{Id: "name", For: "node", AttrName: "name", AttrType: "string"},
+	{Id: "className", For: "node", AttrName: "className", AttrType: "string"},
+	{Id: "hostname", For: "node", AttrName: "hostname", AttrType: "string"},
+	{Id: "rolePath", For: "node", AttrName: "rolePath", AttrType: "string"},
+	{Id: "sourceChannel", For: "edge", AttrName: "sourceChannel", AttrType: "string"},
+	{Id: "targetChannel", For: "edge", AttrName: "targetChannel", AttrType: "string"},
+	{Id: "sourceType", For: "edge", AttrName: "sourceType", AttrType: "string"},
+	{Id: "targetType", For: "edge", AttrName: "targetType", AttrType: "string"},
+	{Id: "sourceMethod", For: "edge", AttrName: "sourceMethod", AttrType: "string"},
+	{Id: "transport", For: "edge", AttrName: "transport", AttrType: "string"},
+	{Id: "address", For: "edge", AttrName: "address", AttrType: "string"},
+	{Id: "sndBufSize", For: "edge", AttrName: "sndBufSize", AttrType: "int"},
+	{Id: "rcvBufSize", For: "edge", AttrName: "rcvBufSize", AttrType: "int"},
+}
+
+// toGraphML renders the topology as a GraphML document, with edges pointing
+// in the direction of the data flow. The buffer sizes are those of the
+// sending channel for sndBufSize, and of the receiving one for rcvBufSize.
+func (t *Topology) toGraphML() (string, error) {
+	doc := graphML{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys:  graphMLKeys,
+		Graph: graphMLGraph{
+			Id:          "topology",
+			EdgeDefault: "directed",
+			Nodes:       make([]graphMLNode, 0, len(t.Nodes)),
+			Edges:       make([]graphMLEdge, 0, len(t.Edges)),
+		},
+	}
+	for _, node := range t.Nodes {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			Id: node.TaskId,
+			Data: []graphMLData{
+				{Key: "name", Value: node.Name},
+				{Key: "className", Value: node.ClassName},
+				{Key: "hostname", Value: node.Hostname},
+				{Key: "rolePath", Value: node.RolePath},
+			},
+		})
+	}
+	for _, edge := range t.Edges {
+		sender, receiver := edge.sender(), edge.receiver()
+		method := "connect"
+		if sender == edge.Bind {
+			method = "bind"
+		}
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			Source: sender.TaskId,
+			Target: receiver.TaskId,
+			Data: []graphMLData{
+				{Key: "sourceChannel", Value: sender.Channel},
+				{Key: "targetChannel", Value: receiver.Channel},
+				{Key: "sourceType", Value: sender.Type.String()},
+				{Key: "targetType", Value: receiver.Type.String()},
+				{Key: "sourceMethod", Value: method},
+				{Key: "transport", Value: edge.Transport.String()},
+				{Key: "address", Value: edge.Address},
+				{Key: "sndBufSize", Value: strconv.Itoa(sender.SndBufSize)},
+				{Key: "rcvBufSize", Value: strconv.Itoa(receiver.RcvBufSize)},
+			},
+		})
+	}
+
+	payload, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(payload) + "\n", nil
+}
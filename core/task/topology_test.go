@@ -0,0 +1,196 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"encoding/json"
+	"encoding/xml"
+
+	"github.com/AliceO2Group/Control/core/task/channel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func topologyTestInbound(name string, chType channel.ChannelType, transport channel.TransportType, global string) channel.Inbound {
+	return channel.Inbound{
+		Channel: channel.Channel{
+			Name:       name,
+			Type:       chType,
+			Transport:  transport,
+			SndBufSize: 1000,
+			RcvBufSize: 2000,
+		},
+		Global:     global,
+		Addressing: channel.TCP,
+	}
+}
+
+func topologyTestOutbound(name string, chType channel.ChannelType, transport channel.TransportType, target string) channel.Outbound {
+	return channel.Outbound{
+		Channel: channel.Channel{
+			Name:       name,
+			Type:       chType,
+			Transport:  transport,
+			Target:     target,
+			SndBufSize: 3000,
+			RcvBufSize: 1000,
+		},
+	}
+}
+
+var _ = Describe("channel topology", func() {
+	var (
+		readout taskChannels
+		stfb    taskChannels
+	)
+	BeforeEach(func() {
+		readout = taskChannels{
+			node: TopologyNode{TaskId: "t-readout", Name: "readout#t-readout", ClassName: "readout", Hostname: "flp001", RolePath: "readout-dataflow.readout"},
+			outbound: []channel.Outbound{
+				topologyTestOutbound("readout", channel.PUSH, channel.SHMEM, "readout-dataflow.stfb:readout"),
+			},
+			localBindMap: channel.BindMap{},
+		}
+		stfb = taskChannels{
+			node: TopologyNode{TaskId: "t-stfb", Name: "stfb#t-stfb", ClassName: "stfbuilder", Hostname: "flp001", RolePath: "readout-dataflow.stfb"},
+			inbound: []channel.Inbound{
+				topologyTestInbound("readout", channel.PULL, channel.SHMEM, ""),
+			},
+			localBindMap: channel.BindMap{
+				"readout": channel.NewBoundTcpEndpoint(10001, channel.SHMEM),
+			},
+		}
+	})
+
+	It("resolves outbound channels to the inbound channels they target", func() {
+		topology := buildTopology([]taskChannels{readout, stfb})
+		Expect(topology.Nodes).To(HaveLen(2))
+		Expect(topology.Edges).To(HaveLen(1))
+		Expect(topology.Issues).To(BeEmpty())
+
+		edge := topology.Edges[0]
+		Expect(edge.Connect.TaskId).To(Equal("t-readout"))
+		Expect(edge.Bind.TaskId).To(Equal("t-stfb"))
+		Expect(edge.Address).To(Equal("tcp://flp001:10001"))
+		Expect(edge.Transport).To(Equal(channel.SHMEM))
+		Expect(edge.Connect.SndBufSize).To(Equal(3000))
+		Expect(edge.Bind.RcvBufSize).To(Equal(2000))
+		Expect(edge.sender().TaskId).To(Equal("t-readout"))
+		Expect(edge.receiver().TaskId).To(Equal("t-stfb"))
+	})
+
+	It("resolves global channel aliases and explicit addresses", func() {
+		stfb.inbound[0].Global = "readout-to-stfb"
+		readout.outbound[0].Target = "::readout-to-stfb"
+		Expect(buildTopology([]taskChannels{readout, stfb}).Edges).To(HaveLen(1))
+
+		readout.outbound[0].Target = "tcp://flp001:10001"
+		Expect(buildTopology([]taskChannels{readout, stfb}).Edges).To(HaveLen(1))
+
+		// an explicit address bound outside of the environment is not an error
+		readout.outbound[0].Target = "tcp://elsewhere:5555"
+		topology := buildTopology([]taskChannels{readout, stfb})
+		Expect(topology.Edges).To(BeEmpty())
+		Expect(topology.HasErrors()).To(BeFalse())
+	})
+
+	It("flags unconnected inbound channels", func() {
+		topology := buildTopology([]taskChannels{stfb})
+		Expect(topology.Issues).To(HaveLen(1))
+		Expect(topology.Issues[0].Severity).To(Equal(TOPOLOGY_WARNING))
+		Expect(topology.Issues[0].TaskId).To(Equal("t-stfb"))
+		Expect(topology.Issues[0].Channel).To(Equal("readout"))
+		Expect(topology.HasErrors()).To(BeFalse())
+	})
+
+	It("flags unresolvable targets", func() {
+		readout.outbound[0].Target = "readout-dataflow.nowhere:readout"
+		topology := buildTopology([]taskChannels{readout, stfb})
+		Expect(topology.HasErrors()).To(BeTrue())
+		Expect(topology.Issues).To(ContainElement(HaveField("Message", ContainSubstring("cannot resolve"))))
+	})
+
+	It("flags channel type mismatches", func() {
+		readout.outbound[0].Type = channel.PULL
+		topology := buildTopology([]taskChannels{readout, stfb})
+		Expect(topology.HasErrors()).To(BeTrue())
+		Expect(topology.Issues).To(HaveLen(1))
+		Expect(topology.Issues[0].Message).To(ContainSubstring("type pull does not match"))
+
+		Expect(channelTypesMatch(channel.PUB, channel.SUB)).To(BeTrue())
+		Expect(channelTypesMatch(channel.PUB, channel.PULL)).To(BeFalse())
+	})
+
+	It("flags transport mismatches", func() {
+		readout.outbound[0].Transport = channel.ZEROMQ
+		topology := buildTopology([]taskChannels{readout, stfb})
+		Expect(topology.HasErrors()).To(BeTrue())
+		Expect(topology.Issues[0].Message).To(ContainSubstring("transport zeromq does not match"))
+
+		// the default transport follows the inbound channel
+		readout.outbound[0].Transport = channel.DEFAULT
+		Expect(buildTopology([]taskChannels{readout, stfb}).Issues).To(BeEmpty())
+	})
+
+	Describe("export", func() {
+		var topology *Topology
+		BeforeEach(func() {
+			topology = buildTopology([]taskChannels{readout, stfb})
+		})
+
+		It("renders JSON", func() {
+			out, err := topology.Export("json")
+			Expect(err).NotTo(HaveOccurred())
+			parsed := Topology{}
+			Expect(json.Unmarshal([]byte(out), &parsed)).To(Succeed())
+			Expect(parsed.Edges).To(HaveLen(1))
+			Expect(parsed.Edges[0].Address).To(Equal("tcp://flp001:10001"))
+		})
+
+		It("renders DOT in the direction of the data flow", func() {
+			out, err := topology.Export("DOT")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HavePrefix("digraph topology {"))
+			Expect(out).To(ContainSubstring(`label="flp001"`))
+			Expect(out).To(ContainSubstring(`"t-readout" -> "t-stfb"`))
+		})
+
+		It("renders GraphML", func() {
+			out, err := topology.Export("graphml")
+			Expect(err).NotTo(HaveOccurred())
+			parsed := graphML{}
+			Expect(xml.Unmarshal([]byte(out), &parsed)).To(Succeed())
+			Expect(parsed.Graph.Nodes).To(HaveLen(2))
+			Expect(parsed.Graph.Edges).To(HaveLen(1))
+			Expect(parsed.Graph.Edges[0].Source).To(Equal("t-readout"))
+			Expect(parsed.Graph.Edges[0].Data).To(ContainElement(graphMLData{Key: "transport", Value: "shmem"}))
+		})
+
+		It("refuses unknown formats", func() {
+			_, err := topology.Export("svg")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -40,6 +40,9 @@ var (
 	writers = make(map[topic.Topic]event.Writer)
 	mu      sync.Mutex
 	log     = logger.New(logrus.StandardLogger(), "core")
+
+	// every event written by the core is also published here, for streaming to clients
+	hub = event.NewHub()
 )
 
 func createOrGetWriter(topic topic.Topic) event.Writer {
@@ -50,9 +53,9 @@ func createOrGetWriter(topic topic.Topic) event.Writer {
 		return writer
 	}
 	if viper.GetBool("enableKafka") {
-		writers[topic] = event.NewWriterWithTopic(topic)
+		writers[topic] = event.NewHubWriter(event.NewWriterWithTopic(topic), hub, topic)
 	} else {
-		writers[topic] = &event.DummyWriter{}
+		writers[topic] = event.NewHubWriter(&event.DummyWriter{}, hub, topic)
 	}
 
 	return writers[topic]
@@ -66,6 +69,10 @@ func EventWriterWithTopic(topic topic.Topic) event.Writer {
 	return createOrGetWriter(topic)
 }
 
+func EventHub() *event.Hub {
+	return hub
+}
+
 func ClearEventWriters() {
 	mu.Lock()
 	defer mu.Unlock()
@@ -26,6 +26,7 @@ package workflow
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	texttemplate "text/template"
@@ -34,6 +35,7 @@ import (
 
 	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/common/event/topic"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
 	pb "github.com/AliceO2Group/Control/common/protos"
 	"github.com/AliceO2Group/Control/configuration/template"
 	"github.com/AliceO2Group/Control/core/repos"
@@ -49,6 +51,12 @@ import (
 type aggregatorRole struct {
 	roleBase
 	aggregator
+
+	// Quorum allows some critical children to fail without failing the role,
+	// see Quorum
+	Quorum   string `yaml:"quorum,omitempty"`
+	quorum   *Quorum
+	degraded degradedFlag
 }
 
 func NewAggregatorRole(name string, roles []Role) (r Role) {
@@ -84,6 +92,15 @@ func (r *aggregatorRole) UnmarshalYAML(unmarshal func(interface{}) error) (err e
 		return
 	}
 
+	auxQuorum := struct {
+		Quorum string `yaml:"quorum"`
+	}{}
+	err = unmarshal(&auxQuorum)
+	if err != nil {
+		return
+	}
+	role.Quorum = auxQuorum.Quorum
+
 	*r = role
 	for _, v := range r.Roles {
 		v.setParent(r)
@@ -106,6 +123,10 @@ func (r *aggregatorRole) MarshalYAML() (interface{}, error) {
 		aux[k] = v
 	}
 
+	if len(r.Quorum) > 0 {
+		aux["quorum"] = r.Quorum
+	}
+
 	return aux, err
 }
 
@@ -137,6 +158,7 @@ func (r *aggregatorRole) ProcessTemplates(workflowRepo repos.IRepo, loadSubworkf
 		template.STAGE3: template.WrapMapItems(r.UserVars.Raw()),
 		template.STAGE4: template.Fields{
 			template.WrapPointer(&r.Name),
+			template.WrapPointer(&r.Quorum),
 		},
 		template.STAGE5: append(append(
 			WrapConstraints(r.Constraints),
@@ -177,6 +199,11 @@ func (r *aggregatorRole) ProcessTemplates(workflowRepo repos.IRepo, loadSubworkf
 
 	r.Enabled = strings.TrimSpace(r.Enabled)
 
+	r.quorum, err = parseQuorum(r.Quorum)
+	if err != nil {
+		return fmt.Errorf("role %s: %w", r.GetPath(), err)
+	}
+
 	// TREE PRUNING: we don't continue with children if this role is disabled
 	if !r.IsEnabled() {
 		r.Roles = make([]Role, 0)
@@ -253,6 +280,8 @@ func (r *aggregatorRole) copy() copyable {
 	rCopy := aggregatorRole{
 		roleBase:   *r.roleBase.copy().(*roleBase),
 		aggregator: *r.aggregator.copy().(*aggregator),
+		Quorum:     r.Quorum,
+		quorum:     r.quorum,
 	}
 	for i := 0; i < len(rCopy.Roles); i++ {
 		rCopy.Roles[i].setParent(&rCopy)
@@ -281,13 +310,15 @@ func (r *aggregatorRole) updateStatus(s task.Status) {
 		Trace("aggregator role about to merge incoming child status")
 	r.status.merge(s, r)
 	log.WithField("new status", r.status.get()).Trace("status merged")
+	degraded, degradedChanged := r.updateDegraded()
 
-	if oldStatus != r.status.get() {
+	if oldStatus != r.status.get() || degradedChanged {
 		the.EventWriterWithTopic(topic.Role).WriteEvent(&pb.Ev_RoleEvent{
 			Name:          r.Name,
 			Status:        r.status.get().String(),
 			RolePath:      r.GetPath(),
 			EnvironmentId: r.GetEnvironmentId().String(),
+			Degraded:      degraded,
 		})
 	}
 	r.SendEvent(&event.RoleEvent{Name: r.Name, Status: r.status.get().String(), RolePath: r.GetPath()})
@@ -305,13 +336,15 @@ func (r *aggregatorRole) updateState(s sm.State) {
 	log.WithField("role", r.Name).
 		WithField("partition", r.GetEnvironmentId().String()).
 		Tracef("updated state to %s upon input state %s", r.state.get().String(), s.String())
+	degraded, degradedChanged := r.updateDegraded()
 
-	if oldState != r.state.get() {
+	if oldState != r.state.get() || degradedChanged {
 		the.EventWriterWithTopic(topic.Role).WriteEvent(&pb.Ev_RoleEvent{
 			Name:          r.Name,
 			State:         r.state.get().String(),
 			RolePath:      r.GetPath(),
 			EnvironmentId: r.GetEnvironmentId().String(),
+			Degraded:      degraded,
 		})
 	}
 	r.SendEvent(&event.RoleEvent{Name: r.Name, State: r.state.get().String(), RolePath: r.GetPath()})
@@ -319,3 +352,26 @@ func (r *aggregatorRole) updateState(s sm.State) {
 		r.parent.updateState(r.state.get())
 	}
 }
+
+// updateDegraded recomputes whether the role is degraded, and reports to
+// operators when this changes.
+func (r *aggregatorRole) updateDegraded() (degraded bool, changed bool) {
+	if !r.hasQuorum() {
+		return false, false
+	}
+	_, degraded = r.healthyRoles()
+	changed = r.degraded.swap(degraded) != degraded
+	if !changed {
+		return
+	}
+	if degraded {
+		log.WithField("partition", r.GetEnvironmentId().String()).
+			WithField("level", infologger.IL_Ops).
+			Warnf("role '%s' is degraded: some critical children failed, but the quorum is still met", r.GetPath())
+	} else {
+		log.WithField("partition", r.GetEnvironmentId().String()).
+			WithField("level", infologger.IL_Ops).
+			Infof("role '%s' is no longer degraded", r.GetPath())
+	}
+	return
+}
@@ -21,8 +21,8 @@ var _ = Describe("aggregator role", func() {
 		When("an aggregator role is empty", func() {
 			BeforeEach(func() {
 				root = &aggregatorRole{
-					roleBase{Name: "root", Enabled: "true"},
-					aggregator{Roles: []Role{}},
+					roleBase:   roleBase{Name: "root", Enabled: "true"},
+					aggregator: aggregator{Roles: []Role{}},
 				}
 			})
 			It("should disable itself", func() {
@@ -36,8 +36,8 @@ var _ = Describe("aggregator role", func() {
 		When("an aggregator role has only disabled sub-roles", func() {
 			BeforeEach(func() {
 				root = &aggregatorRole{
-					roleBase{Name: "root", Enabled: "true"},
-					aggregator{
+					roleBase: roleBase{Name: "root", Enabled: "true"},
+					aggregator: aggregator{
 						Roles: []Role{&taskRole{roleBase: roleBase{Name: "task1", Enabled: "false"}}},
 					},
 				}
@@ -54,8 +54,8 @@ var _ = Describe("aggregator role", func() {
 		When("an aggregator role has an enabled role", func() {
 			BeforeEach(func() {
 				root = &aggregatorRole{
-					roleBase{Name: "root", Enabled: "true"},
-					aggregator{
+					roleBase: roleBase{Name: "root", Enabled: "true"},
+					aggregator: aggregator{
 						Roles: []Role{&taskRole{roleBase: roleBase{Name: "task1", Enabled: "true"}}},
 					},
 				}
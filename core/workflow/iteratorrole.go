@@ -26,14 +26,18 @@ package workflow
 
 import (
 	"errors"
+	"fmt"
 	"sync"
+	texttemplate "text/template"
 
 	"github.com/AliceO2Group/Control/common/gera"
 	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/configuration/template"
 	"github.com/AliceO2Group/Control/core/repos"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/task/constraint"
 	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/core/the"
 	"github.com/gobwas/glob"
 	"github.com/hashicorp/go-multierror"
 	"github.com/spf13/viper"
@@ -43,6 +47,11 @@ type iteratorRole struct {
 	aggregator
 	For      iteratorRange `yaml:"for,omitempty"`
 	template roleTemplate
+
+	// Quorum applies to the roles generated by the iterator as a whole,
+	// see Quorum
+	Quorum string `yaml:"quorum,omitempty"`
+	quorum *Quorum
 }
 
 type templateMap map[string]interface{}
@@ -119,8 +128,22 @@ func (i *iteratorRole) UnmarshalYAML(unmarshal func(interface{}) error) (err err
 		return
 	}
 
+	auxQuorum := struct {
+		Quorum string `yaml:"quorum"`
+	}{}
+	err = unmarshal(&auxQuorum)
+	if err != nil {
+		return
+	}
+	// The quorum of an iterator is about the generated roles, not about the
+	// children of each of them.
+	if aggTemplate, ok := template.(*aggregatorTemplate); ok {
+		aggTemplate.Quorum = ""
+	}
+
 	role.template = template
 	role.For = forBlock
+	role.Quorum = auxQuorum.Quorum
 
 	// FIXME: if Name does not contain {{ }}, we must bail!
 	*i = role
@@ -137,6 +160,9 @@ func (i *iteratorRole) MarshalYAML() (interface{}, error) {
 	}
 
 	aux["for"] = i.For
+	if len(i.Quorum) > 0 {
+		aux["quorum"] = i.Quorum
+	}
 
 	return aux, err
 }
@@ -229,6 +255,19 @@ func (i *iteratorRole) expandTemplate() (err error) {
 		return
 	}
 
+	quorum := i.Quorum
+	fields := template.Fields{
+		template.WrapPointer(&quorum),
+	}
+	err = fields.Execute(the.ConfSvc(), "", varStack, make(map[string]interface{}), nil, make(map[string]texttemplate.Template), nil)
+	if err != nil {
+		return
+	}
+	i.quorum, err = parseQuorum(quorum)
+	if err != nil {
+		return fmt.Errorf("iterator %s: %w", i.GetName(), err)
+	}
+
 	concurrency := viper.GetBool("concurrentIteratorRoleExpansion")
 
 	if concurrency {
@@ -284,6 +323,8 @@ func (i *iteratorRole) copy() copyable {
 		aggregator: *i.aggregator.copy().(*aggregator),
 		For:        i.For.copy().(iteratorRange),
 		template:   i.template.copy().(roleTemplate), // the template must be copied too, because it is a pointer to something that might change
+		Quorum:     i.Quorum,
+		quorum:     i.quorum,
 	}
 	return &iCopy
 }
@@ -32,6 +32,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/AliceO2Group/Control/common/controlmode"
 	"github.com/AliceO2Group/Control/common/gera"
 	"github.com/AliceO2Group/Control/core/repos"
 	"github.com/AliceO2Group/Control/core/task"
@@ -142,6 +143,38 @@ func Load(workflowPath string, parent Updatable, taskManager *task.Manager, user
 		return
 	}
 	err = taskManager.RefreshClasses(taskClassesRequired)
+	if err != nil {
+		return
+	}
+	err = checkRestartPolicies(workflow, taskManager.GetTaskClass)
+	return
+}
+
+// checkRestartPolicies refuses a workflow whose tasks would be restarted on
+// failure while binding inbound channels. Such tasks can only be replaced
+// while the environment is DEPLOYED, because their peers would not learn of
+// the replacement's endpoints, so the policy could never be honoured.
+func checkRestartPolicies(root Role, getTaskClass func(name string) *taskclass.Class) (err error) {
+	LeafWalk(root, func(role Role) {
+		t, ok := role.(*taskRole)
+		if !ok || err != nil {
+			return
+		}
+		class := getTaskClass(t.LoadTaskClass)
+		if class == nil || class.Control.Mode == controlmode.HOOK {
+			return
+		}
+		policy := t.Restart
+		if policy == nil {
+			policy = class.Restart
+		}
+		if policy == nil || policy.Mode != taskclass.RestartOnFailure {
+			return
+		}
+		if len(t.CollectInboundChannels()) > 0 || len(class.Bind) > 0 {
+			err = fmt.Errorf("task role %s binds inbound channels, it cannot have restart policy %s", t.GetPath(), taskclass.RestartOnFailure)
+		}
+	})
 	return
 }
 
@@ -0,0 +1,93 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/core/task/channel"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("restart policy check", func() {
+	var (
+		classes map[string]*taskclass.Class
+		root    *aggregatorRole
+		role    *taskRole
+	)
+	onFailure := &taskclass.RestartPolicy{Mode: taskclass.RestartOnFailure, MaxAttempts: 3}
+	never := &taskclass.RestartPolicy{Mode: taskclass.RestartNever}
+	inbound := []channel.Inbound{{Channel: channel.Channel{Name: "data", Type: channel.PULL}}}
+
+	getTaskClass := func(name string) *taskclass.Class {
+		return classes[name]
+	}
+
+	BeforeEach(func() {
+		classes = map[string]*taskclass.Class{"readout": {Identifier: taskclass.Id{Name: "readout"}}}
+		role = &taskRole{roleBase: roleBase{Name: "readout"}, LoadTaskClass: "readout"}
+		root = &aggregatorRole{
+			roleBase:   roleBase{Name: "root"},
+			aggregator: aggregator{Roles: []Role{role}},
+		}
+		LinkChildrenToParents(root)
+	})
+
+	It("accepts restarting tasks which bind no channels", func() {
+		role.Restart = onFailure
+		Expect(checkRestartPolicies(root, getTaskClass)).To(Succeed())
+	})
+
+	It("refuses restarting tasks whose class binds channels", func() {
+		classes["readout"].Bind = inbound
+		classes["readout"].Restart = onFailure
+		Expect(checkRestartPolicies(root, getTaskClass)).To(MatchError(ContainSubstring("root.readout binds inbound channels")))
+	})
+
+	It("refuses restarting tasks whose parent role binds channels", func() {
+		root.Roles = []Role{&aggregatorRole{
+			roleBase:   roleBase{Name: "flp", Bind: inbound},
+			aggregator: aggregator{Roles: []Role{role}},
+		}}
+		LinkChildrenToParents(root)
+		role.Restart = onFailure
+		Expect(checkRestartPolicies(root, getTaskClass)).NotTo(Succeed())
+	})
+
+	It("accepts a role which disables the restart policy of its class", func() {
+		classes["readout"].Bind = inbound
+		classes["readout"].Restart = onFailure
+		role.Restart = never
+		Expect(checkRestartPolicies(root, getTaskClass)).To(Succeed())
+	})
+
+	It("ignores hooks, which are never restarted", func() {
+		classes["readout"].Bind = inbound
+		classes["readout"].Restart = onFailure
+		classes["readout"].Control.Mode = controlmode.HOOK
+		Expect(checkRestartPolicies(root, getTaskClass)).To(Succeed())
+	})
+})
@@ -0,0 +1,273 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/sm"
+)
+
+// Quorum is the minimum number of critical children of an aggregator or
+// iterator role which must be healthy for the role itself to be considered
+// healthy. It is written either as an absolute count ("48") or as a
+// percentage of the critical children ("95%").
+type Quorum struct {
+	count   int
+	percent float64
+}
+
+func parseQuorum(str string) (q *Quorum, err error) {
+	str = strings.TrimSpace(str)
+	if len(str) == 0 {
+		return nil, nil
+	}
+	if strings.HasSuffix(str, "%") {
+		var percent float64
+		percent, err = strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(str, "%")), 64)
+		if err != nil || percent <= 0 || percent > 100 {
+			return nil, fmt.Errorf("invalid quorum %s, percentage must be in (0, 100]", str)
+		}
+		return &Quorum{percent: percent}, nil
+	}
+	var count int
+	count, err = strconv.Atoi(str)
+	if err != nil || count < 1 {
+		return nil, fmt.Errorf("invalid quorum %s, must be a positive count or a percentage", str)
+	}
+	return &Quorum{count: count}, nil
+}
+
+// Required returns the number of healthy children needed out of total.
+// A count larger than total requires all of them.
+func (q *Quorum) Required(total int) int {
+	if q == nil {
+		return total
+	}
+	if q.percent > 0 {
+		// the epsilon protects exact ratios like 95% of 20 from rounding up
+		return int(math.Ceil(float64(total)*q.percent/100 - 1e-9))
+	}
+	if q.count > total {
+		return total
+	}
+	return q.count
+}
+
+func (q *Quorum) String() string {
+	if q == nil {
+		return ""
+	}
+	if q.percent > 0 {
+		return strconv.FormatFloat(q.percent, 'f', -1, 64) + "%"
+	}
+	return strconv.Itoa(q.count)
+}
+
+// roleFailed tells whether a role counts against the quorum of its group.
+// Roles which are merely not active yet do not, otherwise a deployment
+// would be considered complete as soon as the quorum is reached.
+func roleFailed(role Role) bool {
+	return role.GetState() == sm.ERROR || role.GetStatus() == task.UNDEPLOYABLE
+}
+
+// A quorumGroup is a set of sibling roles whose failures are absorbed as long
+// as the quorum is met. Roles not covered by any quorum form a group with a
+// nil quorum.
+type quorumGroup struct {
+	roles  []Role
+	quorum *Quorum
+}
+
+// tolerates counts the failed critical roles in the group, and tells whether
+// the quorum is still met.
+func (g quorumGroup) tolerates(failed func(Role) bool) (tolerated bool, failedCount int) {
+	critical := 0
+	for _, role := range g.roles {
+		if !role.IsCritical() {
+			continue
+		}
+		critical++
+		if failed(role) {
+			failedCount++
+		}
+	}
+	if g.quorum == nil {
+		return failedCount == 0, failedCount
+	}
+	return critical-failedCount >= g.quorum.Required(critical), failedCount
+}
+
+// quorumGroups splits the children of the role according to the quorums of
+// the role itself and of its iterators.
+func (r *aggregatorRole) quorumGroups() (groups []quorumGroup) {
+	if r.quorum != nil {
+		return []quorumGroup{{roles: r.GetRoles(), quorum: r.quorum}}
+	}
+	plain := quorumGroup{roles: make([]Role, 0)}
+	for _, v := range r.Roles {
+		if iter, ok := v.(*iteratorRole); ok {
+			if iter.quorum != nil {
+				groups = append(groups, quorumGroup{roles: iter.GetRoles(), quorum: iter.quorum})
+			} else {
+				plain.roles = append(plain.roles, iter.GetRoles()...)
+			}
+			continue
+		}
+		plain.roles = append(plain.roles, v)
+	}
+	return append(groups, plain)
+}
+
+// quorumGroupOf returns the group of the given child if it is covered by a
+// quorum.
+func (r *aggregatorRole) quorumGroupOf(child Role) (group quorumGroup, ok bool) {
+	for _, g := range r.quorumGroups() {
+		if g.quorum == nil {
+			continue
+		}
+		for _, role := range g.roles {
+			if isSameRole(role, child) {
+				return g, true
+			}
+		}
+	}
+	return quorumGroup{}, false
+}
+
+// isSameRole also matches an includeRole with its inner aggregatorRole, which
+// is what the children of the includeRole see as their parent.
+func isSameRole(role Role, other Role) bool {
+	if role == other {
+		return true
+	}
+	if incl, ok := role.(*includeRole); ok {
+		if agg, ok := other.(*aggregatorRole); ok {
+			return &incl.aggregatorRole == agg
+		}
+	}
+	return false
+}
+
+// hasQuorum tells whether failures of some children of r can be absorbed, in
+// which case an incoming ERROR must be aggregated rather than adopted.
+func hasQuorum(r Role) bool {
+	agg, isAggregator := r.(*aggregatorRole)
+	return isAggregator && agg.hasQuorum()
+}
+
+func (r *aggregatorRole) hasQuorum() bool {
+	for _, g := range r.quorumGroups() {
+		if g.quorum != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// healthyRoles returns the children which should be taken into account when
+// aggregating state and status. Failed critical children are left out for as
+// long as their quorum is met, in which case the role is degraded.
+func (r *aggregatorRole) healthyRoles() (roles []Role, degraded bool) {
+	roles = make([]Role, 0)
+	for _, g := range r.quorumGroups() {
+		if g.quorum == nil {
+			roles = append(roles, g.roles...)
+			continue
+		}
+		tolerated, failedCount := g.tolerates(roleFailed)
+		if !tolerated || failedCount == 0 {
+			roles = append(roles, g.roles...)
+			continue
+		}
+		degraded = true
+		for _, role := range g.roles {
+			if role.IsCritical() && roleFailed(role) {
+				continue
+			}
+			roles = append(roles, role)
+		}
+	}
+	return
+}
+
+// QuorumTolerates returns true if the failure of this role, together with the
+// failures of the roles at failedPaths, is absorbed by the quorum of one of
+// its ancestors.
+func (t *taskRole) QuorumTolerates(failedPaths []string) bool {
+	if t == nil {
+		return false
+	}
+	failedPaths = append([]string{t.GetPath()}, failedPaths...)
+	failed := func(role Role) bool {
+		if roleFailed(role) {
+			return true
+		}
+		path := role.GetPath()
+		for _, failedPath := range failedPaths {
+			if failedPath == path || strings.HasPrefix(failedPath, path+PATH_SEPARATOR) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// We climb the tree from the failed role: if the nearest quorum is not met,
+	// the role which owns it fails in turn and a further quorum might absorb it.
+	var child Role = t
+	for {
+		parent, ok := child.GetParent().(*aggregatorRole)
+		if !ok {
+			return false
+		}
+		if group, hasGroup := parent.quorumGroupOf(child); hasGroup {
+			if tolerated, _ := group.tolerates(failed); tolerated {
+				return true
+			}
+			failedPaths = append(failedPaths, parent.GetPath())
+		}
+		child = parent
+	}
+}
+
+// degradedFlag remembers whether a role was last seen degraded, so that
+// changes can be reported once.
+type degradedFlag struct {
+	mu  sync.Mutex
+	set bool
+}
+
+func (d *degradedFlag) swap(degraded bool) (previous bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	previous = d.set
+	d.set = degraded
+	return
+}
@@ -0,0 +1,134 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+)
+
+var _ = Describe("role quorum", func() {
+	criticalTaskRole := func(name string, state sm.State) *taskRole {
+		return &taskRole{
+			roleBase: roleBase{Name: name, state: SafeState{state: state}, status: SafeStatus{status: task.ACTIVE}},
+			Traits:   task.Traits{Critical: true},
+		}
+	}
+
+	When("parsing a quorum", func() {
+		It("should accept counts and percentages", func() {
+			q, err := parseQuorum("3")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(q.Required(10)).To(Equal(3))
+			Expect(q.Required(2)).To(Equal(2))
+
+			q, err = parseQuorum("95%")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(q.Required(20)).To(Equal(19))
+			Expect(q.Required(100)).To(Equal(95))
+			Expect(q.Required(10)).To(Equal(10))
+			Expect(q.String()).To(Equal("95%"))
+		})
+		It("should reject invalid values", func() {
+			for _, str := range []string{"0", "-1", "0%", "101%", "most"} {
+				_, err := parseQuorum(str)
+				Expect(err).To(HaveOccurred())
+			}
+		})
+		It("should not set a quorum for an empty value", func() {
+			q, err := parseQuorum(" ")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(q).To(BeNil())
+		})
+	})
+
+	When("an aggregator role has a quorum", func() {
+		var ar *aggregatorRole
+		var children []*taskRole
+		BeforeEach(func() {
+			children = []*taskRole{
+				criticalTaskRole("t1", sm.RUNNING),
+				criticalTaskRole("t2", sm.RUNNING),
+				criticalTaskRole("t3", sm.RUNNING),
+				criticalTaskRole("t4", sm.RUNNING),
+			}
+			ar = &aggregatorRole{
+				roleBase:   roleBase{Name: "root", state: SafeState{state: sm.RUNNING}, status: SafeStatus{status: task.ACTIVE}},
+				aggregator: aggregator{Roles: []Role{children[0], children[1], children[2], children[3]}},
+				quorum:     &Quorum{count: 3},
+			}
+			LinkChildrenToParents(ar)
+		})
+
+		It("should tolerate failures within the quorum", func() {
+			children[0].state.merge(sm.ERROR, children[0])
+			ar.state.merge(sm.ERROR, ar)
+			Expect(ar.GetState()).To(Equal(sm.RUNNING))
+			ar.status.merge(task.INACTIVE, ar)
+			Expect(ar.GetStatus()).To(Equal(task.Status(task.ACTIVE)))
+
+			_, degraded := ar.healthyRoles()
+			Expect(degraded).To(BeTrue())
+		})
+		It("should fail once the quorum is lost", func() {
+			children[0].state.merge(sm.ERROR, children[0])
+			children[1].state.merge(sm.ERROR, children[1])
+			ar.state.merge(sm.ERROR, ar)
+			Expect(ar.GetState()).To(Equal(sm.ERROR))
+		})
+		It("should tell whether further failures are tolerated", func() {
+			Expect(children[0].QuorumTolerates(nil)).To(BeTrue())
+			Expect(children[0].QuorumTolerates([]string{"root.t2"})).To(BeFalse())
+		})
+	})
+
+	When("an iterator role has a quorum", func() {
+		It("should apply to the iterator and not to the generated roles", func() {
+			root := new(aggregatorRole)
+			err := yaml.Unmarshal([]byte(`
+name: "epn"
+roles:
+  - name: "host-{{ it }}"
+    for:
+      range: "{{ hosts }}"
+      var: it
+    quorum: 95%
+    roles:
+      - name: "tf-builder"
+        task:
+          load: tfbuilder
+`), root)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(root.Roles).To(HaveLen(1))
+			iter, ok := root.Roles[0].(*iteratorRole)
+			Expect(ok).To(BeTrue())
+			Expect(iter.Quorum).To(Equal("95%"))
+			Expect(iter.template.(*aggregatorTemplate).Quorum).To(BeEmpty())
+		})
+	})
+})
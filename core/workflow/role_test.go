@@ -1,8 +1,11 @@
 package workflow
 
 import (
+	"time"
+
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"gopkg.in/yaml.v3"
@@ -36,15 +39,15 @@ func complexRoleTree() (root Role, leaves map[string]Role) {
 	}
 
 	root = &aggregatorRole{
-		roleBase{Name: "root", state: SafeState{state: defaultState}},
-		aggregator{
+		roleBase: roleBase{Name: "root", state: SafeState{state: defaultState}},
+		aggregator: aggregator{
 			Roles: []Role{
 				call1,
 				task1,
 				&includeRole{
 					aggregatorRole: aggregatorRole{
-						roleBase{Name: "agg1", state: SafeState{state: defaultState}},
-						aggregator{
+						roleBase: roleBase{Name: "agg1", state: SafeState{state: defaultState}},
+						aggregator: aggregator{
 							Roles: []Role{
 								agg1task_noncritical,
 								agg1task_critical,
@@ -53,8 +56,8 @@ func complexRoleTree() (root Role, leaves map[string]Role) {
 					},
 				},
 				&aggregatorRole{
-					roleBase{Name: "agg2", state: SafeState{state: sm.INVARIANT}},
-					aggregator{
+					roleBase: roleBase{Name: "agg2", state: SafeState{state: sm.INVARIANT}},
+					aggregator: aggregator{
 						Roles: []Role{agg2task_noncritical},
 					},
 				},
@@ -88,8 +91,8 @@ var _ = Describe("role", func() {
 						Traits:   task.Traits{Critical: true},
 					}
 					root = &aggregatorRole{
-						roleBase{Name: "agg1", state: SafeState{state: defaultState}},
-						aggregator{
+						roleBase: roleBase{Name: "agg1", state: SafeState{state: defaultState}},
+						aggregator: aggregator{
 							Roles: []Role{task1},
 						},
 					}
@@ -107,8 +110,8 @@ var _ = Describe("role", func() {
 					// the aggregator role is expected to be in INVARIANT, because there are no critical roles inside,
 					// thus nothing to affect the state of the role
 					root = &aggregatorRole{
-						roleBase{Name: "agg1", state: SafeState{state: sm.INVARIANT}},
-						aggregator{
+						roleBase: roleBase{Name: "agg1", state: SafeState{state: sm.INVARIANT}},
+						aggregator: aggregator{
 							Roles: []Role{task1},
 						},
 					}
@@ -129,8 +132,8 @@ var _ = Describe("role", func() {
 						Traits:   task.Traits{Critical: true},
 					}
 					root = &aggregatorRole{
-						roleBase{Name: "agg1", state: SafeState{state: defaultState}},
-						aggregator{
+						roleBase: roleBase{Name: "agg1", state: SafeState{state: defaultState}},
+						aggregator: aggregator{
 							Roles: []Role{task1, task2},
 						},
 					}
@@ -150,8 +153,8 @@ var _ = Describe("role", func() {
 						Traits:   task.Traits{Critical: true},
 					}
 					root = &aggregatorRole{
-						roleBase{Name: "agg1", state: SafeState{state: defaultState}},
-						aggregator{
+						roleBase: roleBase{Name: "agg1", state: SafeState{state: defaultState}},
+						aggregator: aggregator{
 							Roles: []Role{task1, task2},
 						},
 					}
@@ -522,3 +525,88 @@ roles:
 		})
 	})
 })
+
+var _ = Describe("task role restart policy", func() {
+	When("a task role sets a restart policy", func() {
+		It("should override the policy of the task class", func() {
+			role := new(taskRole)
+			err := yaml.Unmarshal([]byte(`
+name: "readout"
+task:
+  load: readout
+  restart:
+    policy: on-failure
+    maxAttempts: 2
+    backoff: 10s
+`), role)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(role.GetRestartPolicy()).NotTo(BeNil())
+			Expect(role.GetRestartPolicy().Mode).To(Equal(taskclass.RestartOnFailure))
+			Expect(role.GetRestartPolicy().MaxAttempts).To(Equal(2))
+			Expect(role.GetRestartPolicy().BackoffForAttempt(2)).To(Equal(20 * time.Second))
+		})
+	})
+	When("a task role sets an invalid restart policy", func() {
+		It("should fail to unmarshal", func() {
+			role := new(taskRole)
+			err := yaml.Unmarshal([]byte(`
+name: "readout"
+task:
+  load: readout
+  restart:
+    policy: always
+`), role)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+	When("a task role does not set a restart policy", func() {
+		It("should defer to the task class", func() {
+			role := new(taskRole)
+			err := yaml.Unmarshal([]byte(`
+name: "readout"
+task:
+  load: readout
+`), role)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(role.GetRestartPolicy()).To(BeNil())
+		})
+	})
+})
+
+var _ = Describe("task role affinity", func() {
+	When("a task role sets an affinity", func() {
+		It("should override the affinity wanted by the task class", func() {
+			role := new(taskRole)
+			err := yaml.Unmarshal([]byte(`
+name: "readout"
+task:
+  load: readout
+  affinity:
+    numa: "1"
+`), role)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(role.Affinity).NotTo(BeNil())
+
+			merged := role.Affinity.Merge(&taskclass.Affinity{Numa: "0", Cpuset: "0-3"})
+			Expect(merged.Numa).To(Equal("1"))
+			Expect(merged.Cpuset).To(Equal("0-3"))
+
+			copied := role.copy().(*taskRole)
+			copied.Affinity.Numa = "0"
+			Expect(role.Affinity.Numa).To(Equal("1"))
+		})
+	})
+	When("a task role does not set an affinity", func() {
+		It("should defer to the task class", func() {
+			role := new(taskRole)
+			err := yaml.Unmarshal([]byte(`
+name: "readout"
+task:
+  load: readout
+`), role)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(role.Affinity).To(BeNil())
+			Expect(role.Affinity.Merge(&taskclass.Affinity{Numa: "0"}).Numa).To(Equal("0"))
+		})
+	})
+})
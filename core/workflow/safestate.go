@@ -76,11 +76,14 @@ func (t *SafeState) merge(s sm.State, r Role) {
 	case s == sm.MIXED && t.state != sm.ERROR:
 		t.state = sm.MIXED
 		return
-	case s == sm.ERROR:
+	case s == sm.ERROR && !hasQuorum(r):
 		t.state = sm.ERROR
 		return
 	default:
 		allRoles := r.GetRoles()
+		if agg, isAggregator := r.(*aggregatorRole); isAggregator {
+			allRoles, _ = agg.healthyRoles()
+		}
 		t.state = aggregateState(allRoles)
 	}
 }
@@ -118,6 +118,9 @@ func (t *SafeStatus) merge(s task.Status, r Role) {
 		return
 	default:
 		allRoles := r.GetRoles()
+		if agg, isAggregator := r.(*aggregatorRole); isAggregator {
+			allRoles, _ = agg.healthyRoles()
+		}
 		t.status = aggregateStatus(allRoles)
 	}
 }
@@ -39,6 +39,7 @@ import (
 	"github.com/AliceO2Group/Control/configuration/template"
 	"github.com/AliceO2Group/Control/core/repos"
 	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
 	"github.com/AliceO2Group/Control/core/the"
 	"github.com/AliceO2Group/Control/core/workflow/callable"
 	"github.com/gobwas/glob"
@@ -49,6 +50,10 @@ type taskRole struct {
 	task.Traits
 	Task          *task.Task `yaml:"-,omitempty"`
 	LoadTaskClass string     `yaml:"-,omitempty"`
+	// Restart overrides the restart policy of the task class, if set
+	Restart *taskclass.RestartPolicy `yaml:"-,omitempty"`
+	// Affinity overrides the NUMA node and cpuset wanted by the task class, if set
+	Affinity *taskclass.Affinity `yaml:"-,omitempty"`
 }
 
 func (t *taskRole) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
@@ -59,6 +64,8 @@ func (t *taskRole) UnmarshalYAML(unmarshal func(interface{}) error) (err error)
 			Await    *string
 			Timeout  *string
 			Critical *bool
+			Restart  *taskclass.RestartPolicy
+			Affinity *taskclass.Affinity
 		}
 	}{}
 
@@ -76,6 +83,8 @@ func (t *taskRole) UnmarshalYAML(unmarshal func(interface{}) error) (err error)
 	}
 
 	role.LoadTaskClass = aux.Task.Load
+	role.Restart = aux.Task.Restart
+	role.Affinity = aux.Task.Affinity
 
 	// Set up basicTaskTraits defaults
 	if aux.Task.Trigger != nil && len(*aux.Task.Trigger) > 0 { // hook
@@ -122,6 +131,12 @@ func (t *taskRole) MarshalYAML() (interface{}, error) {
 	}
 	taskRole["critical"] = t.Traits.Critical
 	taskRole["load"] = t.LoadTaskClass
+	if t.Restart != nil {
+		taskRole["restart"] = t.Restart
+	}
+	if t.Affinity != nil {
+		taskRole["affinity"] = t.Affinity
+	}
 
 	auxRoleBase, err := t.roleBase.MarshalYAML()
 	aux := auxRoleBase.(map[string]interface{})
@@ -142,6 +157,20 @@ func (t *taskRole) ProcessTemplates(workflowRepo repos.IRepo, _ LoadSubworkflowF
 		return errors.New("role tree error when processing templates")
 	}
 
+	stage4Fields := template.Fields{
+		template.WrapPointer(&t.Name),
+		template.WrapPointer(&t.LoadTaskClass),
+		template.WrapPointer(&t.Timeout),
+		template.WrapPointer(&t.Trigger),
+		template.WrapPointer(&t.Await),
+	}
+	if t.Affinity != nil {
+		stage4Fields = append(stage4Fields,
+			template.WrapPointer(&t.Affinity.Numa),
+			template.WrapPointer(&t.Affinity.Cpuset),
+		)
+	}
+
 	templSequence := template.Sequence{
 		template.STAGE0: template.Fields{
 			template.WrapPointer(&t.Enabled),
@@ -149,13 +178,7 @@ func (t *taskRole) ProcessTemplates(workflowRepo repos.IRepo, _ LoadSubworkflowF
 		template.STAGE1: template.WrapMapItems(t.Defaults.Raw()),
 		template.STAGE2: template.WrapMapItems(t.Vars.Raw()),
 		template.STAGE3: template.WrapMapItems(t.UserVars.Raw()),
-		template.STAGE4: template.Fields{
-			template.WrapPointer(&t.Name),
-			template.WrapPointer(&t.LoadTaskClass),
-			template.WrapPointer(&t.Timeout),
-			template.WrapPointer(&t.Trigger),
-			template.WrapPointer(&t.Await),
-		},
+		template.STAGE4: stage4Fields,
 		template.STAGE5: append(append(
 			WrapConstraints(t.Constraints),
 			t.wrapBindAndConnectFields()...)),
@@ -261,7 +284,11 @@ func (t *taskRole) updateState(s sm.State) {
 			if t.Task != nil {
 				host = t.Task.GetHostname()
 			}
-			if t.Critical {
+			if t.Critical && t.QuorumTolerates(nil) {
+				log.WithField("partition", t.GetEnvironmentId().String()).
+					WithField("level", infologger.IL_Ops).
+					Errorf("critical task '%s' on host '%s' went into ERROR, but the quorum of its role is still met and the environment continues", t.Name, host)
+			} else if t.Critical {
 				log.WithField("partition", t.GetEnvironmentId().String()).
 					WithField("level", infologger.IL_Ops).
 					Errorf("critical task '%s' on host '%s' went into ERROR, the environment will stop or tear down", t.Name, host)
@@ -291,6 +318,12 @@ func (t *taskRole) copy() copyable {
 		Task:          nil,
 		LoadTaskClass: t.LoadTaskClass,
 		Traits:        t.Traits,
+		Restart:       t.Restart,
+	}
+	if t.Affinity != nil {
+		// templates are processed in place, so each copy needs its own
+		affinity := *t.Affinity
+		rCopy.Affinity = &affinity
 	}
 	rCopy.status = SafeStatus{status: task.INACTIVE}
 	rCopy.state = SafeState{state: sm.STANDBY}
@@ -313,6 +346,7 @@ func (t *taskRole) GenerateTaskDescriptors() (ds task.Descriptors) {
 		RoleConstraints: t.getConstraints(),
 		RoleConnect:     t.CollectOutboundChannels(),
 		RoleBind:        t.CollectInboundChannels(),
+		RoleAffinity:    t.Affinity,
 	}}
 	return
 }
@@ -383,6 +417,15 @@ func (t *taskRole) GetTaskTraits() task.Traits {
 	return t.Traits
 }
 
+// GetRestartPolicy returns the restart policy set on this role, which takes
+// precedence over the one of the task class, or nil if none is set.
+func (t *taskRole) GetRestartPolicy() *taskclass.RestartPolicy {
+	if t == nil {
+		return nil
+	}
+	return t.Restart
+}
+
 func (t *taskRole) GetTaskClasses() []string {
 	if t == nil {
 		return nil
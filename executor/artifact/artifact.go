@@ -0,0 +1,350 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package artifact implements the staging of task artifacts by the executor:
+// files are fetched into a local cache keyed by their sha256 checksum, then
+// copied or extracted into a sandbox directory per task, which becomes the
+// working directory of the task process.
+package artifact
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DIR_ENV           = "O2_ALIECS_ARTIFACT_DIR"
+	RETENTION_ENV     = "O2_ALIECS_ARTIFACT_RETENTION"
+	FETCH_TIMEOUT_ENV = "O2_ALIECS_ARTIFACT_FETCH_TIMEOUT"
+
+	// SANDBOX_ENV is set in the environment of tasks which have artifacts
+	SANDBOX_ENV = "O2_TASK_SANDBOX"
+
+	cacheSubdir    = "cache"
+	sandboxSubdir  = "sandboxes"
+	tempFilePrefix = ".fetch-"
+)
+
+var log = logger.New(logrus.StandardLogger(), "artifact")
+
+// fetchLocks serializes fetches of the same checksum, so that tasks launched
+// together download a shared artifact only once
+var fetchLocks sync.Map
+
+// Config describes where artifacts are kept and how they are fetched.
+type Config struct {
+	// Dir holds the artifact cache and the task sandboxes
+	Dir string
+	// Retention is how long a cached artifact is kept after it was last
+	// used, 0 to keep them forever
+	Retention time.Duration
+	// FetchTimeout bounds the download of a single http(s) artifact
+	FetchTimeout time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Dir:          filepath.Join(os.TempDir(), "o2-aliecs-artifacts"),
+		Retention:    7 * 24 * time.Hour,
+		FetchTimeout: 5 * time.Minute,
+	}
+}
+
+// ConfigFromEnv returns the configuration passed by the core through the
+// executor environment, with defaults for anything unset or invalid.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	if v := os.Getenv(DIR_ENV); v != "" {
+		cfg.Dir = v
+	}
+	if v, err := time.ParseDuration(os.Getenv(RETENTION_ENV)); err == nil && v >= 0 {
+		cfg.Retention = v
+	}
+	if v, err := time.ParseDuration(os.Getenv(FETCH_TIMEOUT_ENV)); err == nil && v > 0 {
+		cfg.FetchTimeout = v
+	}
+	return cfg
+}
+
+// Env returns the environment variables which make ConfigFromEnv return cfg.
+func (cfg Config) Env() map[string]string {
+	return map[string]string{
+		DIR_ENV:           cfg.Dir,
+		RETENTION_ENV:     cfg.Retention.String(),
+		FETCH_TIMEOUT_ENV: cfg.FetchTimeout.String(),
+	}
+}
+
+// SandboxPath returns the sandbox directory of a task.
+func (cfg Config) SandboxPath(taskId string) (string, error) {
+	if taskId == "" || taskId == "." || taskId == ".." || strings.ContainsAny(taskId, `/\`) {
+		return "", fmt.Errorf("invalid task ID %q", taskId)
+	}
+	return filepath.Join(cfg.Dir, sandboxSubdir, taskId), nil
+}
+
+func (cfg Config) cachePath(digest string) string {
+	return filepath.Join(cfg.Dir, cacheSubdir, digest)
+}
+
+// Stage fetches the artifacts of a task and places them in a new sandbox
+// directory, whose path it returns. Artifacts already in the cache are not
+// fetched again. If any artifact cannot be staged, the sandbox is removed.
+func (cfg Config) Stage(ctx context.Context, taskId string, artifacts common.TaskArtifacts) (sandbox string, err error) {
+	sandbox, err = cfg.SandboxPath(taskId)
+	if err != nil {
+		return "", err
+	}
+	if err = os.RemoveAll(sandbox); err != nil {
+		return "", fmt.Errorf("cannot clear sandbox: %w", err)
+	}
+	if err = os.MkdirAll(sandbox, 0755); err != nil {
+		return "", fmt.Errorf("cannot create sandbox: %w", err)
+	}
+	dir := sandbox
+	defer func() {
+		if err != nil {
+			_ = os.RemoveAll(dir)
+		}
+	}()
+
+	for i := range artifacts {
+		a := &artifacts[i]
+		if err = a.Validate(); err != nil {
+			return "", fmt.Errorf("invalid artifact %s: %w", a.Uri, err)
+		}
+
+		var cached string
+		cached, err = cfg.fetch(ctx, a)
+		if err != nil {
+			return "", fmt.Errorf("cannot fetch artifact %s: %w", a.Uri, err)
+		}
+
+		dest := filepath.Join(sandbox, filepath.FromSlash(a.DestPath()))
+		if a.Extract {
+			err = extract(cached, a.ArchiveSuffix(), dest)
+		} else {
+			mode := os.FileMode(0644)
+			if a.Executable {
+				mode = 0755
+			}
+			err = copyFile(cached, dest, mode)
+		}
+		if err != nil {
+			return "", fmt.Errorf("cannot place artifact %s in the sandbox: %w", a.Uri, err)
+		}
+	}
+	return sandbox, nil
+}
+
+// fetch returns the path of the artifact in the cache, fetching it first if
+// needed. The content is verified against the checksum, if any.
+func (cfg Config) fetch(ctx context.Context, a *common.TaskArtifact) (string, error) {
+	var digest string
+	if len(a.Checksum) > 0 {
+		var err error
+		if digest, err = a.ChecksumDigest(); err != nil {
+			return "", err
+		}
+
+		lock, _ := fetchLocks.LoadOrStore(digest, &sync.Mutex{})
+		lock.(*sync.Mutex).Lock()
+		defer lock.(*sync.Mutex).Unlock()
+
+		cached := cfg.cachePath(digest)
+		if _, err = os.Stat(cached); err == nil {
+			// the modification time tracks the last use, for cleanup
+			now := time.Now()
+			_ = os.Chtimes(cached, now, now)
+			log.WithField("uri", a.Uri).
+				WithField("checksum", a.Checksum).
+				Debug("artifact found in cache")
+			return cached, nil
+		}
+	}
+
+	var src io.ReadCloser
+	switch a.Scheme() {
+	case common.ARTIFACT_SCHEME_HTTP, common.ARTIFACT_SCHEME_HTTPS:
+		var err error
+		if src, err = cfg.download(ctx, a.Uri); err != nil {
+			return "", err
+		}
+	case common.ARTIFACT_SCHEME_FILE:
+		u, err := url.Parse(strings.TrimSpace(a.Uri))
+		if err != nil {
+			return "", err
+		}
+		if src, err = os.Open(u.Path); err != nil {
+			return "", err
+		}
+	case common.ARTIFACT_SCHEME_APRICOT:
+		if a.Content == nil {
+			return "", errors.New("content not received from the core")
+		}
+		src = io.NopCloser(strings.NewReader(*a.Content))
+	default:
+		return "", fmt.Errorf("unsupported scheme %q", a.Scheme())
+	}
+	defer src.Close()
+
+	return cfg.store(src, digest)
+}
+
+func (cfg Config) download(ctx context.Context, uri string) (io.ReadCloser, error) {
+	client := &http.Client{Timeout: cfg.FetchTimeout}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		_ = response.Body.Close()
+		return nil, fmt.Errorf("server returned %s", response.Status)
+	}
+	return response.Body, nil
+}
+
+// store writes src to the cache under its sha256 digest, failing if it does
+// not match the expected digest.
+func (cfg Config) store(src io.Reader, expectedDigest string) (string, error) {
+	cacheDir := filepath.Join(cfg.Dir, cacheSubdir)
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return "", fmt.Errorf("cannot create cache directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(cacheDir, tempFilePrefix+"*")
+	if err != nil {
+		return "", fmt.Errorf("cannot create cache file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }() // no-op once renamed
+
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(tmp, hash), src)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	digest := hex.EncodeToString(hash.Sum(nil))
+	if len(expectedDigest) > 0 && digest != expectedDigest {
+		return "", fmt.Errorf("checksum mismatch: expected %s%s, got %s%s",
+			common.ARTIFACT_CHECKSUM_PREFIX, expectedDigest, common.ARTIFACT_CHECKSUM_PREFIX, digest)
+	}
+	if err = os.Chmod(tmp.Name(), 0644); err != nil {
+		return "", err
+	}
+	cached := cfg.cachePath(digest)
+	if err = os.Rename(tmp.Name(), cached); err != nil {
+		return "", err
+	}
+	return cached, nil
+}
+
+func copyFile(src string, dest string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	return writeFile(dest, in, mode)
+}
+
+func writeFile(dest string, src io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, src)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	// the mode passed to OpenFile is subject to the umask
+	return os.Chmod(dest, mode)
+}
+
+// Cleanup removes the sandboxes of the tasks which are no longer active, as
+// well as the cached artifacts which were not used within the retention
+// period. It returns how many sandboxes and cache entries were removed.
+func (cfg Config) Cleanup(isActive func(taskId string) bool) (removed int, err error) {
+	sandboxes, err := os.ReadDir(filepath.Join(cfg.Dir, sandboxSubdir))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, err
+	}
+	for _, entry := range sandboxes {
+		if isActive(entry.Name()) {
+			continue
+		}
+		if err = os.RemoveAll(filepath.Join(cfg.Dir, sandboxSubdir, entry.Name())); err == nil {
+			removed++
+		}
+	}
+
+	if cfg.Retention == 0 {
+		return removed, nil
+	}
+	cached, err := os.ReadDir(filepath.Join(cfg.Dir, cacheSubdir))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return removed, nil
+		}
+		return removed, err
+	}
+	for _, entry := range cached {
+		info, infoErr := entry.Info()
+		if infoErr != nil || time.Since(info.ModTime()) < cfg.Retention {
+			continue
+		}
+		if err = os.Remove(filepath.Join(cfg.Dir, cacheSubdir, entry.Name())); err == nil {
+			removed++
+		}
+	}
+	return removed, nil
+}
@@ -0,0 +1,13 @@
+package artifact
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestArtifact(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Task Artifact Test Suite")
+}
@@ -0,0 +1,285 @@
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("task artifacts", func() {
+	var (
+		cfg Config
+		src string
+	)
+
+	checksum := func(content string) string {
+		sum := sha256.Sum256([]byte(content))
+		return common.ARTIFACT_CHECKSUM_PREFIX + hex.EncodeToString(sum[:])
+	}
+
+	writeSource := func(name string, content string) string {
+		path := filepath.Join(src, name)
+		Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+		return path
+	}
+
+	writeTarGz := func(name string, entries map[string]string) string {
+		path := filepath.Join(src, name)
+		f, err := os.Create(path)
+		Expect(err).NotTo(HaveOccurred())
+		gz := gzip.NewWriter(f)
+		tw := tar.NewWriter(gz)
+		for entryName, content := range entries {
+			Expect(tw.WriteHeader(&tar.Header{
+				Name:     entryName,
+				Mode:     0600,
+				Size:     int64(len(content)),
+				Typeflag: tar.TypeReg,
+			})).To(Succeed())
+			_, err = tw.Write([]byte(content))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(tw.Close()).To(Succeed())
+		Expect(gz.Close()).To(Succeed())
+		Expect(f.Close()).To(Succeed())
+		return path
+	}
+
+	// writeTar writes an uncompressed tarball with the given entries in order,
+	// regular files get their name as content
+	writeTar := func(name string, headers ...*tar.Header) string {
+		path := filepath.Join(src, name)
+		f, err := os.Create(path)
+		Expect(err).NotTo(HaveOccurred())
+		tw := tar.NewWriter(f)
+		for _, header := range headers {
+			if header.Typeflag == tar.TypeReg {
+				header.Mode = 0600
+				header.Size = int64(len(header.Name))
+			}
+			Expect(tw.WriteHeader(header)).To(Succeed())
+			if header.Typeflag == tar.TypeReg {
+				_, err = tw.Write([]byte(header.Name))
+				Expect(err).NotTo(HaveOccurred())
+			}
+		}
+		Expect(tw.Close()).To(Succeed())
+		Expect(f.Close()).To(Succeed())
+		return path
+	}
+
+	readSandbox := func(sandbox string, name string) string {
+		content, err := os.ReadFile(filepath.Join(sandbox, name))
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	BeforeEach(func() {
+		cfg = Config{
+			Dir:          GinkgoT().TempDir(),
+			Retention:    time.Hour,
+			FetchTimeout: 10 * time.Second,
+		}
+		src = GinkgoT().TempDir()
+	})
+
+	It("stages a local file in the sandbox of the task", func() {
+		path := writeSource("readout.cfg", "[readout]\n")
+		sandbox, err := cfg.Stage(context.Background(), "task1", common.TaskArtifacts{
+			{Uri: "file://" + path, Dest: "cfg/readout.cfg"},
+			{Uri: "file://" + path, Executable: true},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sandbox).To(Equal(filepath.Join(cfg.Dir, sandboxSubdir, "task1")))
+		Expect(readSandbox(sandbox, "cfg/readout.cfg")).To(Equal("[readout]\n"))
+
+		info, err := os.Stat(filepath.Join(sandbox, "readout.cfg"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+	})
+
+	It("downloads over http and serves the following fetches from the cache", func() {
+		content := "#!/bin/sh\necho hello\n"
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			_, _ = w.Write([]byte(content))
+		}))
+		defer server.Close()
+
+		artifacts := common.TaskArtifacts{
+			{Uri: server.URL + "/hello.sh", Checksum: checksum(content), Executable: true},
+		}
+		_, err := cfg.Stage(context.Background(), "task1", artifacts)
+		Expect(err).NotTo(HaveOccurred())
+		sandbox, err := cfg.Stage(context.Background(), "task2", artifacts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(readSandbox(sandbox, "hello.sh")).To(Equal(content))
+		Expect(requests).To(Equal(1))
+	})
+
+	It("fails on a checksum mismatch and removes the sandbox", func() {
+		path := writeSource("data.bin", "actual content")
+		_, err := cfg.Stage(context.Background(), "task1", common.TaskArtifacts{
+			{Uri: "file://" + path, Checksum: checksum("expected content")},
+		})
+		Expect(err).To(MatchError(ContainSubstring("checksum mismatch")))
+		Expect(filepath.Join(cfg.Dir, sandboxSubdir, "task1")).NotTo(BeADirectory())
+
+		cached, err := os.ReadDir(filepath.Join(cfg.Dir, cacheSubdir))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cached).To(BeEmpty())
+	})
+
+	It("uses the content resolved by the core for apricot artifacts", func() {
+		content := "key: value\n"
+		sandbox, err := cfg.Stage(context.Background(), "task1", common.TaskArtifacts{
+			{Uri: "apricot://qc/ANY/any/qc-config", Dest: "qc.yaml", Content: &content},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(readSandbox(sandbox, "qc.yaml")).To(Equal(content))
+
+		_, err = cfg.Stage(context.Background(), "task2", common.TaskArtifacts{
+			{Uri: "apricot://qc/ANY/any/qc-config"},
+		})
+		Expect(err).To(MatchError(ContainSubstring("content not received")))
+	})
+
+	It("extracts tar.gz and zip archives", func() {
+		tarball := writeTarGz("bundle.tar.gz", map[string]string{
+			"bin/run.sh":   "run",
+			"etc/conf.ini": "conf",
+		})
+
+		zipPath := filepath.Join(src, "bundle.zip")
+		f, err := os.Create(zipPath)
+		Expect(err).NotTo(HaveOccurred())
+		zw := zip.NewWriter(f)
+		w, err := zw.Create("data/table.csv")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = w.Write([]byte("a,b\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zw.Close()).To(Succeed())
+		Expect(f.Close()).To(Succeed())
+
+		sandbox, err := cfg.Stage(context.Background(), "task1", common.TaskArtifacts{
+			{Uri: "file://" + tarball, Dest: "bundle", Extract: true},
+			{Uri: "file://" + zipPath, Extract: true},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(readSandbox(sandbox, "bundle/bin/run.sh")).To(Equal("run"))
+		Expect(readSandbox(sandbox, "bundle/etc/conf.ini")).To(Equal("conf"))
+		Expect(readSandbox(sandbox, "data/table.csv")).To(Equal("a,b\n"))
+	})
+
+	It("refuses archive entries outside of the destination", func() {
+		tarball := writeTarGz("evil.tar.gz", map[string]string{
+			"../../escaped": "nope",
+		})
+		_, err := cfg.Stage(context.Background(), "task1", common.TaskArtifacts{
+			{Uri: "file://" + tarball, Extract: true},
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(filepath.Join(cfg.Dir, "escaped")).NotTo(BeAnExistingFile())
+	})
+
+	Context("with symbolic links in archives", func() {
+		var parent, dest string
+		BeforeEach(func() {
+			parent = GinkgoT().TempDir()
+			dest = filepath.Join(parent, "dest")
+		})
+
+		It("refuses links which only point outside through earlier links", func() {
+			tarball := writeTar("chained.tar",
+				&tar.Header{Name: "x/y", Linkname: "..", Typeflag: tar.TypeSymlink},
+				&tar.Header{Name: "x/y/w", Linkname: "..", Typeflag: tar.TypeSymlink},
+				&tar.Header{Name: "x/y/w/evil", Typeflag: tar.TypeReg},
+			)
+			Expect(extract(tarball, ".tar", dest)).To(MatchError(ContainSubstring("outside of the destination")))
+			Expect(filepath.Join(parent, "evil")).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(dest, "w")).NotTo(BeAnExistingFile())
+		})
+
+		It("refuses links which go up from another link", func() {
+			tarball := writeTar("dotdot.tar",
+				&tar.Header{Name: "a", Linkname: ".", Typeflag: tar.TypeSymlink},
+				&tar.Header{Name: "b", Linkname: "a/..", Typeflag: tar.TypeSymlink},
+				&tar.Header{Name: "b/evil", Typeflag: tar.TypeReg},
+			)
+			Expect(extract(tarball, ".tar", dest)).To(HaveOccurred())
+			Expect(filepath.Join(parent, "evil")).NotTo(BeAnExistingFile())
+		})
+
+		It("refuses to write files through links left by earlier entries", func() {
+			outside := filepath.Join(parent, "outside")
+			// p only points outside once q exists
+			tarball := writeTar("overwrite.tar",
+				&tar.Header{Name: "p", Linkname: "q/../outside", Typeflag: tar.TypeSymlink},
+				&tar.Header{Name: "q", Linkname: ".", Typeflag: tar.TypeSymlink},
+				&tar.Header{Name: "p", Typeflag: tar.TypeReg},
+			)
+			Expect(extract(tarball, ".tar", dest)).To(MatchError(ContainSubstring("is a symbolic link")))
+			Expect(outside).NotTo(BeAnExistingFile())
+		})
+
+		It("extracts links which stay within the destination", func() {
+			tarball := writeTar("links.tar",
+				&tar.Header{Name: "lib/", Typeflag: tar.TypeDir, Mode: 0755},
+				&tar.Header{Name: "lib/real.so", Typeflag: tar.TypeReg},
+				&tar.Header{Name: "lib/link.so", Linkname: "real.so", Typeflag: tar.TypeSymlink},
+				&tar.Header{Name: "current", Linkname: "lib", Typeflag: tar.TypeSymlink},
+				&tar.Header{Name: "current/other", Typeflag: tar.TypeReg},
+				&tar.Header{Name: "hard", Linkname: "current/real.so", Typeflag: tar.TypeLink},
+			)
+			Expect(extract(tarball, ".tar", dest)).To(Succeed())
+			Expect(readSandbox(dest, "lib/link.so")).To(Equal("lib/real.so"))
+			Expect(readSandbox(dest, "lib/other")).To(Equal("current/other"))
+			Expect(readSandbox(dest, "hard")).To(Equal("lib/real.so"))
+		})
+	})
+
+	It("rejects invalid artifacts", func() {
+		_, err := cfg.Stage(context.Background(), "task1", common.TaskArtifacts{
+			{Uri: "https://example.org/file"},
+		})
+		Expect(err).To(MatchError(ContainSubstring("invalid artifact")))
+	})
+
+	It("cleans up inactive sandboxes and expired cache entries", func() {
+		fresh := writeSource("fresh", "fresh")
+		stale := writeSource("stale", "stale")
+		_, err := cfg.Stage(context.Background(), "active", common.TaskArtifacts{
+			{Uri: "file://" + fresh, Checksum: checksum("fresh")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = cfg.Stage(context.Background(), "gone", common.TaskArtifacts{
+			{Uri: "file://" + stale, Checksum: checksum("stale")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		staleDigest := checksum("stale")[len(common.ARTIFACT_CHECKSUM_PREFIX):]
+		past := time.Now().Add(-2 * cfg.Retention)
+		Expect(os.Chtimes(cfg.cachePath(staleDigest), past, past)).To(Succeed())
+
+		removed, err := cfg.Cleanup(func(taskId string) bool { return taskId == "active" })
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removed).To(Equal(2))
+		Expect(filepath.Join(cfg.Dir, sandboxSubdir, "active")).To(BeADirectory())
+		Expect(filepath.Join(cfg.Dir, sandboxSubdir, "gone")).NotTo(BeADirectory())
+		Expect(cfg.cachePath(staleDigest)).NotTo(BeAnExistingFile())
+		Expect(cfg.cachePath(checksum("fresh")[len(common.ARTIFACT_CHECKSUM_PREFIX):])).To(BeAnExistingFile())
+	})
+})
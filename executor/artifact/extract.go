@@ -0,0 +1,270 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// extract unpacks the archive into destDir. Entries which would end up
+// outside of destDir, including through symbolic links, are refused.
+func extract(archive string, suffix string, destDir string) error {
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		return err
+	}
+	// entries are checked against where things are on disk, so destDir must
+	// be too
+	destDir, err := filepath.Abs(destDir)
+	if err != nil {
+		return err
+	}
+	if destDir, err = filepath.EvalSymlinks(destDir); err != nil {
+		return err
+	}
+	if suffix == ".zip" {
+		return extractZip(archive, destDir)
+	}
+
+	f, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	switch suffix {
+	case ".tar":
+	case ".tar.gz", ".tgz":
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	case ".tar.bz2", ".tbz2":
+		r = bzip2.NewReader(f)
+	default:
+		return fmt.Errorf("unsupported archive format %s", suffix)
+	}
+	return extractTar(r, destDir)
+}
+
+func extractTar(r io.Reader, destDir string) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target, err := entryPath(destDir, header.Name)
+		if err != nil {
+			return err
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, 0755)
+		case tar.TypeReg:
+			err = writeEntry(target, tr, header.FileInfo().Mode().Perm())
+		case tar.TypeSymlink:
+			err = symlink(destDir, target, header.Linkname)
+		case tar.TypeLink:
+			var linked string
+			if linked, err = entryPath(destDir, header.Linkname); err == nil {
+				err = os.Link(linked, target)
+			}
+		case tar.TypeXGlobalHeader:
+		default:
+			err = fmt.Errorf("unsupported archive entry type %q", string(header.Typeflag))
+		}
+		if err != nil {
+			return fmt.Errorf("cannot extract %s: %w", header.Name, err)
+		}
+	}
+}
+
+func extractZip(archive string, destDir string) error {
+	zr, err := zip.OpenReader(archive)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		target, err := entryPath(destDir, f.Name)
+		if err != nil {
+			return err
+		}
+		mode := f.Mode()
+		switch {
+		case mode.IsDir():
+			err = os.MkdirAll(target, 0755)
+		case mode&os.ModeSymlink != 0:
+			var linkname []byte
+			if linkname, err = readZipEntry(f); err == nil {
+				err = symlink(destDir, target, string(linkname))
+			}
+		case mode.IsRegular():
+			err = writeZipEntry(f, target)
+		default:
+			err = fmt.Errorf("unsupported archive entry mode %s", mode)
+		}
+		if err != nil {
+			return fmt.Errorf("cannot extract %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+func readZipEntry(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+func writeZipEntry(f *zip.File, target string) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	perm := f.Mode().Perm()
+	if perm == 0 { // archives made on some platforms carry no permissions
+		perm = 0644
+	}
+	return writeEntry(target, rc, perm)
+}
+
+// writeEntry writes a regular file entry, refusing to follow a symbolic link
+// which an earlier entry left in its place.
+func writeEntry(target string, src io.Reader, mode os.FileMode) error {
+	if info, err := os.Lstat(target); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		return fmt.Errorf("%s is a symbolic link", target)
+	}
+	return writeFile(target, src, mode)
+}
+
+// entryPath returns where an archive entry goes, refusing entries which
+// would end up outside of destDir. The symbolic links extracted so far are
+// followed, as the file system would when the entry is written.
+func entryPath(destDir string, name string) (string, error) {
+	target := filepath.Join(destDir, filepath.FromSlash(name))
+	if !isWithin(destDir, target) {
+		return "", fmt.Errorf("archive entry %s is outside of the destination directory", name)
+	}
+	if target == destDir {
+		return target, nil
+	}
+	parent, err := resolve(filepath.Dir(target))
+	if err != nil {
+		return "", err
+	}
+	if !isWithin(destDir, parent) {
+		return "", fmt.Errorf("archive entry %s is outside of the destination directory", name)
+	}
+	return filepath.Join(parent, filepath.Base(target)), nil
+}
+
+// symlink creates a symbolic link at target, refusing links which point
+// outside of destDir, including through the links extracted so far.
+func symlink(destDir string, target string, linkname string) error {
+	if filepath.IsAbs(linkname) {
+		return fmt.Errorf("link to %s is outside of the destination directory", linkname)
+	}
+	// not joined, as that would drop the components before a ".." even if
+	// they are symbolic links
+	linked, err := resolve(filepath.Dir(target) + string(filepath.Separator) + filepath.FromSlash(linkname))
+	if err != nil {
+		return err
+	}
+	if !isWithin(destDir, linked) {
+		return fmt.Errorf("link to %s is outside of the destination directory", linkname)
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	return os.Symlink(linkname, target)
+}
+
+// resolve returns where the absolute path is on disk, following symbolic
+// links component by component the way the file system does. Components which
+// do not exist are taken as they are.
+func resolve(path string) (string, error) {
+	const maxLinks = 255
+	sep := string(filepath.Separator)
+	resolved := sep
+	parts := strings.Split(path, sep)
+	for i, links := 0, 0; i < len(parts); i++ {
+		switch parts[i] {
+		case "", ".":
+			continue
+		case "..":
+			resolved = filepath.Dir(resolved)
+			continue
+		}
+		next := filepath.Join(resolved, parts[i])
+		info, err := os.Lstat(next)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		if err != nil || info.Mode()&os.ModeSymlink == 0 {
+			resolved = next
+			continue
+		}
+		if links++; links > maxLinks {
+			return "", fmt.Errorf("too many levels of symbolic links in %s", path)
+		}
+		linkname, err := os.Readlink(next)
+		if err != nil {
+			return "", err
+		}
+		if filepath.IsAbs(linkname) {
+			resolved = sep
+		}
+		parts = append(strings.Split(linkname, sep), parts[i+1:]...)
+		i = -1
+	}
+	return resolved, nil
+}
+
+func isWithin(dir string, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,86 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executable
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/executor/artifact"
+)
+
+// artifacts configures where task artifacts are cached and staged
+var artifacts = artifact.DefaultConfig()
+
+// SetArtifactConfig sets where and how task artifacts are fetched, it must
+// be called before any task is launched.
+func SetArtifactConfig(cfg artifact.Config) {
+	artifacts = cfg
+}
+
+// ArtifactConfig returns the current task artifact configuration.
+func ArtifactConfig() artifact.Config {
+	return artifacts
+}
+
+// stageArtifacts fetches the artifacts of the task into its sandbox. It may
+// take a while, so it must not run in the executor event loop.
+func (t *taskBase) stageArtifacts() error {
+	if len(t.Tci.Fetch) == 0 {
+		return nil
+	}
+	stageStart := time.Now()
+	sandbox, err := artifacts.Stage(context.Background(), t.ti.TaskID.Value, t.Tci.Fetch)
+	if err != nil {
+		return err
+	}
+	t.sandbox = sandbox
+	log.WithField("partition", t.knownEnvironmentId.String()).
+		WithField("detector", t.knownDetector).
+		WithField("task", t.ti.Name).
+		WithField("sandbox", sandbox).
+		WithField("artifacts", len(t.Tci.Fetch)).
+		WithField("duration", time.Since(stageStart).String()).
+		WithField("level", infologger.IL_Devel).
+		Debug("task artifacts staged")
+	return nil
+}
+
+// useSandbox makes the task process run in its sandbox, if it has one,
+// unless the task command sets an absolute working directory.
+func (t *taskBase) useSandbox(taskCmd *exec.Cmd) {
+	if len(t.sandbox) == 0 {
+		return
+	}
+	if len(taskCmd.Dir) == 0 {
+		taskCmd.Dir = t.sandbox
+	} else if !filepath.IsAbs(taskCmd.Dir) {
+		taskCmd.Dir = filepath.Join(t.sandbox, taskCmd.Dir)
+	}
+	taskCmd.Env = append(taskCmd.Env, artifact.SANDBOX_ENV+"="+t.sandbox)
+}
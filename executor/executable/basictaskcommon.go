@@ -31,7 +31,7 @@ import (
 	"github.com/AliceO2Group/Control/common/utils"
 	"io"
 	"os/exec"
-	"syscall"
+	"sync"
 	"time"
 
 	"github.com/AliceO2Group/Control/common/controlmode"
@@ -50,10 +50,12 @@ type basicTaskBase struct {
 	taskCmd                 *exec.Cmd
 	transitioner            transitioner.Transitioner
 	pendingFinalTaskStateCh chan mesos.TaskState
+	// closed once the task process is reaped, nil if it never started
+	taskExited chan struct{}
 }
 
 func (t *basicTaskBase) startBasicTask() (err error) {
-	t.taskCmd, err = prepareTaskCmd(t.Tci)
+	t.taskCmd, err = prepareTaskCmd(t.Tci, t.secrets)
 	if err != nil {
 		msg := "cannot build task command"
 		log.WithField("partition", t.knownEnvironmentId.String()).
@@ -68,6 +70,7 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 	if t.taskCmd == nil {
 		return errors.New("could not instantiate basic task command")
 	}
+	t.useSandbox(t.taskCmd)
 
 	// Set up pipes for controlled process
 	var errStdout, errStderr error
@@ -138,12 +141,17 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 		stderr = &stderrBuf
 	}
 
-	stdoutIn, _ := t.taskCmd.StdoutPipe()
-	stderrIn, _ := t.taskCmd.StderrPipe()
+	stdoutIn, stderrIn, outputStarted, err := outputPipes(t.taskCmd)
+	if err != nil {
+		return err
+	}
 
-	err = t.taskCmd.Start()
+	err = t.startTaskCmd(t.taskCmd)
+	outputStarted()
 
 	if err != nil {
+		_ = stdoutIn.Close()
+		_ = stderrIn.Close()
 		log.WithField("partition", t.knownEnvironmentId.String()).
 			WithFields(logrus.Fields{
 				"id":      t.ti.TaskID.Value,
@@ -160,17 +168,32 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 		WithField("task", t.ti.Name).
 		Debug("basic task started")
 
+	// The in-memory buffers only live as long as the task, the task log is kept
+	stdoutTee, stderrTee, closeTaskLog := t.teeTaskLog(stdoutIn, stderrIn)
+	stopProbes := t.startProbes(t.taskCmd, nil)
+
+	// the copies end once the task and its children closed their output
+	var copying sync.WaitGroup
+	copying.Add(2)
 	go func() {
-		_, errStdout = io.Copy(stdout, stdoutIn)
+		defer copying.Done()
+		_, errStdout = io.Copy(stdout, stdoutTee)
 	}()
 	go func() {
-		_, errStderr = io.Copy(stderr, stderrIn)
+		defer copying.Done()
+		_, errStderr = io.Copy(stderr, stderrTee)
 	}()
 
+	taskExited := make(chan struct{})
+	t.taskExited = taskExited
 	go func() {
 		taskCmd := t.taskCmd
-		err = taskCmd.Wait()
+		err := taskCmd.Wait()
 		// ^ when this unblocks, the task is done
+		close(taskExited)
+		drainTaskOutput(&copying, stdoutIn, stderrIn)
+		stopProbes()
+		closeTaskLog()
 
 		pendingState := mesos.TASK_FINISHED
 		var tciCommandStr string
@@ -250,17 +273,27 @@ func (t *basicTaskBase) ensureBasicTaskKilled() (err error) {
 	if t.Tci.ControlMode == controlmode.HOOK {
 		return nil
 	}
-	if t.taskCmd.ProcessState.Exited() {
+	if t.taskExited == nil {
+		// never started
+		return nil
+	}
+	select {
+	case <-t.taskExited:
+		// already exited
 		return nil
+	default:
 	}
 
 	// Preparing to kill running task
-	t.pendingFinalTaskStateCh <- mesos.TASK_KILLED
+	select {
+	case t.pendingFinalTaskStateCh <- mesos.TASK_KILLED:
+	default: // already being killed
+	}
 
-	// TODO: SIGTERM before SIGKILL
+	policy := t.stopPolicy()
+	t.runPreStop(policy, t.taskCmd)
 
-	pid := t.taskCmd.Process.Pid
-	err = syscall.Kill(-pid, syscall.SIGKILL)
+	err = t.stopProcess(-t.taskCmd.Process.Pid, policy)
 	if err != nil {
 		log.WithError(err).
 			WithField("partition", t.knownEnvironmentId.String()).
@@ -284,7 +317,23 @@ func (t *basicTaskBase) doLaunch(transitionFunc transitioner.DoTransitionFunc) e
 		WithField("level", infologger.IL_Devel).
 		Debug("basic task staged")
 
-	time.AfterFunc(200*time.Millisecond, func() { t.sendStatus(t.knownEnvironmentId, mesos.TASK_RUNNING, "") })
+	time.AfterFunc(200*time.Millisecond, func() {
+		// The process only starts on trigger, but its artifacts are fetched
+		// now, so that a fetch failure is reported as a launch failure
+		if err := t.stageArtifacts(); err != nil {
+			msg := "cannot fetch task artifacts"
+			log.WithField("partition", t.knownEnvironmentId.String()).
+				WithFields(logrus.Fields{
+					"id":    t.ti.TaskID.Value,
+					"task":  t.ti.Name,
+					"error": err,
+				}).
+				Error(msg)
+			t.sendStatus(t.knownEnvironmentId, mesos.TASK_FAILED, msg+": "+err.Error())
+			return
+		}
+		t.sendStatus(t.knownEnvironmentId, mesos.TASK_RUNNING, "")
+	})
 
 	return nil
 }
@@ -309,6 +358,8 @@ func (t *basicTaskBase) Transition(cmd *executorcmd.ExecutorCommand_Transition)
 
 func (t *basicTaskBase) Kill() error {
 	if t.taskCmd != nil {
+		// a basic task killed while running is stopped as on STOP
+		_ = t.ensureBasicTaskKilled()
 		t.taskCmd = nil
 	}
 
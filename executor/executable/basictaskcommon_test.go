@@ -0,0 +1,111 @@
+package executable
+
+import (
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/AliceO2Group/Control/executor/tasklog"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newTestBasicTask returns a basic task running command through the shell,
+// whose device events are pushed to events.
+func newTestBasicTask(command string, events chan<- event.DeviceEvent) *BasicTask {
+	shell, none := true, "none"
+	return &BasicTask{
+		basicTaskBase: basicTaskBase{
+			taskBase: taskBase{
+				ti: &mesos.TaskInfo{
+					Name:     "basic",
+					TaskID:   mesos.TaskID{Value: "basic-" + uid.New().String()},
+					Executor: &mesos.ExecutorInfo{},
+				},
+				Tci: &common.TaskCommandInfo{
+					CommandInfo: common.CommandInfo{
+						Shell:  &shell,
+						Value:  &command,
+						Stdout: &none,
+						Stderr: &none,
+					},
+					ControlMode: controlmode.BASIC,
+				},
+				sendDeviceEvent: func(_ uid.ID, e event.DeviceEvent) {
+					events <- e
+				},
+				knownEnvironmentId: uid.New(),
+			},
+		},
+	}
+}
+
+var _ = Describe("basic task output", func() {
+	var previousTaskLogs tasklog.Config
+
+	BeforeEach(func() {
+		previousTaskLogs = TaskLogConfig()
+		cfg := tasklog.DefaultConfig()
+		cfg.Dir = GinkgoT().TempDir()
+		SetTaskLogConfig(cfg)
+	})
+	AfterEach(func() {
+		SetTaskLogConfig(previousTaskLogs)
+	})
+
+	It("is complete in the task log once the task is reported terminated", func() {
+		events := make(chan event.DeviceEvent, 1)
+		t := newTestBasicTask("seq 1 20000; echo done", events)
+		Expect(t.startBasicTask()).To(Succeed())
+
+		var received event.DeviceEvent
+		Eventually(events, 10*time.Second).Should(Receive(&received))
+		Expect(received.GetType()).To(Equal(pb.DeviceEventType_BASIC_TASK_TERMINATED))
+		btt := received.(*event.BasicTaskTerminated)
+		Expect(btt.ExitCode).To(Equal(0))
+		Expect(strings.HasSuffix(btt.Stdout, "20000\ndone\n")).To(BeTrue())
+
+		chunk, err := TaskLogConfig().Read(t.ti.TaskID.Value, 2, 0, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(chunk.Content)).To(Equal("20000\ndone\n"))
+	})
+
+	It("is reported terminated when its children outlive a timeout", func() {
+		events := make(chan event.DeviceEvent, 1)
+		t := newTestBasicTask("sleep 30 & wait", events)
+		t.Tci.Timeout = 300 * time.Millisecond
+		Expect(t.startBasicTask()).To(Succeed())
+
+		var received event.DeviceEvent
+		Eventually(events, 5*time.Second).Should(Receive(&received))
+		Expect(received.GetType()).To(Equal(pb.DeviceEventType_BASIC_TASK_TERMINATED))
+		Expect(received.(*event.BasicTaskTerminated).ExitCode).To(Equal(-1))
+	})
+
+	It("is reported finished while a leftover child still holds its output", func() {
+		events := make(chan event.DeviceEvent, 1)
+		t := newTestBasicTask("sleep 30 & echo done", events)
+		Expect(t.startBasicTask()).To(Succeed())
+		DeferCleanup(func() {
+			_ = syscall.Kill(-t.taskCmd.Process.Pid, syscall.SIGKILL)
+		})
+
+		var received event.DeviceEvent
+		Eventually(events, outputDrainTimeout+5*time.Second).Should(Receive(&received))
+		Expect(received.GetType()).To(Equal(pb.DeviceEventType_BASIC_TASK_TERMINATED))
+		btt := received.(*event.BasicTaskTerminated)
+		Expect(btt.ExitCode).To(Equal(0))
+		Expect(btt.FinalMesosState).To(Equal(mesos.TASK_FINISHED))
+		Expect(btt.Stdout).To(Equal("done\n"))
+
+		chunk, err := TaskLogConfig().Read(t.ti.TaskID.Value, 1, 0, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(chunk.Content)).To(Equal("done\n"))
+	})
+})
@@ -31,6 +31,7 @@ import (
 	"io"
 	"reflect"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -56,6 +57,7 @@ const (
 	SIGINT_TIMEOUT          = 3 * time.Second
 	KILL_TRANSITION_TIMEOUT = 5 * time.Second // to be on the safe side, readout might need up to 5s to go from RUNNING to DONE
 	TRANSITION_TIMEOUT      = 10 * time.Second
+	STATE_CONFIRM_TIMEOUT   = 2 * time.Second
 )
 
 type ControllableTask struct {
@@ -63,6 +65,12 @@ type ControllableTask struct {
 	rpc                     *executorcmd.RpcClient
 	pendingFinalTaskStateCh chan mesos.TaskState
 	knownPid                int
+
+	// stateMu guards knownState and transitioning, which allow the StateStream
+	// watcher to tell unsolicited state changes from the ones we requested
+	stateMu       sync.Mutex
+	knownState    string
+	transitioning bool
 }
 
 type CommitResponse struct {
@@ -92,7 +100,7 @@ func (t *ControllableTask) Launch() error {
 		}))
 
 	t.pendingFinalTaskStateCh = make(chan mesos.TaskState, 1) // we use this to receive a pending status update if the task was killed
-	taskCmd, err := prepareTaskCmd(t.Tci)
+	taskCmd, err := prepareTaskCmd(t.Tci, t.secrets)
 	if err != nil {
 		msg := "cannot build task command"
 		log.WithFields(logrus.Fields{
@@ -130,13 +138,46 @@ func (t *ControllableTask) Launch() error {
 			"detector":  t.knownDetector,
 		}).Debug("executor.ControllableTask.Launch.async begin")
 
+		err = t.stageArtifacts()
+		if err != nil {
+			msg := "cannot fetch task artifacts"
+			log.WithFields(logrus.Fields{
+				"id":        t.ti.TaskID.Value,
+				"task":      t.ti.Name,
+				"error":     err.Error(),
+				"partition": t.knownEnvironmentId.String(),
+				"detector":  t.knownDetector,
+			}).
+				Error(msg)
+
+			t.sendStatus(t.knownEnvironmentId, mesos.TASK_FAILED, msg+": "+err.Error())
+			return
+		}
+		t.useSandbox(taskCmd)
+
 		// Set up pipes for controlled process
 		var errStdout, errStderr error
-		stdoutIn, _ := taskCmd.StdoutPipe()
-		stderrIn, _ := taskCmd.StderrPipe()
+		stdoutIn, stderrIn, outputStarted, err := outputPipes(taskCmd)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"id":        t.ti.TaskID.Value,
+				"task":      t.ti.Name,
+				"error":     err.Error(),
+				"command":   truncatedCmd,
+				"partition": t.knownEnvironmentId.String(),
+				"detector":  t.knownDetector,
+			}).
+				Error("cannot set up task output")
 
-		err = taskCmd.Start()
+			t.sendStatus(t.knownEnvironmentId, mesos.TASK_FAILED, err.Error())
+			return
+		}
+
+		err = t.startTaskCmd(taskCmd)
+		outputStarted()
 		if err != nil {
+			_ = stdoutIn.Close()
+			_ = stderrIn.Close()
 			log.WithFields(logrus.Fields{
 				"id":        t.ti.TaskID.Value,
 				"task":      t.ti.Name,
@@ -148,7 +189,7 @@ func (t *ControllableTask) Launch() error {
 				Error("failed to run task")
 
 			t.sendStatus(t.knownEnvironmentId, mesos.TASK_FAILED, err.Error())
-			_ = t.doTermIntKill(-taskCmd.Process.Pid)
+			_ = t.stopProcess(-taskCmd.Process.Pid, t.stopPolicy())
 			return
 		}
 		log.WithField("id", t.ti.TaskID.Value).
@@ -157,6 +198,17 @@ func (t *ControllableTask) Launch() error {
 			WithField("detector", t.knownDetector).
 			Debug("task launched")
 
+		// Whatever the stdout/stderr settings, the output is always kept in the task log
+		stdoutTee, stderrTee, closeTaskLog := t.teeTaskLog(stdoutIn, stderrIn)
+		// the task log may only be closed once both copies are done writing
+		// to it, including when we give up on the task early
+		var copying sync.WaitGroup
+		copying.Add(2)
+		defer func() {
+			drainTaskOutput(&copying, stdoutIn, stderrIn)
+			closeTaskLog()
+		}()
+
 		utils.TimeTrack(launchStartTime,
 			"executor.ControllableTask.Launch.async: Launch begin to taskCmd.Start() complete",
 			log.WithField("partition", t.knownEnvironmentId.String()).
@@ -180,6 +232,7 @@ func (t *ControllableTask) Launch() error {
 		switch *t.Tci.Stdout {
 		case "stdout":
 			go func() {
+				defer copying.Done()
 				entry := log.WithPrefix("task-stdout").
 					WithField("level", infologger.IL_Support).
 					WithField("partition", t.knownEnvironmentId.String()).
@@ -190,11 +243,12 @@ func (t *ControllableTask) Launch() error {
 					Entry:     entry,
 					PrintFunc: entry.Debug,
 				}
-				_, errStdout = io.Copy(writer, stdoutIn)
+				_, errStdout = io.Copy(writer, stdoutTee)
 				writer.Flush()
 			}()
 		case "all":
 			go func() {
+				defer copying.Done()
 				entry := log.WithPrefix("task-stdout").
 					WithField("level", infologger.IL_Support).
 					WithField("partition", t.knownEnvironmentId.String()).
@@ -204,18 +258,20 @@ func (t *ControllableTask) Launch() error {
 					Entry:     entry,
 					PrintFunc: entry.Debug,
 				}
-				_, errStdout = io.Copy(writer, stdoutIn)
+				_, errStdout = io.Copy(writer, stdoutTee)
 				writer.Flush()
 			}()
 		default:
 			go func() {
-				_, errStdout = io.Copy(io.Discard, stdoutIn)
+				defer copying.Done()
+				_, errStdout = io.Copy(io.Discard, stdoutTee)
 			}()
 		}
 
 		switch *t.Tci.Stderr {
 		case "stdout":
 			go func() {
+				defer copying.Done()
 				entry := log.WithPrefix("task-stderr").
 					WithField("level", infologger.IL_Support).
 					WithField("partition", t.knownEnvironmentId.String()).
@@ -226,11 +282,12 @@ func (t *ControllableTask) Launch() error {
 					Entry:     entry,
 					PrintFunc: entry.Warn,
 				}
-				_, errStderr = io.Copy(writer, stderrIn)
+				_, errStderr = io.Copy(writer, stderrTee)
 				writer.Flush()
 			}()
 		case "all":
 			go func() {
+				defer copying.Done()
 				entry := log.WithPrefix("task-stderr").
 					WithField("level", infologger.IL_Support).
 					WithField("partition", t.knownEnvironmentId.String()).
@@ -240,15 +297,18 @@ func (t *ControllableTask) Launch() error {
 					Entry:     entry,
 					PrintFunc: entry.Warn,
 				}
-				_, errStderr = io.Copy(writer, stderrIn)
+				_, errStderr = io.Copy(writer, stderrTee)
 				writer.Flush()
 			}()
 		default:
 			go func() {
-				_, errStderr = io.Copy(io.Discard, stderrIn)
+				defer copying.Done()
+				_, errStderr = io.Copy(io.Discard, stderrTee)
 			}()
 		}
 
+		// taskCmd holds the secret values, only their placeholders may be logged
+		loggedPath, loggedArgv := loggedTaskCmd(t.Tci, taskCmd)
 		log.WithField("partition", t.knownEnvironmentId.String()).
 			WithField("detector", t.knownDetector).
 			WithFields(logrus.Fields{
@@ -256,9 +316,9 @@ func (t *ControllableTask) Launch() error {
 				"controlMode": t.Tci.ControlMode.String(),
 				"task":        t.ti.Name,
 				"id":          t.ti.TaskID.Value,
-				"path":        taskCmd.Path,
-				"argv":        "[ " + strings.Join(taskCmd.Args, ", ") + " ]",
-				"argc":        len(taskCmd.Args),
+				"path":        loggedPath,
+				"argv":        "[ " + strings.Join(loggedArgv, ", ") + " ]",
+				"argc":        len(loggedArgv),
 				"level":       infologger.IL_Devel,
 			}).
 			Debug("starting gRPC client")
@@ -301,7 +361,7 @@ func (t *ControllableTask) Launch() error {
 				Error("could not start gRPC client")
 
 			t.sendStatus(t.knownEnvironmentId, mesos.TASK_FAILED, err.Error())
-			_ = t.doTermIntKill(-taskCmd.Process.Pid)
+			_ = t.stopProcess(-taskCmd.Process.Pid, t.stopPolicy())
 			return
 		}
 		t.rpc.TaskCmd = taskCmd
@@ -458,6 +518,23 @@ func (t *ControllableTask) Launch() error {
 			return
 		}
 
+		// Set up state stream from task, tasks which don't support it are still
+		// controllable but state drift will only be noticed on the next transition
+		t.stateMu.Lock()
+		t.knownState = "STANDBY"
+		t.stateMu.Unlock()
+		ssc, err := t.rpc.StateStream(context.TODO(), &pb.StateStreamRequest{}, grpc.EmptyCallOption{})
+		if err != nil {
+			log.WithField("task", t.ti.Name).
+				WithError(err).
+				WithField("partition", t.knownEnvironmentId.String()).
+				WithField("detector", t.knownDetector).
+				WithField("level", infologger.IL_Devel).
+				Warning("cannot set up state stream from task")
+		} else {
+			go t.watchStateStream(t.rpc, ssc)
+		}
+
 		// send RUNNING
 		t.sendStatus(t.knownEnvironmentId, mesos.TASK_RUNNING, "")
 		taskMessage := event.NewAnnounceTaskPIDEvent(t.ti.TaskID.GetValue(), int32(t.knownPid))
@@ -483,6 +560,15 @@ func (t *ControllableTask) Launch() error {
 				}).Debug("executor.ControllableTask.Launch.async: TASK_RUNNING sent back to core")
 		}
 
+		rpc := t.rpc
+		stopProbes := t.startProbes(taskCmd, func(ctx context.Context) (string, error) {
+			response, err := rpc.GetState(ctx, &pb.GetStateRequest{}, grpc.EmptyCallOption{})
+			if err != nil {
+				return "", err
+			}
+			return rpc.FromDeviceState(response.GetState()), nil
+		})
+
 		// Process events from task in yet another goroutine
 		go func() {
 			deo := event.DeviceEventOrigin{
@@ -562,6 +648,8 @@ func (t *ControllableTask) Launch() error {
 
 		err = taskCmd.Wait()
 		// ^ when this unblocks, the task is done
+		drainTaskOutput(&copying, stdoutIn, stderrIn)
+		stopProbes()
 		log.WithField("partition", t.knownEnvironmentId.String()).
 			WithField("detector", t.knownDetector).
 			WithFields(logrus.Fields{
@@ -654,8 +742,111 @@ func (t *ControllableTask) UnmarshalTransition(data []byte) (cmd *executorcmd.Ex
 	return
 }
 
+// watchStateStream forwards to core the state changes the task reports on its
+// StateStream without them being requested by a transition, for instance a
+// FairMQ device going to ERROR on its own.
+func (t *ControllableTask) watchStateStream(rpc *executorcmd.RpcClient, ssc pb.Occ_StateStreamClient) {
+	deo := event.DeviceEventOrigin{
+		AgentId:    t.ti.AgentID,
+		ExecutorId: t.ti.GetExecutor().ExecutorID,
+		TaskId:     t.ti.TaskID,
+	}
+	for {
+		ssr, err := ssc.Recv()
+		if err != nil {
+			if status.Code(err) == codes.Unimplemented {
+				log.WithField("partition", t.knownEnvironmentId.String()).
+					WithField("detector", t.knownDetector).
+					WithField("taskId", deo.TaskId.GetValue()).
+					WithField("taskName", t.ti.Name).
+					Debug("task does not support state stream")
+			} else {
+				log.WithField("partition", t.knownEnvironmentId.String()).
+					WithField("detector", t.knownDetector).
+					WithField("taskId", deo.TaskId.GetValue()).
+					WithField("taskName", t.ti.Name).
+					WithError(err).
+					Debug("state stream done")
+			}
+			return
+		}
+		if ssr.GetType() != pb.StateType_STATE_STABLE {
+			continue
+		}
+		// NOTE: we acquire the transitioner-dependent equivalent state
+		reachedState := rpc.FromDeviceState(ssr.GetState())
+		if reachedState == "" || !t.isUnsolicitedState(reachedState) {
+			continue
+		}
+
+		// The stream might lag behind the transitions we commit, so before
+		// notifying core we make sure this is still the current state
+		cxt, cancel := context.WithTimeout(context.Background(), STATE_CONFIRM_TIMEOUT)
+		response, err := rpc.GetState(cxt, &pb.GetStateRequest{}, grpc.EmptyCallOption{})
+		cancel()
+		if err != nil {
+			log.WithField("partition", t.knownEnvironmentId.String()).
+				WithField("detector", t.knownDetector).
+				WithField("taskId", deo.TaskId.GetValue()).
+				WithField("taskName", t.ti.Name).
+				WithError(err).
+				Debug("cannot confirm task state received from state stream")
+			continue
+		}
+		reachedState = rpc.FromDeviceState(response.GetState())
+
+		t.stateMu.Lock()
+		if t.transitioning || reachedState == "" || reachedState == t.knownState {
+			t.stateMu.Unlock()
+			continue
+		}
+		previousState := t.knownState
+		t.knownState = reachedState
+		t.stateMu.Unlock()
+
+		log.WithField("partition", t.knownEnvironmentId.String()).
+			WithField("detector", t.knownDetector).
+			WithField("taskId", deo.TaskId.GetValue()).
+			WithField("taskName", t.ti.Name).
+			WithField("taskPid", t.knownPid).
+			WithField("level", infologger.IL_Support).
+			Warningf("task transitioned from %s to %s on its own - notifying environment", previousState, reachedState)
+
+		deviceEvent := event.NewDeviceEvent(deo, pb.DeviceEventType_DEVICE_STATE_CHANGED)
+		if dsc, ok := deviceEvent.(*event.DeviceStateChanged); ok {
+			dsc.State = reachedState
+			dsc.PreviousState = previousState
+		}
+		deviceEvent.SetLabels(map[string]string{"detector": t.knownDetector, "environmentId": t.knownEnvironmentId.String()})
+		t.sendDeviceEvent(t.knownEnvironmentId, deviceEvent)
+	}
+}
+
+func (t *ControllableTask) isUnsolicitedState(state string) bool {
+	t.stateMu.Lock()
+	defer t.stateMu.Unlock()
+	return !t.transitioning && state != t.knownState
+}
+
+func (t *ControllableTask) beginTransition() {
+	t.stateMu.Lock()
+	defer t.stateMu.Unlock()
+	t.transitioning = true
+}
+
+func (t *ControllableTask) endTransition(newState string) {
+	t.stateMu.Lock()
+	defer t.stateMu.Unlock()
+	t.transitioning = false
+	if newState != "" {
+		t.knownState = newState
+	}
+}
+
 func (t *ControllableTask) Transition(cmd *executorcmd.ExecutorCommand_Transition) *controlcommands.MesosCommandResponse_Transition {
+	t.beginTransition()
 	newState, transitionError := cmd.Commit()
+	t.endTransition(newState)
 
 	response := cmd.PrepareResponse(transitionError, newState, t.ti.TaskID.Value)
 	return response
@@ -666,10 +857,22 @@ func (t *ControllableTask) Kill() error {
 		pid          = 0
 		reachedState = "UNKNOWN" // FIXME: should be LAUNCHING or similar
 	)
-	cxt, cancel := context.WithTimeout(context.Background(), KILL_TRANSITION_TIMEOUT)
-	defer cancel()
-	response, err := t.rpc.GetState(cxt, &pb.GetStateRequest{}, grpc.EmptyCallOption{})
-	if err == nil { // we successfully got the state from the task
+	// the teardown sequence is ours, the state stream must not report it
+	t.beginTransition()
+
+	policy := t.stopPolicy()
+	t.runPreStop(policy, t.rpc.TaskCmd)
+
+	var (
+		response *pb.GetStateReply
+		err      error
+	)
+	if policy.Transition {
+		cxt, cancel := context.WithTimeout(context.Background(), policy.TransitionTimeout)
+		defer cancel()
+		response, err = t.rpc.GetState(cxt, &pb.GetStateRequest{}, grpc.EmptyCallOption{})
+	}
+	if policy.Transition && err == nil { // we successfully got the state from the task
 		log.WithField("nativeState", response.GetState()).
 			WithField("taskId", t.ti.GetTaskID()).
 			WithField("level", infologger.IL_Devel).
@@ -740,7 +943,7 @@ func (t *ControllableTask) Kill() error {
 			var commitResponse *CommitResponse
 			select {
 			case commitResponse = <-commitDone:
-			case <-time.After(KILL_TRANSITION_TIMEOUT):
+			case <-time.After(policy.TransitionTimeout):
 				log.WithField("partition", t.knownEnvironmentId.String()).
 					WithField("detector", t.knownDetector).
 					WithField("task", t.ti.TaskID.Value).
@@ -785,11 +988,13 @@ func (t *ControllableTask) Kill() error {
 		// If GetState didn't succeed during this Kill code path, but might still have
 		// at some earlier point during the lifetime of this task.
 		// Either way, we might or might not have the true PID.
-		log.WithField("partition", t.knownEnvironmentId.String()).
-			WithField("detector", t.knownDetector).
-			WithError(err).
-			WithField("taskId", t.ti.GetTaskID()).
-			Warn("cannot query task status for graceful process termination")
+		if policy.Transition {
+			log.WithField("partition", t.knownEnvironmentId.String()).
+				WithField("detector", t.knownDetector).
+				WithError(err).
+				WithField("taskId", t.ti.GetTaskID()).
+				Warn("cannot query task status for graceful process termination")
+		}
 		pid = t.knownPid
 		if pid == 0 {
 			// The pid was never known through a successful `GetState` in the lifetime
@@ -828,7 +1033,7 @@ func (t *ControllableTask) Kill() error {
 	}
 
 	if pidExists(pid) {
-		return t.doTermIntKill(pid)
+		return t.stopProcess(pid, policy)
 	} else {
 		log.WithField("taskId", t.ti.GetTaskID()).
 			WithField("partition", t.knownEnvironmentId.String()).
@@ -837,72 +1042,3 @@ func (t *ControllableTask) Kill() error {
 		return nil
 	}
 }
-
-func (t *ControllableTask) doKill9(pid int) error {
-	log.WithField("partition", t.knownEnvironmentId.String()).
-		WithField("detector", t.knownDetector).
-		WithField("taskId", t.ti.GetTaskID()).
-		Debug("sending SIGKILL (9) to task")
-	killErr := syscall.Kill(pid, syscall.SIGKILL)
-	if killErr != nil {
-		log.WithField("partition", t.knownEnvironmentId.String()).
-			WithField("detector", t.knownDetector).
-			WithError(killErr).
-			WithField("taskId", t.ti.GetTaskID()).
-			Warning("task SIGKILL failed")
-	}
-
-	return killErr
-}
-
-func (t *ControllableTask) doTermIntKill(pid int) error {
-
-	killErrCh := make(chan error)
-	go func() {
-		log.WithField("partition", t.knownEnvironmentId.String()).
-			WithField("detector", t.knownDetector).
-			WithField("taskId", t.ti.GetTaskID()).
-			Debug("sending SIGTERM (15) to task")
-		err := syscall.Kill(pid, syscall.SIGTERM)
-		if err != nil {
-			log.WithField("partition", t.knownEnvironmentId.String()).
-				WithField("detector", t.knownDetector).
-				WithError(err).
-				WithField("taskId", t.ti.GetTaskID()).
-				Warning("task SIGTERM failed")
-		}
-		killErrCh <- err
-	}()
-
-	// Set a small timeout to SIGTERM if SIGTERM fails or timeout passes,
-	// we perform a SIGKILL.
-	select {
-	case killErr := <-killErrCh:
-		if killErr == nil {
-			time.Sleep(SIGTERM_TIMEOUT) // Waiting for the SIGTERM to kick in
-			if pidExists(pid) {
-				// SIGINT for the "Waiting for graceful device shutdown.
-				// Hit Ctrl-C again to abort immediately" message.
-				log.WithField("partition", t.knownEnvironmentId.String()).
-					WithField("detector", t.knownDetector).
-					WithField("taskId", t.ti.GetTaskID()).
-					Debug("sending SIGINT (2) to task")
-				killErr = syscall.Kill(pid, syscall.SIGINT)
-				if killErr != nil {
-					log.WithField("partition", t.knownEnvironmentId.String()).
-						WithField("detector", t.knownDetector).
-						WithError(killErr).
-						WithField("taskId", t.ti.GetTaskID()).
-						Warning("task SIGINT failed")
-				}
-				time.Sleep(SIGINT_TIMEOUT)
-			}
-		}
-		if !pidExists(pid) {
-			return killErr
-		}
-	case <-time.After(SIGTERM_TIMEOUT + SIGINT_TIMEOUT):
-	}
-
-	return t.doKill9(pid)
-}
@@ -0,0 +1,175 @@
+package executable
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	"github.com/AliceO2Group/Control/executor/executorcmd/nopb"
+	"github.com/AliceO2Group/Control/executor/executorcmd/transitioner"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeOccClient answers GetState with whatever state the task is set to.
+type fakeOccClient struct {
+	pb.OccClient
+	mu    sync.Mutex
+	state string
+}
+
+func (c *fakeOccClient) setState(state string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.state = state
+}
+
+func (c *fakeOccClient) GetState(context.Context, *pb.GetStateRequest, ...grpc.CallOption) (*pb.GetStateReply, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &pb.GetStateReply{State: c.state}, nil
+}
+
+// fakeStateStream hands out the replies and errors pushed to it, in order.
+type fakeStateStream struct {
+	grpc.ClientStream
+	replies chan *pb.StateStreamReply
+	errs    chan error
+}
+
+func (s *fakeStateStream) Recv() (*pb.StateStreamReply, error) {
+	select {
+	case r := <-s.replies:
+		return r, nil
+	case err := <-s.errs:
+		return nil, err
+	}
+}
+
+var _ = Describe("controllable task state stream", func() {
+	var (
+		t       *ControllableTask
+		occ     *fakeOccClient
+		stream  *fakeStateStream
+		events  chan event.DeviceEvent
+		watched chan struct{}
+	)
+
+	stable := func(state string) *pb.StateStreamReply {
+		return &pb.StateStreamReply{Type: pb.StateType_STATE_STABLE, State: state}
+	}
+
+	BeforeEach(func() {
+		envId := uid.New()
+		events = make(chan event.DeviceEvent, 10)
+		occ = &fakeOccClient{state: "STANDBY"}
+		stream = &fakeStateStream{
+			replies: make(chan *pb.StateStreamReply),
+			errs:    make(chan error),
+		}
+		t = &ControllableTask{
+			taskBase: taskBase{
+				ti: &mesos.TaskInfo{
+					Name:     "readout",
+					TaskID:   mesos.TaskID{Value: "task-1"},
+					AgentID:  mesos.AgentID{Value: "agent-1"},
+					Executor: &mesos.ExecutorInfo{ExecutorID: mesos.ExecutorID{Value: "executor-1"}},
+				},
+				sendDeviceEvent: func(_ uid.ID, e event.DeviceEvent) {
+					events <- e
+				},
+				knownEnvironmentId: envId,
+				knownDetector:      "TST",
+			},
+			rpc: &executorcmd.RpcClient{
+				OccClient:    occ,
+				Transitioner: transitioner.NewDirectTransitioner(nil),
+			},
+			knownState: "STANDBY",
+		}
+
+		watched = make(chan struct{})
+		go func() {
+			defer close(watched)
+			t.watchStateStream(t.rpc, stream)
+		}()
+	})
+
+	AfterEach(func() {
+		select {
+		case stream.errs <- status.Error(codes.Canceled, "stream closed"):
+		case <-watched:
+		}
+		Eventually(watched).Should(BeClosed())
+	})
+
+	It("reports states the task reached on its own", func() {
+		stream.replies <- stable("STANDBY")
+		stream.replies <- &pb.StateStreamReply{Type: pb.StateType_STATE_INTERMEDIATE, State: "RESETTING TASK"}
+		occ.setState("ERROR")
+		stream.replies <- stable("ERROR")
+
+		var received event.DeviceEvent
+		Eventually(events).Should(Receive(&received))
+		Expect(received.GetType()).To(Equal(pb.DeviceEventType_DEVICE_STATE_CHANGED))
+		Expect(received.GetOrigin().TaskId.Value).To(Equal("task-1"))
+		Expect(received.GetLabels()).To(HaveKeyWithValue("detector", "TST"))
+		dsc, ok := received.(*event.DeviceStateChanged)
+		Expect(ok).To(BeTrue())
+		Expect(dsc.PreviousState).To(Equal("STANDBY"))
+		Expect(dsc.State).To(Equal("ERROR"))
+		Expect(events).NotTo(Receive())
+
+		// the same state is not reported twice
+		stream.replies <- stable("ERROR")
+		Consistently(events, 200*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("does not report states reached through a transition", func() {
+		t.beginTransition()
+		occ.setState("CONFIGURED")
+		stream.replies <- stable("CONFIGURED")
+		t.endTransition("CONFIGURED")
+
+		stream.replies <- stable("CONFIGURED")
+		Consistently(events, 200*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("does not report states the task already left", func() {
+		// the stream lags behind, GetState says the task is still where we left it
+		stream.replies <- stable("CONFIGURED")
+		Consistently(events, 200*time.Millisecond).ShouldNot(Receive())
+
+		t.stateMu.Lock()
+		defer t.stateMu.Unlock()
+		Expect(t.knownState).To(Equal("STANDBY"))
+	})
+
+	It("gives up on tasks which do not implement the state stream", func() {
+		stream.errs <- status.Error(codes.Unimplemented, "unknown method StateStream")
+		Eventually(watched).Should(BeClosed())
+		Expect(events).NotTo(Receive())
+	})
+})
+
+var _ = Describe("JSON codec state stream replies", func() {
+	It("decodes replies as sent by the OCC lite plugin", func() {
+		codec := &nopb.JsonCodec{}
+		reply := new(pb.StateStreamReply)
+		Expect(codec.Unmarshal([]byte(`{"type":1,"state":"INITIALIZING DEVICE"}`), reply)).To(Succeed())
+		Expect(reply.GetType()).To(Equal(pb.StateType_STATE_INTERMEDIATE))
+		Expect(reply.GetState()).To(Equal("INITIALIZING DEVICE"))
+
+		Expect(codec.Unmarshal([]byte(`{"type":0,"state":"ERROR"}`), reply)).To(Succeed())
+		Expect(reply.GetType()).To(Equal(pb.StateType_STATE_STABLE))
+		Expect(reply.GetState()).To(Equal("ERROR"))
+	})
+})
@@ -0,0 +1,13 @@
+package executable
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestExecutable(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Executor Task Test Suite")
+}
@@ -0,0 +1,264 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executable
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+)
+
+const probeOutputMaxLength = 256
+
+// probeCheck makes a single attempt of a probe, it returns nil on success.
+type probeCheck func(ctx context.Context) error
+
+// getStateFunc returns the current OCC state of a controllable task.
+type getStateFunc func(ctx context.Context) (string, error)
+
+// startProbes runs the probes of the task until the returned function is
+// called, which must happen as soon as the task process is gone. getState is
+// used by OCC probes, and is nil for basic tasks.
+func (t *taskBase) startProbes(taskCmd *exec.Cmd, getState getStateFunc) (stop func()) {
+	if t.Tci == nil || t.Tci.Probes.IsEmpty() {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	for _, p := range []struct {
+		name  string
+		probe *common.TaskProbe
+	}{
+		{common.LIVENESS_PROBE, t.Tci.Probes.Liveness},
+		{common.READINESS_PROBE, t.Tci.Probes.Readiness},
+	} {
+		if p.probe == nil {
+			continue
+		}
+		check, err := makeProbeCheck(p.probe, taskCmd, getState)
+		if err != nil {
+			log.WithField("partition", t.knownEnvironmentId.String()).
+				WithField("detector", t.knownDetector).
+				WithField("task", t.ti.Name).
+				WithField("probe", p.name).
+				WithField("level", infologger.IL_Support).
+				WithError(err).
+				Warning("cannot run task probe")
+			continue
+		}
+		go t.runProbe(ctx, p.name, p.probe, check)
+	}
+	return cancel
+}
+
+// runProbe makes an attempt every period and reports the probe as failed
+// after failureThreshold consecutive unsuccessful attempts. Once a liveness
+// probe fails it is not run anymore, as the core handles the task as dead.
+func (t *taskBase) runProbe(ctx context.Context, name string, probe *common.TaskProbe, check probeCheck) {
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(probe.InitialDelay):
+	}
+
+	ticker := time.NewTicker(probe.Period)
+	defer ticker.Stop()
+
+	failures := 0
+	failed := false
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, probe.Timeout)
+		err := check(checkCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err == nil {
+			failures = 0
+			if failed {
+				failed = false
+				t.sendProbeEvent(name, true, "")
+			}
+		} else {
+			failures++
+			log.WithField("partition", t.knownEnvironmentId.String()).
+				WithField("detector", t.knownDetector).
+				WithField("task", t.ti.Name).
+				WithField("probe", name).
+				WithField("failures", failures).
+				WithField("level", infologger.IL_Devel).
+				WithError(err).
+				Debug("task probe attempt failed")
+			if !failed && failures >= probe.FailureThreshold {
+				failed = true
+				t.sendProbeEvent(name, false, fmt.Sprintf("%s probe failed %d times, last error: %s", probe.Kind(), failures, err.Error()))
+				if name == common.LIVENESS_PROBE {
+					return
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *taskBase) sendProbeEvent(name string, healthy bool, message string) {
+	taskMessage := event.NewTaskProbeEvent(t.ti.TaskID.GetValue(), name, healthy, message)
+	taskMessage.SetLabels(map[string]string{"detector": t.knownDetector, "environmentId": t.knownEnvironmentId.String()})
+
+	jsonEvent, err := json.Marshal(taskMessage)
+	if err != nil {
+		log.WithField("partition", t.knownEnvironmentId.String()).
+			WithField("detector", t.knownDetector).
+			WithField("taskId", t.ti.TaskID.GetValue()).
+			WithError(err).
+			Warning("error marshaling message")
+		return
+	}
+	t.sendMessage(jsonEvent)
+}
+
+func makeProbeCheck(probe *common.TaskProbe, taskCmd *exec.Cmd, getState getStateFunc) (probeCheck, error) {
+	switch {
+	case probe.Exec != nil:
+		return execProbeCheck(probe.Exec, taskCmd), nil
+	case probe.HttpGet != nil:
+		return httpGetProbeCheck(probe.HttpGet), nil
+	case probe.TcpSocket != nil:
+		return tcpSocketProbeCheck(probe.TcpSocket), nil
+	case probe.Occ != nil:
+		if getState == nil {
+			return nil, errors.New("occ probes only apply to controllable tasks")
+		}
+		return occProbeCheck(probe.Occ, getState), nil
+	}
+	return nil, errors.New("probe has no check")
+}
+
+// execProbeCheck runs the command with the environment and credentials of
+// the task.
+func execProbeCheck(probe *common.ExecProbe, taskCmd *exec.Cmd) probeCheck {
+	return func(ctx context.Context) error {
+		if len(probe.Command) == 0 {
+			return errors.New("no command")
+		}
+		cmd := exec.CommandContext(ctx, probe.Command[0], probe.Command[1:]...)
+		if taskCmd != nil {
+			cmd.Env = taskCmd.Env
+			if taskCmd.SysProcAttr != nil && taskCmd.SysProcAttr.Credential != nil {
+				cmd.SysProcAttr = &syscall.SysProcAttr{Credential: taskCmd.SysProcAttr.Credential}
+			}
+		}
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("timed out: %w", ctx.Err())
+			}
+			out := strings.TrimSpace(string(output))
+			if len(out) > probeOutputMaxLength {
+				out = out[:probeOutputMaxLength] + "…"
+			}
+			if len(out) > 0 {
+				return fmt.Errorf("%w: %s", err, out)
+			}
+			return err
+		}
+		return nil
+	}
+}
+
+func probeAddress(host string, port string) string {
+	if len(strings.TrimSpace(host)) == 0 {
+		host = "localhost"
+	}
+	return net.JoinHostPort(strings.TrimSpace(host), strings.TrimSpace(port))
+}
+
+func httpGetProbeCheck(probe *common.HttpGetProbe) probeCheck {
+	path := probe.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	url := "http://" + probeAddress(probe.Host, probe.Port) + path
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		_ = resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+			return fmt.Errorf("GET %s returned %s", url, resp.Status)
+		}
+		return nil
+	}
+}
+
+func tcpSocketProbeCheck(probe *common.TcpSocketProbe) probeCheck {
+	address := probeAddress(probe.Host, probe.Port)
+	return func(ctx context.Context) error {
+		conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+}
+
+func occProbeCheck(probe *common.OccProbe, getState getStateFunc) probeCheck {
+	return func(ctx context.Context) error {
+		state, err := getState(ctx)
+		if err != nil {
+			return err
+		}
+		for _, expected := range probe.States {
+			if strings.EqualFold(strings.TrimSpace(expected), state) {
+				return nil
+			}
+		}
+		return fmt.Errorf("task is in state %s, expected one of %s", state, strings.Join(probe.States, ", "))
+	}
+}
@@ -0,0 +1,166 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executable
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"golang.org/x/sys/unix"
+)
+
+// how often we check whether a process being stopped is gone
+const stopPollInterval = 100 * time.Millisecond
+
+// stopPolicy returns how the task process is stopped, with the defaults of
+// its control mode for what the task template leaves out.
+func (t *taskBase) stopPolicy() *common.TaskStopPolicy {
+	policy := &common.TaskStopPolicy{
+		Transition:        true,
+		TransitionTimeout: KILL_TRANSITION_TIMEOUT,
+	}
+	if t.Tci != nil && t.Tci.Stop != nil {
+		policy = t.Tci.Stop.Copy()
+	}
+	if policy.Signals == nil {
+		if t.Tci != nil && (t.Tci.ControlMode == controlmode.BASIC || t.Tci.ControlMode == controlmode.HOOK) {
+			// basic tasks have always been killed straight away
+			policy.Signals = []common.TaskStopSignal{}
+		} else {
+			// SIGINT for the "Waiting for graceful device shutdown.
+			// Hit Ctrl-C again to abort immediately" message.
+			policy.Signals = []common.TaskStopSignal{
+				{Signal: "SIGTERM", Grace: SIGTERM_TIMEOUT},
+				{Signal: "SIGINT", Grace: SIGINT_TIMEOUT},
+			}
+		}
+	}
+	return policy
+}
+
+// runPreStop runs the pre-stop hook of the stop policy, if any, with the
+// environment, working directory and credentials of the task. A failing hook
+// is logged, but does not prevent the task from being stopped.
+func (t *taskBase) runPreStop(policy *common.TaskStopPolicy, taskCmd *exec.Cmd) {
+	if policy == nil || policy.PreStop == nil || len(policy.PreStop.Command) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), policy.PreStop.Timeout)
+	defer cancel()
+
+	hook := policy.PreStop.Command
+	cmd := exec.CommandContext(ctx, hook[0], hook[1:]...)
+	if taskCmd != nil {
+		cmd.Env = taskCmd.Env
+		cmd.Dir = taskCmd.Dir
+		if taskCmd.SysProcAttr != nil && taskCmd.SysProcAttr.Credential != nil {
+			cmd.SysProcAttr = &syscall.SysProcAttr{Credential: taskCmd.SysProcAttr.Credential}
+		}
+	}
+
+	hookStart := time.Now()
+	output, err := cmd.CombinedOutput()
+	logEntry := log.WithField("partition", t.knownEnvironmentId.String()).
+		WithField("detector", t.knownDetector).
+		WithField("taskId", t.ti.GetTaskID()).
+		WithField("command", strings.Join(hook, " ")).
+		WithField("duration", time.Since(hookStart).String())
+	if err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		logEntry.WithError(err).
+			WithField("output", strings.TrimSpace(string(output))).
+			Warning("task pre-stop hook failed")
+		return
+	}
+	logEntry.WithField("level", infologger.IL_Devel).
+		Debug("task pre-stop hook done")
+}
+
+// stopProcess sends the signals of the stop policy in turn to pid, which is
+// negative for a process group, moving on to the next signal once its grace
+// period expires. A SIGKILL follows if the process is still alive by then.
+func (t *taskBase) stopProcess(pid int, policy *common.TaskStopPolicy) error {
+	var killErr error
+	for _, step := range policy.Signals {
+		if !pidExists(pid) {
+			return killErr
+		}
+		signal := unix.SignalNum(step.Signal)
+		if signal == 0 {
+			log.WithField("partition", t.knownEnvironmentId.String()).
+				WithField("detector", t.knownDetector).
+				WithField("taskId", t.ti.GetTaskID()).
+				WithField("signal", step.Signal).
+				Warning("unknown stop signal, skipping")
+			continue
+		}
+
+		log.WithField("partition", t.knownEnvironmentId.String()).
+			WithField("detector", t.knownDetector).
+			WithField("taskId", t.ti.GetTaskID()).
+			WithField("grace", step.Grace.String()).
+			Debugf("sending %s (%d) to task", step.Signal, int(signal))
+		killErr = syscall.Kill(pid, signal)
+		if killErr != nil {
+			log.WithField("partition", t.knownEnvironmentId.String()).
+				WithField("detector", t.knownDetector).
+				WithError(killErr).
+				WithField("taskId", t.ti.GetTaskID()).
+				Warningf("task %s failed", step.Signal)
+			continue
+		}
+
+		// Waiting for the signal to kick in
+		deadline := time.Now().Add(step.Grace)
+		for pidExists(pid) && time.Now().Before(deadline) {
+			time.Sleep(stopPollInterval)
+		}
+	}
+	if !pidExists(pid) {
+		return killErr
+	}
+
+	log.WithField("partition", t.knownEnvironmentId.String()).
+		WithField("detector", t.knownDetector).
+		WithField("taskId", t.ti.GetTaskID()).
+		Debug("sending SIGKILL (9) to task")
+	killErr = syscall.Kill(pid, syscall.SIGKILL)
+	if killErr != nil {
+		log.WithField("partition", t.knownEnvironmentId.String()).
+			WithField("detector", t.knownDetector).
+			WithError(killErr).
+			WithField("taskId", t.ti.GetTaskID()).
+			Warning("task SIGKILL failed")
+	}
+	return killErr
+}
@@ -0,0 +1,346 @@
+package executable
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	"github.com/AliceO2Group/Control/executor/executorcmd/transitioner"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+)
+
+// countingOccClient counts the GetState calls it gets, without ever
+// answering them successfully.
+type countingOccClient struct {
+	pb.OccClient
+	getStateCalls atomic.Int32
+}
+
+func (c *countingOccClient) GetState(context.Context, *pb.GetStateRequest, ...grpc.CallOption) (*pb.GetStateReply, error) {
+	c.getStateCalls.Add(1)
+	return nil, context.DeadlineExceeded
+}
+
+// startStopTestProcess runs script in dir, in its own process group, until it
+// has printed a line, and reaps it in the background. The returned channel
+// yields the process state once the process is gone.
+func startStopTestProcess(dir, script string) (*exec.Cmd, <-chan *os.ProcessState) {
+	cmd := exec.Command("/bin/sh", "-c", script)
+	cmd.Dir = dir
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	stdout, err := cmd.StdoutPipe()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cmd.Start()).To(Succeed())
+	DeferCleanup(func() {
+		_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	})
+
+	// the script is ready for signals once its traps are set
+	_, err = bufio.NewReader(stdout).ReadString('\n')
+	Expect(err).NotTo(HaveOccurred())
+
+	exited := make(chan *os.ProcessState, 1)
+	go func() {
+		_ = cmd.Wait()
+		exited <- cmd.ProcessState
+	}()
+	return cmd, exited
+}
+
+// terminatingSignal returns the signal that ended the process, if any.
+func terminatingSignal(state *os.ProcessState) syscall.Signal {
+	status := state.Sys().(syscall.WaitStatus)
+	if !status.Signaled() {
+		return 0
+	}
+	return status.Signal()
+}
+
+var _ = Describe("stopping a task", func() {
+	var t *taskBase
+
+	BeforeEach(func() {
+		t = &taskBase{
+			ti: &mesos.TaskInfo{
+				Name:   "stopped",
+				TaskID: mesos.TaskID{Value: "stopped-" + uid.New().String()},
+			},
+			knownEnvironmentId: uid.New(),
+		}
+	})
+
+	Describe("the stop policy", func() {
+		It("kills basic tasks straight away by default", func() {
+			t.Tci = &common.TaskCommandInfo{ControlMode: controlmode.BASIC}
+			policy := t.stopPolicy()
+			Expect(policy.Transition).To(BeTrue())
+			Expect(policy.Signals).NotTo(BeNil())
+			Expect(policy.Signals).To(BeEmpty())
+		})
+
+		It("sends SIGTERM then SIGINT to controllable tasks by default", func() {
+			t.Tci = &common.TaskCommandInfo{ControlMode: controlmode.FAIRMQ}
+			policy := t.stopPolicy()
+			Expect(policy.TransitionTimeout).To(Equal(KILL_TRANSITION_TIMEOUT))
+			Expect(policy.Signals).To(Equal([]common.TaskStopSignal{
+				{Signal: "SIGTERM", Grace: SIGTERM_TIMEOUT},
+				{Signal: "SIGINT", Grace: SIGINT_TIMEOUT},
+			}))
+		})
+
+		It("uses the policy of the task template, without modifying it", func() {
+			stop := &common.TaskStopPolicy{
+				Transition: false,
+				Signals:    []common.TaskStopSignal{{Signal: "SIGUSR1", Grace: time.Second}},
+			}
+			t.Tci = &common.TaskCommandInfo{ControlMode: controlmode.FAIRMQ, Stop: stop}
+			policy := t.stopPolicy()
+			Expect(policy).To(Equal(stop))
+			Expect(policy).NotTo(BeIdenticalTo(stop))
+		})
+	})
+
+	Describe("the task process", func() {
+		It("exits on the first signal it honours", func() {
+			cmd, exited := startStopTestProcess("", "echo ready; exec sleep 30")
+			policy := &common.TaskStopPolicy{
+				Signals: []common.TaskStopSignal{{Signal: "SIGTERM", Grace: 10 * time.Second}},
+			}
+
+			start := time.Now()
+			Expect(t.stopProcess(-cmd.Process.Pid, policy)).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+
+			var state *os.ProcessState
+			Eventually(exited).Should(Receive(&state))
+			Expect(terminatingSignal(state)).To(Equal(syscall.SIGTERM))
+		})
+
+		It("is killed once the grace period of a signal it ignores expires", func() {
+			cmd, exited := startStopTestProcess("", "trap '' TERM; echo ready; exec sleep 30")
+			grace := 500 * time.Millisecond
+			policy := &common.TaskStopPolicy{
+				Signals: []common.TaskStopSignal{{Signal: "SIGTERM", Grace: grace}},
+			}
+
+			start := time.Now()
+			Expect(t.stopProcess(-cmd.Process.Pid, policy)).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically(">=", grace))
+
+			var state *os.ProcessState
+			Eventually(exited).Should(Receive(&state))
+			Expect(terminatingSignal(state)).To(Equal(syscall.SIGKILL))
+		})
+
+		It("moves on to the next signal after each grace period", func() {
+			cmd, exited := startStopTestProcess("", "trap '' TERM; echo ready; exec sleep 30")
+			grace := 500 * time.Millisecond
+			policy := &common.TaskStopPolicy{
+				Signals: []common.TaskStopSignal{
+					{Signal: "SIGTERM", Grace: grace},
+					{Signal: "SIGINT", Grace: 10 * time.Second},
+				},
+			}
+
+			start := time.Now()
+			Expect(t.stopProcess(-cmd.Process.Pid, policy)).To(Succeed())
+			elapsed := time.Since(start)
+			Expect(elapsed).To(BeNumerically(">=", grace))
+			Expect(elapsed).To(BeNumerically("<", 5*time.Second))
+
+			var state *os.ProcessState
+			Eventually(exited).Should(Receive(&state))
+			Expect(terminatingSignal(state)).To(Equal(syscall.SIGINT))
+		})
+
+		It("is killed after the grace periods of all the signals it ignores", func() {
+			cmd, exited := startStopTestProcess("", "trap '' TERM INT; echo ready; exec sleep 30")
+			policy := &common.TaskStopPolicy{
+				Signals: []common.TaskStopSignal{
+					{Signal: "SIGTERM", Grace: 300 * time.Millisecond},
+					{Signal: "SIGINT", Grace: 300 * time.Millisecond},
+				},
+			}
+
+			start := time.Now()
+			Expect(t.stopProcess(-cmd.Process.Pid, policy)).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically(">=", 600*time.Millisecond))
+
+			var state *os.ProcessState
+			Eventually(exited).Should(Receive(&state))
+			Expect(terminatingSignal(state)).To(Equal(syscall.SIGKILL))
+		})
+
+		It("is killed straight away without any signals", func() {
+			cmd, exited := startStopTestProcess("", "echo ready; exec sleep 30")
+
+			start := time.Now()
+			Expect(t.stopProcess(-cmd.Process.Pid, &common.TaskStopPolicy{Signals: []common.TaskStopSignal{}})).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+
+			var state *os.ProcessState
+			Eventually(exited).Should(Receive(&state))
+			Expect(terminatingSignal(state)).To(Equal(syscall.SIGKILL))
+		})
+
+		It("skips unknown signals", func() {
+			cmd, exited := startStopTestProcess("", "echo ready; exec sleep 30")
+			policy := &common.TaskStopPolicy{
+				Signals: []common.TaskStopSignal{
+					{Signal: "SIGBOGUS", Grace: 10 * time.Second},
+					{Signal: "SIGTERM", Grace: 10 * time.Second},
+				},
+			}
+
+			start := time.Now()
+			Expect(t.stopProcess(-cmd.Process.Pid, policy)).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+
+			var state *os.ProcessState
+			Eventually(exited).Should(Receive(&state))
+			Expect(terminatingSignal(state)).To(Equal(syscall.SIGTERM))
+		})
+
+		It("is left alone if it is already gone", func() {
+			cmd, exited := startStopTestProcess("", "echo ready")
+			Eventually(exited).Should(Receive())
+
+			policy := &common.TaskStopPolicy{
+				Signals: []common.TaskStopSignal{{Signal: "SIGTERM", Grace: 10 * time.Second}},
+			}
+			start := time.Now()
+			Expect(t.stopProcess(-cmd.Process.Pid, policy)).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+	})
+
+	Describe("the pre-stop hook", func() {
+		var (
+			dir     string
+			taskCmd *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+			taskCmd = exec.Command("/bin/true")
+			taskCmd.Dir = dir
+			taskCmd.Env = []string{"PATH=" + os.Getenv("PATH"), "STOP_TEST_VALUE=from-the-task"}
+		})
+
+		It("runs with the environment and working directory of the task", func() {
+			policy := &common.TaskStopPolicy{
+				PreStop: &common.TaskStopHook{
+					Command: []string{"/bin/sh", "-c", `echo "$PWD $STOP_TEST_VALUE" > prestop.out`},
+					Timeout: 10 * time.Second,
+				},
+			}
+			t.runPreStop(policy, taskCmd)
+
+			output, err := os.ReadFile(filepath.Join(dir, "prestop.out"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(output)).To(Equal(dir + " from-the-task\n"))
+		})
+
+		It("is cut short once its timeout expires", func() {
+			policy := &common.TaskStopPolicy{
+				PreStop: &common.TaskStopHook{
+					Command: []string{"sleep", "30"},
+					Timeout: 300 * time.Millisecond,
+				},
+			}
+
+			start := time.Now()
+			t.runPreStop(policy, taskCmd)
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		})
+
+		It("tolerates a failing or missing hook", func() {
+			policy := &common.TaskStopPolicy{
+				PreStop: &common.TaskStopHook{
+					Command: []string{"/bin/sh", "-c", "exit 3"},
+					Timeout: 10 * time.Second,
+				},
+			}
+			t.runPreStop(policy, taskCmd)
+			t.runPreStop(&common.TaskStopPolicy{}, taskCmd)
+			t.runPreStop(nil, taskCmd)
+		})
+
+		It("runs before a basic task is stopped", func() {
+			events := make(chan event.DeviceEvent, 1)
+			marker := filepath.Join(dir, "stopping")
+			bt := newTestBasicTask("trap '' TERM; sleep 30 & wait", events)
+			bt.Tci.Stop = &common.TaskStopPolicy{
+				PreStop: &common.TaskStopHook{
+					Command: []string{"touch", marker},
+					Timeout: 10 * time.Second,
+				},
+				Signals: []common.TaskStopSignal{{Signal: "SIGTERM", Grace: 300 * time.Millisecond}},
+			}
+			bt.pendingFinalTaskStateCh = make(chan mesos.TaskState, 1)
+			Expect(bt.startBasicTask()).To(Succeed())
+			Expect(bt.ensureBasicTaskKilled()).To(Succeed())
+			Expect(marker).To(BeAnExistingFile())
+
+			var received event.DeviceEvent
+			Eventually(events, 10*time.Second).Should(Receive(&received))
+			Expect(received.GetType()).To(Equal(pb.DeviceEventType_BASIC_TASK_TERMINATED))
+			Expect(received.(*event.BasicTaskTerminated).FinalMesosState).To(Equal(mesos.TASK_KILLED))
+		})
+	})
+
+	When("the stop policy skips the teardown transitions", func() {
+		It("stops the process group of a controllable task without querying it", func() {
+			dir := GinkgoT().TempDir()
+			cmd, exited := startStopTestProcess(dir, "trap '' TERM; echo ready; exec sleep 30")
+			occ := &countingOccClient{}
+			ct := &ControllableTask{
+				taskBase: taskBase{
+					ti: t.ti,
+					Tci: &common.TaskCommandInfo{
+						ControlMode: controlmode.FAIRMQ,
+						Stop: &common.TaskStopPolicy{
+							Transition: false,
+							PreStop: &common.TaskStopHook{
+								Command: []string{"touch", "stopping"},
+								Timeout: 10 * time.Second,
+							},
+							Signals: []common.TaskStopSignal{{Signal: "SIGTERM", Grace: 300 * time.Millisecond}},
+						},
+					},
+					knownEnvironmentId: t.knownEnvironmentId,
+				},
+				rpc: &executorcmd.RpcClient{
+					OccClient:    occ,
+					Transitioner: transitioner.NewDirectTransitioner(nil),
+					TaskCmd:      cmd,
+				},
+				pendingFinalTaskStateCh: make(chan mesos.TaskState, 1),
+			}
+
+			Expect(ct.Kill()).To(Succeed())
+			Expect(occ.getStateCalls.Load()).To(BeZero())
+			Expect(ct.rpc).To(BeNil())
+			Expect(filepath.Join(dir, "stopping")).To(BeAnExistingFile())
+			Expect(ct.pendingFinalTaskStateCh).To(Receive(Equal(mesos.TASK_KILLED)))
+
+			var state *os.ProcessState
+			Eventually(exited).Should(Receive(&state))
+			Expect(terminatingSignal(state)).To(Equal(syscall.SIGKILL))
+		})
+	})
+})
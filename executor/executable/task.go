@@ -30,11 +30,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"os"
 	"os/exec"
 	"os/user"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -43,10 +45,12 @@ import (
 	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/common/logger"
 	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/common/secrets"
 	"github.com/AliceO2Group/Control/common/utils/uid"
 	"github.com/AliceO2Group/Control/core/controlcommands"
 	"github.com/AliceO2Group/Control/executor/executorcmd"
 	"github.com/AliceO2Group/Control/executor/executorutil"
+	"github.com/AliceO2Group/Control/executor/tasklog"
 	mesos "github.com/mesos/mesos-go/api/v1/lib"
 	"github.com/sirupsen/logrus"
 )
@@ -54,10 +58,27 @@ import (
 const (
 	startupPollingInterval = 500 * time.Millisecond
 	startupTimeout         = 30 * time.Second
+	// how long the output of a task is still read once it exited, for
+	// leftover children which may keep it open
+	outputDrainTimeout = 2 * time.Second
 )
 
 var log = logger.New(logrus.StandardLogger(), "executor")
 
+// taskLogs configures the files the output of every task is written to
+var taskLogs = tasklog.DefaultConfig()
+
+// SetTaskLogConfig sets where and how task output is kept, it must be called
+// before any task is launched.
+func SetTaskLogConfig(cfg tasklog.Config) {
+	taskLogs = cfg
+}
+
+// TaskLogConfig returns the current task output configuration.
+func TaskLogConfig() tasklog.Config {
+	return taskLogs
+}
+
 type SendStatusFunc func(envId uid.ID, state mesos.TaskState, message string)
 type SendDeviceEventFunc func(envId uid.ID, event event.DeviceEvent)
 type SendMessageFunc func(message []byte)
@@ -79,6 +100,151 @@ type taskBase struct {
 
 	knownEnvironmentId uid.ID
 	knownDetector      string
+
+	// secret values shipped with the launch payload, kept apart from Tci so
+	// they are only ever used to build the task command
+	secrets map[string]string
+	// directory holding the artifacts of the task, once staged
+	sandbox string
+}
+
+// teeTaskLog makes everything read from the output pipes of the task also go
+// to its log file. The returned function flushes and closes the log file.
+func (t *taskBase) teeTaskLog(stdoutIn, stderrIn io.Reader) (stdout io.Reader, stderr io.Reader, closeLog func()) {
+	taskLog, err := taskLogs.Open(t.ti.TaskID.Value)
+	if err != nil {
+		log.WithField("partition", t.knownEnvironmentId.String()).
+			WithField("detector", t.knownDetector).
+			WithField("task", t.ti.Name).
+			WithField("level", infologger.IL_Devel).
+			WithError(err).
+			Warning("cannot open task log file, task output will not be kept")
+		return stdoutIn, stderrIn, func() {}
+	}
+	stdoutLog, stderrLog := taskLog.Writer(), taskLog.Writer()
+	closeLog = func() {
+		_ = stdoutLog.Close()
+		_ = stderrLog.Close()
+		_ = taskLog.Close()
+	}
+	return io.TeeReader(stdoutIn, stdoutLog), io.TeeReader(stderrIn, stderrLog), closeLog
+}
+
+// outputPipes connects the output of the task to pipes which, unlike those of
+// StdoutPipe, are not closed by Wait, so that the task can be reaped before
+// everything it wrote is read. The returned function closes the write ends of
+// the executor, it must be called once the task is started.
+func outputPipes(taskCmd *exec.Cmd) (stdoutIn *os.File, stderrIn *os.File, started func(), err error) {
+	stdoutIn, stdoutOut, err := os.Pipe()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	stderrIn, stderrOut, err := os.Pipe()
+	if err != nil {
+		_ = stdoutIn.Close()
+		_ = stdoutOut.Close()
+		return nil, nil, nil, err
+	}
+	taskCmd.Stdout, taskCmd.Stderr = stdoutOut, stderrOut
+	started = func() {
+		_ = stdoutOut.Close()
+		_ = stderrOut.Close()
+	}
+	return stdoutIn, stderrIn, started, nil
+}
+
+// drainTaskOutput waits for the copies of the output of an exited task to be
+// done, then closes the output pipes. Leftover children of the task may keep
+// its output open for good, so the pipes are closed after outputDrainTimeout
+// regardless, and whatever they write later is lost.
+func drainTaskOutput(copying *sync.WaitGroup, stdoutIn, stderrIn io.Closer) {
+	done := make(chan struct{})
+	go func() {
+		copying.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(outputDrainTimeout):
+	}
+	_ = stdoutIn.Close()
+	_ = stderrIn.Close()
+	<-done
+}
+
+// startTaskCmd starts the task process with the CPU and NUMA placement,
+// umask and resource limits requested by the core, if any, and reports the
+// placement it got.
+func (t *taskBase) startTaskCmd(taskCmd *exec.Cmd) error {
+	var (
+		affinity *common.TaskAffinity
+		rlimits  common.Rlimits
+		umask    *string
+	)
+	if t.Tci != nil {
+		affinity, rlimits, umask = t.Tci.Affinity, t.Tci.Rlimits, t.Tci.Umask
+	}
+
+	var mask int
+	if umask != nil && len(*umask) > 0 {
+		var err error
+		if mask, err = common.ParseUmask(*umask); err != nil {
+			return err
+		}
+	}
+	releaseRlimits, err := gateRlimits(taskCmd, rlimits)
+	if err != nil {
+		return err
+	}
+
+	var effective *common.TaskAffinity
+	start := func() {
+		effective, err = startTaskCmd(taskCmd, affinity)
+	}
+	if umask != nil && len(*umask) > 0 {
+		if launchErr := startWithUmask(mask, start); launchErr != nil {
+			err = launchErr
+		}
+	} else {
+		start()
+	}
+	if err != nil {
+		_ = releaseRlimits(0)
+		return err
+	}
+
+	if err = releaseRlimits(taskCmd.Process.Pid); err != nil {
+		// the task never ran, its stand-in goes with the whole group in case
+		// it did not exit on its own
+		_ = syscall.Kill(-taskCmd.Process.Pid, syscall.SIGKILL)
+		_ = taskCmd.Wait()
+		return err
+	}
+	if effective == nil {
+		return nil
+	}
+
+	log.WithField("partition", t.knownEnvironmentId.String()).
+		WithField("detector", t.knownDetector).
+		WithField("task", t.ti.Name).
+		WithField("cpus", effective.Cpus).
+		WithField("memoryNodes", effective.MemoryNodes).
+		WithField("level", infologger.IL_Devel).
+		Debug("task placement applied")
+
+	taskMessage := event.NewAnnounceTaskAffinityEvent(t.ti.TaskID.GetValue(), *effective)
+	taskMessage.SetLabels(map[string]string{"detector": t.knownDetector, "environmentId": t.knownEnvironmentId.String()})
+	jsonEvent, marshalErr := json.Marshal(taskMessage)
+	if marshalErr != nil {
+		log.WithField("partition", t.knownEnvironmentId.String()).
+			WithField("detector", t.knownDetector).
+			WithField("taskId", t.ti.TaskID.GetValue()).
+			WithError(marshalErr).
+			Warning("error marshaling message")
+		return nil
+	}
+	t.sendMessage(jsonEvent)
+	return nil
 }
 
 func NewTask(taskInfo mesos.TaskInfo, sendStatusFunc SendStatusFunc, sendDeviceEventFunc SendDeviceEventFunc, sendMessageFunc SendMessageFunc) Task {
@@ -88,9 +254,23 @@ func NewTask(taskInfo mesos.TaskInfo, sendStatusFunc SendStatusFunc, sendDeviceE
 
 	tciData := taskInfo.GetData()
 
-	log.WithField("json", string(tciData[:])).
-		Trace("received TaskCommandInfo")
+	var secretValues map[string]string
 	if err := json.Unmarshal(tciData, &commandInfo); tciData != nil && err == nil {
+		// the launch payload may carry secret values, which must never be
+		// logged, so we also strip them from the TaskInfo we keep around
+		secretValues = commandInfo.Secrets
+		commandInfo.Secrets = nil
+		if len(secretValues) > 0 {
+			if redactedData, marshalErr := json.Marshal(&commandInfo); marshalErr == nil {
+				taskInfo.Data = redactedData
+			} else {
+				taskInfo.Data = nil
+			}
+		}
+		log.WithField("json", string(taskInfo.GetData()[:])).
+			WithField("secrets", len(secretValues)).
+			Trace("received TaskCommandInfo")
+
 		log.WithFields(logrus.Fields{
 			"shell":       *commandInfo.Shell,
 			"value":       *commandInfo.Value,
@@ -145,6 +325,7 @@ func NewTask(taskInfo mesos.TaskInfo, sendStatusFunc SendStatusFunc, sendDeviceE
 					sendMessage:        sendMessageFunc,
 					knownEnvironmentId: envId,
 					knownDetector:      detector,
+					secrets:            secretValues,
 				},
 			},
 		}
@@ -159,6 +340,7 @@ func NewTask(taskInfo mesos.TaskInfo, sendStatusFunc SendStatusFunc, sendDeviceE
 					sendMessage:        sendMessageFunc,
 					knownEnvironmentId: envId,
 					knownDetector:      detector,
+					secrets:            secretValues,
 				},
 			},
 		}
@@ -174,6 +356,7 @@ func NewTask(taskInfo mesos.TaskInfo, sendStatusFunc SendStatusFunc, sendDeviceE
 				sendMessage:        sendMessageFunc,
 				knownEnvironmentId: envId,
 				knownDetector:      detector,
+				secrets:            secretValues,
 			},
 			rpc: nil,
 		}
@@ -182,7 +365,42 @@ func NewTask(taskInfo mesos.TaskInfo, sendStatusFunc SendStatusFunc, sendDeviceE
 	return newTask
 }
 
-func prepareTaskCmd(commandInfo *common.TaskCommandInfo) (*exec.Cmd, error) {
+// loggedTaskCmd returns the path and arguments of the process prepareTaskCmd
+// builds for a task command, with its secrets left unresolved so that they
+// can be logged.
+func loggedTaskCmd(commandInfo *common.TaskCommandInfo, taskCmd *exec.Cmd) (path string, args []string) {
+	value := commandInfo.GetValue()
+	if commandInfo.Shell != nil && *commandInfo.Shell {
+		rawCommand := strings.Join(append([]string{value}, commandInfo.Arguments...), " ")
+		return taskCmd.Path, []string{"/bin/sh", "-c", rawCommand}
+	}
+	path = taskCmd.Path
+	if len(secrets.ReferencedNames(value)) > 0 {
+		path = value
+	}
+	return path, append([]string{value}, commandInfo.Arguments...)
+}
+
+// prepareTaskCmd builds the process for a task command, filling in the values
+// of any secrets it references. Only the returned exec.Cmd ever holds these
+// values, the commandInfo is left untouched.
+func prepareTaskCmd(commandInfo *common.TaskCommandInfo, secretValues map[string]string) (*exec.Cmd, error) {
+	value, err := secrets.Resolve(*commandInfo.Value, secretValues)
+	if err != nil {
+		return nil, err
+	}
+	arguments := make([]string, len(commandInfo.Arguments))
+	for i, argument := range commandInfo.Arguments {
+		arguments[i], err = secrets.Resolve(argument, secretValues)
+		if err != nil {
+			return nil, err
+		}
+	}
+	env, err := secrets.ResolveEnv(commandInfo.Env, secretValues)
+	if err != nil {
+		return nil, err
+	}
+
 	var taskCmd *exec.Cmd
 	ctx := context.Background()
 	if commandInfo.Timeout.Seconds() > 0 { // if a timeout is defined, we add a context
@@ -190,17 +408,26 @@ func prepareTaskCmd(commandInfo *common.TaskCommandInfo) (*exec.Cmd, error) {
 	}
 
 	if *commandInfo.Shell {
-		rawCommand := strings.Join(append([]string{*commandInfo.Value}, commandInfo.Arguments...), " ")
+		rawCommand := strings.Join(append([]string{value}, arguments...), " ")
 		taskCmd = exec.CommandContext(ctx, "/bin/sh", []string{"-c", rawCommand}...)
 	} else {
-		taskCmd = exec.CommandContext(ctx, *commandInfo.Value, commandInfo.Arguments...)
+		taskCmd = exec.CommandContext(ctx, value, arguments...)
+	}
+	taskCmd.Env = append(os.Environ(), env...)
+	if commandInfo.WorkingDir != nil {
+		// a relative working directory is within the sandbox, see useSandbox
+		taskCmd.Dir = *commandInfo.WorkingDir
 	}
-	taskCmd.Env = append(os.Environ(), commandInfo.Env...)
 
 	// We must setpgid(2) in order to be able to kill the whole process group which consists of
 	// the containing shell and all of its children
 	taskCmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
 	setPdeathsig(taskCmd.SysProcAttr)
+	// On timeout the whole group is killed too, otherwise the children of the
+	// shell would keep the output pipes open
+	taskCmd.Cancel = func() error {
+		return syscall.Kill(-taskCmd.Process.Pid, syscall.SIGKILL)
+	}
 
 	// If the commandInfo specifies a username
 	if commandInfo.User != nil && len(*commandInfo.User) > 0 {
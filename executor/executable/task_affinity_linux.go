@@ -0,0 +1,146 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executable
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"unsafe"
+
+	"github.com/AliceO2Group/Control/common"
+	"golang.org/x/sys/unix"
+)
+
+// set_mempolicy(2) modes, not exposed by x/sys/unix
+const (
+	mpolDefault = 0
+	mpolBind    = 2
+
+	maxNumaNodes = 1024
+)
+
+// startTaskCmd starts the task process with the given CPU and NUMA placement
+// and returns the placement the process actually got.
+// The CPU affinity and the memory policy are inherited from the thread which
+// forks, so we set both on a locked OS thread around the fork and restore
+// them afterwards. The children of the task inherit them in turn, so the
+// placement applies to the whole process group.
+func startTaskCmd(taskCmd *exec.Cmd, affinity *common.TaskAffinity) (effective *common.TaskAffinity, err error) {
+	if affinity.IsEmpty() {
+		return nil, taskCmd.Start()
+	}
+
+	var cpus, memoryNodes []int
+	if len(affinity.Cpus) > 0 {
+		if cpus, err = common.ParseCpuList(affinity.Cpus); err != nil {
+			return nil, fmt.Errorf("invalid CPU affinity: %w", err)
+		}
+	}
+	if len(affinity.MemoryNodes) > 0 {
+		if memoryNodes, err = common.ParseCpuList(affinity.MemoryNodes); err != nil {
+			return nil, fmt.Errorf("invalid memory nodes: %w", err)
+		}
+	}
+
+	runtime.LockOSThread()
+	// If the thread cannot be restored we leave it locked, so that no other
+	// goroutine runs with the placement of this task
+	restored := true
+	defer func() {
+		if restored {
+			runtime.UnlockOSThread()
+		}
+	}()
+
+	if len(cpus) > 0 {
+		var previous, wanted unix.CPUSet
+		if err = unix.SchedGetaffinity(0, &previous); err != nil {
+			return nil, fmt.Errorf("cannot get executor CPU affinity: %w", err)
+		}
+		for _, cpu := range cpus {
+			wanted.Set(cpu)
+		}
+		if err = unix.SchedSetaffinity(0, &wanted); err != nil {
+			return nil, fmt.Errorf("cannot set CPU affinity %s: %w", affinity.Cpus, err)
+		}
+		defer func() {
+			if restoreErr := unix.SchedSetaffinity(0, &previous); restoreErr != nil {
+				restored = false
+			}
+		}()
+	}
+	if len(memoryNodes) > 0 {
+		if err = setMempolicy(mpolBind, memoryNodes); err != nil {
+			return nil, fmt.Errorf("cannot bind memory to NUMA nodes %s: %w", affinity.MemoryNodes, err)
+		}
+		defer func() {
+			if restoreErr := setMempolicy(mpolDefault, nil); restoreErr != nil {
+				restored = false
+			}
+		}()
+	}
+
+	if err = taskCmd.Start(); err != nil {
+		return nil, err
+	}
+
+	// The memory policy of another process cannot be queried, but the CPU
+	// affinity can, in case something else has restricted it further
+	effective = &common.TaskAffinity{MemoryNodes: affinity.MemoryNodes}
+	var actual unix.CPUSet
+	if unix.SchedGetaffinity(taskCmd.Process.Pid, &actual) == nil {
+		actualCpus := make([]int, 0, actual.Count())
+		for cpu := 0; cpu < len(actual)*64; cpu++ {
+			if actual.IsSet(cpu) {
+				actualCpus = append(actualCpus, cpu)
+			}
+		}
+		effective.Cpus = common.FormatCpuList(actualCpus)
+	} else {
+		effective.Cpus = affinity.Cpus
+	}
+	return effective, nil
+}
+
+func setMempolicy(mode int, nodes []int) error {
+	var mask [maxNumaNodes / 64]uint64
+	for _, node := range nodes {
+		if node < 0 || node >= maxNumaNodes {
+			return fmt.Errorf("NUMA node %d out of range", node)
+		}
+		mask[node/64] |= 1 << (uint(node) % 64)
+	}
+	var maskPtr uintptr
+	if len(nodes) > 0 {
+		maskPtr = uintptr(unsafe.Pointer(&mask[0]))
+	}
+	// the kernel expects the number of bits in the mask plus one
+	_, _, errno := unix.Syscall(unix.SYS_SET_MEMPOLICY, uintptr(mode), maskPtr, uintptr(maxNumaNodes+1))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executable
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"sync"
+
+	"github.com/AliceO2Group/Control/common"
+	"golang.org/x/sys/unix"
+)
+
+var rlimitResources = map[string]int{
+	"as":         unix.RLIMIT_AS,
+	"core":       unix.RLIMIT_CORE,
+	"cpu":        unix.RLIMIT_CPU,
+	"data":       unix.RLIMIT_DATA,
+	"fsize":      unix.RLIMIT_FSIZE,
+	"locks":      unix.RLIMIT_LOCKS,
+	"memlock":    unix.RLIMIT_MEMLOCK,
+	"msgqueue":   unix.RLIMIT_MSGQUEUE,
+	"nice":       unix.RLIMIT_NICE,
+	"nofile":     unix.RLIMIT_NOFILE,
+	"nproc":      unix.RLIMIT_NPROC,
+	"rss":        unix.RLIMIT_RSS,
+	"rtprio":     unix.RLIMIT_RTPRIO,
+	"rttime":     unix.RLIMIT_RTTIME,
+	"sigpending": unix.RLIMIT_SIGPENDING,
+	"stack":      unix.RLIMIT_STACK,
+}
+
+// gateRlimits makes taskCmd apply the given resource limits before the task
+// runs. Unlike the CPU affinity, resource limits are shared by all the threads
+// of the executor, so they cannot be set around the fork without affecting
+// the executor itself. Instead the process starts as a shell which waits for
+// the executor to set the limits on it with prlimit(2), and only then
+// replaces itself with the task, which keeps them. This also lets a task
+// running as another user have limits above its own hard limits.
+// The returned function must be called once taskCmd.Start returns, with the
+// pid of the process or 0 if it did not start. If it fails, the shell exits
+// without running the task.
+func gateRlimits(taskCmd *exec.Cmd, rlimits common.Rlimits) (release func(pid int) error, err error) {
+	if len(rlimits) == 0 || taskCmd.Err != nil {
+		return func(int) error { return nil }, nil
+	}
+	gateR, gateW, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	gateFd := 3 + len(taskCmd.ExtraFiles)
+	taskCmd.ExtraFiles = append(taskCmd.ExtraFiles, gateR)
+	script := fmt.Sprintf(`read -r _ <&%[1]d || exit 126; exec %[1]d<&-; exec "$0" "$@"`, gateFd)
+	taskCmd.Args = append([]string{"/bin/sh", "-c", script, taskCmd.Path}, taskCmd.Args[1:]...)
+	taskCmd.Path = "/bin/sh"
+
+	return func(pid int) error {
+		// the process has its own copy of the read end by now
+		_ = gateR.Close()
+		defer gateW.Close()
+		if pid == 0 {
+			return nil
+		}
+		if err := setRlimits(pid, rlimits); err != nil {
+			return err
+		}
+		_, err := gateW.Write([]byte("\n"))
+		return err
+	}, nil
+}
+
+// setRlimits applies resource limits to a running process.
+func setRlimits(pid int, rlimits common.Rlimits) error {
+	for _, name := range rlimits.Names() {
+		resource, ok := rlimitResources[name]
+		if !ok {
+			return fmt.Errorf("unknown resource limit %s", name)
+		}
+		soft, hard, err := rlimits[name].Values()
+		if err != nil {
+			return fmt.Errorf("invalid resource limit %s: %w", name, err)
+		}
+		// math.MaxUint64 is RLIM_INFINITY
+		if err = unix.Prlimit(pid, resource, &unix.Rlimit{Cur: soft, Max: hard}, nil); err != nil {
+			return fmt.Errorf("cannot set resource limit %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// umaskLauncher starts the task processes which need a umask of their own.
+// The umask is shared by all the threads of a process, unless a thread stops
+// sharing its filesystem attributes, which cannot be undone. So the launcher
+// keeps a locked OS thread of its own for the lifetime of the executor: if
+// the thread exited, the parent death signal would kill the tasks it forked.
+var umaskLauncher struct {
+	once   sync.Once
+	err    error
+	starts chan func()
+}
+
+// startWithUmask runs start, which must fork the task process, with the
+// given umask.
+func startWithUmask(umask int, start func()) error {
+	umaskLauncher.once.Do(func() {
+		umaskLauncher.starts = make(chan func())
+		ready := make(chan error)
+		go func() {
+			runtime.LockOSThread()
+			if err := unix.Unshare(unix.CLONE_FS); err != nil {
+				ready <- err
+				return
+			}
+			ready <- nil
+			for start := range umaskLauncher.starts {
+				start()
+			}
+		}()
+		umaskLauncher.err = <-ready
+	})
+	if umaskLauncher.err != nil {
+		return fmt.Errorf("cannot set up the task launcher thread: %w", umaskLauncher.err)
+	}
+
+	done := make(chan struct{})
+	umaskLauncher.starts <- func() {
+		defer close(done)
+		previous := unix.Umask(umask)
+		defer unix.Umask(previous)
+		start()
+	}
+	<-done
+	return nil
+}
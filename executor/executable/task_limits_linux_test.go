@@ -0,0 +1,160 @@
+package executable
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/sys/unix"
+)
+
+var _ = Describe("task process limits", func() {
+	// run starts command through startTaskCmd and returns its output once
+	// it is done
+	run := func(t *taskBase, command *exec.Cmd) (string, error) {
+		var out bytes.Buffer
+		command.Stdout = &out
+		command.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+		if err := t.startTaskCmd(command); err != nil {
+			return "", err
+		}
+		Expect(command.Wait()).To(Succeed())
+		return out.String(), nil
+	}
+
+	Describe("setRlimits", func() {
+		var child *exec.Cmd
+		BeforeEach(func() {
+			child = exec.Command("sleep", "10")
+			Expect(child.Start()).To(Succeed())
+		})
+		AfterEach(func() {
+			_ = child.Process.Kill()
+			_ = child.Wait()
+		})
+
+		It("sets the soft and hard limits of a process", func() {
+			Expect(setRlimits(child.Process.Pid, common.Rlimits{
+				"nofile": {Soft: "100", Hard: "200"},
+				"core":   {Soft: "unlimited", Hard: "unlimited"},
+			})).To(Succeed())
+
+			var nofile, core unix.Rlimit
+			Expect(unix.Prlimit(child.Process.Pid, unix.RLIMIT_NOFILE, nil, &nofile)).To(Succeed())
+			Expect(nofile).To(Equal(unix.Rlimit{Cur: 100, Max: 200}))
+			Expect(unix.Prlimit(child.Process.Pid, unix.RLIMIT_CORE, nil, &core)).To(Succeed())
+			Expect(core).To(Equal(unix.Rlimit{Cur: unix.RLIM_INFINITY, Max: unix.RLIM_INFINITY}))
+		})
+
+		It("refuses unknown and invalid limits", func() {
+			Expect(setRlimits(child.Process.Pid, common.Rlimits{"bogus": {Soft: "1", Hard: "1"}})).
+				To(MatchError(ContainSubstring("unknown resource limit")))
+			Expect(setRlimits(child.Process.Pid, common.Rlimits{"nofile": {Soft: "200", Hard: "100"}})).
+				To(MatchError(ContainSubstring("invalid resource limit")))
+		})
+	})
+
+	Describe("starting a task with resource limits", func() {
+		It("applies them before the task command runs", func() {
+			t := &taskBase{Tci: &common.TaskCommandInfo{CommandInfo: common.CommandInfo{
+				Rlimits: common.Rlimits{"nofile": {Soft: "100", Hard: "200"}},
+			}}}
+			out, err := run(t, exec.Command("/bin/sh", "-c", `ulimit -Sn; ulimit -Hn; echo "$0" "$@"`, "zero", "one", "two words"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal("100\n200\nzero one two words\n"))
+		})
+
+		It("keeps the working directory of the task", func() {
+			dir, err := filepath.EvalSymlinks(GinkgoT().TempDir())
+			Expect(err).NotTo(HaveOccurred())
+			t := &taskBase{
+				Tci: &common.TaskCommandInfo{CommandInfo: common.CommandInfo{
+					Rlimits: common.Rlimits{"nofile": {Soft: "100", Hard: "100"}},
+				}},
+				sandbox: dir,
+			}
+			command := exec.Command("pwd", "-P")
+			t.useSandbox(command)
+			out, err := run(t, command)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(dir + "\n"))
+		})
+
+		It("holds the task command back until they are applied", func() {
+			marker := filepath.Join(GinkgoT().TempDir(), "ran")
+			command := exec.Command("touch", marker)
+			release, err := gateRlimits(command, common.Rlimits{"nofile": {Soft: "100", Hard: "100"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(command.Start()).To(Succeed())
+			Consistently(marker, 300*time.Millisecond).ShouldNot(BeAnExistingFile())
+
+			Expect(release(command.Process.Pid)).To(Succeed())
+			Expect(command.Wait()).To(Succeed())
+			Expect(marker).To(BeAnExistingFile())
+		})
+
+		It("does not run the task command if they cannot be applied", func() {
+			marker := filepath.Join(GinkgoT().TempDir(), "ran")
+			t := &taskBase{Tci: &common.TaskCommandInfo{CommandInfo: common.CommandInfo{
+				Rlimits: common.Rlimits{"bogus": {Soft: "1", Hard: "1"}},
+			}}}
+			_, err := run(t, exec.Command("touch", marker))
+			Expect(err).To(MatchError(ContainSubstring("unknown resource limit")))
+			Expect(marker).NotTo(BeAnExistingFile())
+		})
+
+		It("leaves the limits of the executor alone", func() {
+			var before, after unix.Rlimit
+			Expect(unix.Getrlimit(unix.RLIMIT_NOFILE, &before)).To(Succeed())
+			t := &taskBase{Tci: &common.TaskCommandInfo{CommandInfo: common.CommandInfo{
+				Rlimits: common.Rlimits{"nofile": {Soft: "100", Hard: "100"}},
+			}}}
+			_, err := run(t, exec.Command("true"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unix.Getrlimit(unix.RLIMIT_NOFILE, &after)).To(Succeed())
+			Expect(after).To(Equal(before))
+		})
+	})
+
+	Describe("startWithUmask", func() {
+		It("starts the task with the given umask, leaving the executor's alone", func() {
+			before := unix.Umask(0022)
+			unix.Umask(before)
+
+			umask := "0027"
+			t := &taskBase{Tci: &common.TaskCommandInfo{CommandInfo: common.CommandInfo{Umask: &umask}}}
+			out, err := run(t, exec.Command("/bin/sh", "-c", "umask"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal("0027\n"))
+
+			after := unix.Umask(before)
+			Expect(after).To(Equal(before))
+		})
+
+		It("creates the files of the task with the given umask", func() {
+			dir := GinkgoT().TempDir()
+			umask := "0077"
+			t := &taskBase{Tci: &common.TaskCommandInfo{CommandInfo: common.CommandInfo{Umask: &umask}}}
+			_, err := run(t, exec.Command("touch", filepath.Join(dir, "created")))
+			Expect(err).NotTo(HaveOccurred())
+			info, err := os.Stat(filepath.Join(dir, "created"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+
+		It("refuses an invalid umask before starting anything", func() {
+			umask := "0999"
+			t := &taskBase{Tci: &common.TaskCommandInfo{CommandInfo: common.CommandInfo{Umask: &umask}}}
+			command := exec.Command("true")
+			_, err := run(t, command)
+			Expect(err).To(MatchError(ContainSubstring("bad umask")))
+			Expect(command.Process).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,129 @@
+package executable
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/executor/artifact"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("task working directory", func() {
+	var (
+		t       *taskBase
+		sandbox string
+		other   string
+	)
+
+	// run starts the task command the way tasks are launched and returns
+	// what it printed
+	run := func() string {
+		taskCmd, err := prepareTaskCmd(t.Tci, nil)
+		Expect(err).NotTo(HaveOccurred())
+		t.useSandbox(taskCmd)
+		var out bytes.Buffer
+		taskCmd.Stdout = &out
+		Expect(t.startTaskCmd(taskCmd)).To(Succeed())
+		Expect(taskCmd.Wait()).To(Succeed())
+		return out.String()
+	}
+
+	BeforeEach(func() {
+		var err error
+		sandbox, err = filepath.EvalSymlinks(GinkgoT().TempDir())
+		Expect(err).NotTo(HaveOccurred())
+		other, err = filepath.EvalSymlinks(GinkgoT().TempDir())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.Mkdir(filepath.Join(sandbox, "run"), 0755)).To(Succeed())
+
+		shell, command := true, `pwd -P; echo "$`+artifact.SANDBOX_ENV+`"`
+		t = &taskBase{Tci: &common.TaskCommandInfo{CommandInfo: common.CommandInfo{
+			Shell: &shell,
+			Value: &command,
+		}}}
+	})
+
+	When("the task has a sandbox", func() {
+		BeforeEach(func() {
+			t.sandbox = sandbox
+		})
+
+		It("runs in the sandbox by default", func() {
+			Expect(run()).To(Equal(sandbox + "\n" + sandbox + "\n"))
+		})
+
+		It("takes a relative working directory within the sandbox", func() {
+			workingDir := "run"
+			t.Tci.WorkingDir = &workingDir
+			Expect(run()).To(Equal(filepath.Join(sandbox, "run") + "\n" + sandbox + "\n"))
+		})
+
+		It("keeps an absolute working directory", func() {
+			t.Tci.WorkingDir = &other
+			Expect(run()).To(Equal(other + "\n" + sandbox + "\n"))
+		})
+	})
+
+	When("the task has no sandbox", func() {
+		It("runs in the working directory it asks for", func() {
+			t.Tci.WorkingDir = &other
+			Expect(run()).To(Equal(other + "\n\n"))
+		})
+
+		It("leaves a relative working directory as it is", func() {
+			workingDir := "run"
+			t.Tci.WorkingDir = &workingDir
+			taskCmd, err := prepareTaskCmd(t.Tci, nil)
+			Expect(err).NotTo(HaveOccurred())
+			t.useSandbox(taskCmd)
+			Expect(taskCmd.Dir).To(Equal("run"))
+		})
+	})
+})
+
+var _ = Describe("task command logging", func() {
+	values := map[string]string{"token": "s3cr3t"}
+
+	commandInfo := func(shell bool, value string, arguments ...string) *common.TaskCommandInfo {
+		return &common.TaskCommandInfo{CommandInfo: common.CommandInfo{
+			Shell:     &shell,
+			Value:     &value,
+			Arguments: arguments,
+		}}
+	}
+
+	It("keeps the secret placeholders of the arguments", func() {
+		tci := commandInfo(false, "/bin/echo", "--token", "${secret:token}", "secret:token")
+		taskCmd, err := prepareTaskCmd(tci, values)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taskCmd.Args).To(ContainElement("s3cr3t"))
+
+		path, args := loggedTaskCmd(tci, taskCmd)
+		Expect(path).To(Equal("/bin/echo"))
+		Expect(args).To(Equal([]string{"/bin/echo", "--token", "${secret:token}", "secret:token"}))
+	})
+
+	It("keeps the secret placeholders of shell commands", func() {
+		tci := commandInfo(true, "curl -H 'Authorization: ${secret:token}'", "http://localhost")
+		taskCmd, err := prepareTaskCmd(tci, values)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taskCmd.Args[2]).To(ContainSubstring("s3cr3t"))
+
+		path, args := loggedTaskCmd(tci, taskCmd)
+		Expect(path).To(Equal("/bin/sh"))
+		Expect(args).To(Equal([]string{"/bin/sh", "-c", "curl -H 'Authorization: ${secret:token}' http://localhost"}))
+	})
+
+	It("does not log the path of a secret command", func() {
+		tci := commandInfo(false, "secret:token")
+		taskCmd, err := prepareTaskCmd(tci, values)
+		Expect(err).NotTo(HaveOccurred())
+
+		path, args := loggedTaskCmd(tci, taskCmd)
+		Expect(path).To(Equal("secret:token"))
+		Expect(args).To(Equal([]string{"secret:token"}))
+	})
+})
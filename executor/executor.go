@@ -43,8 +43,10 @@ import (
 	"github.com/AliceO2Group/Control/common/logger"
 	"github.com/AliceO2Group/Control/common/logger/infologger"
 	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/executor/artifact"
 	"github.com/AliceO2Group/Control/executor/executable"
 	"github.com/AliceO2Group/Control/executor/executorutil"
+	"github.com/AliceO2Group/Control/executor/tasklog"
 	"github.com/mesos/mesos-go/api/v1/lib"
 	"github.com/mesos/mesos-go/api/v1/lib/backoff"
 	"github.com/mesos/mesos-go/api/v1/lib/encoding/codecs"
@@ -60,8 +62,10 @@ import (
 )
 
 const (
-	apiPath     = "/api/v1/executor"
-	httpTimeout = 10 * time.Second
+	apiPath                 = "/api/v1/executor"
+	httpTimeout             = 10 * time.Second
+	taskLogCleanupInterval  = 1 * time.Hour
+	artifactCleanupInterval = 10 * time.Minute
 )
 
 var log = logger.New(logrus.StandardLogger(), "executor")
@@ -164,6 +168,14 @@ func Run(cfg config.Config) {
 	// Chmod executor directory from 750 to 755 because of OCTRL-766
 	_ = syscall.Chmod(cfg.Directory, 0755)
 
+	// Task output is kept on disk for a while after the tasks are gone
+	executable.SetTaskLogConfig(tasklog.ConfigFromEnv())
+	go cleanupTaskLogs(state)
+
+	// Task artifacts are cached across tasks, sandboxes only live as long as their task
+	executable.SetArtifactConfig(artifact.ConfigFromEnv())
+	go cleanupArtifacts(state)
+
 	// Main loop for (re)subscription. Once we're subscribed, we jump into the event loop for handling the agent.
 	for {
 		func() {
@@ -348,3 +360,53 @@ func newStatus(envId uid.ID, state *internalState, id mesos.TaskID) mesos.TaskSt
 		},
 	}
 }
+
+// cleanupTaskLogs periodically removes the logs of tasks which are gone and
+// past their retention period.
+func cleanupTaskLogs(state *internalState) {
+	isActive := func(taskId string) bool {
+		state.activeTasksMu.RLock()
+		defer state.activeTasksMu.RUnlock()
+		_, ok := state.activeTasks[mesos.TaskID{Value: taskId}]
+		return ok
+	}
+	for {
+		cfg := executable.TaskLogConfig()
+		removed, err := cfg.Cleanup(isActive)
+		if err != nil {
+			log.WithError(err).
+				WithField("dir", cfg.Dir).
+				Warning("cannot clean up task logs")
+		} else if removed > 0 {
+			log.WithField("dir", cfg.Dir).
+				WithField("removed", removed).
+				Debug("expired task logs removed")
+		}
+		time.Sleep(taskLogCleanupInterval)
+	}
+}
+
+// cleanupArtifacts periodically removes the sandboxes of tasks which are gone
+// and the cached artifacts which were not used within their retention period.
+func cleanupArtifacts(state *internalState) {
+	isActive := func(taskId string) bool {
+		state.activeTasksMu.RLock()
+		defer state.activeTasksMu.RUnlock()
+		_, ok := state.activeTasks[mesos.TaskID{Value: taskId}]
+		return ok
+	}
+	for {
+		time.Sleep(artifactCleanupInterval)
+		cfg := executable.ArtifactConfig()
+		removed, err := cfg.Cleanup(isActive)
+		if err != nil {
+			log.WithError(err).
+				WithField("dir", cfg.Dir).
+				Warning("cannot clean up task artifacts")
+		} else if removed > 0 {
+			log.WithField("dir", cfg.Dir).
+				WithField("removed", removed).
+				Debug("stale task artifacts removed")
+		}
+	}
+}
@@ -2,8 +2,11 @@ package nopb
 
 import (
 	"context"
+	"sync/atomic"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/AliceO2Group/Control/executor/protos"
 )
@@ -15,12 +18,38 @@ type OccClient interface {
 	GetState(ctx context.Context, in *pb.GetStateRequest, opts ...grpc.CallOption) (*pb.GetStateReply, error)
 	Transition(ctx context.Context, in *pb.TransitionRequest, opts ...grpc.CallOption) (*pb.TransitionReply, error)
 }
+
+// occClient calls the bare method names registered by the OCClite server. If
+// the task rejects them as unimplemented, as grpc-go servers do, it switches
+// for good to the standard /occ_pb.Occ/<Method> paths.
 type occClient struct {
-	cc *grpc.ClientConn
+	cc              *grpc.ClientConn
+	fullMethodNames atomic.Bool
 }
 
 func NewOccClient(cc *grpc.ClientConn) OccClient {
-	return &occClient{cc}
+	return &occClient{cc: cc}
+}
+
+func (c *occClient) method(bareName string, fullName string) string {
+	if c.fullMethodNames.Load() {
+		return fullName
+	}
+	return bareName
+}
+
+// invoke performs a unary call, falling back to the full method name if the
+// bare one is not implemented by the task.
+func (c *occClient) invoke(ctx context.Context, bareName string, fullName string, in interface{}, out interface{}, opts ...grpc.CallOption) error {
+	methodName := c.method(bareName, fullName)
+	err := c.cc.Invoke(ctx, methodName, in, out, opts...)
+	if methodName == bareName && status.Code(err) == codes.Unimplemented {
+		err = c.cc.Invoke(ctx, fullName, in, out, opts...)
+		if err == nil {
+			c.fullMethodNames.Store(true)
+		}
+	}
+	return err
 }
 
 type occEventStreamClient struct {
@@ -47,7 +76,7 @@ func (c *occClient) EventStream(ctx context.Context, in *pb.EventStreamRequest,
 		ServerStreams: true,
 		ClientStreams: false,
 	}
-	stream, err := c.cc.NewStream(ctx, &streamDesc, "EventStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &streamDesc, c.method("EventStream", pb.Occ_EventStream_FullMethodName), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +90,42 @@ func (c *occClient) EventStream(ctx context.Context, in *pb.EventStreamRequest,
 	return x, nil
 }
 
+type occStateStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *occStateStreamClient) Recv() (*pb.StateStreamReply, error) {
+	m := new(pb.StateStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *occClient) StateStream(ctx context.Context, in *pb.StateStreamRequest, opts ...grpc.CallOption) (pb.Occ_StateStreamClient, error) {
-	return nil, nil
+	opts = append(opts,
+		[]grpc.CallOption{
+			grpc.CallContentSubtype("json"),
+		}...,
+	)
+	streamDesc := grpc.StreamDesc{
+		StreamName:    "StateStream",
+		Handler:       nil,
+		ServerStreams: true,
+		ClientStreams: false,
+	}
+	stream, err := c.cc.NewStream(ctx, &streamDesc, c.method("StateStream", pb.Occ_StateStream_FullMethodName), opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &occStateStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 func (c *occClient) GetState(ctx context.Context, in *pb.GetStateRequest, opts ...grpc.CallOption) (*pb.GetStateReply, error) {
@@ -72,7 +135,7 @@ func (c *occClient) GetState(ctx context.Context, in *pb.GetStateRequest, opts .
 			grpc.CallContentSubtype("json"),
 		}...,
 	)
-	err := c.cc.Invoke(ctx, "GetState", in, &out, opts...)
+	err := c.invoke(ctx, "GetState", pb.Occ_GetState_FullMethodName, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +149,7 @@ func (c *occClient) Transition(ctx context.Context, in *pb.TransitionRequest, op
 			grpc.CallContentSubtype("json"),
 		}...,
 	)
-	err := c.cc.Invoke(ctx, "Transition", in, &out, opts...)
+	err := c.invoke(ctx, "Transition", pb.Occ_Transition_FullMethodName, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -239,6 +239,46 @@ func handleMessageEvent(state *internalState, data []byte) (err error) {
 			}
 		}()
 
+	case "MesosCommand_GetTaskLogs":
+		// Logs are kept after the task is gone, so we don't check for an active task.
+		// Reading a file is cheap, but we still don't want to block the event loop.
+		go func() {
+			var cmd = new(controlcommands.MesosCommand_GetTaskLogs)
+			err = json.Unmarshal(data, cmd)
+			if err != nil {
+				log.WithFields(logrus.Fields{
+					"name":    incoming.Name,
+					"message": string(data[:]),
+					"error":   err.Error(),
+				}).
+					Error("cannot unmarshal incoming MESSAGE")
+				return
+			}
+
+			chunk, readErr := executable.TaskLogConfig().Read(taskId.Value, cmd.Tail, cmd.Offset, cmd.Follow)
+			response := controlcommands.NewMesosCommandResponse_GetTaskLogs(cmd, readErr, taskId.Value, string(chunk.Content), chunk.NextOffset)
+
+			jsonData, marshalError := json.Marshal(response)
+			if marshalError != nil {
+				log.WithFields(logrus.Fields{
+					"commandName":  response.GetCommandName(),
+					"commandId":    response.GetCommandId(),
+					"marshalError": marshalError,
+				}).
+					Error("cannot marshal MesosCommandResponse for sending as MESSAGE")
+				return
+			}
+
+			_, _ = state.cli.Send(context.TODO(), calls.NonStreaming(calls.Message(jsonData)))
+			log.WithFields(logrus.Fields{
+				"commandName": response.GetCommandName(),
+				"commandId":   response.GetCommandId(),
+				"taskId":      response.TaskId,
+				"bytes":       len(response.Content),
+			}).
+				Trace("response sent")
+		}()
+
 	default:
 		err = errors.New(fmt.Sprintf("unrecognized controlcommand %s", incoming.Name))
 	}
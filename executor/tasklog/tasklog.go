@@ -0,0 +1,425 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package tasklog implements the per-task output files kept by the executor,
+// with size-based rotation, retention after the task exits and tail/follow
+// reads for on-demand retrieval from the core.
+package tasklog
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DIR_ENV       = "O2_ALIECS_TASK_LOG_DIR"
+	MAX_SIZE_ENV  = "O2_ALIECS_TASK_LOG_MAX_SIZE"
+	MAX_FILES_ENV = "O2_ALIECS_TASK_LOG_MAX_FILES"
+	RETENTION_ENV = "O2_ALIECS_TASK_LOG_RETENTION"
+
+	// MAX_READ_SIZE bounds the payload of a single Read, which must fit in a
+	// Mesos message
+	MAX_READ_SIZE = 512 * 1024
+	// maxLineLength bounds how much of an unterminated line is buffered
+	maxLineLength = 64 * 1024
+
+	fileSuffix = ".log"
+)
+
+var log = logger.New(logrus.StandardLogger(), "tasklog")
+
+var ErrNoLogs = errors.New("no logs found for task")
+
+// Config describes where task logs are kept and for how long.
+type Config struct {
+	// Dir is the directory holding the task log files
+	Dir string
+	// MaxSize is the size in bytes after which a log file is rotated, 0 for
+	// no rotation
+	MaxSize int64
+	// MaxFiles is the number of rotated files kept besides the current one
+	MaxFiles int
+	// Retention is how long log files are kept after their last write once
+	// the task is gone, 0 to keep them forever
+	Retention time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Dir:       filepath.Join(os.TempDir(), "o2-aliecs-tasklogs"),
+		MaxSize:   16 * 1024 * 1024,
+		MaxFiles:  4,
+		Retention: 72 * time.Hour,
+	}
+}
+
+// ConfigFromEnv returns the configuration passed by the core through the
+// executor environment, with defaults for anything unset or invalid.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	if v := os.Getenv(DIR_ENV); v != "" {
+		cfg.Dir = v
+	}
+	if v, err := strconv.ParseInt(os.Getenv(MAX_SIZE_ENV), 10, 64); err == nil && v >= 0 {
+		cfg.MaxSize = v
+	}
+	if v, err := strconv.Atoi(os.Getenv(MAX_FILES_ENV)); err == nil && v >= 0 {
+		cfg.MaxFiles = v
+	}
+	if v, err := time.ParseDuration(os.Getenv(RETENTION_ENV)); err == nil && v >= 0 {
+		cfg.Retention = v
+	}
+	return cfg
+}
+
+// Env returns the environment variables which make ConfigFromEnv return cfg.
+func (cfg Config) Env() map[string]string {
+	return map[string]string{
+		DIR_ENV:       cfg.Dir,
+		MAX_SIZE_ENV:  strconv.FormatInt(cfg.MaxSize, 10),
+		MAX_FILES_ENV: strconv.Itoa(cfg.MaxFiles),
+		RETENTION_ENV: cfg.Retention.String(),
+	}
+}
+
+func (cfg Config) path(taskId string) (string, error) {
+	if taskId == "" || taskId == "." || taskId == ".." || strings.ContainsAny(taskId, `/\`) {
+		return "", fmt.Errorf("invalid task ID %q", taskId)
+	}
+	return filepath.Join(cfg.Dir, taskId+fileSuffix), nil
+}
+
+func rotatedPath(path string, n int) string {
+	return fmt.Sprintf("%s.%d", path, n)
+}
+
+// Log is the output file of a single task. Writes never fail, so that a full
+// disk cannot break the capture of the task's output by other means.
+type Log struct {
+	mu     sync.Mutex
+	cfg    Config
+	path   string
+	file   *os.File
+	size   int64
+	failed bool
+}
+
+// Open creates or appends to the log file of the given task.
+func (cfg Config) Open(taskId string) (*Log, error) {
+	path, err := cfg.path(taskId)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(cfg.Dir, 0755); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return &Log{
+		cfg:  cfg,
+		path: path,
+		file: file,
+		size: info.Size(),
+	}, nil
+}
+
+// Writer returns a writer for one output stream of the task. Output is
+// written to the log one full line at a time, so that the lines of several
+// streams do not get mixed up.
+func (l *Log) Writer() io.WriteCloser {
+	return &lineWriter{log: l}
+}
+
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+func (l *Log) write(p []byte) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil || len(p) == 0 {
+		return
+	}
+
+	if l.cfg.MaxSize > 0 && l.size > 0 && l.size+int64(len(p)) > l.cfg.MaxSize {
+		if err := l.rotate(); err != nil {
+			l.fail(err)
+			return
+		}
+	}
+
+	n, err := l.file.Write(p)
+	l.size += int64(n)
+	if err != nil {
+		l.fail(err)
+	}
+}
+
+// rotate shifts <task>.log to <task>.log.1 and so on, dropping the oldest
+// file. It must be called with l.mu held.
+func (l *Log) rotate() error {
+	_ = l.file.Close()
+	l.file = nil
+
+	if l.cfg.MaxFiles > 0 {
+		_ = os.Remove(rotatedPath(l.path, l.cfg.MaxFiles))
+		for i := l.cfg.MaxFiles - 1; i >= 1; i-- {
+			_ = os.Rename(rotatedPath(l.path, i), rotatedPath(l.path, i+1))
+		}
+		if err := os.Rename(l.path, rotatedPath(l.path, 1)); err != nil {
+			return err
+		}
+	}
+
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	l.file = file
+	l.size = 0
+	return nil
+}
+
+// fail reports the first write error only, a failing disk would otherwise
+// flood the executor log. It must be called with l.mu held.
+func (l *Log) fail(err error) {
+	if l.failed {
+		return
+	}
+	l.failed = true
+	log.WithError(err).
+		WithField("path", l.path).
+		Warning("cannot write task log")
+}
+
+type lineWriter struct {
+	mu  sync.Mutex
+	log *Log
+	buf []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	if i := bytes.LastIndexByte(w.buf, '\n'); i >= 0 {
+		w.log.write(w.buf[:i+1])
+		w.buf = append(w.buf[:0], w.buf[i+1:]...)
+	}
+	if len(w.buf) > maxLineLength {
+		w.log.write(w.buf)
+		w.buf = w.buf[:0]
+	}
+	return len(p), nil
+}
+
+// Close flushes a trailing unterminated line, if any.
+func (w *lineWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.buf) > 0 {
+		w.log.write(append(w.buf, '\n'))
+		w.buf = nil
+	}
+	return nil
+}
+
+// Chunk is a piece of a task log returned by Read.
+type Chunk struct {
+	Content []byte
+	// NextOffset is the offset to pass to the next Read in follow mode
+	NextOffset int64
+}
+
+// Read returns part of the log of the given task. In follow mode it returns
+// what was written to the current log file since offset, starting over if the
+// file was rotated in the meantime. Otherwise it returns the last tail lines,
+// or as much of the end of the log as fits in a chunk if tail is 0.
+func (cfg Config) Read(taskId string, tail int, offset int64, follow bool) (chunk Chunk, err error) {
+	path, err := cfg.path(taskId)
+	if err != nil {
+		return
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			err = ErrNoLogs
+		}
+		return
+	}
+	size := info.Size()
+
+	if follow {
+		if offset < 0 || offset > size {
+			offset = 0
+		}
+		end := size
+		if end-offset > MAX_READ_SIZE {
+			end = offset + MAX_READ_SIZE
+		}
+		chunk.Content, err = readRange(path, offset, end)
+		chunk.NextOffset = offset + int64(len(chunk.Content))
+		return
+	}
+
+	chunk.Content, err = readTail(path, size)
+	if err != nil {
+		return
+	}
+	// not enough lines in the current file, so we prepend the latest rotated one
+	if tail > 0 && bytes.Count(chunk.Content, []byte{'\n'}) < tail && int64(len(chunk.Content)) == size {
+		rotated := rotatedPath(path, 1)
+		if rotatedInfo, statErr := os.Stat(rotated); statErr == nil {
+			previous, readErr := readTail(rotated, rotatedInfo.Size())
+			if readErr == nil {
+				chunk.Content = append(previous, chunk.Content...)
+			}
+		}
+	}
+	chunk.Content = lastLines(chunk.Content, tail)
+	if len(chunk.Content) > MAX_READ_SIZE {
+		chunk.Content = chunk.Content[len(chunk.Content)-MAX_READ_SIZE:]
+	}
+	chunk.NextOffset = size
+	return
+}
+
+func readRange(path string, start, end int64) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	buf := make([]byte, end-start)
+	n, err := file.ReadAt(buf, start)
+	if err == io.EOF {
+		err = nil
+	}
+	return buf[:n], err
+}
+
+// readTail returns at most MAX_READ_SIZE bytes from the end of a file,
+// starting at a line boundary unless the whole file is returned.
+func readTail(path string, size int64) ([]byte, error) {
+	start := size - MAX_READ_SIZE
+	if start < 0 {
+		start = 0
+	}
+	content, err := readRange(path, start, size)
+	if err != nil || start == 0 {
+		return content, err
+	}
+	if i := bytes.IndexByte(content, '\n'); i >= 0 {
+		content = content[i+1:]
+	}
+	return content, nil
+}
+
+func lastLines(content []byte, n int) []byte {
+	if n <= 0 {
+		return content
+	}
+	// a trailing newline ends the last line, it doesn't start a new one
+	end := len(content)
+	if end > 0 && content[end-1] == '\n' {
+		end--
+	}
+	for i := end - 1; i >= 0; i-- {
+		if content[i] == '\n' {
+			n--
+			if n == 0 {
+				return content[i+1:]
+			}
+		}
+	}
+	return content
+}
+
+// Cleanup removes the log files of tasks which are no longer active and
+// which have not been written to for longer than the retention period.
+func (cfg Config) Cleanup(isActive func(taskId string) bool) (removed int, err error) {
+	if cfg.Retention <= 0 {
+		return 0, nil
+	}
+	entries, err := os.ReadDir(cfg.Dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			err = nil
+		}
+		return 0, err
+	}
+
+	cutoff := time.Now().Add(-cfg.Retention)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		suffixAt := strings.Index(name, fileSuffix)
+		if suffixAt <= 0 {
+			continue
+		}
+		if isActive != nil && isActive(name[:suffixAt]) {
+			continue
+		}
+		info, infoErr := entry.Info()
+		if infoErr != nil || info.ModTime().After(cutoff) {
+			continue
+		}
+		if rmErr := os.Remove(filepath.Join(cfg.Dir, name)); rmErr == nil {
+			removed++
+		}
+	}
+	return removed, nil
+}
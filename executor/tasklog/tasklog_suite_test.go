@@ -0,0 +1,13 @@
+package tasklog
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTasklog(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Task Log Test Suite")
+}
@@ -0,0 +1,132 @@
+package tasklog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("task log", func() {
+	var cfg Config
+
+	BeforeEach(func() {
+		cfg = Config{
+			Dir:       GinkgoT().TempDir(),
+			MaxSize:   1024,
+			MaxFiles:  2,
+			Retention: time.Hour,
+		}
+	})
+
+	writeLines := func(taskId string, from, to int) {
+		l, err := cfg.Open(taskId)
+		Expect(err).NotTo(HaveOccurred())
+		w := l.Writer()
+		for i := from; i <= to; i++ {
+			_, _ = fmt.Fprintf(w, "line %03d\n", i)
+		}
+		Expect(w.Close()).To(Succeed())
+		Expect(l.Close()).To(Succeed())
+	}
+
+	It("keeps lines of different streams whole", func() {
+		l, err := cfg.Open("task1")
+		Expect(err).NotTo(HaveOccurred())
+		stdout, stderr := l.Writer(), l.Writer()
+		_, _ = stdout.Write([]byte("out"))
+		_, _ = stderr.Write([]byte("err line\n"))
+		_, _ = stdout.Write([]byte(" line\nunterminated"))
+		Expect(stdout.Close()).To(Succeed())
+		Expect(stderr.Close()).To(Succeed())
+		Expect(l.Close()).To(Succeed())
+
+		chunk, err := cfg.Read("task1", 0, 0, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(chunk.Content)).To(Equal("err line\nout line\nunterminated\n"))
+	})
+
+	It("returns the last lines, including rotated ones", func() {
+		// 9 bytes per line, 1024 bytes per file
+		writeLines("task1", 1, 150)
+		Expect(filepath.Join(cfg.Dir, "task1.log.1")).To(BeAnExistingFile())
+
+		chunk, err := cfg.Read("task1", 3, 0, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(chunk.Content)).To(Equal("line 148\nline 149\nline 150\n"))
+
+		chunk, err = cfg.Read("task1", 100, 0, false)
+		Expect(err).NotTo(HaveOccurred())
+		lines := strings.Split(strings.TrimSuffix(string(chunk.Content), "\n"), "\n")
+		Expect(lines).To(HaveLen(100))
+		Expect(lines[0]).To(Equal("line 051"))
+	})
+
+	It("drops the oldest rotated files", func() {
+		writeLines("task1", 1, 1000)
+		Expect(filepath.Join(cfg.Dir, "task1.log.2")).To(BeAnExistingFile())
+		Expect(filepath.Join(cfg.Dir, "task1.log.3")).NotTo(BeAnExistingFile())
+	})
+
+	It("follows the log from an offset and starts over after a rotation", func() {
+		writeLines("task1", 1, 10)
+		chunk, err := cfg.Read("task1", 5, 0, false)
+		Expect(err).NotTo(HaveOccurred())
+
+		writeLines("task1", 11, 12)
+		chunk, err = cfg.Read("task1", 0, chunk.NextOffset, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(chunk.Content)).To(Equal("line 011\nline 012\n"))
+
+		chunk, err = cfg.Read("task1", 0, chunk.NextOffset, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chunk.Content).To(BeEmpty())
+
+		offset := chunk.NextOffset
+		writeLines("task1", 13, 120)
+		chunk, err = cfg.Read("task1", 0, offset+100000, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.HasPrefix(string(chunk.Content), "line ")).To(BeTrue())
+	})
+
+	It("rejects task IDs which would escape the log directory", func() {
+		_, err := cfg.Read("../etc/passwd", 10, 0, false)
+		Expect(err).To(HaveOccurred())
+		_, err = cfg.Open("..")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("reports tasks without logs", func() {
+		_, err := cfg.Read("nosuchtask", 10, 0, false)
+		Expect(err).To(MatchError(ErrNoLogs))
+	})
+
+	It("removes logs of inactive tasks past retention", func() {
+		writeLines("old", 1, 200)
+		writeLines("active", 1, 1)
+		writeLines("recent", 1, 1)
+		past := time.Now().Add(-2 * time.Hour)
+		matches, _ := filepath.Glob(filepath.Join(cfg.Dir, "old.log*"))
+		Expect(len(matches)).To(BeNumerically(">", 1))
+		for _, m := range append(matches, filepath.Join(cfg.Dir, "active.log")) {
+			Expect(os.Chtimes(m, past, past)).To(Succeed())
+		}
+
+		removed, err := cfg.Cleanup(func(taskId string) bool { return taskId == "active" })
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removed).To(Equal(len(matches)))
+		Expect(filepath.Join(cfg.Dir, "active.log")).To(BeAnExistingFile())
+		Expect(filepath.Join(cfg.Dir, "recent.log")).To(BeAnExistingFile())
+	})
+
+	It("round-trips its configuration through the environment", func() {
+		for k, v := range cfg.Env() {
+			GinkgoT().Setenv(k, v)
+		}
+		Expect(ConfigFromEnv()).To(Equal(cfg))
+	})
+})
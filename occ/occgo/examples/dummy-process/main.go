@@ -0,0 +1,147 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Command dummy-process is the Go port of the OCC library dummy process
+// example: a task which does nothing but log its transitions and dump the
+// runtime configuration it receives, controllable by AliECS in
+// controlmode.DIRECT.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/occ/occgo"
+	"github.com/spf13/pflag"
+)
+
+// dummyProcess implements occgo.RuntimeControlledObject.
+type dummyProcess struct {
+	occgo.DefaultObject
+
+	// config, if set, is used instead of the runtime configuration pushed
+	// on CONFIGURE
+	config sm.EventArgs
+	// dumpDir is where the received configuration is written
+	dumpDir string
+}
+
+func logScope(name string) func() {
+	fmt.Printf("BEGIN function %s\n", name)
+	return func() { fmt.Printf("END function %s\n", name) }
+}
+
+func (d *dummyProcess) Configure(args sm.EventArgs) error {
+	defer logScope("Configure")()
+
+	if len(d.config) != 0 {
+		args = d.config
+	}
+	payload, err := json.MarshalIndent(args, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Printf("received runtime configuration:\n%s\n", payload)
+
+	// dump configuration payload to file
+	dumpPath := filepath.Join(d.dumpDir,
+		fmt.Sprintf("aliecs-dummyprocess-config-%s.json", time.Now().Format("20060102-1504")))
+	return os.WriteFile(dumpPath, payload, 0644)
+}
+
+func (d *dummyProcess) Reset() error {
+	defer logScope("Reset")()
+	return nil
+}
+
+func (d *dummyProcess) Recover() error {
+	defer logScope("Recover")()
+	return nil
+}
+
+func (d *dummyProcess) Start(runNumber uint32) error {
+	defer logScope("Start")()
+	fmt.Printf("starting run %d\n", runNumber)
+	return nil
+}
+
+func (d *dummyProcess) Stop() error {
+	defer logScope("Stop")()
+	return nil
+}
+
+func (d *dummyProcess) Exit() error {
+	defer logScope("Exit")()
+	return nil
+}
+
+func loadConfig(path string) (sm.EventArgs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := make(sm.EventArgs)
+	err = json.Unmarshal(data, &config)
+	return config, err
+}
+
+func main() {
+	controlPort := pflag.Uint64(occgo.CONTROL_PORT_ARG, 0, "OCC control port (default: $"+occgo.CONTROL_PORT_ENV+" or 47100)")
+	role := pflag.String(occgo.ROLE_ARG, "", "O² role (default: $"+occgo.ROLE_ENV+")")
+	configPath := pflag.String("config", "", "JSON file with a flat configuration object, used instead of the pushed configuration")
+	pflag.Parse()
+
+	csm := &dummyProcess{dumpDir: os.TempDir()}
+	if *configPath != "" {
+		config, err := loadConfig(*configPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "cannot load configuration: %s\n", err)
+			os.Exit(1)
+		}
+		csm.config = config
+	}
+
+	port, err := occgo.ControlPort(*controlPort)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	occ, err := occgo.NewInstance(csm, port)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("AliECS OCC Go test task, role %q\n", occgo.Role(*role))
+
+	// Block until DONE is reached:
+	if err = occ.Wait(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
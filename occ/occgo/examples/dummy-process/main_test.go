@@ -0,0 +1,48 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	"github.com/AliceO2Group/Control/occ/occgo"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+func TestDummyProcess(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OCC Go Dummy Process Test Suite")
+}
+
+var _ = Describe("dummy process", func() {
+	It("is controllable by the executor and dumps its configuration", func() {
+		csm := &dummyProcess{dumpDir: GinkgoT().TempDir()}
+		occ, err := occgo.NewInstance(csm, 0)
+		Expect(err).NotTo(HaveOccurred())
+		defer occ.Stop()
+
+		client := executorcmd.NewClient(occ.Port(), controlmode.DIRECT, executorcmd.ProtobufTransport,
+			logrus.NewEntry(logrus.StandardLogger()))
+		Expect(client).NotTo(BeNil())
+		defer client.Close()
+
+		for _, step := range [][3]string{
+			{"CONFIGURE", "STANDBY", "CONFIGURED"},
+			{"START", "CONFIGURED", "RUNNING"},
+			{"STOP", "RUNNING", "CONFIGURED"},
+			{"EXIT", "CONFIGURED", "DONE"},
+		} {
+			state, err := client.Transitioner.Commit(step[0], step[1], step[2], map[string]string{"runNumber": "42"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal(step[2]))
+		}
+		Expect(occ.Wait()).To(Succeed())
+
+		dumps, err := filepath.Glob(filepath.Join(csm.dumpDir, "aliecs-dummyprocess-config-*.json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dumps).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,131 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package occgo
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	// registers the "json" gRPC codec
+	_ "github.com/AliceO2Group/Control/executor/executorcmd/nopb"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"google.golang.org/grpc"
+)
+
+const (
+	// CHECK_INTERVAL is the period of the IterateRunning/IterateCheck loop,
+	// the same as in the C++ OCC library.
+	CHECK_INTERVAL = 1 * time.Millisecond
+	// SHUTDOWN_TIMEOUT bounds how long Wait lets open streams drain after
+	// DONE is reached.
+	SHUTDOWN_TIMEOUT = 5 * time.Second
+)
+
+// Instance is a running OCC gRPC server which drives a RuntimeControlledObject.
+//
+// The server accepts both the protobuf and the JSON gRPC codecs, on the
+// standard /occ_pb.Occ/<Method> paths. The executor's JSON client falls back to
+// these paths when the bare method names of OCClite are rejected, so Go tasks
+// can be declared with either transport.
+type Instance struct {
+	server     *server
+	grpcServer *grpc.Server
+	listener   net.Listener
+	serveErr   chan error
+}
+
+// NewInstance starts an OCC server for rco on the given port on all
+// interfaces, with the state machine in STANDBY. A port of 0 picks a free
+// port, see Port.
+func NewInstance(rco RuntimeControlledObject, controlPort uint64) (*Instance, error) {
+	if rco == nil {
+		return nil, fmt.Errorf("cannot start OCC instance without a RuntimeControlledObject")
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", controlPort))
+	if err != nil {
+		return nil, fmt.Errorf("cannot listen on control port %d: %w", controlPort, err)
+	}
+
+	i := &Instance{
+		server:     newServer(rco),
+		grpcServer: grpc.NewServer(),
+		listener:   listener,
+		serveErr:   make(chan error, 1),
+	}
+	pb.RegisterOccServer(i.grpcServer, i.server)
+
+	go i.server.runChecker(CHECK_INTERVAL)
+	go func() {
+		i.serveErr <- i.grpcServer.Serve(listener)
+	}()
+
+	log.WithField("port", i.Port()).Info("OCC gRPC server listening")
+	return i, nil
+}
+
+// Port returns the TCP port the OCC server listens on.
+func (i *Instance) Port() uint64 {
+	return uint64(i.listener.Addr().(*net.TCPAddr).Port)
+}
+
+// Done returns a channel which is closed once the state machine reaches DONE.
+func (i *Instance) Done() <-chan struct{} {
+	return i.server.done
+}
+
+// Wait blocks until the state machine reaches DONE, then stops the gRPC
+// server. It returns early with an error if the server stops serving before
+// DONE is reached.
+func (i *Instance) Wait() error {
+	select {
+	case <-i.server.done:
+	case err := <-i.serveErr:
+		return fmt.Errorf("OCC gRPC server stopped before reaching DONE: %w", err)
+	}
+	i.shutdown()
+	log.Info("OCC gRPC server stopped")
+	return nil
+}
+
+// Stop tears down the gRPC server regardless of the current state.
+func (i *Instance) Stop() {
+	i.server.doneOnce.Do(func() { close(i.server.done) })
+	i.shutdown()
+}
+
+func (i *Instance) shutdown() {
+	stopped := make(chan struct{})
+	go func() {
+		i.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(SHUTDOWN_TIMEOUT):
+		i.grpcServer.Stop()
+	}
+}
@@ -0,0 +1,139 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package occgo implements the O² Control and Configuration (OCC) interface
+// in pure Go, so that stateful Go processes can be controlled by AliECS in
+// controlmode.DIRECT without linking against the C++ OCC library.
+//
+// A task implements RuntimeControlledObject (usually by embedding
+// DefaultObject and overriding the methods it cares about), then passes it
+// to NewInstance and calls Wait:
+//
+//	port, err := occgo.ControlPort(flagValue)
+//	...
+//	occ, err := occgo.NewInstance(&myObject{}, port)
+//	...
+//	err = occ.Wait()
+package occgo
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DEFAULT_CONTROL_PORT = 47100
+	CONTROL_PORT_ARG     = "control-port"
+	CONTROL_PORT_ENV     = "OCC_CONTROL_PORT"
+	ROLE_ARG             = "o2-role"
+	ROLE_ENV             = "O2_ROLE"
+)
+
+var log = logger.New(logrus.StandardLogger(), "occgo")
+
+// ErrEndOfStream is returned by IterateRunning to signal that the task has
+// no more data to process. An END_OF_STREAM event is pushed to the
+// EventStream and IterateRunning is not called again until the next START.
+var ErrEndOfStream = errors.New("end of stream")
+
+// RuntimeControlledObject is the interface a task must implement in order to
+// be driven by the OCC state machine. It mirrors the C++ class of the same
+// name in occlib/RuntimeControlledObject.h.
+//
+// Transition methods are called with the state machine locked, so they never
+// run concurrently with each other nor with the Iterate methods. A non-nil
+// error moves the task to ERROR.
+type RuntimeControlledObject interface {
+	// Configure is called on STANDBY→CONFIGURED with the runtime
+	// configuration pushed by AliECS as flat key-value pairs. Payloads
+	// which the C++ library would parse into a property tree are passed
+	// under their plain key as raw JSON or YAML.
+	Configure(args sm.EventArgs) error
+	// Reset is called on CONFIGURED→STANDBY.
+	Reset() error
+	// Recover is called on ERROR→STANDBY.
+	Recover() error
+	// Start is called on CONFIGURED→RUNNING with the number of the run
+	// being started.
+	Start(runNumber uint32) error
+	// Stop is called on RUNNING→CONFIGURED.
+	Stop() error
+	// Exit is called on STANDBY, CONFIGURED or ERROR→DONE, just before the
+	// OCC server shuts down.
+	Exit() error
+
+	// IterateRunning is called periodically while RUNNING. It may return
+	// ErrEndOfStream to signal the end of data; any other error moves the
+	// task to ERROR.
+	IterateRunning() error
+	// IterateCheck is called periodically in every state except ERROR. An
+	// error moves the task to ERROR and pushes a TASK_INTERNAL_ERROR event.
+	IterateCheck() error
+}
+
+// DefaultObject provides no-op implementations of all RuntimeControlledObject
+// methods, meant to be embedded by tasks which only need some of them.
+type DefaultObject struct{}
+
+func (DefaultObject) Configure(sm.EventArgs) error { return nil }
+func (DefaultObject) Reset() error                 { return nil }
+func (DefaultObject) Recover() error               { return nil }
+func (DefaultObject) Start(uint32) error           { return nil }
+func (DefaultObject) Stop() error                  { return nil }
+func (DefaultObject) Exit() error                  { return nil }
+func (DefaultObject) IterateRunning() error        { return nil }
+func (DefaultObject) IterateCheck() error          { return nil }
+
+// ControlPort resolves the port the OCC server should listen on, following
+// the OCC convention: the value of the --control-port command line option if
+// non-zero, otherwise the OCC_CONTROL_PORT environment variable, otherwise
+// DEFAULT_CONTROL_PORT. The AliECS executor sets both for DIRECT tasks.
+func ControlPort(argValue uint64) (uint64, error) {
+	if argValue != 0 {
+		return argValue, nil
+	}
+	if envValue, ok := os.LookupEnv(CONTROL_PORT_ENV); ok && envValue != "" {
+		port, err := strconv.ParseUint(envValue, 10, 16)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s value %q: %w", CONTROL_PORT_ENV, envValue, err)
+		}
+		return port, nil
+	}
+	return DEFAULT_CONTROL_PORT, nil
+}
+
+// Role returns the O² role of this process, as passed via the --o2-role
+// command line option or the O2_ROLE environment variable.
+func Role(argValue string) string {
+	if argValue != "" {
+		return argValue
+	}
+	return os.Getenv(ROLE_ENV)
+}
@@ -0,0 +1,13 @@
+package occgo
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOccgo(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OCC Go Library Test Suite")
+}
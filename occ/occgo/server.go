@@ -0,0 +1,398 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package occgo
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/task/sm"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	subscriberQueueSize = 64
+	ptreeKeyPrefix      = "__ptree__:"
+)
+
+// expectedFinalState maps every OCC event to the state a successful
+// transition ends in, as in EXPECTED_FINAL_STATE of occlib/OccServer.h.
+var expectedFinalState = map[sm.Event]sm.State{
+	sm.CONFIGURE: sm.CONFIGURED,
+	sm.RESET:     sm.STANDBY,
+	sm.START:     sm.RUNNING,
+	sm.STOP:      sm.CONFIGURED,
+	sm.EXIT:      sm.DONE,
+	sm.GO_ERROR:  sm.ERROR,
+	sm.RECOVER:   sm.STANDBY,
+}
+
+// server implements pb.OccServer on top of a RuntimeControlledObject.
+type server struct {
+	pb.UnimplementedOccServer
+
+	rco RuntimeControlledObject
+
+	// mu serializes transitions and periodic iterations
+	mu        sync.Mutex
+	runNumber uint32
+	endOfData bool
+
+	// subMu guards the current state and the stream subscribers, so that a
+	// subscriber never misses a state change published after it subscribed
+	subMu     sync.Mutex
+	state     sm.State
+	stateSubs map[chan sm.State]struct{}
+	eventSubs map[chan pb.DeviceEventType]struct{}
+
+	done     chan struct{}
+	doneOnce sync.Once
+}
+
+func newServer(rco RuntimeControlledObject) *server {
+	return &server{
+		rco:       rco,
+		state:     sm.STANDBY,
+		stateSubs: make(map[chan sm.State]struct{}),
+		eventSubs: make(map[chan pb.DeviceEventType]struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+func (s *server) getState() sm.State {
+	s.subMu.Lock()
+	defer s.subMu.Unlock()
+	return s.state
+}
+
+// updateState sets the current state and publishes it to all StateStream
+// subscribers. Reaching DONE closes the done channel.
+func (s *server) updateState(newState sm.State) {
+	s.subMu.Lock()
+	s.state = newState
+	for ch := range s.stateSubs {
+		select {
+		case ch <- newState:
+		default:
+			log.WithField("state", newState.String()).
+				Warn("StateStream subscriber queue full, dropping state update")
+		}
+	}
+	s.subMu.Unlock()
+
+	log.WithField("state", newState.String()).Debug("state updated")
+
+	if newState == sm.DONE {
+		s.doneOnce.Do(func() { close(s.done) })
+	}
+}
+
+func (s *server) pushEvent(evt pb.DeviceEventType) {
+	s.subMu.Lock()
+	for ch := range s.eventSubs {
+		select {
+		case ch <- evt:
+		default:
+			log.WithField("event", evt.String()).
+				Warn("EventStream subscriber queue full, dropping event")
+		}
+	}
+	s.subMu.Unlock()
+
+	log.WithField("event", evt.String()).Debug("event pushed")
+}
+
+func (s *server) EventStream(_ *pb.EventStreamRequest, stream pb.Occ_EventStreamServer) error {
+	ch := make(chan pb.DeviceEventType, subscriberQueueSize)
+	s.subMu.Lock()
+	if s.state == sm.DONE {
+		s.subMu.Unlock()
+		return nil
+	}
+	s.eventSubs[ch] = struct{}{}
+	s.subMu.Unlock()
+	defer func() {
+		s.subMu.Lock()
+		delete(s.eventSubs, ch)
+		s.subMu.Unlock()
+	}()
+
+	send := func(evt pb.DeviceEventType) error {
+		return stream.Send(&pb.EventStreamReply{Event: &pb.DeviceEvent{Type: evt}})
+	}
+
+	for {
+		select {
+		case evt := <-ch:
+			if err := send(evt); err != nil {
+				return err
+			}
+		case <-s.done:
+			// flush whatever was pushed before reaching DONE
+			for {
+				select {
+				case evt := <-ch:
+					if err := send(evt); err != nil {
+						return err
+					}
+				default:
+					return nil
+				}
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func (s *server) StateStream(_ *pb.StateStreamRequest, stream pb.Occ_StateStreamServer) error {
+	ch := make(chan sm.State, subscriberQueueSize)
+	s.subMu.Lock()
+	if s.state == sm.DONE {
+		s.subMu.Unlock()
+		return stream.Send(&pb.StateStreamReply{Type: pb.StateType_STATE_STABLE, State: sm.DONE.String()})
+	}
+	s.stateSubs[ch] = struct{}{}
+	s.subMu.Unlock()
+	defer func() {
+		s.subMu.Lock()
+		delete(s.stateSubs, ch)
+		s.subMu.Unlock()
+	}()
+
+	send := func(newState sm.State) error {
+		return stream.Send(&pb.StateStreamReply{Type: pb.StateType_STATE_STABLE, State: newState.String()})
+	}
+
+	for {
+		select {
+		case newState := <-ch:
+			if err := send(newState); err != nil {
+				return err
+			}
+			// we're about to shut down, DONE is the last state we ever send
+			if newState == sm.DONE {
+				return nil
+			}
+		case <-s.done:
+			// DONE reached or instance stopped, flush what is left
+			for {
+				select {
+				case newState := <-ch:
+					if err := send(newState); err != nil {
+						return err
+					}
+				default:
+					return nil
+				}
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func (s *server) GetState(context.Context, *pb.GetStateRequest) (*pb.GetStateReply, error) {
+	return &pb.GetStateReply{
+		State: s.getState().String(),
+		Pid:   int32(os.Getpid()),
+	}, nil
+}
+
+func (s *server) Transition(_ context.Context, req *pb.TransitionRequest) (*pb.TransitionReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "null request received")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	currentState := s.getState()
+	if currentState == sm.DONE {
+		return nil, status.Error(codes.FailedPrecondition, "cannot transition from DONE state")
+	}
+	if !strings.EqualFold(req.GetSrcState(), currentState.String()) {
+		return nil, status.Errorf(codes.InvalidArgument, "transition not possible: state mismatch: source: %s current: %s",
+			req.GetSrcState(), currentState.String())
+	}
+
+	evt := sm.Event(strings.ToUpper(req.GetTransitionEvent()))
+	finalState, ok := expectedFinalState[evt]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown transition event %s", req.GetTransitionEvent())
+	}
+
+	args := make(sm.EventArgs, len(req.GetArguments()))
+	for _, entry := range req.GetArguments() {
+		args[unwrapPtreeKey(entry.GetKey())] = entry.GetValue()
+	}
+
+	newState := s.processTransition(evt, currentState, args)
+
+	var trigger pb.StateChangeTrigger
+	switch {
+	case newState == sm.ERROR:
+		trigger = pb.StateChangeTrigger_DEVICE_ERROR
+	case newState == finalState:
+		trigger = pb.StateChangeTrigger_EXECUTOR
+	default: // some other state, for whatever reason - we assume DEVICE_INTENTIONAL
+		trigger = pb.StateChangeTrigger_DEVICE_INTENTIONAL
+	}
+
+	return &pb.TransitionReply{
+		Trigger:         trigger,
+		State:           newState.String(),
+		TransitionEvent: req.GetTransitionEvent(),
+		Ok:              newState == finalState,
+	}, nil
+}
+
+// unwrapPtreeKey turns a __ptree__:<syntax>:<key> argument key, which the C++
+// OCC library parses into a property subtree, into the plain <key>. The value
+// is left as the raw JSON or YAML payload for the task to unmarshal.
+func unwrapPtreeKey(key string) string {
+	if !strings.HasPrefix(key, ptreeKeyPrefix) {
+		return key
+	}
+	parts := strings.SplitN(strings.TrimPrefix(key, ptreeKeyPrefix), ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return key
+	}
+	return parts[1]
+}
+
+// processTransition invokes the RuntimeControlledObject method for evt and
+// returns the resulting state. It must be called with s.mu held.
+func (s *server) processTransition(evt sm.Event, currentState sm.State, args sm.EventArgs) sm.State {
+	if rns, ok := args["runNumber"]; ok {
+		runNumber, err := strconv.ParseUint(rns, 10, 32)
+		if err != nil {
+			log.WithField("runNumber", rns).Warn("cannot parse run number")
+		} else {
+			s.runNumber = uint32(runNumber)
+		}
+	}
+
+	log.WithFields(logrus.Fields{
+		"event":     evt.String(),
+		"state":     currentState.String(),
+		"runNumber": s.runNumber,
+	}).Debug("processing event")
+
+	var (
+		newState sm.State
+		err      error
+	)
+	switch {
+	case evt == sm.EXIT && (currentState == sm.STANDBY || currentState == sm.CONFIGURED || currentState == sm.ERROR):
+		err = s.rco.Exit()
+		newState = sm.DONE
+	default:
+		t, ok := sm.TransitionFor(evt, currentState)
+		if !ok {
+			log.WithFields(logrus.Fields{
+				"event": evt.String(),
+				"state": currentState.String(),
+			}).Warn("invalid event received")
+			return currentState
+		}
+		newState = t.Dst
+		switch evt {
+		case sm.CONFIGURE:
+			err = s.rco.Configure(args)
+		case sm.RESET:
+			err = s.rco.Reset()
+		case sm.START:
+			s.endOfData = false
+			err = s.rco.Start(s.runNumber)
+		case sm.STOP:
+			err = s.rco.Stop()
+		case sm.RECOVER:
+			err = s.rco.Recover()
+		}
+	}
+
+	if err != nil {
+		log.WithError(err).
+			WithField("event", evt.String()).
+			Error("transition failed")
+		newState = sm.ERROR
+	}
+	s.updateState(newState)
+	return newState
+}
+
+// runChecker calls the periodic iteration methods of the
+// RuntimeControlledObject until DONE is reached.
+func (s *server) runChecker(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.iterate()
+		}
+	}
+}
+
+func (s *server) iterate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	currentState := s.getState()
+	if currentState == sm.DONE {
+		return
+	}
+
+	if currentState == sm.RUNNING && !s.endOfData {
+		err := s.rco.IterateRunning()
+		if err == ErrEndOfStream {
+			s.endOfData = true
+			s.pushEvent(pb.DeviceEventType_END_OF_STREAM)
+		} else if err != nil {
+			log.WithError(err).Error("IterateRunning failed")
+			s.updateState(sm.ERROR)
+		}
+	}
+
+	if s.getState() != sm.ERROR {
+		if err := s.rco.IterateCheck(); err != nil {
+			log.WithError(err).Error("IterateCheck failed")
+			// the state change is published on the StateStream, but we also push
+			// an event because the transition was initiated by the task
+			s.updateState(sm.ERROR)
+			s.pushEvent(pb.DeviceEventType_TASK_INTERNAL_ERROR)
+		}
+	}
+}
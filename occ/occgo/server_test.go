@@ -0,0 +1,270 @@
+package occgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type recordingObject struct {
+	DefaultObject
+
+	mu          sync.Mutex
+	calls       []string
+	config      sm.EventArgs
+	runNumber   uint32
+	failOn      string
+	endOfStream bool
+	checkError  error
+}
+
+func (r *recordingObject) record(call string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, call)
+	if r.failOn == call {
+		return fmt.Errorf("%s failed", call)
+	}
+	return nil
+}
+
+func (r *recordingObject) Configure(args sm.EventArgs) error {
+	r.mu.Lock()
+	r.config = args
+	r.mu.Unlock()
+	return r.record("Configure")
+}
+func (r *recordingObject) Reset() error   { return r.record("Reset") }
+func (r *recordingObject) Recover() error { return r.record("Recover") }
+func (r *recordingObject) Start(runNumber uint32) error {
+	r.mu.Lock()
+	r.runNumber = runNumber
+	r.mu.Unlock()
+	return r.record("Start")
+}
+func (r *recordingObject) Stop() error { return r.record("Stop") }
+func (r *recordingObject) Exit() error { return r.record("Exit") }
+func (r *recordingObject) IterateRunning() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.endOfStream {
+		return ErrEndOfStream
+	}
+	return nil
+}
+func (r *recordingObject) IterateCheck() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.checkError
+}
+
+func (r *recordingObject) getCalls() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.calls...)
+}
+
+var _ = Describe("OCC Go server", func() {
+	var (
+		rco    *recordingObject
+		occ    *Instance
+		client *executorcmd.RpcClient
+	)
+
+	BeforeEach(func() {
+		var err error
+		rco = &recordingObject{}
+		occ, err = NewInstance(rco, 0)
+		Expect(err).NotTo(HaveOccurred())
+		client = executorcmd.NewClient(occ.Port(), controlmode.DIRECT, executorcmd.ProtobufTransport,
+			logrus.NewEntry(logrus.StandardLogger()))
+		Expect(client).NotTo(BeNil())
+	})
+
+	AfterEach(func() {
+		_ = client.Close()
+		occ.Stop()
+	})
+
+	commit := func(evt, src, dst string, args map[string]string) (string, error) {
+		return client.Transitioner.Commit(evt, src, dst, args)
+	}
+
+	It("starts in STANDBY and reports its pid", func() {
+		reply, err := client.GetState(context.Background(), &pb.GetStateRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reply.GetState()).To(Equal("STANDBY"))
+		Expect(reply.GetPid()).To(BeNumerically(">", 0))
+	})
+
+	It("goes through the full lifecycle and delivers the runtime configuration", func() {
+		state, err := commit("CONFIGURE", "STANDBY", "CONFIGURED", map[string]string{
+			"chans.data.0.address":   "tcp://127.0.0.1:5555",
+			"__ptree__:json:payload": `{"a":1}`,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state).To(Equal("CONFIGURED"))
+		Expect(rco.config).To(HaveKeyWithValue("chans.data.0.address", "tcp://127.0.0.1:5555"))
+		Expect(rco.config).To(HaveKeyWithValue("payload", `{"a":1}`))
+
+		state, err = commit("START", "CONFIGURED", "RUNNING", map[string]string{"runNumber": "1234"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state).To(Equal("RUNNING"))
+		Expect(rco.runNumber).To(Equal(uint32(1234)))
+
+		state, err = commit("STOP", "RUNNING", "CONFIGURED", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state).To(Equal("CONFIGURED"))
+
+		state, err = commit("RESET", "CONFIGURED", "STANDBY", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state).To(Equal("STANDBY"))
+
+		state, err = commit("EXIT", "STANDBY", "DONE", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state).To(Equal("DONE"))
+		Eventually(occ.Done()).Should(BeClosed())
+		Expect(occ.Wait()).To(Succeed())
+
+		Expect(rco.getCalls()).To(Equal([]string{"Configure", "Start", "Stop", "Reset", "Exit"}))
+	})
+
+	It("rejects transitions from the wrong source state", func() {
+		_, err := commit("START", "CONFIGURED", "RUNNING", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("InvalidArgument"))
+		Expect(rco.getCalls()).To(BeEmpty())
+	})
+
+	It("leaves the state unchanged on events invalid in the current state", func() {
+		reply, err := client.Transition(context.Background(), &pb.TransitionRequest{
+			SrcState:        "STANDBY",
+			TransitionEvent: "START",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reply.GetOk()).To(BeFalse())
+		Expect(reply.GetState()).To(Equal("STANDBY"))
+		Expect(reply.GetTrigger()).To(Equal(pb.StateChangeTrigger_DEVICE_INTENTIONAL))
+	})
+
+	It("moves to ERROR when a transition fails and can recover", func() {
+		rco.failOn = "Configure"
+		state, err := commit("CONFIGURE", "STANDBY", "CONFIGURED", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(state).To(Equal("ERROR"))
+
+		state, err = commit("RECOVER", "ERROR", "STANDBY", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state).To(Equal("STANDBY"))
+	})
+
+	It("streams state changes and task events", func() {
+		states, err := client.StateStream(context.Background(), &pb.StateStreamRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		events, err := client.EventStream(context.Background(), &pb.EventStreamRequest{})
+		Expect(err).NotTo(HaveOccurred())
+
+		// make sure both streams are subscribed before anything happens
+		Eventually(func() int {
+			occ.server.subMu.Lock()
+			defer occ.server.subMu.Unlock()
+			return len(occ.server.stateSubs) + len(occ.server.eventSubs)
+		}).Should(Equal(2))
+
+		_, err = commit("CONFIGURE", "STANDBY", "CONFIGURED", nil)
+		Expect(err).NotTo(HaveOccurred())
+		stateReply, err := states.Recv()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stateReply.GetState()).To(Equal("CONFIGURED"))
+		Expect(stateReply.GetType()).To(Equal(pb.StateType_STATE_STABLE))
+
+		rco.mu.Lock()
+		rco.endOfStream = true
+		rco.mu.Unlock()
+		_, err = commit("START", "CONFIGURED", "RUNNING", nil)
+		Expect(err).NotTo(HaveOccurred())
+		eventReply, err := events.Recv()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(eventReply.GetEvent().GetType()).To(Equal(pb.DeviceEventType_END_OF_STREAM))
+
+		rco.mu.Lock()
+		rco.checkError = errors.New("something broke")
+		rco.mu.Unlock()
+		eventReply, err = events.Recv()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(eventReply.GetEvent().GetType()).To(Equal(pb.DeviceEventType_TASK_INTERNAL_ERROR))
+
+		Expect(states.Recv()).To(HaveField("State", "RUNNING"))
+		Expect(states.Recv()).To(HaveField("State", "ERROR"))
+	})
+
+	It("serves the JSON codec on the standard method paths", func() {
+		conn, err := grpc.Dial(fmt.Sprintf("127.0.0.1:%d", occ.Port()),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).NotTo(HaveOccurred())
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		reply := &pb.GetStateReply{}
+		err = conn.Invoke(ctx, pb.Occ_GetState_FullMethodName, &pb.GetStateRequest{}, reply,
+			grpc.CallContentSubtype("json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reply.GetState()).To(Equal("STANDBY"))
+	})
+
+	It("is controllable by the executor's JSON client", func() {
+		jsonClient := executorcmd.NewClient(occ.Port(), controlmode.DIRECT, executorcmd.JsonTransport,
+			logrus.NewEntry(logrus.StandardLogger()))
+		Expect(jsonClient).NotTo(BeNil())
+		defer jsonClient.Close()
+
+		reply, err := jsonClient.GetState(context.Background(), &pb.GetStateRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reply.GetState()).To(Equal("STANDBY"))
+		Expect(reply.GetPid()).To(BeNumerically(">", 0))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		states, err := jsonClient.StateStream(ctx, &pb.StateStreamRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(func() int {
+			occ.server.subMu.Lock()
+			defer occ.server.subMu.Unlock()
+			return len(occ.server.stateSubs)
+		}).Should(Equal(1))
+
+		state, err := jsonClient.Transitioner.Commit("CONFIGURE", "STANDBY", "CONFIGURED", map[string]string{"detector": "TST"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state).To(Equal("CONFIGURED"))
+		Expect(states.Recv()).To(HaveField("State", "CONFIGURED"))
+		Expect(rco.getCalls()).To(Equal([]string{"Configure"}))
+	})
+})
+
+var _ = Describe("OCC control port", func() {
+	It("prefers the argument, then the environment, then the default", func() {
+		GinkgoT().Setenv(CONTROL_PORT_ENV, "")
+		Expect(ControlPort(0)).To(Equal(uint64(DEFAULT_CONTROL_PORT)))
+
+		GinkgoT().Setenv(CONTROL_PORT_ENV, "47200")
+		Expect(ControlPort(0)).To(Equal(uint64(47200)))
+		Expect(ControlPort(47300)).To(Equal(uint64(47300)))
+
+		GinkgoT().Setenv(CONTROL_PORT_ENV, "notaport")
+		_, err := ControlPort(0)
+		Expect(err).To(HaveOccurred())
+	})
+})
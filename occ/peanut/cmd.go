@@ -0,0 +1,303 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package peanut
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Run runs peanut with the given command line arguments. Without a command,
+// it starts the interactive interface.
+func Run(args []string) error {
+	rootCmd := newRootCommand()
+	rootCmd.SetArgs(args)
+	return rootCmd.Execute()
+}
+
+func newRootCommand() *cobra.Command {
+	opts := connectionOptions{}
+
+	rootCmd := &cobra.Command{
+		Use:   "peanut",
+		Short: "process execution and control utility for OCClib-based O² processes",
+		Long: `peanut connects to a running OCClib-based process, queries its state, drives its state machine
+and pushes runtime configuration data.
+
+Without a command, peanut starts an interactive interface. The commands allow driving the
+process from scripts, and exit with a non-zero status on failure.
+
+If no control port is passed, the OCC_CONTROL_PORT environment variable is used.`,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTUI(opts)
+		},
+	}
+	rootCmd.PersistentFlags().Uint64VarP(&opts.port, "port", "p", 0, "control port of the process (default $"+CONTROL_PORT_ENV+")")
+	rootCmd.PersistentFlags().StringVar(&opts.controlMode, "control-mode", "direct", "control mode of the process: direct or fairmq")
+	rootCmd.PersistentFlags().StringVar(&opts.transport, "transport", "protobuf", "gRPC codec of the process: protobuf, or json for FairMQ devices with the OCClite plugin")
+	rootCmd.PersistentFlags().BoolVarP(&opts.verbose, "verbose", "v", false, "log RPC client activity to stderr")
+
+	rootCmd.AddCommand(
+		newStateCommand(&opts),
+		newTransitionCommand(&opts),
+		newWatchCommand(&opts),
+		newRunCommand(&opts),
+	)
+	return rootCmd
+}
+
+func newStateCommand(opts *connectionOptions) *cobra.Command {
+	var timeout time.Duration
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "print the current state of the process",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rpcClient, err := opts.connect()
+			if err != nil {
+				return err
+			}
+			defer rpcClient.Close()
+
+			currentState, err := getState(rpcClient, timeout)
+			if err != nil {
+				return fmt.Errorf("cannot get state: %w", err)
+			}
+			_, _ = fmt.Fprintln(cmd.OutOrStdout(), currentState)
+			return nil
+		},
+	}
+	cmd.Flags().DurationVar(&timeout, "timeout", DEFAULT_TIMEOUT, "how long to wait for the process to respond")
+	return cmd
+}
+
+func newTransitionCommand(opts *connectionOptions) *cobra.Command {
+	var (
+		configPath string
+		extraArgs  map[string]string
+		expect     string
+		timeout    time.Duration
+	)
+	cmd := &cobra.Command{
+		Use:   "transition EVENT",
+		Short: "perform a transition and print the resulting state",
+		Long: `Perform a transition of the process state machine and print the resulting state.
+
+EVENT is one of CONFIGURE, RESET, START, STOP, RECOVER, EXIT or GO_ERROR. Runtime configuration
+data is read from a JSON or YAML file and flattened in the same way as in the interactive interface.
+A START transition without an explicit runNumber argument is sent with a generated run number.`,
+		Example: `  peanut -p 47100 transition CONFIGURE --config cfg.yaml
+  peanut -p 47100 transition START --arg runNumber=42`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			evt := strings.ToUpper(args[0])
+			if _, ok := knownEvents[evt]; !ok {
+				return fmt.Errorf("unknown transition %s", args[0])
+			}
+
+			var configMap map[string]string
+			if len(configPath) > 0 {
+				var err error
+				if configMap, _, err = readConfigFile(configPath); err != nil {
+					return err
+				}
+			}
+
+			rpcClient, err := opts.connect()
+			if err != nil {
+				return err
+			}
+			defer rpcClient.Close()
+
+			src, err := getState(rpcClient, timeout)
+			if err != nil {
+				return fmt.Errorf("cannot get state: %w", err)
+			}
+			newState, err := commitTransition(rpcClient, evt, src, transitionArgs(evt, configMap, extraArgs), timeout)
+			if len(newState) > 0 {
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), newState)
+			}
+			if err != nil {
+				return fmt.Errorf("transition %s from %s failed: %w", evt, src, err)
+			}
+			if len(expect) > 0 && newState != expect {
+				return fmt.Errorf("transition %s from %s ended in %s, expected %s", evt, src, newState, expect)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&configPath, "config", "c", "", "runtime configuration file (JSON or YAML) to push")
+	cmd.Flags().StringToStringVarP(&extraArgs, "arg", "a", nil, "additional argument to push as KEY=VALUE, overrides the configuration file")
+	cmd.Flags().StringVar(&expect, "expect", "", "fail unless the process ends up in this state")
+	cmd.Flags().DurationVar(&timeout, "timeout", DEFAULT_TIMEOUT, "how long to wait for the transition to complete")
+	return cmd
+}
+
+func newWatchCommand(opts *connectionOptions) *cobra.Command {
+	var timeout time.Duration
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "print the events and state changes reported by the process",
+		Long: `Print the messages of the process's EventStream and StateStream, one per line, until interrupted,
+until the timeout expires or until the process closes both streams.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rpcClient, err := opts.connect()
+			if err != nil {
+				return err
+			}
+			defer rpcClient.Close()
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			if timeout > 0 {
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			return watch(ctx, rpcClient, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+	cmd.Flags().DurationVar(&timeout, "timeout", 0, "stop watching after this long (default: never)")
+	return cmd
+}
+
+func newRunCommand(opts *connectionOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run SCRIPT",
+		Short: "run a sequence of transitions and state checks from a YAML file",
+		Long: `Run a sequence of steps from a YAML script against the process, stopping with a non-zero exit
+status at the first step which fails, times out or ends in an unexpected state.
+
+Each step has exactly one of transition, expect or sleep. A transition step may also have
+config, a runtime configuration file relative to the script's directory, args, and expect,
+which defaults to the transition's destination state. An expect step without a transition
+waits until the process reports that state. Each step may override the script's timeout.`,
+		Example: `  timeout: 30s
+  steps:
+    - transition: CONFIGURE
+      config: cfg.yaml
+    - transition: START
+      args:
+        runNumber: "42"
+    - sleep: 10s
+    - expect: RUNNING
+      timeout: 5s
+    - transition: STOP
+    - transition: RESET
+    - transition: EXIT
+      expect: DONE`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := loadScript(args[0])
+			if err != nil {
+				return err
+			}
+
+			rpcClient, err := opts.connect()
+			if err != nil {
+				return err
+			}
+			defer rpcClient.Close()
+
+			return s.run(rpcClient, cmd.OutOrStdout())
+		},
+	}
+	return cmd
+}
+
+// watch prints the EventStream and StateStream messages of the process to
+// out until ctx is done or both streams end. A stream which the process does
+// not implement is reported to errOut and otherwise ignored.
+func watch(ctx context.Context, rpcClient *executorcmd.RpcClient, out io.Writer, errOut io.Writer) error {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+	printLine := func(format string, a ...interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+		_, _ = fmt.Fprintln(out, time.Now().Format("2006-01-02T15:04:05.000Z07:00"), fmt.Sprintf(format, a...))
+	}
+	streamEnded := func(name string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case err == nil || errors.Is(err, io.EOF) || ctx.Err() != nil:
+		case status.Code(err) == codes.Unimplemented:
+			_, _ = fmt.Fprintf(errOut, "%s not supported by the process\n", name)
+		case firstErr == nil:
+			firstErr = fmt.Errorf("%s failed: %w", name, err)
+		}
+	}
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		esc, err := rpcClient.EventStream(ctx, &pb.EventStreamRequest{})
+		for err == nil {
+			var reply *pb.EventStreamReply
+			if reply, err = esc.Recv(); err == nil {
+				printLine("event %s", reply.GetEvent().GetType().String())
+			}
+		}
+		streamEnded("EventStream", err)
+	}()
+	go func() {
+		defer wg.Done()
+		ssc, err := rpcClient.StateStream(ctx, &pb.StateStreamRequest{})
+		for err == nil {
+			var reply *pb.StateStreamReply
+			if reply, err = ssc.Recv(); err == nil {
+				deviceState := reply.GetState()
+				shown := fromDeviceState(rpcClient, deviceState)
+				if shown != deviceState {
+					shown += " (" + deviceState + ")"
+				}
+				printLine("state %s %s", shown, strings.TrimPrefix(reply.GetType().String(), "STATE_"))
+			}
+		}
+		streamEnded("StateStream", err)
+	}()
+	wg.Wait()
+	return firstErr
+}
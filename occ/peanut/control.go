@@ -0,0 +1,234 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package peanut
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/AliceO2Group/Control/occ/peanut/flatten"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	CONTROL_PORT_ENV = "OCC_CONTROL_PORT"
+	DEFAULT_TIMEOUT  = 30 * time.Second
+)
+
+// connectionOptions describes how to reach the controlled process, as set by
+// the persistent command line flags.
+type connectionOptions struct {
+	port        uint64
+	controlMode string
+	transport   string
+	verbose     bool
+}
+
+// connect dials the controlled process. If no port was passed, the
+// OCC_CONTROL_PORT environment variable (occ/OccGlobals.h) must be defined.
+func (o connectionOptions) connect() (*executorcmd.RpcClient, error) {
+	port := o.port
+	if port == 0 {
+		occPortString := os.Getenv(CONTROL_PORT_ENV)
+		if len(occPortString) == 0 {
+			return nil, fmt.Errorf("no control port passed and %s not defined", CONTROL_PORT_ENV)
+		}
+		var err error
+		port, err = strconv.ParseUint(occPortString, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %q: %w", CONTROL_PORT_ENV, occPortString, err)
+		}
+	}
+
+	var cm controlmode.ControlMode
+	switch strings.ToLower(o.controlMode) {
+	case "direct":
+		cm = controlmode.DIRECT
+	case "fairmq":
+		cm = controlmode.FAIRMQ
+	default:
+		return nil, fmt.Errorf("invalid control mode %q, must be direct or fairmq", o.controlMode)
+	}
+
+	var transport executorcmd.ControlTransport
+	switch strings.ToLower(o.transport) {
+	case "protobuf":
+		transport = executorcmd.ProtobufTransport
+	case "json":
+		transport = executorcmd.JsonTransport
+	default:
+		return nil, fmt.Errorf("invalid transport %q, must be protobuf or json", o.transport)
+	}
+
+	// The RPC client logs through logrus, which would garble the TUI and
+	// duplicate the errors we return, so we only let it through on request.
+	clientLogger := logrus.New()
+	if o.verbose {
+		clientLogger.SetOutput(os.Stderr)
+		clientLogger.SetLevel(logrus.DebugLevel)
+	} else {
+		clientLogger.SetOutput(io.Discard)
+	}
+
+	rpcClient := executorcmd.NewClient(port, cm, transport, clientLogger.WithField("id", ""))
+	if rpcClient == nil {
+		return nil, fmt.Errorf("cannot connect to control port %d", port)
+	}
+	return rpcClient, nil
+}
+
+// getState returns the current state of the controlled process, translated
+// into an O² state where the control mode allows it. FairMQ states which
+// have no O² equivalent are returned as is.
+func getState(rpcClient *executorcmd.RpcClient, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	response, err := rpcClient.GetState(ctx, &pb.GetStateRequest{})
+	if err != nil {
+		return "", err
+	}
+	return fromDeviceState(rpcClient, response.GetState()), nil
+}
+
+func fromDeviceState(rpcClient *executorcmd.RpcClient, deviceState string) string {
+	if state := rpcClient.FromDeviceState(deviceState); state != "" {
+		return state
+	}
+	return deviceState
+}
+
+// destinationState returns the state which a successful transition triggered
+// by evt leads to from src, or an empty string if the task state machine has
+// no such transition.
+func destinationState(evt string, src string) string {
+	if t, ok := sm.TransitionFor(sm.Event(evt), sm.StateFromString(src)); ok {
+		return t.Dst.String()
+	}
+	switch sm.Event(evt) {
+	case sm.EXIT:
+		return sm.DONE.String()
+	case sm.GO_ERROR:
+		return sm.ERROR.String()
+	}
+	return ""
+}
+
+// commitTransition performs a transition through the control mode's
+// transitioner, so that a single O² event is translated into the sequence of
+// FairMQ events it stands for where needed. Transitions which the task state
+// machine does not know are still sent, so that the process's handling of
+// invalid requests can be exercised. A timeout of 0 waits indefinitely.
+func commitTransition(rpcClient *executorcmd.RpcClient, evt string, src string, args map[string]string, timeout time.Duration) (newState string, err error) {
+	type result struct {
+		state string
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		state, err := rpcClient.Transitioner.Commit(evt, src, destinationState(evt, src), args)
+		done <- result{state: state, err: err}
+	}()
+
+	var timeoutCh <-chan time.Time
+	if timeout > 0 {
+		timeoutCh = time.After(timeout)
+	}
+
+	select {
+	case r := <-done:
+		newState, err = r.state, r.err
+	case <-timeoutCh:
+		return "", fmt.Errorf("transition %s timed out after %s", evt, timeout)
+	}
+	if err == nil && newState == "" {
+		err = fmt.Errorf("transition %s not possible from state %s", evt, src)
+	}
+	return
+}
+
+// transitionArgs builds the arguments pushed with a transition: the runtime
+// configuration, overridden by any explicitly passed arguments.
+func transitionArgs(evt string, config map[string]string, extra map[string]string) map[string]string {
+	args := make(map[string]string, len(config)+len(extra)+1)
+	for k, v := range config {
+		args[k] = v
+	}
+	for k, v := range extra {
+		args[k] = v
+	}
+
+	// We simulate a new run number on every START event
+	if _, ok := args["runNumber"]; evt == "START" && !ok {
+		args["runNumber"] = time.Now().Format("0102150405")
+	}
+	return args
+}
+
+// readConfigFile reads a JSON or YAML runtime configuration file and
+// flattens it into the key-value pairs pushed during CONFIGURE. It also
+// returns the flattened form as JSON text for display.
+func readConfigFile(configFilePath string) (configMap map[string]string, flattened string, err error) {
+	if len(configFilePath) == 0 {
+		return nil, "", fmt.Errorf("path empty")
+	}
+	yamlConfig, err := os.ReadFile(configFilePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot open configuration file: %w", err)
+	}
+	flattened, err = flatten.FlattenString(string(yamlConfig), "", flatten.DotStyle)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot prepare configuration file: %w", err)
+	}
+	// Flattened values keep their JSON type, but are pushed as strings
+	values := make(map[string]interface{})
+	decoder := json.NewDecoder(strings.NewReader(flattened))
+	decoder.UseNumber()
+	if err = decoder.Decode(&values); err != nil {
+		return nil, "", fmt.Errorf("cannot process configuration file: %w", err)
+	}
+	configMap = make(map[string]string, len(values))
+	for k, v := range values {
+		switch typed := v.(type) {
+		case nil:
+			configMap[k] = ""
+		case string:
+			configMap[k] = typed
+		default:
+			configMap[k] = fmt.Sprint(typed)
+		}
+	}
+	return configMap, flattened, nil
+}
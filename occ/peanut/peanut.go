@@ -27,22 +27,9 @@
 package peanut
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
-	"io/ioutil"
-	"os"
-	"strconv"
-	"time"
-
-	"github.com/AliceO2Group/Control/common/controlmode"
 	"github.com/AliceO2Group/Control/executor/executorcmd"
-	"github.com/AliceO2Group/Control/executor/protos"
-	"github.com/AliceO2Group/Control/occ/peanut/flatten"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
-	log "github.com/sirupsen/logrus"
-	"google.golang.org/grpc"
 )
 
 var (
@@ -51,6 +38,7 @@ var (
 	configMap      map[string]string
 	controlList    *tview.List
 	configTextView *tview.TextView
+	configPages    *tview.Pages
 	rpcClient      *executorcmd.RpcClient
 )
 
@@ -65,34 +53,22 @@ func modal(p tview.Primitive, width, height int) tview.Primitive {
 }
 
 func transition(evt string) error {
-	args := make([]*pb.ConfigEntry, 0)
-	for k, v := range configMap {
-		args = append(args, &pb.ConfigEntry{Key: k, Value: v})
+	if rpcClient == nil {
+		errorMessage("Cannot perform transition "+evt, "not connected")
+		return nil
 	}
 
-	// We simulate a new run number on every START event
-	if evt == "START" {
-		args = append(args, &pb.ConfigEntry{
-			Key:   "runNumber",
-			Value: time.Now().Format("0102150405"),
-		})
+	newState, err := commitTransition(rpcClient, evt, state, transitionArgs(evt, configMap, nil), 0)
+	if newState != "" {
+		state = newState
 	}
-
-	response, err := rpcClient.Transition(context.TODO(), &pb.TransitionRequest{
-		TransitionEvent: evt,
-		Arguments:       args,
-		SrcState:        state,
-	}, grpc.EmptyCallOption{})
 	if err != nil {
-		app.Stop()
-
-		fmt.Println(err.Error())
+		errorMessage("Transition "+evt+" failed", err.Error())
 		return err
 	}
 	if evt == "CONFIGURE" {
 		configTextView.SetTitle("runtime configuration (PUSHED)")
 	}
-	state = response.GetState()
 	return nil
 }
 
@@ -101,7 +77,7 @@ func drawStatus(screen tcell.Screen, x int, y int, width int, height int) (int,
 	return 0, 0, 0, 0
 }
 
-func acquireConfigFile(configPages *tview.Pages) error {
+func acquireConfigFile() error {
 	configInputFrame := tview.NewForm()
 	configInputFrame.SetTitle("file path for runtime configuration")
 	configInputFrame.SetBorder(true)
@@ -120,7 +96,7 @@ func acquireConfigFile(configPages *tview.Pages) error {
 		pathInput := pathItem.(*tview.InputField)
 		configFilePath := pathInput.GetText()
 		configCancelFunc()
-		loadConfig(configFilePath, configPages)
+		loadConfig(configFilePath)
 	})
 
 	configInputFrame.SetCancelFunc(configCancelFunc)
@@ -132,7 +108,7 @@ func acquireConfigFile(configPages *tview.Pages) error {
 	return nil
 }
 
-func errorMessage(configPages *tview.Pages, title string, text string) {
+func errorMessage(title string, text string) {
 	modalPage := tview.NewModal().SetText(title + "\n\nError: " + text).AddButtons([]string{"Ok"}).
 		SetDoneFunc(func(_ int, _ string) {
 			configPages.RemovePage("modal")
@@ -144,49 +120,22 @@ func errorMessage(configPages *tview.Pages, title string, text string) {
 	app.Draw()
 }
 
-func loadConfig(configFilePath string, configPages *tview.Pages) {
-	if len(configFilePath) == 0 {
-		errorMessage(configPages, "Cannot open configuration file", "path empty")
-		return
-	}
-	/*// Make sure we trim all variants
-	trimmed := strings.TrimPrefix(configFilePath, "file://")
-	trimmed = strings.TrimPrefix(trimmed, "file:/")
-	trimmed = strings.TrimPrefix(trimmed, "file:")
-	uri := "file://" + trimmed
-	cfg, err := configuration.NewConfiguration(uri)
+func loadConfig(configFilePath string) {
+	newConfigMap, flattened, err := readConfigFile(configFilePath)
 	if err != nil {
-		errorMessage(configPages, "Cannot open configuration file", err.Error())
-		return
-	}
-	yamlConfig, err := cfg.GetRecursiveYaml("")
-	if err != nil {
-		errorMessage(configPages, "Cannot parse configuration file", err.Error())
-		return
-	}*/
-	yamlConfig, err := ioutil.ReadFile(configFilePath)
-	if err != nil {
-		errorMessage(configPages, "Cannot open configuration file", err.Error())
-		return
-	}
-	flattened, err := flatten.FlattenString(string(yamlConfig), "", flatten.DotStyle)
-	if err != nil {
-		errorMessage(configPages, "Cannot prepare configuration file", err.Error())
+		errorMessage("Cannot load configuration file", err.Error())
 		return
 	}
 	configTextView.SetText(flattened)
 
 	configTextView.SetTitle("runtime configuration (NOT PUSHED)")
 
-	configMap = make(map[string]string)
-	err = json.Unmarshal([]byte(configTextView.GetText(false))[:], &configMap)
-	if err != nil {
-		errorMessage(configPages, "Cannot process configuration file", err.Error())
-		return
-	}
+	configMap = newConfigMap
 }
 
-func Run(cmdString string) (err error) {
+// runTUI starts the interactive interface, connected to the process described
+// by opts.
+func runTUI(opts connectionOptions) (err error) {
 	state = "UNKNOWN"
 
 	// Setup UI
@@ -195,7 +144,7 @@ func Run(cmdString string) (err error) {
 	statusBox := tview.NewBox().SetBorder(true).SetTitle("state")
 	configTextView = tview.NewTextView().SetChangedFunc(func() { app.Draw() })
 	configTextView.SetBorder(true).SetTitle("runtime configuration (EMPTY)")
-	configPages := tview.NewPages().
+	configPages = tview.NewPages().
 		AddPage("configBox", configTextView, true, true)
 
 	controlList = tview.NewList().
@@ -246,7 +195,7 @@ func Run(cmdString string) (err error) {
 			"read runtime configuration from file",
 			'l',
 			func() {
-				err = acquireConfigFile(configPages)
+				err = acquireConfigFile()
 			}).
 		AddItem("Quit",
 			"disconnect from the process and quit peanut",
@@ -263,41 +212,31 @@ func Run(cmdString string) (err error) {
 
 	statusBox.SetDrawFunc(drawStatus)
 
-	// Parse input
-	var occPort uint64
-	if len(cmdString) > 0 {
-		// RUN process
-	} else {
-		// If cmdString not passed, env var OCC_CONTROL_PORT (occ/OccGlobals.h) must be defined
-		occPortString := os.Getenv("OCC_CONTROL_PORT")
-		if len(occPortString) == 0 {
-			err = fmt.Errorf("OCC_CONTROL_PORT not defined")
-			return
-		}
-		occPort, err = strconv.ParseUint(occPortString, 10, 64)
-		if err != nil {
-			return
-		}
-	}
-
 	// Setup RPC
+	var connErr error
 	go func() {
-		// FIXME allow choice of controlmode.FAIRMQ
-		rpcClient = executorcmd.NewClient(
-			occPort,
-			controlmode.DIRECT,
-			executorcmd.ProtobufTransport,
-			log.WithField("id", ""))
-		var response *pb.GetStateReply
-		response, err = rpcClient.GetState(context.TODO(), &pb.GetStateRequest{}, grpc.EmptyCallOption{})
-		if err != nil {
-			app.Stop()
-			fmt.Println(err.Error())
-			return
+		client, err := opts.connect()
+		if err == nil {
+			var currentState string
+			// NOTE: we acquire the transitioner-dependent STANDBY equivalent state
+			currentState, err = getState(client, DEFAULT_TIMEOUT)
+			if err == nil {
+				app.QueueUpdateDraw(func() {
+					rpcClient = client
+					state = currentState
+				})
+				return
+			}
+			_ = client.Close()
 		}
-		// NOTE: we acquire the transitioner-dependent STANDBY equivalent state
-		state = rpcClient.FromDeviceState(response.GetState())
-		app.Draw()
+		connErr = err
+		app.Stop()
 	}()
-	return app.SetRoot(flex, true).SetFocus(controlList).Run()
+	if err = app.SetRoot(flex, true).SetFocus(controlList).Run(); err != nil {
+		return
+	}
+	if rpcClient != nil {
+		_ = rpcClient.Close()
+	}
+	return connErr
 }
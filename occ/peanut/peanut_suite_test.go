@@ -0,0 +1,13 @@
+package peanut
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPeanut(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Peanut Test Suite")
+}
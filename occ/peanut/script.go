@@ -0,0 +1,213 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package peanut
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	"gopkg.in/yaml.v3"
+)
+
+const WAIT_POLL_INTERVAL = 100 * time.Millisecond
+
+// script is a sequence of steps run against a controlled process by
+// `peanut run`. Each step either performs a transition, waits for the
+// process to reach a state, or pauses:
+//
+//	timeout: 30s              # default timeout for each step
+//	steps:
+//	  - transition: CONFIGURE
+//	    config: cfg.yaml      # relative to the script's directory
+//	  - transition: START
+//	    args:
+//	      runNumber: "42"
+//	  - sleep: 10s
+//	  - expect: RUNNING       # wait until the process is RUNNING
+//	    timeout: 5s
+//	  - transition: STOP
+//	    expect: CONFIGURED    # defaults to the transition's destination
+type script struct {
+	Timeout time.Duration `yaml:"timeout"`
+	Steps   []scriptStep  `yaml:"steps"`
+}
+
+type scriptStep struct {
+	Transition string            `yaml:"transition"`
+	Config     string            `yaml:"config"`
+	Args       map[string]string `yaml:"args"`
+	Expect     string            `yaml:"expect"`
+	Sleep      time.Duration     `yaml:"sleep"`
+	Timeout    time.Duration     `yaml:"timeout"`
+
+	configMap map[string]string
+}
+
+var knownEvents = map[string]struct{}{
+	sm.CONFIGURE.String(): {},
+	sm.RESET.String():     {},
+	sm.START.String():     {},
+	sm.STOP.String():      {},
+	sm.EXIT.String():      {},
+	sm.GO_ERROR.String():  {},
+	sm.RECOVER.String():   {},
+}
+
+// loadScript parses and validates a script file, and reads the runtime
+// configuration files it references, so that mistakes are reported before
+// the controlled process is touched.
+func loadScript(scriptPath string) (*script, error) {
+	data, err := os.ReadFile(scriptPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read script: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	s := &script{}
+	if err = decoder.Decode(s); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("cannot parse script %s: %w", scriptPath, err)
+	}
+	if len(s.Steps) == 0 {
+		return nil, fmt.Errorf("script %s has no steps", scriptPath)
+	}
+	if s.Timeout <= 0 {
+		s.Timeout = DEFAULT_TIMEOUT
+	}
+
+	for i := range s.Steps {
+		step := &s.Steps[i]
+		if err = step.validate(); err != nil {
+			return nil, fmt.Errorf("script %s step %d: %w", scriptPath, i+1, err)
+		}
+		if step.Timeout <= 0 {
+			step.Timeout = s.Timeout
+		}
+		if len(step.Config) == 0 {
+			continue
+		}
+		configPath := step.Config
+		if !filepath.IsAbs(configPath) {
+			configPath = filepath.Join(filepath.Dir(scriptPath), configPath)
+		}
+		if step.configMap, _, err = readConfigFile(configPath); err != nil {
+			return nil, fmt.Errorf("script %s step %d: %w", scriptPath, i+1, err)
+		}
+	}
+	return s, nil
+}
+
+func (step *scriptStep) validate() error {
+	switch {
+	case len(step.Transition) > 0:
+		if _, ok := knownEvents[step.Transition]; !ok {
+			return fmt.Errorf("unknown transition %s", step.Transition)
+		}
+		if step.Sleep != 0 {
+			return fmt.Errorf("a transition step cannot sleep")
+		}
+	case len(step.Config) > 0 || len(step.Args) > 0:
+		return fmt.Errorf("config and args are only valid in a transition step")
+	case len(step.Expect) > 0:
+		if step.Sleep != 0 {
+			return fmt.Errorf("a step cannot both sleep and expect a state")
+		}
+	case step.Sleep > 0:
+	default:
+		return fmt.Errorf("step must have one of transition, expect or sleep")
+	}
+	return nil
+}
+
+// run executes the script's steps in order, reporting progress to out, and
+// stops at the first step which fails or ends in an unexpected state.
+func (s *script) run(rpcClient *executorcmd.RpcClient, out io.Writer) error {
+	for i, step := range s.Steps {
+		prefix := fmt.Sprintf("[%d/%d]", i+1, len(s.Steps))
+		stepStart := time.Now()
+
+		switch {
+		case len(step.Transition) > 0:
+			src, err := getState(rpcClient, step.Timeout)
+			if err != nil {
+				return fmt.Errorf("%s cannot get state before %s: %w", prefix, step.Transition, err)
+			}
+			expected := step.Expect
+			if len(expected) == 0 {
+				expected = destinationState(step.Transition, src)
+			}
+
+			args := transitionArgs(step.Transition, step.configMap, step.Args)
+			newState, err := commitTransition(rpcClient, step.Transition, src, args, step.Timeout)
+			// A failed transition is acceptable if the script explicitly
+			// expects the state the process ended up in, e.g. to check that
+			// an invalid request is rejected.
+			if err != nil && (len(step.Expect) == 0 || newState != step.Expect) {
+				return fmt.Errorf("%s transition %s from %s failed: %w", prefix, step.Transition, src, err)
+			}
+			if len(expected) > 0 && newState != expected {
+				return fmt.Errorf("%s transition %s from %s ended in %s, expected %s", prefix, step.Transition, src, newState, expected)
+			}
+			_, _ = fmt.Fprintf(out, "%s %s: %s -> %s (%s)\n", prefix, step.Transition, src, newState, time.Since(stepStart).Round(time.Millisecond))
+
+		case len(step.Expect) > 0:
+			if err := waitForState(rpcClient, step.Expect, step.Timeout); err != nil {
+				return fmt.Errorf("%s %w", prefix, err)
+			}
+			_, _ = fmt.Fprintf(out, "%s reached %s (%s)\n", prefix, step.Expect, time.Since(stepStart).Round(time.Millisecond))
+
+		default:
+			time.Sleep(step.Sleep)
+			_, _ = fmt.Fprintf(out, "%s slept %s\n", prefix, step.Sleep)
+		}
+	}
+	return nil
+}
+
+// waitForState polls the controlled process until it reports the expected
+// state or the timeout expires.
+func waitForState(rpcClient *executorcmd.RpcClient, expected string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		current, err := getState(rpcClient, timeout)
+		if err != nil {
+			return fmt.Errorf("cannot get state: %w", err)
+		}
+		if current == expected {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("state is %s, expected %s within %s", current, expected, timeout)
+		}
+		time.Sleep(WAIT_POLL_INTERVAL)
+	}
+}
@@ -0,0 +1,134 @@
+package peanut
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	"github.com/AliceO2Group/Control/occ/occgo"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("peanut scripts", func() {
+	var dir string
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	Describe("loading", func() {
+		It("applies default timeouts and reads configuration files relative to the script", func() {
+			writeFile("cfg.yaml", "chans:\n  data:\n    rateLogging: 1\n    address: tcp://127.0.0.1:5555\n")
+			s, err := loadScript(writeFile("script.yaml", `
+steps:
+  - transition: CONFIGURE
+    config: cfg.yaml
+  - expect: CONFIGURED
+    timeout: 1s
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.Timeout).To(Equal(DEFAULT_TIMEOUT))
+			Expect(s.Steps).To(HaveLen(2))
+			Expect(s.Steps[0].Timeout).To(Equal(DEFAULT_TIMEOUT))
+			Expect(s.Steps[0].configMap).To(Equal(map[string]string{
+				"chans.data.rateLogging": "1",
+				"chans.data.address":     "tcp://127.0.0.1:5555",
+			}))
+			Expect(s.Steps[1].Timeout).To(Equal(time.Second))
+		})
+
+		DescribeTable("rejects invalid scripts",
+			func(content string, message string) {
+				_, err := loadScript(writeFile("script.yaml", content))
+				Expect(err).To(MatchError(ContainSubstring(message)))
+			},
+			Entry("no steps", "timeout: 1s\n", "has no steps"),
+			Entry("unknown field", "steps:\n  - transiton: START\n", "transiton"),
+			Entry("unknown transition", "steps:\n  - transition: BOOT\n", "unknown transition BOOT"),
+			Entry("empty step", "steps:\n  - timeout: 1s\n", "must have one of"),
+			Entry("args without transition", "steps:\n  - expect: RUNNING\n    args:\n      a: b\n", "only valid in a transition step"),
+			Entry("missing configuration file", "steps:\n  - transition: CONFIGURE\n    config: nope.yaml\n", "cannot open configuration file"),
+		)
+	})
+
+	Describe("running", func() {
+		var (
+			occ       *occgo.Instance
+			rpcClient *executorcmd.RpcClient
+			out       *bytes.Buffer
+		)
+
+		BeforeEach(func() {
+			var err error
+			occ, err = occgo.NewInstance(&occgo.DefaultObject{}, 0)
+			Expect(err).NotTo(HaveOccurred())
+			rpcClient, err = connectionOptions{port: occ.Port(), controlMode: "direct", transport: "protobuf"}.connect()
+			Expect(err).NotTo(HaveOccurred())
+			out = &bytes.Buffer{}
+		})
+
+		AfterEach(func() {
+			_ = rpcClient.Close()
+			occ.Stop()
+		})
+
+		run := func(content string) error {
+			s, err := loadScript(writeFile("script.yaml", content))
+			Expect(err).NotTo(HaveOccurred())
+			return s.run(rpcClient, out)
+		}
+
+		It("drives the process through its lifecycle", func() {
+			Expect(run(`
+timeout: 5s
+steps:
+  - transition: CONFIGURE
+  - transition: START
+  - expect: RUNNING
+  - transition: STOP
+  - transition: RESET
+  - transition: EXIT
+`)).To(Succeed())
+			Expect(out.String()).To(ContainSubstring("[1/6] CONFIGURE: STANDBY -> CONFIGURED"))
+			Expect(out.String()).To(ContainSubstring("[6/6] EXIT: STANDBY -> DONE"))
+			Eventually(occ.Done()).Should(BeClosed())
+		})
+
+		It("accepts a rejected transition when the resulting state is expected", func() {
+			Expect(run(`
+steps:
+  - transition: START
+    expect: STANDBY
+`)).To(Succeed())
+		})
+
+		It("fails on a rejected transition", func() {
+			err := run(`
+steps:
+  - transition: CONFIGURE
+  - transition: RECOVER
+  - transition: START
+`)
+			Expect(err).To(MatchError(ContainSubstring("[2/3] transition RECOVER from CONFIGURED failed")))
+			Expect(out.String()).NotTo(ContainSubstring("[3/3]"))
+		})
+
+		It("fails when the expected state is not reached in time", func() {
+			err := run(`
+steps:
+  - expect: RUNNING
+    timeout: 200ms
+`)
+			Expect(err).To(MatchError(ContainSubstring("state is STANDBY, expected RUNNING within 200ms")))
+		})
+	})
+})
@@ -27,6 +27,7 @@
 #include "litestructs/GetState.h"
 #include "litestructs/Transition.h"
 #include "litestructs/EventStream.h"
+#include "litestructs/StateStream.h"
 
 #include "util/Defer.h"
 #include "util/Logger.h"
@@ -68,6 +69,13 @@ OccLite::Service::Service(fair::mq::PluginServices* pluginServices)
         ::grpc::internal::RpcMethod::SERVER_STREAMING,
         eventStreamHandler);
     AddMethod(eventStream);
+
+    auto stateStreamHandler = new ::grpc::internal::ServerStreamingHandler<OccLite::Service, OccLite::nopb::StateStreamRequest, OccLite::nopb::StateStreamResponse>(&OccLite::Service::StateStream, this);
+    auto stateStream = new ::grpc::internal::RpcServiceMethod(
+        "StateStream",
+        ::grpc::internal::RpcMethod::SERVER_STREAMING,
+        stateStreamHandler);
+    AddMethod(stateStream);
 }
 
 ::grpc::Status OccLite::Service::GetState(::grpc::ServerContext* context,
@@ -164,3 +172,52 @@ OccLite::Service::EventStream(::grpc::ServerContext* context, const OccLite::nop
 }
 
 
+
+::grpc::Status
+OccLite::Service::StateStream(::grpc::ServerContext* context, const OccLite::nopb::StateStreamRequest* request,
+                              ::grpc::ServerWriter<OccLite::nopb::StateStreamResponse>* writer)
+{
+    (void) context;
+    (void) request;
+
+    std::mutex writer_mu;
+    std::condition_variable finished;
+    std::mutex finished_mu;
+    std::string last_known_state;
+
+    auto onDeviceStateChange = [&](fair::mq::PluginServices::DeviceState reachedState) {
+        std::lock_guard<std::mutex> lock(writer_mu);
+        auto state = fair::mq::PluginServices::ToStr(reachedState);
+        last_known_state = state;
+
+        nopb::StateStreamResponse response;
+        response.type = isIntermediateFMQState(state) ? nopb::STATE_INTERMEDIATE : nopb::STATE_STABLE;
+        response.state = state;
+
+        OLOG(debug) << "[StateStream] new state: " << state << "; type: " << response.type;
+
+        if (state != "EXITING") {
+            writer->Write(response);
+        } else {
+            std::unique_lock<std::mutex> finished_lk(finished_mu);
+            writer->WriteLast(response, grpc::WriteOptions());
+            finished.notify_one();
+        }
+    };
+
+    auto id = generateSubscriptionId("StateStream");
+
+    m_pluginServices->SubscribeToDeviceStateChange(id, onDeviceStateChange);
+    DEFER({
+        if (last_known_state == "EXITING") {
+            m_pluginServices->UnsubscribeFromDeviceStateChange(id);
+        }
+    });
+
+    {
+        std::unique_lock<std::mutex> lk(finished_mu);
+        finished.wait(lk);
+    }
+
+    return ::grpc::Status::OK;
+}
@@ -50,6 +50,8 @@ class TransitionRequest;
 class TransitionResponse;
 class EventStreamRequest;
 class EventStreamResponse;
+class StateStreamRequest;
+class StateStreamResponse;
 }
 
 class Service : public ::grpc::Service
@@ -64,6 +66,10 @@ public:
                                const nopb::EventStreamRequest* request,
                                ::grpc::ServerWriter<nopb::EventStreamResponse>* writer);
 
+    ::grpc::Status StateStream(::grpc::ServerContext* context,
+                               const nopb::StateStreamRequest* request,
+                               ::grpc::ServerWriter<nopb::StateStreamResponse>* writer);
+
     ::grpc::Status GetState(::grpc::ServerContext*,
                             const nopb::GetStateRequest*,
                             nopb::GetStateResponse*);
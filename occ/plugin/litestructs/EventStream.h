@@ -49,7 +49,8 @@ enum DeviceEventType : unsigned {
     NULL_DEVICE_EVENT = 0,
     END_OF_STREAM = 1,
     BASIC_TASK_TERMINATED = 2,
-    TASK_INTERNAL_ERROR = 3
+    TASK_INTERNAL_ERROR = 3,
+    DEVICE_STATE_CHANGED = 4
 };
 
 struct DeviceEvent : public JsonMessage
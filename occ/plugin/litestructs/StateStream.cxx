@@ -0,0 +1,58 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+#include "StateStream.h"
+
+bool OccLite::nopb::StateStreamRequest::Serialize(rapidjson::Writer<rapidjson::StringBuffer>* writer) const
+{
+    writer->StartObject();
+    writer->EndObject();
+    return true;
+}
+
+bool OccLite::nopb::StateStreamRequest::Deserialize(const rapidjson::Value& obj)
+{
+    return true;
+}
+
+bool OccLite::nopb::StateStreamResponse::Serialize(rapidjson::Writer<rapidjson::StringBuffer>* writer) const
+{
+    writer->StartObject();
+
+    writer->String("type");
+    writer->Uint(type);
+
+    writer->String("state");
+    writer->String(state);
+
+    writer->EndObject();
+    return true;
+}
+
+bool OccLite::nopb::StateStreamResponse::Deserialize(const rapidjson::Value& obj)
+{
+    type = static_cast<StateType>(obj["type"].GetUint());
+    state = obj["state"].GetString();
+    return true;
+}